@@ -93,6 +93,7 @@ import (
 	_ "github.com/clawscli/claws/custom/cloudtrail/trails"
 
 	// CloudWatch
+	_ "github.com/clawscli/claws/custom/cloudwatch/alarm-history"
 	_ "github.com/clawscli/claws/custom/cloudwatch/alarms"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-groups"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-streams"
@@ -114,6 +115,7 @@ import (
 	_ "github.com/clawscli/claws/custom/compute-optimizer/summary"
 
 	// Config
+	_ "github.com/clawscli/claws/custom/configservice/history"
 	_ "github.com/clawscli/claws/custom/configservice/rules"
 
 	// DataSync
@@ -149,6 +151,7 @@ import (
 
 	// ECS
 	_ "github.com/clawscli/claws/custom/ecs/clusters"
+	_ "github.com/clawscli/claws/custom/ecs/service-events"
 	_ "github.com/clawscli/claws/custom/ecs/services"
 	_ "github.com/clawscli/claws/custom/ecs/task-definitions"
 	_ "github.com/clawscli/claws/custom/ecs/tasks"
@@ -212,12 +215,14 @@ import (
 	_ "github.com/clawscli/claws/custom/inspector2/findings"
 
 	// Kinesis
+	_ "github.com/clawscli/claws/custom/kinesis/shards"
 	_ "github.com/clawscli/claws/custom/kinesis/streams"
 
 	// KMS
 	_ "github.com/clawscli/claws/custom/kms/keys"
 
 	// Lambda
+	_ "github.com/clawscli/claws/custom/lambda/event-source-mappings"
 	_ "github.com/clawscli/claws/custom/lambda/functions"
 
 	// License Manager
@@ -262,6 +267,7 @@ import (
 
 	// S3
 	_ "github.com/clawscli/claws/custom/s3/buckets"
+	_ "github.com/clawscli/claws/custom/s3/objects"
 
 	// S3 Vectors
 	_ "github.com/clawscli/claws/custom/s3vectors/buckets"
@@ -288,12 +294,15 @@ import (
 	_ "github.com/clawscli/claws/custom/sns/topics"
 
 	// SQS
+	_ "github.com/clawscli/claws/custom/sqs/move-tasks"
 	_ "github.com/clawscli/claws/custom/sqs/queues"
 
 	// Systems Manager
 	_ "github.com/clawscli/claws/custom/ssm/parameters"
+	_ "github.com/clawscli/claws/custom/ssm/paths"
 
 	// Step Functions
+	_ "github.com/clawscli/claws/custom/stepfunctions/execution-history"
 	_ "github.com/clawscli/claws/custom/stepfunctions/executions"
 	_ "github.com/clawscli/claws/custom/stepfunctions/state-machines"
 
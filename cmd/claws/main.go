@@ -78,6 +78,14 @@ func main() {
 
 	applyStartupConfig(opts, fileCfg, cfg)
 
+	if len(opts.args) > 0 && opts.args[0] == "snapshot" {
+		if err := runSnapshotCommand(context.Background(), opts.args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	ui.ApplyConfigWithOverride(fileCfg.GetTheme(), opts.theme)
 
 	// Validate and resolve startup service/resource
@@ -135,6 +143,7 @@ type cliOptions struct {
 	resourceID    string
 	theme         string
 	compactHeader *bool
+	args          []string // positional arguments (subcommands)
 }
 
 // parseFlags parses command line flags and returns options
@@ -213,6 +222,10 @@ func parseFlagsFromArgs(args []string) cliOptions {
 			showHelp = true
 		case "-v", "--version":
 			showVersion = true
+		default:
+			if !strings.HasPrefix(args[i], "-") {
+				opts.args = append(opts.args, args[i])
+			}
 		}
 	}
 
@@ -233,6 +246,8 @@ func printUsage() {
 	fmt.Println("claws - A terminal UI for AWS resource management")
 	fmt.Println()
 	fmt.Println("Usage: claws [options]")
+	fmt.Println("       claws [options] snapshot save <name> <service[/resource]>...")
+	fmt.Println("       claws snapshot list")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -p, --profile <name>[,name2,...]")
@@ -279,6 +294,8 @@ func printUsage() {
 	fmt.Println("  claws -s ec2 -i i-12345           Open detail view for instance i-12345")
 	fmt.Println("  claws -p dev,prod                 Query multiple profiles")
 	fmt.Println("  claws -r us-east-1,ap-northeast-1 Query multiple regions")
+	fmt.Println("  claws -p prod snapshot save friday ec2 lambda/functions")
+	fmt.Println("                                    Record an inventory snapshot without the TUI")
 	fmt.Println()
 	fmt.Println("Environment Variables:")
 	fmt.Println("  CLAWS_CONFIG=<path>      Use custom config file")
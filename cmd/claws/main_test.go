@@ -146,3 +146,15 @@ func TestParseFlags_ConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFlags_PositionalArgs(t *testing.T) {
+	opts := parseFlagsFromArgs([]string{"-p", "prod", "snapshot", "save", "friday", "ec2", "-r", "us-east-1"})
+
+	want := []string{"snapshot", "save", "friday", "ec2"}
+	if !slices.Equal(opts.args, want) {
+		t.Errorf("args = %v, want %v", opts.args, want)
+	}
+	if !slices.Equal(opts.profiles, []string{"prod"}) {
+		t.Errorf("profiles = %v, want [prod]", opts.profiles)
+	}
+}
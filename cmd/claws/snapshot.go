@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/snapshot"
+)
+
+// runSnapshotCommand runs the headless snapshot subcommands:
+//
+//	claws [-p profiles] [-r regions] snapshot save <name> <service[/resource]>...
+//	claws snapshot list
+func runSnapshotCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: claws snapshot save <name> <service[/resource]>... | claws snapshot list")
+	}
+
+	switch args[0] {
+	case "list":
+		infos, err := snapshot.List()
+		if err != nil {
+			return err
+		}
+		for _, info := range infos {
+			fmt.Printf("%-32s %s\n", info.Name, info.CreatedAt.Format("2006-01-02 15:04"))
+		}
+		return nil
+
+	case "save":
+		if len(args) < 3 {
+			return fmt.Errorf("usage: claws snapshot save <name> <service[/resource]>...")
+		}
+		name := args[1]
+		if !snapshot.IsValidName(name) {
+			return fmt.Errorf("invalid snapshot name: %q", name)
+		}
+		targets, err := snapshot.ParseTargets(registry.Global, args[2:])
+		if err != nil {
+			return err
+		}
+
+		s := snapshot.Capture(ctx, registry.Global, name, snapshot.CurrentScope(targets))
+		for _, e := range s.Errors {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", e)
+		}
+		// A snapshot with nothing in it because every list failed would
+		// look like an empty inventory
+		if len(s.Resources) == 0 && len(s.Errors) > 0 {
+			return fmt.Errorf("snapshot %s not saved: %d list(s) failed and no resources were captured", name, len(s.Errors))
+		}
+		path, err := snapshot.Save(s)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Saved snapshot %s: %d resources → %s\n", name, len(s.Resources), path)
+		if len(s.Errors) > 0 {
+			return fmt.Errorf("snapshot %s is incomplete: %d list(s) failed", name, len(s.Errors))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown snapshot command: %s", args[0])
+}
@@ -11,6 +11,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
 )
 
 // ActivityDAO provides data access for Auto Scaling activities
@@ -221,3 +222,25 @@ func (r *ActivityResource) Details() string {
 func (r *ActivityResource) ASGName() string {
 	return appaws.Str(r.Activity.AutoScalingGroupName)
 }
+
+// TimelineEvent implements timeline.Provider
+func (r *ActivityResource) TimelineEvent() timeline.Event {
+	summary := r.Description()
+	if msg := r.StatusMessage(); msg != "" {
+		summary += ": " + msg
+	}
+	subject := r.ASGName()
+	if subject == "" {
+		subject = r.AutoScalingGroupName
+	}
+	event := timeline.Event{
+		Source:   timeline.SourceAutoScaling,
+		Subject:  subject,
+		Summary:  summary,
+		Severity: timeline.SeverityFromStatus(r.StatusCode()),
+	}
+	if t := r.StartTimeT(); t != nil {
+		event.Time = *t
+	}
+	return event
+}
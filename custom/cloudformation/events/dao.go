@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
 )
 
 // EventDAO provides data access for CloudFormation stack events
@@ -130,3 +131,21 @@ func (r *EventResource) StatusReason() string {
 func (r *EventResource) PhysicalResourceId() string {
 	return appaws.Str(r.Item.PhysicalResourceId)
 }
+
+// TimelineEvent implements timeline.Provider
+func (r *EventResource) TimelineEvent() timeline.Event {
+	summary := appaws.Str(r.Item.LogicalResourceId) + " " + r.ResourceStatus()
+	if reason := r.StatusReason(); reason != "" {
+		summary += ": " + reason
+	}
+	event := timeline.Event{
+		Source:   timeline.SourceStack,
+		Subject:  appaws.Str(r.Item.StackName),
+		Summary:  summary,
+		Severity: timeline.SeverityFromStatus(r.ResourceStatus()),
+	}
+	if r.Item.Timestamp != nil {
+		event.Time = *r.Item.Timestamp
+	}
+	return event
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
 )
 
 // EventDAO provides data access for CloudTrail events.
@@ -42,13 +44,21 @@ func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
 }
 
 // ListPage returns a page of CloudTrail events for the last 24 hours.
+// With a ResourceName filter in the context, it returns the events that
+// reference that resource name or ARN over the 90 days LookupEvents covers.
 // Implements dao.PaginatedDAO interface.
 func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	resourceName := dao.GetFilterFromContext(ctx, "ResourceName")
+
 	// CloudTrail requires same StartTime/EndTime for pagination
 	// Reset time range on first page, reuse for subsequent pages
 	if pageToken == "" {
+		lookback := 24 * time.Hour
+		if resourceName != "" {
+			lookback = 90 * 24 * time.Hour
+		}
 		endTime := time.Now()
-		startTime := endTime.Add(-24 * time.Hour)
+		startTime := endTime.Add(-lookback)
 		d.paginationStartTime = &startTime
 		d.paginationEndTime = &endTime
 	}
@@ -64,6 +74,14 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 		EndTime:    d.paginationEndTime,
 		MaxResults: &maxResults,
 	}
+	if resourceName != "" {
+		input.LookupAttributes = []types.LookupAttribute{
+			{
+				AttributeKey:   types.LookupAttributeKeyResourceName,
+				AttributeValue: &resourceName,
+			},
+		}
+	}
 	if pageToken != "" {
 		input.NextToken = &pageToken
 	}
@@ -178,3 +196,27 @@ func (r *EventResource) CloudTrailEvent() string {
 func (r *EventResource) Resources() []types.Resource {
 	return r.Item.Resources
 }
+
+// TimelineEvent implements timeline.Provider.
+func (r *EventResource) TimelineEvent() timeline.Event {
+	subject := r.EventSource()
+	if len(r.Item.Resources) > 0 {
+		subject = appaws.Str(r.Item.Resources[0].ResourceName)
+	}
+	summary := r.EventName() + " by " + r.Username()
+
+	severity := timeline.SeverityInfo
+	var detail struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	if err := json.Unmarshal([]byte(r.CloudTrailEvent()), &detail); err == nil && detail.ErrorCode != "" {
+		summary += " (" + detail.ErrorCode + ")"
+		severity = timeline.SeverityWarning
+	}
+
+	event := timeline.Event{Source: timeline.SourceCloudTrail, Subject: subject, Summary: summary, Severity: severity}
+	if t := r.EventTime(); t != nil {
+		event.Time = *t
+	}
+	return event
+}
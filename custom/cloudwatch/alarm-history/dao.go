@@ -0,0 +1,182 @@
+package alarmhistory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
+)
+
+// AlarmHistoryDAO provides data access for CloudWatch alarm state changes
+type AlarmHistoryDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewAlarmHistoryDAO creates a new AlarmHistoryDAO
+func NewAlarmHistoryDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AlarmHistoryDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "alarm-history"),
+		client:  cloudwatch.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns state changes (first page only for backwards compatibility).
+// For paginated access, use ListPage instead.
+func (d *AlarmHistoryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of state changes of an alarm, newest first.
+// Implements dao.PaginatedDAO interface.
+func (d *AlarmHistoryDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	alarmName := dao.GetFilterFromContext(ctx, "AlarmName")
+	if alarmName == "" {
+		return nil, "", fmt.Errorf("AlarmName required: navigate from alarms using 'h' key")
+	}
+
+	maxRecords := int32(pageSize)
+	if maxRecords > 100 {
+		maxRecords = 100 // AWS API max
+	}
+
+	input := &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       &alarmName,
+		HistoryItemType: types.HistoryItemTypeStateUpdate,
+		ScanBy:          types.ScanByTimestampDescending,
+		MaxRecords:      &maxRecords,
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.DescribeAlarmHistory(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "describe alarm history %s", alarmName)
+	}
+
+	resources := make([]dao.Resource, len(output.AlarmHistoryItems))
+	for i, item := range output.AlarmHistoryItems {
+		resources[i] = NewAlarmHistoryResource(item)
+	}
+
+	nextToken := ""
+	if output.NextToken != nil {
+		nextToken = *output.NextToken
+	}
+
+	return resources, nextToken, nil
+}
+
+// Get is not supported: history items have no ID
+func (d *AlarmHistoryDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for alarm history")
+}
+
+// Delete is not supported for alarm history
+func (d *AlarmHistoryDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for alarm history")
+}
+
+// Supports returns supported operations
+func (d *AlarmHistoryDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// historyData is the part of HistoryData used for state updates
+type historyData struct {
+	OldState struct {
+		StateValue string `json:"stateValue"`
+	} `json:"oldState"`
+	NewState struct {
+		StateValue  string `json:"stateValue"`
+		StateReason string `json:"stateReason"`
+	} `json:"newState"`
+}
+
+// AlarmHistoryResource represents one state change of an alarm
+type AlarmHistoryResource struct {
+	dao.BaseResource
+	Item types.AlarmHistoryItem
+	data historyData
+}
+
+// NewAlarmHistoryResource creates a new AlarmHistoryResource
+func NewAlarmHistoryResource(item types.AlarmHistoryItem) *AlarmHistoryResource {
+	name := appaws.Str(item.AlarmName)
+	id := name
+	if item.Timestamp != nil {
+		id += "@" + item.Timestamp.Format(time.RFC3339)
+	}
+
+	r := &AlarmHistoryResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: name,
+			Data: item,
+		},
+		Item: item,
+	}
+	// HistoryData is documented JSON; keep going with the summary if it changes
+	_ = json.Unmarshal([]byte(appaws.Str(item.HistoryData)), &r.data)
+	return r
+}
+
+// Timestamp returns when the state changed
+func (r *AlarmHistoryResource) Timestamp() *time.Time {
+	return r.Item.Timestamp
+}
+
+// Summary returns the history summary, e.g. "Alarm updated from OK to ALARM"
+func (r *AlarmHistoryResource) Summary() string {
+	return appaws.Str(r.Item.HistorySummary)
+}
+
+// OldState returns the state before the change
+func (r *AlarmHistoryResource) OldState() string {
+	return r.data.OldState.StateValue
+}
+
+// NewState returns the state after the change
+func (r *AlarmHistoryResource) NewState() string {
+	return r.data.NewState.StateValue
+}
+
+// StateReason returns why the alarm changed state
+func (r *AlarmHistoryResource) StateReason() string {
+	return r.data.NewState.StateReason
+}
+
+// TimelineEvent implements timeline.Provider
+func (r *AlarmHistoryResource) TimelineEvent() timeline.Event {
+	summary := r.Summary()
+	if r.OldState() != "" && r.NewState() != "" {
+		summary = r.OldState() + " → " + r.NewState()
+	}
+	if reason := r.StateReason(); reason != "" {
+		summary += ": " + reason
+	}
+	event := timeline.Event{
+		Source:   timeline.SourceAlarm,
+		Subject:  r.GetName(),
+		Summary:  summary,
+		Severity: timeline.SeverityFromStatus(r.NewState()),
+	}
+	if t := r.Timestamp(); t != nil {
+		event.Time = *t
+	}
+	return event
+}
@@ -0,0 +1,20 @@
+package alarmhistory
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "alarm-history", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAlarmHistoryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAlarmHistoryRenderer()
+		},
+	})
+}
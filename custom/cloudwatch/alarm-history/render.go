@@ -0,0 +1,118 @@
+package alarmhistory
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// AlarmHistoryRenderer renders alarm state changes
+type AlarmHistoryRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAlarmHistoryRenderer creates a new AlarmHistoryRenderer
+func NewAlarmHistoryRenderer() render.Renderer {
+	return &AlarmHistoryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "alarm-history",
+			Cols: []render.Column{
+				{Name: "TIMESTAMP", Width: 20, Getter: getTimestamp},
+				{Name: "FROM", Width: 18, Getter: getOldState},
+				{Name: "TO", Width: 18, Getter: getNewState},
+				{Name: "REASON", Width: 60, Getter: getReason},
+			},
+		},
+	}
+}
+
+func getTimestamp(r dao.Resource) string {
+	h, ok := r.(*AlarmHistoryResource)
+	if !ok || h.Timestamp() == nil {
+		return ""
+	}
+	return h.Timestamp().Format("01-02 15:04:05")
+}
+
+func getOldState(r dao.Resource) string {
+	if h, ok := r.(*AlarmHistoryResource); ok {
+		return h.OldState()
+	}
+	return ""
+}
+
+func getNewState(r dao.Resource) string {
+	if h, ok := r.(*AlarmHistoryResource); ok {
+		return h.NewState()
+	}
+	return ""
+}
+
+func getReason(r dao.Resource) string {
+	if h, ok := r.(*AlarmHistoryResource); ok {
+		return h.StateReason()
+	}
+	return ""
+}
+
+// RenderDetail renders a state change
+func (r *AlarmHistoryRenderer) RenderDetail(resource dao.Resource) string {
+	h, ok := resource.(*AlarmHistoryResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Alarm State Change", h.GetName())
+
+	d.Section("State Change")
+	d.Field("Alarm", h.GetName())
+	if t := h.Timestamp(); t != nil {
+		d.Field("Timestamp", t.Format(time.RFC3339))
+	}
+	d.Field("From", h.OldState())
+	d.FieldStyled("To", h.NewState(), stateColorer(h.NewState()))
+	d.Field("Summary", h.Summary())
+
+	if reason := h.StateReason(); reason != "" {
+		d.Section("Reason")
+		d.Line("  " + reason)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *AlarmHistoryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	h, ok := resource.(*AlarmHistoryResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Alarm", Value: h.GetName()},
+		{Label: "To", Value: h.NewState(), Style: stateColorer(h.NewState())},
+	}
+	if t := h.Timestamp(); t != nil {
+		fields = append(fields, render.SummaryField{Label: "Time", Value: t.Format("2006-01-02 15:04:05")})
+	}
+	return fields
+}
+
+// stateColorer returns a style for an alarm state
+func stateColorer(state string) render.Style {
+	switch state {
+	case "ALARM":
+		return ui.DangerStyle()
+	case "OK":
+		return ui.SuccessStyle()
+	case "INSUFFICIENT_DATA":
+		return ui.WarningStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
@@ -0,0 +1,53 @@
+package alarmhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/clawscli/claws/internal/timeline"
+)
+
+func TestNewAlarmHistoryResource(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	item := types.AlarmHistoryItem{
+		AlarmName:       aws.String("cpu-high"),
+		Timestamp:       &now,
+		HistoryItemType: types.HistoryItemTypeStateUpdate,
+		HistorySummary:  aws.String("Alarm updated from OK to ALARM"),
+		HistoryData: aws.String(`{"version":"1.0","oldState":{"stateValue":"OK"},` +
+			`"newState":{"stateValue":"ALARM","stateReason":"Threshold Crossed: 1 datapoint [91.0] was greater than the threshold (80.0)."}}`),
+	}
+
+	r := NewAlarmHistoryResource(item)
+	if r.GetID() != "cpu-high@2026-01-01T10:00:00Z" {
+		t.Errorf("GetID() = %q", r.GetID())
+	}
+	if r.OldState() != "OK" || r.NewState() != "ALARM" {
+		t.Errorf("states = %s → %s, want OK → ALARM", r.OldState(), r.NewState())
+	}
+
+	event := r.TimelineEvent()
+	if event.Source != timeline.SourceAlarm || event.Subject != "cpu-high" || !event.Time.Equal(now) {
+		t.Errorf("TimelineEvent() = %+v", event)
+	}
+	if event.Severity != timeline.SeverityError {
+		t.Errorf("Severity = %d, want error", event.Severity)
+	}
+	if want := "OK → ALARM: Threshold Crossed"; event.Summary[:len(want)] != want {
+		t.Errorf("Summary = %q", event.Summary)
+	}
+}
+
+func TestNewAlarmHistoryResource_UnparsableData(t *testing.T) {
+	r := NewAlarmHistoryResource(types.AlarmHistoryItem{
+		AlarmName:      aws.String("cpu-high"),
+		HistorySummary: aws.String("Alarm updated from OK to ALARM"),
+		HistoryData:    aws.String("not json"),
+	})
+	if got := r.TimelineEvent().Summary; got != "Alarm updated from OK to ALARM" {
+		t.Errorf("Summary = %q, want history summary", got)
+	}
+}
@@ -259,3 +259,20 @@ func (r *AlarmResource) DimensionsStr() string {
 	}
 	return result
 }
+
+// DimensionValues returns the dimension values of the alarm's metrics,
+// such as instance IDs or function names, including metric math queries
+func (r *AlarmResource) DimensionValues() []string {
+	var values []string
+	for _, d := range r.Dimensions {
+		values = append(values, appaws.Str(d.Value))
+	}
+	for _, m := range r.Metrics {
+		if m.MetricStat != nil && m.MetricStat.Metric != nil {
+			for _, d := range m.MetricStat.Metric.Dimensions {
+				values = append(values, appaws.Str(d.Value))
+			}
+		}
+	}
+	return values
+}
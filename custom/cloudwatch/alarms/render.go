@@ -264,7 +264,16 @@ func (r *AlarmRenderer) Navigations(resource dao.Resource) []render.Navigation {
 		return nil
 	}
 
-	var navs []render.Navigation
+	navs := []render.Navigation{
+		{
+			Key:         "h",
+			Label:       "History",
+			Service:     "cloudwatch",
+			Resource:    "alarm-history",
+			FilterField: "AlarmName",
+			FilterValue: alarm.GetName(),
+		},
+	}
 
 	if len(alarm.AlarmActions) > 0 && strings.Contains(alarm.AlarmActions[0], ":sns:") {
 		navs = append(navs, render.Navigation{
@@ -0,0 +1,212 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ConfigItemDAO provides data access for the AWS Config configuration
+// history of one resource.
+type ConfigItemDAO struct {
+	dao.BaseDAO
+	client *configservice.Client
+}
+
+// NewConfigItemDAO creates a new ConfigItemDAO.
+func NewConfigItemDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ConfigItemDAO{
+		BaseDAO: dao.NewBaseDAO("configservice", "history"),
+		client:  configservice.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the most recent configuration items (first page only).
+// For paginated access, use ListPage instead.
+func (d *ConfigItemDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of configuration items, newest first.
+// Requires the ResourceType filter (e.g. AWS::EC2::SecurityGroup) and a
+// ResourceId or ResourceName filter.
+// Implements dao.PaginatedDAO interface.
+func (d *ConfigItemDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	resourceType := dao.GetFilterFromContext(ctx, "ResourceType")
+	resourceID, err := d.resolveResourceID(ctx, resourceType,
+		dao.GetFilterFromContext(ctx, "ResourceId"), dao.GetFilterFromContext(ctx, "ResourceName"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	// GetResourceConfigHistory Limit is capped at 100
+	limit := int32(min(pageSize, 100))
+	input := &configservice.GetResourceConfigHistoryInput{
+		ResourceType: types.ResourceType(resourceType),
+		ResourceId:   &resourceID,
+		Limit:        limit,
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.GetResourceConfigHistory(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "get config history %s %s", resourceType, resourceID)
+	}
+
+	resources := make([]dao.Resource, len(output.ConfigurationItems))
+	for i, item := range output.ConfigurationItems {
+		resources[i] = NewConfigItemResource(item)
+	}
+
+	nextToken := ""
+	if output.NextToken != nil {
+		nextToken = *output.NextToken
+	}
+	return resources, nextToken, nil
+}
+
+// resolveResourceID returns the resource ID AWS Config records the resource
+// under. It is often the claws ID, but some types (IAM roles, RDS
+// instances) are recorded by an internal ID, so fall back to the name.
+func (d *ConfigItemDAO) resolveResourceID(ctx context.Context, resourceType, id, name string) (string, error) {
+	if resourceType == "" || (id == "" && name == "") {
+		return "", fmt.Errorf("resource type and resource ID filters required")
+	}
+
+	lookups := []*configservice.ListDiscoveredResourcesInput{}
+	if id != "" {
+		lookups = append(lookups, &configservice.ListDiscoveredResourcesInput{
+			ResourceType: types.ResourceType(resourceType),
+			ResourceIds:  []string{id},
+		})
+	}
+	if name != "" {
+		lookups = append(lookups, &configservice.ListDiscoveredResourcesInput{
+			ResourceType: types.ResourceType(resourceType),
+			ResourceName: &name,
+		})
+	}
+
+	for _, input := range lookups {
+		output, err := d.client.ListDiscoveredResources(ctx, input)
+		if err != nil {
+			return "", apperrors.Wrapf(err, "list discovered %s", resourceType)
+		}
+		if len(output.ResourceIdentifiers) > 0 {
+			return appaws.Str(output.ResourceIdentifiers[0].ResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("%s %s is not recorded by AWS Config", resourceType, firstNonEmpty(id, name))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Get returns the configuration item with the given configuration state ID.
+func (d *ConfigItemDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	token := ""
+	for {
+		resources, next, err := d.ListPage(ctx, 100, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resources {
+			if r.GetID() == id {
+				return r, nil
+			}
+		}
+		if next == "" {
+			return nil, fmt.Errorf("configuration item not found: %s", id)
+		}
+		token = next
+	}
+}
+
+// Delete is not supported for configuration items.
+func (d *ConfigItemDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for config history")
+}
+
+// Supports returns supported operations
+func (d *ConfigItemDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ConfigItemResource wraps an AWS Config configuration item.
+type ConfigItemResource struct {
+	dao.BaseResource
+	Item          types.ConfigurationItem
+	configuration any
+}
+
+// NewConfigItemResource creates a new ConfigItemResource.
+func NewConfigItemResource(item types.ConfigurationItem) *ConfigItemResource {
+	r := &ConfigItemResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(item.ConfigurationStateId),
+			Name: appaws.Str(item.ResourceName),
+			ARN:  appaws.Str(item.Arn),
+			Tags: item.Tags,
+			Data: item,
+		},
+		Item: item,
+	}
+	if raw := appaws.Str(item.Configuration); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &r.configuration); err != nil {
+			log.Debug("cannot parse configuration item", "id", r.ID, "error", err)
+		}
+	}
+	return r
+}
+
+// CaptureTime returns when the configuration item was recorded.
+func (r *ConfigItemResource) CaptureTime() *time.Time {
+	return r.Item.ConfigurationItemCaptureTime
+}
+
+// Status returns the configuration item status (OK, ResourceDeleted, ...).
+func (r *ConfigItemResource) Status() string {
+	return string(r.Item.ConfigurationItemStatus)
+}
+
+// ResourceId returns the ID AWS Config records the resource under.
+func (r *ConfigItemResource) ResourceId() string {
+	return appaws.Str(r.Item.ResourceId)
+}
+
+// ResourceType returns the AWS Config resource type.
+func (r *ConfigItemResource) ResourceType() string {
+	return string(r.Item.ResourceType)
+}
+
+// Configuration returns the recorded configuration as decoded JSON, or nil.
+func (r *ConfigItemResource) Configuration() any {
+	return r.configuration
+}
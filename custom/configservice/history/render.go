@@ -0,0 +1,111 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ConfigItemRenderer renders AWS Config configuration items.
+type ConfigItemRenderer struct {
+	render.BaseRenderer
+}
+
+// NewConfigItemRenderer creates a new ConfigItemRenderer.
+func NewConfigItemRenderer() render.Renderer {
+	return &ConfigItemRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "configservice",
+			Resource: "history",
+			Cols: []render.Column{
+				{Name: "CAPTURE TIME", Width: 20, Getter: getCaptureTime},
+				{Name: "STATUS", Width: 28, Getter: getStatus},
+				{Name: "STATE ID", Width: 16, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "RESOURCE", Width: 40, Getter: getResourceName},
+			},
+		},
+	}
+}
+
+func getCaptureTime(r dao.Resource) string {
+	item, ok := r.(*ConfigItemResource)
+	if !ok {
+		return ""
+	}
+	if t := item.CaptureTime(); t != nil {
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	item, ok := r.(*ConfigItemResource)
+	if !ok {
+		return ""
+	}
+	return item.Status()
+}
+
+func getResourceName(r dao.Resource) string {
+	item, ok := r.(*ConfigItemResource)
+	if !ok {
+		return ""
+	}
+	if name := item.GetName(); name != "" {
+		return name
+	}
+	return item.ResourceId()
+}
+
+// RenderDetail renders the detail view for a configuration item.
+func (r *ConfigItemRenderer) RenderDetail(resource dao.Resource) string {
+	item, ok := resource.(*ConfigItemResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Configuration Item", getCaptureTime(item))
+
+	d.Section("Basic Information")
+	d.Field("Resource Type", item.ResourceType())
+	d.Field("Resource ID", item.ResourceId())
+	d.FieldIf("Resource Name", item.Item.ResourceName)
+	d.FieldIf("ARN", item.Item.Arn)
+	d.Field("Status", item.Status())
+	d.Field("State ID", item.GetID())
+	d.Field("Capture Time", getCaptureTime(item))
+	d.FieldIf("Version", item.Item.Version)
+	d.FieldIf("Account ID", item.Item.AccountId)
+	d.FieldIf("Region", item.Item.AwsRegion)
+
+	d.Tags(item.GetTags())
+
+	if raw := appaws.Str(item.Item.Configuration); raw != "" {
+		d.Section("Configuration")
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, []byte(raw), "", "  "); err != nil {
+			d.Line(raw)
+		} else {
+			d.Line(buf.String())
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a configuration item.
+func (r *ConfigItemRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	item, ok := resource.(*ConfigItemResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Resource", Value: item.ResourceType() + " " + item.ResourceId()},
+		{Label: "Status", Value: item.Status()},
+		{Label: "Captured", Value: getCaptureTime(item)},
+	}
+}
@@ -172,6 +172,12 @@ func (r *TableResource) LSICount() int {
 	return len(r.Item.LocalSecondaryIndexes)
 }
 
+// TableDescription returns the table description, which the item explorer
+// reads keys and indexes from
+func (r *TableResource) TableDescription() types.TableDescription {
+	return r.Item
+}
+
 // KeySchema returns the key schema
 func (r *TableResource) KeySchema() []types.KeySchemaElement {
 	return r.Item.KeySchema
@@ -315,3 +315,13 @@ func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *TableRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	if _, ok := dao.UnwrapResource(resource).(*TableResource); !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "i", Label: "Items", ViewType: render.ViewTypeItemExplorer},
+	}
+}
@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ render.Navigator          = (*InstanceRenderer)(nil)
-	_ render.MetricSpecProvider = (*InstanceRenderer)(nil)
+	_ render.Navigator           = (*InstanceRenderer)(nil)
+	_ render.MetricSpecProvider  = (*InstanceRenderer)(nil)
+	_ render.MetricChartProvider = (*InstanceRenderer)(nil)
 )
 
 // InstanceRenderer renders EC2 instances with custom columns
@@ -370,13 +371,24 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 	return navs
 }
 
-func (r *InstanceRenderer) MetricSpec() *render.MetricSpec {
-	return &render.MetricSpec{
-		Namespace:     "AWS/EC2",
-		MetricName:    "CPUUtilization",
-		DimensionName: "InstanceId",
-		Stat:          "Average",
-		ColumnHeader:  "CPU(15m)",
-		Unit:          "%",
+func (r *InstanceRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{
+		{
+			Namespace:     "AWS/EC2",
+			MetricName:    "CPUUtilization",
+			DimensionName: "InstanceId",
+			Stat:          "Average",
+			ColumnHeader:  "CPU(15m)",
+			Unit:          "%",
+		},
+	}
+}
+
+func (r *InstanceRenderer) MetricCharts() []render.MetricSpec {
+	return []render.MetricSpec{
+		{Namespace: "AWS/EC2", MetricName: "CPUUtilization", DimensionName: "InstanceId", Stat: "Average", Unit: "%"},
+		{Namespace: "AWS/EC2", MetricName: "NetworkIn", DimensionName: "InstanceId", Stat: "Average", Unit: "B"},
+		{Namespace: "AWS/EC2", MetricName: "NetworkOut", DimensionName: "InstanceId", Stat: "Average", Unit: "B"},
+		{Namespace: "AWS/EC2", MetricName: "StatusCheckFailed", DimensionName: "InstanceId", Stat: "Maximum"},
 	}
 }
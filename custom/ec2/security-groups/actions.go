@@ -19,6 +19,7 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "DeleteSecurityGroup",
 			Confirm:   action.ConfirmDangerous,
+			Plan:      planDeleteSecurityGroup,
 		},
 	})
 
@@ -45,7 +46,7 @@ func executeDeleteSecurityGroup(ctx context.Context, resource dao.Resource) acti
 		GroupId: &groupID,
 	})
 	if err != nil {
-		return action.ActionResult{Success: false, Error: fmt.Errorf("delete security group: %w", err)}
+		return action.FailResultf(err, "delete security group %s", groupID)
 	}
 
 	return action.ActionResult{
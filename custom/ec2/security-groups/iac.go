@@ -0,0 +1,165 @@
+package securitygroups
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("ec2", "security-groups", generateSecurityGroup)
+}
+
+// sgRule is one source or destination of a rule. AWS groups the sources
+// of a port range; both Terraform and CloudFormation need one per source
+// to keep per-source descriptions.
+type sgRule struct {
+	protocol    string
+	from, to    *int32
+	cidr        string
+	cidrIPv6    string
+	groupID     string
+	groupOwner  string
+	prefixList  string
+	description *string
+}
+
+func expandRules(perms []types.IpPermission) []sgRule {
+	var rules []sgRule
+	for _, p := range perms {
+		base := sgRule{protocol: appaws.Str(p.IpProtocol), from: p.FromPort, to: p.ToPort}
+		for _, r := range p.IpRanges {
+			rule := base
+			rule.cidr, rule.description = appaws.Str(r.CidrIp), r.Description
+			rules = append(rules, rule)
+		}
+		for _, r := range p.Ipv6Ranges {
+			rule := base
+			rule.cidrIPv6, rule.description = appaws.Str(r.CidrIpv6), r.Description
+			rules = append(rules, rule)
+		}
+		for _, r := range p.UserIdGroupPairs {
+			rule := base
+			rule.groupID, rule.groupOwner, rule.description = appaws.Str(r.GroupId), appaws.Str(r.UserId), r.Description
+			rules = append(rules, rule)
+		}
+		for _, r := range p.PrefixListIds {
+			rule := base
+			rule.prefixList, rule.description = appaws.Str(r.PrefixListId), r.Description
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func generateSecurityGroup(res dao.Resource) (*iac.Block, error) {
+	sg, ok := res.(*SecurityGroupResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	groupID := sg.GetID()
+	name := sg.GetName()
+	owner := appaws.Str(sg.Item.OwnerId)
+	ingress := expandRules(sg.Item.IpPermissions)
+	egress := expandRules(sg.Item.IpPermissionsEgress)
+
+	tf := iac.NewBody().
+		Set("name", name).
+		Set("description", sg.Description()).
+		Set("vpc_id", sg.VpcID())
+	for _, rule := range ingress {
+		terraformRule(tf.Block("ingress"), rule, groupID, owner)
+	}
+	for _, rule := range egress {
+		terraformRule(tf.Block("egress"), rule, groupID, owner)
+	}
+	tf.Set("tags", iac.UserTags(sg.GetTags()))
+
+	var cfnIngress, cfnEgress []any
+	for _, rule := range ingress {
+		cfnIngress = append(cfnIngress, cloudFormationRule(rule, false, owner))
+	}
+	for _, rule := range egress {
+		cfnEgress = append(cfnEgress, cloudFormationRule(rule, true, owner))
+	}
+	props := iac.NewBody().
+		Set("GroupName", name).
+		Set("GroupDescription", sg.Description()).
+		Set("VpcId", sg.VpcID()).
+		Set("SecurityGroupIngress", cfnIngress).
+		Set("SecurityGroupEgress", cfnEgress).
+		Set("Tags", iac.CloudFormationTags(sg.GetTags()))
+
+	block := &iac.Block{
+		Name: fmt.Sprintf("Security group %s (%s)", name, groupID),
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_security_group",
+			Name:     iac.LocalName(name),
+			ImportID: groupID,
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::EC2::SecurityGroup",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("GroupId", groupID),
+			Properties: props,
+		},
+	}
+	if name == "default" {
+		block.Notes = append(block.Notes, "default groups cannot be created; use aws_default_security_group in Terraform")
+	}
+	return block, nil
+}
+
+func terraformRule(b *iac.Body, rule sgRule, groupID, owner string) {
+	from, to := int32(0), int32(0)
+	if rule.protocol != "-1" {
+		from, to = appaws.Int32(rule.from), appaws.Int32(rule.to)
+	}
+	b.Set("from_port", from).Set("to_port", to).Set("protocol", rule.protocol)
+	switch {
+	case rule.cidr != "":
+		b.Set("cidr_blocks", []string{rule.cidr})
+	case rule.cidrIPv6 != "":
+		b.Set("ipv6_cidr_blocks", []string{rule.cidrIPv6})
+	case rule.groupID == groupID:
+		b.Set("self", true)
+	case rule.groupOwner != "" && rule.groupOwner != owner:
+		// Groups of other accounts are written owner/group
+		b.Set("security_groups", []string{rule.groupOwner + "/" + rule.groupID})
+	case rule.groupID != "":
+		b.Set("security_groups", []string{rule.groupID})
+	case rule.prefixList != "":
+		b.Set("prefix_list_ids", []string{rule.prefixList})
+	}
+	b.Set("description", rule.description)
+}
+
+func cloudFormationRule(rule sgRule, egress bool, owner string) *iac.Body {
+	b := iac.NewBody().Set("IpProtocol", rule.protocol)
+	if rule.protocol != "-1" {
+		b.Set("FromPort", rule.from).Set("ToPort", rule.to)
+	}
+	switch {
+	case rule.cidr != "":
+		b.Set("CidrIp", rule.cidr)
+	case rule.cidrIPv6 != "":
+		b.Set("CidrIpv6", rule.cidrIPv6)
+	case rule.groupID != "" && egress:
+		b.Set("DestinationSecurityGroupId", rule.groupID)
+	case rule.groupID != "":
+		b.Set("SourceSecurityGroupId", rule.groupID)
+		if rule.groupOwner != owner {
+			b.Set("SourceSecurityGroupOwnerId", rule.groupOwner)
+		}
+	case rule.prefixList != "" && egress:
+		b.Set("DestinationPrefixListId", rule.prefixList)
+	case rule.prefixList != "":
+		b.Set("SourcePrefixListId", rule.prefixList)
+	}
+	return b.Set("Description", rule.description)
+}
@@ -0,0 +1,98 @@
+package securitygroups
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func TestGenerateSecurityGroup(t *testing.T) {
+	sg := NewSecurityGroupResource(types.SecurityGroup{
+		GroupId:     aws.String("sg-111"),
+		GroupName:   aws.String("web"),
+		Description: aws.String("Web servers"),
+		VpcId:       aws.String("vpc-1"),
+		OwnerId:     aws.String("123456789012"),
+		IpPermissions: []types.IpPermission{{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int32(443),
+			ToPort:     aws.Int32(443),
+			IpRanges: []types.IpRange{
+				{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("internal")},
+				{CidrIp: aws.String("192.168.0.0/16")},
+			},
+			UserIdGroupPairs: []types.UserIdGroupPair{
+				{GroupId: aws.String("sg-111"), UserId: aws.String("123456789012")},
+				{GroupId: aws.String("sg-999"), UserId: aws.String("210987654321")},
+			},
+		}},
+		IpPermissionsEgress: []types.IpPermission{{
+			IpProtocol: aws.String("-1"),
+			IpRanges:   []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+		}},
+	})
+
+	block, err := generateSecurityGroup(sg)
+	if err != nil {
+		t.Fatalf("generateSecurityGroup() error = %v", err)
+	}
+
+	tf, err := iac.Render([]*iac.Block{block}, iac.Terraform)
+	if err != nil {
+		t.Fatalf("Render(Terraform) error = %v", err)
+	}
+	if got := strings.Count(tf, "  ingress {"); got != 4 {
+		t.Errorf("ingress blocks = %d, want 4 (one per source):\n%s", got, tf)
+	}
+	for _, want := range []string{
+		`resource "aws_security_group" "web" {`,
+		`cidr_blocks = ["10.0.0.0/8"]`,
+		`description = "internal"`,
+		`self      = true`,
+		`security_groups = ["210987654321/sg-999"]`,
+		`from_port   = 0`,
+		`protocol    = "-1"`,
+		`  id = "sg-111"`,
+	} {
+		if !strings.Contains(tf, want) {
+			t.Errorf("Terraform output missing %q:\n%s", want, tf)
+		}
+	}
+
+	cfn, err := iac.Render([]*iac.Block{block}, iac.CloudFormation)
+	if err != nil {
+		t.Fatalf("Render(CloudFormation) error = %v", err)
+	}
+	for _, want := range []string{
+		"SourceSecurityGroupId: sg-999",
+		`SourceSecurityGroupOwnerId: "210987654321"`,
+		"CidrIp: 0.0.0.0/0",
+	} {
+		if !strings.Contains(cfn, want) {
+			t.Errorf("CloudFormation output missing %q:\n%s", want, cfn)
+		}
+	}
+	// All-traffic rules have no port range
+	if strings.Contains(cfn, "FromPort: 0") {
+		t.Errorf("CloudFormation output has ports for protocol -1:\n%s", cfn)
+	}
+}
+
+func TestGenerateDefaultSecurityGroupNote(t *testing.T) {
+	sg := NewSecurityGroupResource(types.SecurityGroup{
+		GroupId:   aws.String("sg-222"),
+		GroupName: aws.String("default"),
+		VpcId:     aws.String("vpc-1"),
+	})
+	block, err := generateSecurityGroup(sg)
+	if err != nil {
+		t.Fatalf("generateSecurityGroup() error = %v", err)
+	}
+	if len(block.Notes) != 1 || !strings.Contains(block.Notes[0], "aws_default_security_group") {
+		t.Errorf("Notes = %v, want default group note", block.Notes)
+	}
+}
@@ -0,0 +1,93 @@
+package securitygroups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// planDeleteSecurityGroup revokes the rules of other groups that reference
+// the group; network interfaces still using it are in use.
+func planDeleteSecurityGroup(ctx context.Context, resource dao.Resource) (*action.Plan, error) {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groupID := resource.GetID()
+	plan := &action.Plan{}
+
+	if resource.GetName() == "default" {
+		plan.AddInUse("default security group of the VPC (deleted with the VPC)")
+	}
+
+	referencing := make(map[string]types.SecurityGroup)
+	for _, filterName := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		groups, err := appaws.Paginate(ctx, func(token *string) ([]types.SecurityGroup, *string, error) {
+			output, err := client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+				Filters:   []types.Filter{{Name: aws.String(filterName), Values: []string{groupID}}},
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrapf(err, "describe security groups referencing %s", groupID)
+			}
+			return output.SecurityGroups, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			// Rules referencing the group itself go away with it
+			if id := appaws.Str(g.GroupId); id != groupID {
+				referencing[id] = g
+			}
+		}
+	}
+	for _, g := range referencing {
+		ingress, egress := appec2.ReferencingRules(g, groupID)
+		refID := appaws.Str(g.GroupId)
+		plan.AddStep(
+			fmt.Sprintf("Revoke %d rule(s) of %s referencing %s", len(ingress)+len(egress), appec2.GroupLabel(g), groupID),
+			func(ctx context.Context) error {
+				return appec2.RevokeRules(ctx, client, refID, ingress, egress)
+			})
+	}
+
+	enis, err := appaws.Paginate(ctx, func(token *string) ([]types.NetworkInterface, *string, error) {
+		output, err := client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+			Filters:   []types.Filter{{Name: aws.String("group-id"), Values: []string{groupID}}},
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe network interfaces using %s", groupID)
+		}
+		return output.NetworkInterfaces, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, eni := range enis {
+		plan.AddInUse("network interface %s: %s", appaws.Str(eni.NetworkInterfaceId), eniOwner(eni))
+	}
+
+	return plan, nil
+}
+
+// eniOwner describes what a network interface belongs to
+func eniOwner(eni types.NetworkInterface) string {
+	if eni.Attachment != nil && eni.Attachment.InstanceId != nil {
+		return "instance " + *eni.Attachment.InstanceId
+	}
+	if desc := appaws.Str(eni.Description); desc != "" {
+		return desc
+	}
+	return string(eni.InterfaceType)
+}
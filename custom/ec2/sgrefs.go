@@ -0,0 +1,67 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ReferencingRules returns the rules of group that allow traffic from or to
+// groupID. Only the group pairs naming groupID are kept, so revoking them
+// leaves the rest of each rule in place.
+func ReferencingRules(group types.SecurityGroup, groupID string) (ingress, egress []types.IpPermission) {
+	return filterGroupPairs(group.IpPermissions, groupID), filterGroupPairs(group.IpPermissionsEgress, groupID)
+}
+
+func filterGroupPairs(perms []types.IpPermission, groupID string) []types.IpPermission {
+	var out []types.IpPermission
+	for _, p := range perms {
+		var pairs []types.UserIdGroupPair
+		for _, pair := range p.UserIdGroupPairs {
+			if appaws.Str(pair.GroupId) == groupID {
+				pairs = append(pairs, pair)
+			}
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+		out = append(out, types.IpPermission{
+			IpProtocol:       p.IpProtocol,
+			FromPort:         p.FromPort,
+			ToPort:           p.ToPort,
+			UserIdGroupPairs: pairs,
+		})
+	}
+	return out
+}
+
+// RevokeRules revokes ingress and egress rules from a security group
+func RevokeRules(ctx context.Context, client *ec2.Client, groupID string, ingress, egress []types.IpPermission) error {
+	if len(ingress) > 0 {
+		if _, err := client.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       &groupID,
+			IpPermissions: ingress,
+		}); err != nil {
+			return apperrors.Wrapf(err, "revoke ingress rules of %s", groupID)
+		}
+	}
+	if len(egress) > 0 {
+		if _, err := client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       &groupID,
+			IpPermissions: egress,
+		}); err != nil {
+			return apperrors.Wrapf(err, "revoke egress rules of %s", groupID)
+		}
+	}
+	return nil
+}
+
+// GroupLabel returns "sg-id (name)" for plan descriptions
+func GroupLabel(group types.SecurityGroup) string {
+	return fmt.Sprintf("%s (%s)", appaws.Str(group.GroupId), appaws.Str(group.GroupName))
+}
@@ -20,6 +20,7 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "DeleteCluster",
 			Confirm:   action.ConfirmDangerous,
+			Plan:      planDeleteCluster,
 		},
 	})
 
@@ -38,20 +39,13 @@ func executeClusterAction(ctx context.Context, act action.Action, resource dao.R
 }
 
 func executeDeleteCluster(ctx context.Context, resource dao.Resource) action.ActionResult {
+	// Services, tasks and container instances are removed by the delete plan;
+	// DeleteCluster reports any left as a dependency error
 	cluster, ok := resource.(*ClusterResource)
 	if !ok {
 		return action.InvalidResourceResult()
 	}
 
-	// Check if cluster has running tasks or services
-	if cluster.RunningTasksCount() > 0 || cluster.ActiveServicesCount() > 0 {
-		return action.ActionResult{
-			Success: false,
-			Error: fmt.Errorf("cluster has %d running tasks and %d active services; stop them first",
-				cluster.RunningTasksCount(), cluster.ActiveServicesCount()),
-		}
-	}
-
 	client, err := ecsClient.GetClient(ctx)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: err}
@@ -65,7 +59,7 @@ func executeDeleteCluster(ctx context.Context, resource dao.Resource) action.Act
 
 	_, err = client.DeleteCluster(ctx, input)
 	if err != nil {
-		return action.ActionResult{Success: false, Error: fmt.Errorf("delete cluster: %w", err)}
+		return action.FailResultf(err, "delete cluster %s", clusterName)
 	}
 
 	return action.ActionResult{
@@ -0,0 +1,147 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	ecsClient "github.com/clawscli/claws/custom/ecs"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// drainTimeout bounds waiting for deleted services to become inactive
+const drainTimeout = 10 * time.Minute
+
+// planDeleteCluster orders the removal of what DeleteCluster fails on:
+// services (scaled to zero and deleted), standalone tasks, and registered
+// container instances. The EC2 instances themselves are not terminated.
+func planDeleteCluster(ctx context.Context, resource dao.Resource) (*action.Plan, error) {
+	client, err := ecsClient.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cluster := resource.GetName()
+	plan := &action.Plan{}
+
+	serviceArns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := client.ListServices(ctx, &ecs.ListServicesInput{Cluster: &cluster, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list services")
+		}
+		return output.ServiceArns, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, arn := range serviceArns {
+		name := appaws.ExtractResourceName(arn)
+		plan.AddStep(fmt.Sprintf("Scale service %s to 0 and delete it", name), func(ctx context.Context) error {
+			if _, err := client.UpdateService(ctx, &ecs.UpdateServiceInput{
+				Cluster:      &cluster,
+				Service:      &arn,
+				DesiredCount: aws.Int32(0),
+			}); err != nil && !apperrors.IsNotFound(err) {
+				return apperrors.Wrapf(err, "scale service %s", name)
+			}
+			if _, err := client.DeleteService(ctx, &ecs.DeleteServiceInput{
+				Cluster: &cluster,
+				Service: &arn,
+				Force:   aws.Bool(true),
+			}); err != nil {
+				return apperrors.Wrapf(err, "delete service %s", name)
+			}
+			waiter := ecs.NewServicesInactiveWaiter(client)
+			return waiter.Wait(ctx, &ecs.DescribeServicesInput{Cluster: &cluster, Services: []string{arn}}, drainTimeout)
+		})
+	}
+
+	// Service tasks stop with their service; the rest are stopped directly
+	taskArns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := client.ListTasks(ctx, &ecs.ListTasksInput{
+			Cluster:       &cluster,
+			DesiredStatus: types.DesiredStatusRunning,
+			NextToken:     token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list tasks")
+		}
+		return output.TaskArns, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	standalone, err := standaloneTasks(ctx, client, cluster, taskArns)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range standalone {
+		arn := appaws.Str(task.TaskArn)
+		id := appaws.ExtractResourceName(arn)
+		plan.AddStep(fmt.Sprintf("Stop task %s (%s)", id, appaws.Str(task.Group)), func(ctx context.Context) error {
+			if _, err := client.StopTask(ctx, &ecs.StopTaskInput{
+				Cluster: &cluster,
+				Task:    &arn,
+				Reason:  aws.String("Cluster deleted from claws"),
+			}); err != nil {
+				return apperrors.Wrapf(err, "stop task %s", id)
+			}
+			waiter := ecs.NewTasksStoppedWaiter(client)
+			return waiter.Wait(ctx, &ecs.DescribeTasksInput{Cluster: &cluster, Tasks: []string{arn}}, drainTimeout)
+		})
+	}
+
+	instanceArns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := client.ListContainerInstances(ctx, &ecs.ListContainerInstancesInput{Cluster: &cluster, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list container instances")
+		}
+		return output.ContainerInstanceArns, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, arn := range instanceArns {
+		id := appaws.ExtractResourceName(arn)
+		plan.AddStep("Deregister container instance "+id, func(ctx context.Context) error {
+			_, err := client.DeregisterContainerInstance(ctx, &ecs.DeregisterContainerInstanceInput{
+				Cluster:           &cluster,
+				ContainerInstance: &arn,
+				Force:             aws.Bool(true),
+			})
+			return apperrors.Wrapf(err, "deregister container instance %s", id)
+		})
+	}
+	if len(instanceArns) > 0 {
+		plan.Warnings = append(plan.Warnings,
+			fmt.Sprintf("%d container instance(s) are deregistered but keep running; an Auto Scaling group may register new ones.", len(instanceArns)))
+	}
+
+	return plan, nil
+}
+
+// standaloneTasks returns the tasks not started by a service
+func standaloneTasks(ctx context.Context, client *ecs.Client, cluster string, taskArns []string) ([]types.Task, error) {
+	var tasks []types.Task
+	// DescribeTasks accepts up to 100 tasks
+	for i := 0; i < len(taskArns); i += 100 {
+		end := min(i+100, len(taskArns))
+		output, err := client.DescribeTasks(ctx, &ecs.DescribeTasksInput{Cluster: &cluster, Tasks: taskArns[i:end]})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe tasks")
+		}
+		for _, task := range output.Tasks {
+			if !strings.HasPrefix(appaws.Str(task.Group), "service:") {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+	return tasks, nil
+}
@@ -0,0 +1,174 @@
+package serviceevents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
+)
+
+// ServiceEventDAO provides data access for the events of an ECS service
+type ServiceEventDAO struct {
+	dao.BaseDAO
+	client *ecs.Client
+}
+
+// NewServiceEventDAO creates a new ServiceEventDAO
+func NewServiceEventDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ServiceEventDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "service-events"),
+		client:  ecs.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the events ECS keeps for a service (the latest 100).
+// The service comes from the ServiceArn filter, or ClusterName and ServiceName.
+func (d *ServiceEventDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	cluster, service := serviceFromContext(ctx)
+	if service == "" {
+		return nil, fmt.Errorf("ServiceArn required: navigate from services using 'v' key")
+	}
+
+	output, err := d.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  &cluster,
+		Services: []string{service},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe service %s", service)
+	}
+	if len(output.Services) == 0 {
+		return nil, fmt.Errorf("service not found: %s", service)
+	}
+
+	svc := output.Services[0]
+	resources := make([]dao.Resource, len(svc.Events))
+	for i, event := range svc.Events {
+		resources[i] = NewServiceEventResource(event, appaws.Str(svc.ServiceName))
+	}
+	return resources, nil
+}
+
+// serviceFromContext returns the cluster and service to describe
+func serviceFromContext(ctx context.Context) (cluster, service string) {
+	cluster = dao.GetFilterFromContext(ctx, "ClusterName")
+	service = dao.GetFilterFromContext(ctx, "ServiceName")
+
+	// arn:aws:ecs:region:account:service/cluster/service (long ARN format)
+	if arn := dao.GetFilterFromContext(ctx, "ServiceArn"); arn != "" {
+		service = arn
+		if _, path, ok := strings.Cut(arn, ":service/"); ok {
+			if c, _, ok := strings.Cut(path, "/"); ok {
+				cluster = c
+			}
+		}
+	}
+	if cluster == "" {
+		cluster = "default"
+	}
+	return cluster, service
+}
+
+// Get returns a service event by ID
+func (d *ServiceEventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("service event not found: %s", id)
+}
+
+// Delete is not supported for service events
+func (d *ServiceEventDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for service events")
+}
+
+// Supports returns supported operations
+func (d *ServiceEventDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// ServiceEventResource represents an event of an ECS service
+type ServiceEventResource struct {
+	dao.BaseResource
+	Item        types.ServiceEvent
+	ServiceName string
+}
+
+// NewServiceEventResource creates a new ServiceEventResource
+func NewServiceEventResource(event types.ServiceEvent, serviceName string) *ServiceEventResource {
+	return &ServiceEventResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(event.Id),
+			Name: serviceName,
+			Data: event,
+		},
+		Item:        event,
+		ServiceName: serviceName,
+	}
+}
+
+// CreatedAt returns when the event was recorded
+func (r *ServiceEventResource) CreatedAt() *time.Time {
+	return r.Item.CreatedAt
+}
+
+// Message returns the event message
+func (r *ServiceEventResource) Message() string {
+	return appaws.Str(r.Item.Message)
+}
+
+// Severity classifies the message: placement and deployment failures are
+// errors, failed health checks and stopped tasks warnings.
+func (r *ServiceEventResource) Severity() timeline.Severity {
+	msg := strings.ToLower(r.Message())
+	switch {
+	case strings.Contains(msg, "unable"), strings.Contains(msg, "failed to"),
+		strings.Contains(msg, "rolling back"), strings.Contains(msg, "error"):
+		return timeline.SeverityError
+	case strings.Contains(msg, "unhealthy"), strings.Contains(msg, "health checks"),
+		strings.Contains(msg, "has stopped"):
+		return timeline.SeverityWarning
+	case strings.Contains(msg, "steady state"), strings.Contains(msg, "deployment completed"):
+		return timeline.SeverityOK
+	default:
+		return timeline.SeverityInfo
+	}
+}
+
+// TimelineEvent implements timeline.Provider
+func (r *ServiceEventResource) TimelineEvent() timeline.Event {
+	// Messages start with "(service name)", which the subject already shows
+	summary := strings.TrimPrefix(r.Message(), "(service "+r.ServiceName+") ")
+	event := timeline.Event{
+		Source:   timeline.SourceECS,
+		Subject:  r.ServiceName,
+		Summary:  summary,
+		Severity: r.Severity(),
+	}
+	if t := r.CreatedAt(); t != nil {
+		event.Time = *t
+	}
+	return event
+}
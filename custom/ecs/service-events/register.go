@@ -0,0 +1,20 @@
+package serviceevents
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ecs", "service-events", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewServiceEventDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewServiceEventRenderer()
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package serviceevents
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/timeline"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ServiceEventRenderer renders ECS service events
+type ServiceEventRenderer struct {
+	render.BaseRenderer
+}
+
+// NewServiceEventRenderer creates a new ServiceEventRenderer
+func NewServiceEventRenderer() render.Renderer {
+	return &ServiceEventRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "service-events",
+			Cols: []render.Column{
+				{Name: "CREATED", Width: 20, Getter: getCreated},
+				{Name: "MESSAGE", Width: 100, Getter: getMessage},
+			},
+		},
+	}
+}
+
+func getCreated(r dao.Resource) string {
+	e, ok := r.(*ServiceEventResource)
+	if !ok || e.CreatedAt() == nil {
+		return ""
+	}
+	return e.CreatedAt().Format("01-02 15:04:05")
+}
+
+func getMessage(r dao.Resource) string {
+	if e, ok := r.(*ServiceEventResource); ok {
+		return e.Message()
+	}
+	return ""
+}
+
+// RenderDetail renders a service event
+func (r *ServiceEventRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*ServiceEventResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Service Event", e.ServiceName)
+
+	d.Section("Event Information")
+	d.Field("Event ID", e.GetID())
+	d.Field("Service", e.ServiceName)
+	if t := e.CreatedAt(); t != nil {
+		d.Field("Created", t.Format(time.RFC3339))
+	}
+
+	d.Section("Message")
+	d.Line("  " + e.Message())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ServiceEventRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*ServiceEventResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Service", Value: e.ServiceName},
+	}
+	if t := e.CreatedAt(); t != nil {
+		fields = append(fields, render.SummaryField{Label: "Created", Value: t.Format("2006-01-02 15:04:05")})
+	}
+	fields = append(fields, render.SummaryField{Label: "Message", Value: e.Message(), Style: severityStyle(e.Severity())})
+	return fields
+}
+
+// severityStyle returns a style for a message severity
+func severityStyle(s timeline.Severity) render.Style {
+	switch s {
+	case timeline.SeverityError:
+		return ui.DangerStyle()
+	case timeline.SeverityWarning:
+		return ui.WarningStyle()
+	case timeline.SeverityOK:
+		return ui.SuccessStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
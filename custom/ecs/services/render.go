@@ -446,6 +446,14 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterField: "LogGroupPrefix",
 			FilterValue: "/ecs/" + svc.GetName(),
 		},
+		{
+			Key:         "v",
+			Label:       "Events",
+			Service:     "ecs",
+			Resource:    "service-events",
+			FilterField: "ServiceArn",
+			FilterValue: svc.GetARN(),
+		},
 	}
 
 	if td := svc.TaskDefinition(); td != "" {
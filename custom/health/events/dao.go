@@ -12,6 +12,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/timeline"
 )
 
 // EventDAO provides data access for AWS Health events.
@@ -178,3 +179,33 @@ func (r *EventResource) StatusCode() string {
 func (r *EventResource) EventScopeCode() string {
 	return string(r.Item.EventScopeCode)
 }
+
+// TimelineEvent implements timeline.Provider.
+// Open issues are errors, scheduled changes warnings.
+func (r *EventResource) TimelineEvent() timeline.Event {
+	subject := r.Service()
+	if region := r.Region(); region != "" {
+		subject += " " + region
+	}
+
+	severity := timeline.SeverityInfo
+	switch {
+	case r.EventTypeCategory() == string(types.EventTypeCategoryIssue) && r.StatusCode() != string(types.EventStatusCodeClosed):
+		severity = timeline.SeverityError
+	case r.EventTypeCategory() == string(types.EventTypeCategoryIssue):
+		severity = timeline.SeverityOK
+	case r.EventTypeCategory() == string(types.EventTypeCategoryScheduledChange):
+		severity = timeline.SeverityWarning
+	}
+
+	event := timeline.Event{
+		Source:   timeline.SourceHealth,
+		Subject:  subject,
+		Summary:  r.EventTypeCode() + " (" + r.StatusCode() + ")",
+		Severity: severity,
+	}
+	if t := r.StartTime(); t != nil {
+		event.Time = *t
+	}
+	return event
+}
@@ -0,0 +1,63 @@
+package policies
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("iam", "policies", generatePolicy)
+}
+
+func generatePolicy(res dao.Resource) (*iac.Block, error) {
+	policy, ok := res.(*PolicyResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	name := policy.GetName()
+	if policy.IsAWSManaged() {
+		return nil, fmt.Errorf("policy %s is managed by AWS", name)
+	}
+	if policy.PolicyDocument == "" {
+		return nil, fmt.Errorf("policy document of %s not loaded", name)
+	}
+	doc, err := iac.DecodeDocument(policy.PolicyDocument)
+	if err != nil {
+		return nil, err
+	}
+	description := ""
+	if policy.Item.Description != nil {
+		description = *policy.Item.Description
+	}
+
+	tf := iac.NewBody().
+		Set("name", name).
+		Set("path", policy.Path()).
+		Set("description", description).
+		Set("policy", iac.JSONEncode(doc)).
+		Set("tags", iac.UserTags(policy.GetTags()))
+
+	props := iac.NewBody().
+		Set("ManagedPolicyName", name).
+		Set("Path", policy.Path()).
+		Set("Description", description).
+		Set("PolicyDocument", doc)
+
+	return &iac.Block{
+		Name: "IAM policy " + name,
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_iam_policy",
+			Name:     iac.LocalName(name),
+			ImportID: policy.Arn(),
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::IAM::ManagedPolicy",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("PolicyArn", policy.Arn()),
+			Properties: props,
+		},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package roles
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("iam", "roles", generateRole)
+}
+
+func generateRole(res dao.Resource) (*iac.Block, error) {
+	role, ok := res.(*RoleResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	name := role.GetName()
+	if strings.HasPrefix(role.Path(), "/aws-service-role/") {
+		return nil, fmt.Errorf("role %s is service-linked and managed by AWS", name)
+	}
+
+	trust, err := iac.DecodeDocument(appaws.Str(role.Item.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, err
+	}
+	boundary := ""
+	if role.Item.PermissionsBoundary != nil {
+		boundary = appaws.Str(role.Item.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+	local := iac.LocalName(name)
+
+	tf := iac.NewBody().
+		Set("name", name).
+		Set("path", role.Path()).
+		Set("description", role.Description()).
+		Set("max_session_duration", role.Item.MaxSessionDuration).
+		Set("permissions_boundary", boundary).
+		Set("assume_role_policy", iac.JSONEncode(trust)).
+		Set("tags", iac.UserTags(role.GetTags()))
+	resources := []iac.TerraformResource{{
+		Type:     "aws_iam_role",
+		Name:     local,
+		ImportID: name,
+		Body:     tf,
+	}}
+
+	var managed []string
+	for _, p := range role.AttachedPolicies {
+		arn := appaws.Str(p.PolicyArn)
+		managed = append(managed, arn)
+		resources = append(resources, iac.TerraformResource{
+			Type:     "aws_iam_role_policy_attachment",
+			Name:     local + "_" + iac.LocalName(appaws.Str(p.PolicyName)),
+			ImportID: name + "/" + arn,
+			Body: iac.NewBody().
+				Set("role", iac.Ref("aws_iam_role."+local+".name")).
+				Set("policy_arn", arn),
+		})
+	}
+
+	props := iac.NewBody().
+		Set("RoleName", name).
+		Set("Path", role.Path()).
+		Set("Description", role.Description()).
+		Set("MaxSessionDuration", role.Item.MaxSessionDuration).
+		Set("PermissionsBoundary", boundary).
+		Set("AssumeRolePolicyDocument", trust).
+		Set("ManagedPolicyArns", managed).
+		Set("Tags", iac.CloudFormationTags(role.GetTags()))
+
+	block := &iac.Block{
+		Name:      "IAM role " + name,
+		Terraform: resources,
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::IAM::Role",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("RoleName", name),
+			Properties: props,
+		},
+	}
+	if len(role.InlinePolicies) > 0 {
+		block.Notes = append(block.Notes, fmt.Sprintf("inline policies not included: %s", strings.Join(role.InlinePolicies, ", ")))
+	}
+	return block, nil
+}
@@ -0,0 +1,7 @@
+// Code generated by go generate; DO NOT EDIT.
+// To regenerate: task gen-imports
+
+package shards
+
+// ServiceResourcePath is the canonical path for this resource type.
+const ServiceResourcePath = "kinesis/shards"
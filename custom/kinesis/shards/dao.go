@@ -0,0 +1,129 @@
+package shards
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/kinesisrec"
+)
+
+// FilterStreamARN is the filter holding the ARN of the stream
+const FilterStreamARN = "StreamARN"
+
+// ShardDAO lists the shards of a Kinesis stream
+type ShardDAO struct {
+	dao.BaseDAO
+	client *kinesis.Client
+}
+
+// NewShardDAO creates a new ShardDAO
+func NewShardDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ShardDAO{
+		BaseDAO: dao.NewBaseDAO("kinesis", "shards"),
+		client:  kinesis.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the shards of the stream, closed ones included
+func (d *ShardDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	streamARN := dao.GetFilterFromContext(ctx, FilterStreamARN)
+	if streamARN == "" {
+		return nil, fmt.Errorf("%s required: navigate from kinesis/streams using 's' key", FilterStreamARN)
+	}
+	shards, err := kinesisrec.ListShards(ctx, d.client, streamARN)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]dao.Resource, len(shards))
+	for i, shard := range shards {
+		resources[i] = NewShardResource(streamARN, shard)
+	}
+	return resources, nil
+}
+
+// Get is not supported: shards are only listed per stream
+func (d *ShardDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get shard %s: shards can only be listed by stream", id)
+}
+
+// Delete is not supported: shards are changed by resharding the stream
+func (d *ShardDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete shard %s: shards are changed by resharding the stream", id)
+}
+
+// ShardResource is a shard of a Kinesis stream
+type ShardResource struct {
+	dao.BaseResource
+	StreamARN string
+	Shard     types.Shard
+}
+
+// NewShardResource creates a new ShardResource
+func NewShardResource(streamARN string, shard types.Shard) *ShardResource {
+	id := appaws.Str(shard.ShardId)
+	return &ShardResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: shard,
+		},
+		StreamARN: streamARN,
+		Shard:     shard,
+	}
+}
+
+// KinesisStream returns the name and ARN of the stream, for the record viewer
+func (r *ShardResource) KinesisStream() (name, arn string) {
+	return appaws.ExtractResourceName(r.StreamARN), r.StreamARN
+}
+
+// ShardID returns the shard ID, for the record viewer
+func (r *ShardResource) ShardID() string {
+	return r.ID
+}
+
+// Status returns OPEN, or CLOSED once a reshard replaced the shard
+func (r *ShardResource) Status() string {
+	if kinesisrec.IsOpen(r.Shard) {
+		return "OPEN"
+	}
+	return "CLOSED"
+}
+
+// Parents returns the shards this one was split or merged from
+func (r *ShardResource) Parents() []string {
+	var parents []string
+	for _, p := range []*string{r.Shard.ParentShardId, r.Shard.AdjacentParentShardId} {
+		if id := appaws.Str(p); id != "" {
+			parents = append(parents, id)
+		}
+	}
+	return parents
+}
+
+// HashKeyRange returns the start and end of the shard's hash key range
+func (r *ShardResource) HashKeyRange() (start, end string) {
+	if r.Shard.HashKeyRange == nil {
+		return "", ""
+	}
+	return appaws.Str(r.Shard.HashKeyRange.StartingHashKey), appaws.Str(r.Shard.HashKeyRange.EndingHashKey)
+}
+
+// SequenceRange returns the first and, for a closed shard, last sequence
+// number of the shard
+func (r *ShardResource) SequenceRange() (start, end string) {
+	if r.Shard.SequenceNumberRange == nil {
+		return "", ""
+	}
+	return appaws.Str(r.Shard.SequenceNumberRange.StartingSequenceNumber), appaws.Str(r.Shard.SequenceNumberRange.EndingSequenceNumber)
+}
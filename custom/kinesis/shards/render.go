@@ -0,0 +1,153 @@
+package shards
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/kinesisrec"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure ShardRenderer implements render.Navigator
+var _ render.Navigator = (*ShardRenderer)(nil)
+
+// ShardRenderer renders the shards of a Kinesis stream
+type ShardRenderer struct {
+	render.BaseRenderer
+}
+
+// NewShardRenderer creates a new ShardRenderer
+func NewShardRenderer() render.Renderer {
+	return &ShardRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "kinesis",
+			Resource: "shards",
+			Cols: []render.Column{
+				{Name: "SHARD ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "STATUS", Width: 8, Getter: getStatus, Priority: 0},
+				{Name: "KEYSPACE", Width: 9, Getter: getKeyspace, Priority: 1},
+				{Name: "PARENT", Width: 22, Getter: getParent, Priority: 2},
+				{Name: "HASH KEY START", Width: 40, Getter: getHashStart, Priority: 3},
+				{Name: "HASH KEY END", Width: 40, Getter: getHashEnd, Priority: 3},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if s, ok := r.(*ShardResource); ok {
+		return s.Status()
+	}
+	return ""
+}
+
+func getKeyspace(r dao.Resource) string {
+	if s, ok := r.(*ShardResource); ok {
+		return formatShare(kinesisrec.KeyspaceShare(s.Shard.HashKeyRange))
+	}
+	return ""
+}
+
+func getParent(r dao.Resource) string {
+	if s, ok := r.(*ShardResource); ok {
+		return strings.Join(s.Parents(), ", ")
+	}
+	return ""
+}
+
+func getHashStart(r dao.Resource) string {
+	if s, ok := r.(*ShardResource); ok {
+		start, _ := s.HashKeyRange()
+		return start
+	}
+	return ""
+}
+
+func getHashEnd(r dao.Resource) string {
+	if s, ok := r.(*ShardResource); ok {
+		_, end := s.HashKeyRange()
+		return end
+	}
+	return ""
+}
+
+// formatShare shows a key space percentage, e.g. "25%" or "33.33%"
+func formatShare(share float64) string {
+	if share < 0 {
+		return "-"
+	}
+	s := fmt.Sprintf("%.2f", share)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return s + "%"
+}
+
+func statusStyle(status string) render.Style {
+	if status == "OPEN" {
+		return ui.SuccessStyle()
+	}
+	return ui.DimStyle()
+}
+
+// RenderDetail renders the shard's hash key and sequence number ranges
+func (r *ShardRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*ShardResource)
+	if !ok {
+		return ""
+	}
+	stream, _ := s.KinesisStream()
+
+	d := render.NewDetailBuilder()
+	d.Title("Kinesis Shard", s.GetID())
+
+	d.Section("Shard")
+	d.Field("Shard ID", s.GetID())
+	d.Field("Stream", stream)
+	d.FieldStyled("Status", s.Status(), statusStyle(s.Status()))
+	if parents := s.Parents(); len(parents) > 0 {
+		d.Field("Parents", strings.Join(parents, ", "))
+	}
+
+	d.Section("Hash Key Range")
+	start, end := s.HashKeyRange()
+	d.Field("Start", start)
+	d.Field("End", end)
+	d.Field("Key Space", formatShare(kinesisrec.KeyspaceShare(s.Shard.HashKeyRange)))
+
+	d.Section("Sequence Number Range")
+	start, end = s.SequenceRange()
+	d.Field("Start", start)
+	if end != "" {
+		d.Field("End", end)
+	} else {
+		d.DimIndent("Open: no end until the shard is split or merged")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ShardRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*ShardResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	stream, _ := s.KinesisStream()
+	return []render.SummaryField{
+		{Label: "Shard", Value: s.GetID()},
+		{Label: "Stream", Value: stream},
+		{Label: "Status", Value: s.Status(), Style: statusStyle(s.Status())},
+		{Label: "Key Space", Value: formatShare(kinesisrec.KeyspaceShare(s.Shard.HashKeyRange))},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ShardRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	if _, ok := resource.(*ShardResource); !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "r", Label: "Records", ViewType: render.ViewTypeRecordViewer},
+	}
+}
@@ -137,6 +137,11 @@ func (r *StreamResource) StreamName() string {
 	return ""
 }
 
+// KinesisStream returns the name and ARN of the stream, for the record viewer
+func (r *StreamResource) KinesisStream() (name, arn string) {
+	return r.StreamName(), r.GetARN()
+}
+
 // Status returns the stream status
 func (r *StreamResource) Status() string {
 	if r.Summary != nil {
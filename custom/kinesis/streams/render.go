@@ -174,6 +174,15 @@ func (r *StreamRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 // Navigations returns navigation shortcuts
 func (r *StreamRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
-	return nil
+	stream, ok := resource.(*StreamResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "s", Label: "Shards", Service: "kinesis", Resource: "shards",
+			FilterField: "StreamARN", FilterValue: stream.GetARN(),
+		},
+		{Key: "r", Label: "Records", ViewType: render.ViewTypeRecordViewer},
+	}
 }
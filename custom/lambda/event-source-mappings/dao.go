@@ -0,0 +1,169 @@
+package eventsourcemappings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EventSourceMappingDAO provides data access for Lambda event source mappings
+type EventSourceMappingDAO struct {
+	dao.BaseDAO
+	client *lambda.Client
+}
+
+// NewEventSourceMappingDAO creates a new EventSourceMappingDAO
+func NewEventSourceMappingDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EventSourceMappingDAO{
+		BaseDAO: dao.NewBaseDAO("lambda", "event-source-mappings"),
+		client:  lambda.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the event source mappings of the function in the FunctionName filter
+func (d *EventSourceMappingDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	functionName := dao.GetFilterFromContext(ctx, "FunctionName")
+	if functionName == "" {
+		return nil, fmt.Errorf("FunctionName required: navigate from functions using 'e' key")
+	}
+
+	mappings, err := appaws.PaginateMarker(ctx, func(token *string) ([]types.EventSourceMappingConfiguration, *string, error) {
+		output, err := d.client.ListEventSourceMappings(ctx, &lambda.ListEventSourceMappingsInput{
+			FunctionName: &functionName,
+			Marker:       token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list event source mappings for %s", functionName)
+		}
+		return output.EventSourceMappings, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(mappings))
+	for i, m := range mappings {
+		resources[i] = NewEventSourceMappingResource(m)
+	}
+	return resources, nil
+}
+
+// Get returns an event source mapping by UUID
+func (d *EventSourceMappingDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetEventSourceMapping(ctx, &lambda.GetEventSourceMappingInput{
+		UUID: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get event source mapping %s", id)
+	}
+	return NewEventSourceMappingResource(types.EventSourceMappingConfiguration{
+		UUID:                           output.UUID,
+		FunctionArn:                    output.FunctionArn,
+		EventSourceArn:                 output.EventSourceArn,
+		State:                          output.State,
+		StateTransitionReason:          output.StateTransitionReason,
+		BatchSize:                      output.BatchSize,
+		MaximumBatchingWindowInSeconds: output.MaximumBatchingWindowInSeconds,
+		StartingPosition:               output.StartingPosition,
+		LastModified:                   output.LastModified,
+		LastProcessingResult:           output.LastProcessingResult,
+		DestinationConfig:              output.DestinationConfig,
+		FilterCriteria:                 output.FilterCriteria,
+		MaximumRetryAttempts:           output.MaximumRetryAttempts,
+		BisectBatchOnFunctionError:     output.BisectBatchOnFunctionError,
+		EventSourceMappingArn:          output.EventSourceMappingArn,
+	}), nil
+}
+
+// Delete deletes an event source mapping by UUID
+func (d *EventSourceMappingDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteEventSourceMapping(ctx, &lambda.DeleteEventSourceMappingInput{
+		UUID: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		return apperrors.Wrapf(err, "delete event source mapping %s", id)
+	}
+	return nil
+}
+
+// EventSourceMappingResource wraps a Lambda event source mapping
+type EventSourceMappingResource struct {
+	dao.BaseResource
+	Item types.EventSourceMappingConfiguration
+}
+
+// NewEventSourceMappingResource creates a new EventSourceMappingResource
+func NewEventSourceMappingResource(m types.EventSourceMappingConfiguration) *EventSourceMappingResource {
+	return &EventSourceMappingResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(m.UUID),
+			Name: appaws.Str(m.UUID),
+			ARN:  appaws.Str(m.EventSourceMappingArn),
+			Data: m,
+		},
+		Item: m,
+	}
+}
+
+// EventSourceArn returns the ARN of the event source (queue, stream, ...)
+func (r *EventSourceMappingResource) EventSourceArn() string {
+	return appaws.Str(r.Item.EventSourceArn)
+}
+
+// EventSourceName returns the event source name from its ARN
+func (r *EventSourceMappingResource) EventSourceName() string {
+	if a := appaws.ParseARN(r.EventSourceArn()); a != nil {
+		return a.ShortID()
+	}
+	return r.EventSourceArn()
+}
+
+// EventSourceService returns the event source service from its ARN
+func (r *EventSourceMappingResource) EventSourceService() string {
+	if a := appaws.ParseARN(r.EventSourceArn()); a != nil {
+		return a.Service
+	}
+	return ""
+}
+
+// FunctionArn returns the target function ARN
+func (r *EventSourceMappingResource) FunctionArn() string {
+	return appaws.Str(r.Item.FunctionArn)
+}
+
+// State returns the mapping state (Enabled, Disabled, Creating, ...)
+func (r *EventSourceMappingResource) State() string {
+	return appaws.Str(r.Item.State)
+}
+
+// BatchSize returns the batch size
+func (r *EventSourceMappingResource) BatchSize() int32 {
+	if r.Item.BatchSize == nil {
+		return 0
+	}
+	return *r.Item.BatchSize
+}
+
+// LastModified returns when the mapping was last updated
+func (r *EventSourceMappingResource) LastModified() *time.Time {
+	return r.Item.LastModified
+}
+
+// LastProcessingResult returns the result of the last poll
+func (r *EventSourceMappingResource) LastProcessingResult() string {
+	return appaws.Str(r.Item.LastProcessingResult)
+}
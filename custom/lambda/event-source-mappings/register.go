@@ -0,0 +1,20 @@
+package eventsourcemappings
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("lambda", "event-source-mappings", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEventSourceMappingDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEventSourceMappingRenderer()
+		},
+	})
+}
@@ -0,0 +1,170 @@
+package eventsourcemappings
+
+import (
+	"fmt"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// EventSourceMappingRenderer renders Lambda event source mappings
+type EventSourceMappingRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEventSourceMappingRenderer creates a new EventSourceMappingRenderer
+func NewEventSourceMappingRenderer() render.Renderer {
+	return &EventSourceMappingRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "lambda",
+			Resource: "event-source-mappings",
+			Cols: []render.Column{
+				{Name: "UUID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "SOURCE", Width: 10, Getter: getSourceService},
+				{Name: "SOURCE NAME", Width: 36, Getter: getSourceName},
+				{Name: "STATE", Width: 10, Getter: getState},
+				{Name: "BATCH", Width: 6, Getter: getBatchSize},
+				{Name: "LAST RESULT", Width: 20, Getter: getLastResult},
+			},
+		},
+	}
+}
+
+func getSourceService(r dao.Resource) string {
+	if m, ok := r.(*EventSourceMappingResource); ok {
+		return m.EventSourceService()
+	}
+	return ""
+}
+
+func getSourceName(r dao.Resource) string {
+	if m, ok := r.(*EventSourceMappingResource); ok {
+		return m.EventSourceName()
+	}
+	return ""
+}
+
+func getState(r dao.Resource) string {
+	if m, ok := r.(*EventSourceMappingResource); ok {
+		return m.State()
+	}
+	return ""
+}
+
+func getBatchSize(r dao.Resource) string {
+	m, ok := r.(*EventSourceMappingResource)
+	if !ok || m.BatchSize() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", m.BatchSize())
+}
+
+func getLastResult(r dao.Resource) string {
+	if m, ok := r.(*EventSourceMappingResource); ok {
+		return m.LastProcessingResult()
+	}
+	return ""
+}
+
+// RenderDetail renders an event source mapping
+func (r *EventSourceMappingRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*EventSourceMappingResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Event Source Mapping", m.GetID())
+
+	d.Section("Basic Information")
+	d.Field("UUID", m.GetID())
+	d.FieldIf("ARN", m.Item.EventSourceMappingArn)
+	d.Field("State", m.State())
+	d.FieldIf("State Reason", m.Item.StateTransitionReason)
+	if t := m.LastModified(); t != nil {
+		d.Field("Last Modified", t.Format(time.RFC3339))
+	}
+	d.FieldIf("Last Result", m.Item.LastProcessingResult)
+
+	d.Section("Source and Target")
+	d.Field("Event Source", m.EventSourceArn())
+	d.Field("Function", m.FunctionArn())
+	if m.Item.StartingPosition != "" {
+		d.Field("Starting Position", string(m.Item.StartingPosition))
+	}
+
+	d.Section("Batching")
+	if m.BatchSize() > 0 {
+		d.Field("Batch Size", fmt.Sprintf("%d", m.BatchSize()))
+	}
+	if w := m.Item.MaximumBatchingWindowInSeconds; w != nil {
+		d.Field("Batching Window", fmt.Sprintf("%ds", *w))
+	}
+	if n := m.Item.MaximumRetryAttempts; n != nil {
+		d.Field("Max Retries", fmt.Sprintf("%d", *n))
+	}
+	if b := m.Item.BisectBatchOnFunctionError; b != nil {
+		d.Field("Bisect On Error", fmt.Sprintf("%v", *b))
+	}
+
+	if dc := m.Item.DestinationConfig; dc != nil && dc.OnFailure != nil && dc.OnFailure.Destination != nil {
+		d.Section("On Failure Destination")
+		d.Field("Destination", *dc.OnFailure.Destination)
+	}
+
+	if fc := m.Item.FilterCriteria; fc != nil && len(fc.Filters) > 0 {
+		d.Section("Filter Criteria")
+		for _, f := range fc.Filters {
+			d.Line("  " + appaws.Str(f.Pattern))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EventSourceMappingRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*EventSourceMappingResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.SummaryField{
+		{Label: "UUID", Value: m.GetID()},
+		{Label: "Source", Value: m.EventSourceName()},
+		{Label: "State", Value: m.State()},
+	}
+}
+
+// Navigations returns navigation shortcuts to the event source and function
+func (r *EventSourceMappingRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	m, ok := resource.(*EventSourceMappingResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key: "l", Label: "Function", Service: "lambda", Resource: "functions",
+			FilterField: "FunctionName", FilterValue: appaws.ExtractResourceName(m.FunctionArn()),
+		},
+	}
+
+	switch m.EventSourceService() {
+	case "sqs":
+		navs = append(navs, render.Navigation{
+			Key: "q", Label: "SQS Queue", Service: "sqs", Resource: "queues",
+			FilterField: "QueueName", FilterValue: m.EventSourceName(),
+		})
+	case "kinesis":
+		navs = append(navs, render.Navigation{
+			Key: "k", Label: "Kinesis Stream", Service: "kinesis", Resource: "streams",
+			FilterField: "StreamName", FilterValue: m.EventSourceName(),
+		})
+	}
+
+	return navs
+}
@@ -2,7 +2,6 @@ package functions
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -22,6 +21,7 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "InvokeFunction",
 			Confirm:   action.ConfirmSimple,
+			Compose:   invokeCompose,
 		},
 		{
 			Name:      "Invoke (Dry Run)",
@@ -45,10 +45,8 @@ func init() {
 // executeFunctionAction executes an action on a Lambda function
 func executeFunctionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
-	case "InvokeFunction":
-		return executeInvoke(ctx, resource, false)
 	case "InvokeFunctionDryRun":
-		return executeInvoke(ctx, resource, true)
+		return executeInvokeDryRun(ctx, resource)
 	case "DeleteFunction":
 		return executeDeleteFunction(ctx, resource)
 	default:
@@ -60,7 +58,9 @@ func getLambdaClient(ctx context.Context) (*lambda.Client, error) {
 	return lambdaClient.GetClient(ctx)
 }
 
-func executeInvoke(ctx context.Context, resource dao.Resource, dryRun bool) action.ActionResult {
+// executeInvokeDryRun validates the caller may invoke the function without
+// running it. Real invokes go through the compose view (invokeCompose).
+func executeInvokeDryRun(ctx context.Context, resource dao.Resource) action.ActionResult {
 	fn, ok := resource.(*FunctionResource)
 	if !ok {
 		return action.InvalidResourceResult()
@@ -72,54 +72,16 @@ func executeInvoke(ctx context.Context, resource dao.Resource, dryRun bool) acti
 	}
 
 	functionName := fn.GetName()
-
-	// Use empty JSON object as test payload
-	payload := []byte("{}")
-
-	input := &lambda.InvokeInput{
-		FunctionName: &functionName,
-		Payload:      payload,
-	}
-
-	if dryRun {
-		input.InvocationType = lambdatypes.InvocationTypeDryRun
-	} else {
-		input.InvocationType = lambdatypes.InvocationTypeRequestResponse
-	}
-
-	output, err := client.Invoke(ctx, input)
+	output, err := client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName:   &functionName,
+		Payload:        []byte("{}"),
+		InvocationType: lambdatypes.InvocationTypeDryRun,
+	})
 	if err != nil {
 		return action.FailResultf(err, "invoke function %s", functionName)
 	}
 
-	if dryRun {
-		return action.SuccessResult(fmt.Sprintf("Dry run successful for %s (Status: %d)", functionName, output.StatusCode))
-	}
-
-	// Parse response
-	statusCode := output.StatusCode
-	var responsePreview string
-
-	if len(output.Payload) > 0 {
-		// Try to pretty-print JSON response
-		var result any
-		if err := json.Unmarshal(output.Payload, &result); err == nil {
-			if len(output.Payload) > 100 {
-				responsePreview = string(output.Payload[:100]) + "..."
-			} else {
-				responsePreview = string(output.Payload)
-			}
-		} else {
-			responsePreview = string(output.Payload)
-		}
-	}
-
-	// Check for function error
-	if output.FunctionError != nil && *output.FunctionError != "" {
-		return action.FailResult(fmt.Errorf("function error: %s - %s", *output.FunctionError, responsePreview))
-	}
-
-	return action.SuccessResult(fmt.Sprintf("Invoked %s (Status: %d) Response: %s", functionName, statusCode, responsePreview))
+	return action.SuccessResult(fmt.Sprintf("Dry run successful for %s (Status: %d)", functionName, output.StatusCode))
 }
 
 func executeDeleteFunction(ctx context.Context, resource dao.Resource) action.ActionResult {
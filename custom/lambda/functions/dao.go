@@ -149,6 +149,15 @@ func NewFunctionResourceFromConfig(fn types.FunctionConfiguration) *FunctionReso
 	}
 }
 
+// LogGroupName returns the function's log group: the one set in its logging
+// config, or /aws/lambda/<name>
+func (r *FunctionResource) LogGroupName() string {
+	if r.Item.LoggingConfig != nil && appaws.Str(r.Item.LoggingConfig.LogGroup) != "" {
+		return appaws.Str(r.Item.LoggingConfig.LogGroup)
+	}
+	return "/aws/lambda/" + r.GetName()
+}
+
 // Runtime returns the runtime
 func (r *FunctionResource) Runtime() string {
 	return string(r.Item.Runtime)
@@ -0,0 +1,122 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+// codePlaceholder stands in for the deployment package, which the API
+// does not return
+const codePlaceholder = "CHANGE_ME"
+
+func init() {
+	iac.Register("lambda", "functions", generateFunction)
+}
+
+func generateFunction(res dao.Resource) (*iac.Block, error) {
+	fn, ok := res.(*FunctionResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	cfg := fn.Item
+	name := fn.GetName()
+	image := cfg.PackageType == types.PackageTypeImage
+
+	var layers []string
+	for _, l := range cfg.Layers {
+		layers = append(layers, appaws.Str(l.Arn))
+	}
+	var env map[string]string
+	if cfg.Environment != nil {
+		env = cfg.Environment.Variables
+	}
+
+	tf := iac.NewBody().
+		Set("function_name", name).
+		Set("description", cfg.Description).
+		Set("role", cfg.Role)
+	props := iac.NewBody().
+		Set("FunctionName", name).
+		Set("Description", cfg.Description).
+		Set("Role", cfg.Role)
+	if image {
+		tf.Set("package_type", "Image").Set("image_uri", codePlaceholder)
+		props.Set("PackageType", "Image").Set("Code", iac.NewBody().Set("ImageUri", codePlaceholder))
+	} else {
+		tf.Set("handler", cfg.Handler).
+			Set("runtime", cfg.Runtime).
+			Set("filename", codePlaceholder+".zip")
+		props.Set("Handler", cfg.Handler).
+			Set("Runtime", cfg.Runtime).
+			Set("Code", iac.NewBody().Set("S3Bucket", codePlaceholder).Set("S3Key", codePlaceholder+".zip"))
+	}
+	tf.Set("architectures", cfg.Architectures).
+		Set("memory_size", cfg.MemorySize).
+		Set("timeout", cfg.Timeout).
+		Set("layers", layers).
+		Set("kms_key_arn", cfg.KMSKeyArn).
+		Set("reserved_concurrent_executions", fn.ReservedConcurrency)
+	props.Set("Architectures", cfg.Architectures).
+		Set("MemorySize", cfg.MemorySize).
+		Set("Timeout", cfg.Timeout).
+		Set("Layers", layers).
+		Set("KmsKeyArn", cfg.KMSKeyArn).
+		Set("ReservedConcurrentExecutions", fn.ReservedConcurrency)
+
+	if len(env) > 0 {
+		tf.Block("environment").Set("variables", env)
+		props.Set("Environment", iac.NewBody().Set("Variables", env))
+	}
+	if vpc := cfg.VpcConfig; vpc != nil && len(vpc.SubnetIds) > 0 {
+		tf.Block("vpc_config").
+			Set("subnet_ids", vpc.SubnetIds).
+			Set("security_group_ids", vpc.SecurityGroupIds)
+		props.Set("VpcConfig", iac.NewBody().
+			Set("SubnetIds", vpc.SubnetIds).
+			Set("SecurityGroupIds", vpc.SecurityGroupIds))
+	}
+	if dlq := cfg.DeadLetterConfig; dlq != nil && dlq.TargetArn != nil {
+		tf.Block("dead_letter_config").Set("target_arn", dlq.TargetArn)
+		props.Set("DeadLetterConfig", iac.NewBody().Set("TargetArn", dlq.TargetArn))
+	}
+	if tracing := cfg.TracingConfig; tracing != nil && tracing.Mode == types.TracingModeActive {
+		tf.Block("tracing_config").Set("mode", tracing.Mode)
+		props.Set("TracingConfig", iac.NewBody().Set("Mode", tracing.Mode))
+	}
+	// 512 MB is the default
+	if storage := cfg.EphemeralStorage; storage != nil && appaws.Int32(storage.Size) != 512 {
+		tf.Block("ephemeral_storage").Set("size", storage.Size)
+		props.Set("EphemeralStorage", iac.NewBody().Set("Size", storage.Size))
+	}
+	if logging := cfg.LoggingConfig; logging != nil && logging.LogGroup != nil &&
+		appaws.Str(logging.LogGroup) != "/aws/lambda/"+name {
+		tf.Block("logging_config").
+			Set("log_format", logging.LogFormat).
+			Set("log_group", logging.LogGroup)
+		props.Set("LoggingConfig", iac.NewBody().
+			Set("LogFormat", logging.LogFormat).
+			Set("LogGroup", logging.LogGroup))
+	}
+
+	return &iac.Block{
+		Name: "Lambda function " + name,
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_lambda_function",
+			Name:     iac.LocalName(name),
+			ImportID: name,
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::Lambda::Function",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("FunctionName", name),
+			Properties: props,
+		},
+		Notes: []string{"the function code is not included: replace " + codePlaceholder + " with the deployment package"},
+	}, nil
+}
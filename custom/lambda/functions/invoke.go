@@ -0,0 +1,177 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	fieldQualifier = "qualifier"
+
+	latestQualifier = "$LATEST"
+	// maxQualifierVersions is how many of the newest versions are offered
+	maxQualifierVersions = 20
+)
+
+// invokeCompose invokes the function with a payload from the compose view
+var invokeCompose = &action.Compose{
+	Fields: func(dao.Resource) []action.ComposeField {
+		return []action.ComposeField{
+			{Key: fieldQualifier, Label: "Qualifier", Value: latestQualifier, Options: []string{latestQualifier}},
+		}
+	},
+	Body: func(dao.Resource) (string, string) {
+		return "{}", ".json"
+	},
+	Options: loadQualifiers,
+	Events: func(r dao.Resource) []string {
+		return []string{"lambda", r.GetName()}
+	},
+	Send: sendInvoke,
+}
+
+// loadQualifiers offers $LATEST, the aliases and the newest versions
+func loadQualifiers(ctx context.Context, resource dao.Resource) (map[string][]string, error) {
+	client, err := getLambdaClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := resource.GetName()
+
+	var aliases []string
+	aliasPaginator := lambda.NewListAliasesPaginator(client, &lambda.ListAliasesInput{FunctionName: &name})
+	for aliasPaginator.HasMorePages() {
+		page, err := aliasPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list aliases of %s", name)
+		}
+		for _, a := range page.Aliases {
+			aliases = append(aliases, appaws.Str(a.Name))
+		}
+	}
+
+	var versions []int
+	versionPaginator := lambda.NewListVersionsByFunctionPaginator(client, &lambda.ListVersionsByFunctionInput{FunctionName: &name})
+	for versionPaginator.HasMorePages() {
+		page, err := versionPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list versions of %s", name)
+		}
+		for _, v := range page.Versions {
+			if n, err := strconv.Atoi(appaws.Str(v.Version)); err == nil {
+				versions = append(versions, n)
+			}
+		}
+	}
+	return map[string][]string{fieldQualifier: qualifierOptions(aliases, versions)}, nil
+}
+
+// qualifierOptions lists $LATEST, the aliases by name, then the newest versions first
+func qualifierOptions(aliases []string, versions []int) []string {
+	slices.Sort(aliases)
+	slices.Sort(versions)
+	slices.Reverse(versions)
+	options := append([]string{latestQualifier}, aliases...)
+	for _, v := range versions[:min(len(versions), maxQualifierVersions)] {
+		options = append(options, strconv.Itoa(v))
+	}
+	return options
+}
+
+func sendInvoke(ctx context.Context, resource dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+	fn, ok := dao.UnwrapResource(resource).(*FunctionResource)
+	if !ok {
+		return action.ComposeResult{}, fmt.Errorf("invalid resource type")
+	}
+	payload := []byte(strings.TrimSpace(body))
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	}
+	if !json.Valid(payload) {
+		return action.ComposeResult{}, fmt.Errorf("payload must be valid JSON")
+	}
+
+	client, err := getLambdaClient(ctx)
+	if err != nil {
+		return action.ComposeResult{}, err
+	}
+	name := fn.GetName()
+	input := &lambda.InvokeInput{
+		FunctionName:   &name,
+		Payload:        payload,
+		InvocationType: lambdatypes.InvocationTypeRequestResponse,
+		LogType:        lambdatypes.LogTypeTail,
+	}
+	if q := values[fieldQualifier]; q != "" && q != latestQualifier {
+		input.Qualifier = &q
+	}
+	output, err := client.Invoke(ctx, input)
+	if err != nil {
+		return action.ComposeResult{}, apperrors.Wrapf(err, "invoke function %s", name)
+	}
+
+	requestID, _ := awsmiddleware.GetRequestIDMetadata(output.ResultMetadata)
+	return invokeResult(fn, output, requestID), nil
+}
+
+// invokeResult shows the response payload, the function error and the log tail
+func invokeResult(fn *FunctionResource, output *lambda.InvokeOutput, requestID string) action.ComposeResult {
+	version := appaws.Str(output.ExecutedVersion)
+	result := action.ComposeResult{
+		Message:   fmt.Sprintf("Invoked %s:%s (status %d, request %s)", fn.GetName(), version, output.StatusCode, requestID),
+		ID:        requestID,
+		LogGroup:  fn.LogGroupName(),
+		LogFilter: requestID,
+	}
+	if fnErr := appaws.Str(output.FunctionError); fnErr != "" {
+		result.Failed = true
+		result.Message = fmt.Sprintf("Function error (%s) in %s:%s, request %s", fnErr, fn.GetName(), version, requestID)
+	}
+
+	var out strings.Builder
+	out.WriteString("Response\n")
+	out.WriteString(prettyPayload(output.Payload) + "\n")
+	if tail := decodeLogResult(appaws.Str(output.LogResult)); tail != "" {
+		out.WriteString("\nLog tail\n" + tail)
+	}
+	result.Output = out.String()
+	return result
+}
+
+func prettyPayload(payload []byte) string {
+	if len(payload) == 0 {
+		return "(empty)"
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, payload, "", "  "); err == nil {
+		return buf.String()
+	}
+	return string(payload)
+}
+
+// decodeLogResult decodes the base64 tail (last 4 KB) of the invocation log
+func decodeLogResult(logResult string) string {
+	if logResult == "" {
+		return ""
+	}
+	data, err := base64.StdEncoding.DecodeString(logResult)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(string(data), "\n")
+}
@@ -0,0 +1,69 @@
+package functions
+
+import (
+	"encoding/base64"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+func TestQualifierOptions(t *testing.T) {
+	versions := make([]int, 30)
+	for i := range versions {
+		versions[i] = i + 1
+	}
+	got := qualifierOptions([]string{"prod", "live"}, versions)
+	if !slices.Equal(got[:5], []string{"$LATEST", "live", "prod", "30", "29"}) {
+		t.Errorf("qualifierOptions() = %v", got[:5])
+	}
+	if len(got) != 3+maxQualifierVersions {
+		t.Errorf("qualifierOptions() = %d options, want the newest %d versions", len(got), maxQualifierVersions)
+	}
+}
+
+func TestInvokeResult(t *testing.T) {
+	fn := NewFunctionResourceFromConfig(types.FunctionConfiguration{FunctionName: aws.String("orders-api")})
+	tail := "START RequestId: req-1\nboom\nEND RequestId: req-1\n"
+	output := &lambda.InvokeOutput{
+		StatusCode:      200,
+		ExecutedVersion: aws.String("7"),
+		FunctionError:   aws.String("Unhandled"),
+		Payload:         []byte(`{"errorMessage":"boom"}`),
+		LogResult:       aws.String(base64.StdEncoding.EncodeToString([]byte(tail))),
+	}
+
+	result := invokeResult(fn, output, "req-1")
+	if !result.Failed || !strings.Contains(result.Message, "Unhandled") || !strings.Contains(result.Message, "orders-api:7") {
+		t.Errorf("result = %+v, want a function error", result)
+	}
+	if result.ID != "req-1" || result.LogGroup != "/aws/lambda/orders-api" || result.LogFilter != "req-1" {
+		t.Errorf("result = %+v, want logs filtered to the request", result)
+	}
+	for _, want := range []string{"\"errorMessage\": \"boom\"", "Log tail", "END RequestId: req-1"} {
+		if !strings.Contains(result.Output, want) {
+			t.Errorf("Output missing %q:\n%s", want, result.Output)
+		}
+	}
+
+	output.FunctionError = nil
+	output.Payload = nil
+	output.LogResult = nil
+	result = invokeResult(fn, output, "req-2")
+	if result.Failed || !strings.Contains(result.Output, "(empty)") || strings.Contains(result.Output, "Log tail") {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestFunctionResource_LogGroupName(t *testing.T) {
+	fn := NewFunctionResourceFromConfig(types.FunctionConfiguration{
+		FunctionName:  aws.String("orders-api"),
+		LoggingConfig: &types.LoggingConfig{LogGroup: aws.String("/shared/lambda")},
+	})
+	if got := fn.LogGroupName(); got != "/shared/lambda" {
+		t.Errorf("LogGroupName() = %q, want the configured log group", got)
+	}
+}
@@ -12,8 +12,9 @@ import (
 
 // FunctionRenderer renders Lambda functions
 var (
-	_ render.Navigator          = (*FunctionRenderer)(nil)
-	_ render.MetricSpecProvider = (*FunctionRenderer)(nil)
+	_ render.Navigator           = (*FunctionRenderer)(nil)
+	_ render.MetricSpecProvider  = (*FunctionRenderer)(nil)
+	_ render.MetricChartProvider = (*FunctionRenderer)(nil)
 )
 
 type FunctionRenderer struct {
@@ -322,7 +323,7 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 	var navs []render.Navigation
 
 	// Navigate to CloudWatch Logs
-	logGroupName := "/aws/lambda/" + fn.GetName()
+	logGroupName := fn.LogGroupName()
 	navs = append(navs, render.Navigation{
 		Key:         "l",
 		Label:       "Logs",
@@ -345,6 +346,16 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 		})
 	}
 
+	// Event source mappings (SQS, Kinesis, DynamoDB streams, ...)
+	navs = append(navs, render.Navigation{
+		Key:         "e",
+		Label:       "Event Sources",
+		Service:     "lambda",
+		Resource:    "event-source-mappings",
+		FilterField: "FunctionName",
+		FilterValue: fn.GetName(),
+	})
+
 	// VPC navigation (if function is in VPC)
 	if fn.Item.VpcConfig != nil && fn.Item.VpcConfig.VpcId != nil && *fn.Item.VpcConfig.VpcId != "" {
 		navs = append(navs, render.Navigation{
@@ -372,13 +383,38 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 	return navs
 }
 
-func (r *FunctionRenderer) MetricSpec() *render.MetricSpec {
-	return &render.MetricSpec{
-		Namespace:     "AWS/Lambda",
-		MetricName:    "Invocations",
-		DimensionName: "FunctionName",
-		Stat:          "Sum",
-		ColumnHeader:  "INVOC(15m)",
-		Unit:          "",
+func (r *FunctionRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{
+		{
+			Namespace:     "AWS/Lambda",
+			MetricName:    "Invocations",
+			DimensionName: "FunctionName",
+			Stat:          "Sum",
+			ColumnHeader:  "INVOC(15m)",
+		},
+		{
+			Namespace:     "AWS/Lambda",
+			MetricName:    "Errors",
+			DimensionName: "FunctionName",
+			Stat:          "Sum",
+			ColumnHeader:  "ERRORS(15m)",
+		},
+		{
+			Namespace:     "AWS/Lambda",
+			MetricName:    "Duration",
+			DimensionName: "FunctionName",
+			Stat:          "p99",
+			ColumnHeader:  "P99(15m)",
+			Unit:          "ms",
+		},
+	}
+}
+
+func (r *FunctionRenderer) MetricCharts() []render.MetricSpec {
+	return []render.MetricSpec{
+		{Namespace: "AWS/Lambda", MetricName: "Invocations", DimensionName: "FunctionName", Stat: "Sum"},
+		{Namespace: "AWS/Lambda", MetricName: "Errors", DimensionName: "FunctionName", Stat: "Sum"},
+		{Namespace: "AWS/Lambda", MetricName: "Duration", DimensionName: "FunctionName", Stat: "Average", Unit: "ms"},
+		{Namespace: "AWS/Lambda", MetricName: "Throttles", DimensionName: "FunctionName", Stat: "Sum"},
 	}
 }
@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ render.Navigator          = (*InstanceRenderer)(nil)
-	_ render.MetricSpecProvider = (*InstanceRenderer)(nil)
+	_ render.Navigator           = (*InstanceRenderer)(nil)
+	_ render.MetricSpecProvider  = (*InstanceRenderer)(nil)
+	_ render.MetricChartProvider = (*InstanceRenderer)(nil)
 )
 
 // InstanceRenderer renders RDS instances with custom columns
@@ -306,13 +307,31 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 	return navs
 }
 
-func (r *InstanceRenderer) MetricSpec() *render.MetricSpec {
-	return &render.MetricSpec{
-		Namespace:     "AWS/RDS",
-		MetricName:    "CPUUtilization",
-		DimensionName: "DBInstanceIdentifier",
-		Stat:          "Average",
-		ColumnHeader:  "CPU(15m)",
-		Unit:          "%",
+func (r *InstanceRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{
+		{
+			Namespace:     "AWS/RDS",
+			MetricName:    "CPUUtilization",
+			DimensionName: "DBInstanceIdentifier",
+			Stat:          "Average",
+			ColumnHeader:  "CPU(15m)",
+			Unit:          "%",
+		},
+		{
+			Namespace:     "AWS/RDS",
+			MetricName:    "DatabaseConnections",
+			DimensionName: "DBInstanceIdentifier",
+			Stat:          "Average",
+			ColumnHeader:  "CONN(15m)",
+		},
+	}
+}
+
+func (r *InstanceRenderer) MetricCharts() []render.MetricSpec {
+	return []render.MetricSpec{
+		{Namespace: "AWS/RDS", MetricName: "CPUUtilization", DimensionName: "DBInstanceIdentifier", Stat: "Average", Unit: "%"},
+		{Namespace: "AWS/RDS", MetricName: "DatabaseConnections", DimensionName: "DBInstanceIdentifier", Stat: "Average"},
+		{Namespace: "AWS/RDS", MetricName: "FreeableMemory", DimensionName: "DBInstanceIdentifier", Stat: "Average", Unit: "B"},
+		{Namespace: "AWS/RDS", MetricName: "ReadIOPS", DimensionName: "DBInstanceIdentifier", Stat: "Average"},
 	}
 }
@@ -0,0 +1,149 @@
+package recordsets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("route53", "record-sets", generateRecordSet)
+}
+
+func generateRecordSet(res dao.Resource) (*iac.Block, error) {
+	record, ok := res.(*RecordSetResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	rs := record.Item
+	zoneID := strings.TrimPrefix(record.HostedZoneID, "/hostedzone/")
+	name := decodeRecordName(record.RecordName())
+	recordType := string(rs.Type)
+
+	importID := zoneID + "_" + name + "_" + recordType
+	label := name + " " + recordType
+	if rs.SetIdentifier != nil {
+		importID += "_" + *rs.SetIdentifier
+		label += " " + *rs.SetIdentifier
+	}
+
+	var tfRecords []string
+	for _, v := range record.Values() {
+		if recordType == "TXT" || recordType == "SPF" {
+			v = terraformTXT(v)
+		}
+		tfRecords = append(tfRecords, v)
+	}
+
+	tf := iac.NewBody().
+		Set("zone_id", zoneID).
+		Set("name", name).
+		Set("type", recordType).
+		Set("set_identifier", rs.SetIdentifier)
+	props := iac.NewBody().
+		Set("HostedZoneId", zoneID).
+		Set("Name", name+".").
+		Set("Type", recordType).
+		Set("SetIdentifier", rs.SetIdentifier)
+
+	if alias := rs.AliasTarget; alias != nil {
+		tf.Block("alias").
+			Set("name", alias.DNSName).
+			Set("zone_id", alias.HostedZoneId).
+			Set("evaluate_target_health", alias.EvaluateTargetHealth)
+		props.Set("AliasTarget", iac.NewBody().
+			Set("DNSName", alias.DNSName).
+			Set("HostedZoneId", alias.HostedZoneId).
+			Set("EvaluateTargetHealth", alias.EvaluateTargetHealth))
+	} else {
+		tf.Set("ttl", rs.TTL).Set("records", tfRecords)
+		// CloudFormation takes the TTL as a string
+		if rs.TTL != nil {
+			props.Set("TTL", strconv.FormatInt(*rs.TTL, 10))
+		}
+		props.Set("ResourceRecords", record.Values())
+	}
+
+	setRoutingPolicy(tf, props, rs)
+	tf.Set("health_check_id", rs.HealthCheckId)
+	props.Set("HealthCheckId", rs.HealthCheckId)
+
+	block := &iac.Block{
+		Name: "Route 53 record " + label,
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_route53_record",
+			Name:     iac.LocalName(name + "_" + recordType),
+			ImportID: importID,
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::Route53::RecordSet",
+			LogicalID:  iac.LogicalID(name + " " + recordType),
+			Properties: props,
+		},
+		Notes: []string{"CloudFormation cannot import record sets: delete and recreate, or manage the record in Terraform"},
+	}
+	if recordType == "NS" || recordType == "SOA" {
+		block.Notes = append(block.Notes, "NS and SOA records of the zone apex are created with the hosted zone")
+	}
+	return block, nil
+}
+
+func setRoutingPolicy(tf, props *iac.Body, rs types.ResourceRecordSet) {
+	switch {
+	case rs.Weight != nil:
+		tf.Block("weighted_routing_policy").Set("weight", rs.Weight)
+		props.Set("Weight", rs.Weight)
+	case rs.Region != "":
+		tf.Block("latency_routing_policy").Set("region", rs.Region)
+		props.Set("Region", rs.Region)
+	case rs.Failover != "":
+		tf.Block("failover_routing_policy").Set("type", rs.Failover)
+		props.Set("Failover", rs.Failover)
+	case rs.GeoLocation != nil:
+		tf.Block("geolocation_routing_policy").
+			Set("continent", rs.GeoLocation.ContinentCode).
+			Set("country", rs.GeoLocation.CountryCode).
+			Set("subdivision", rs.GeoLocation.SubdivisionCode)
+		props.Set("GeoLocation", iac.NewBody().
+			Set("ContinentCode", rs.GeoLocation.ContinentCode).
+			Set("CountryCode", rs.GeoLocation.CountryCode).
+			Set("SubdivisionCode", rs.GeoLocation.SubdivisionCode))
+	case appaws.Bool(rs.MultiValueAnswer):
+		tf.Set("multivalue_answer_routing_policy", true)
+		props.Set("MultiValueAnswer", true)
+	}
+}
+
+// decodeRecordName decodes the octal escapes Route 53 uses for characters
+// such as * (\052)
+func decodeRecordName(name string) string {
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+3 < len(name) {
+			if n, err := strconv.ParseUint(name[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(name[i])
+	}
+	return sb.String()
+}
+
+// terraformTXT converts a TXT value from the API ("part1" "part2") to the
+// form aws_route53_record expects: unquoted, with "" between 255-character
+// strings
+func terraformTXT(v string) string {
+	if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+		return v
+	}
+	return strings.ReplaceAll(v[1:len(v)-1], `" "`, `""`)
+}
@@ -0,0 +1,73 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/custom/s3/objects"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("s3", "buckets", []action.Action{
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteBucket",
+			Confirm:   action.ConfirmDangerous,
+			Plan:      planDeleteBucket,
+		},
+		{
+			Name:      "Upload",
+			Shortcut:  "U",
+			Type:      action.ActionTypeAPI,
+			Operation: "UploadObjects",
+			Confirm:   action.ConfirmDangerous,
+			Transfer: objects.NewUploadTransfer(func(r dao.Resource) string {
+				return apps3.URI(r.GetID(), "")
+			}),
+		},
+	})
+
+	action.RegisterExecutor("s3", "buckets", executeBucketAction)
+}
+
+func executeBucketAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeleteBucket":
+		return executeDeleteBucket(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeDeleteBucket(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := bucketClient(ctx, resource)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	bucket := resource.GetID()
+	if _, err := client.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: &bucket}); err != nil {
+		return action.FailResultf(err, "delete bucket %s", bucket)
+	}
+
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Deleted bucket %s", bucket),
+	}
+}
+
+// bucketClient returns a client for the bucket's region. Buckets are listed
+// globally, so the region can differ from the current one.
+func bucketClient(ctx context.Context, resource dao.Resource) (*s3.Client, error) {
+	if b, ok := dao.UnwrapResource(resource).(*BucketResource); ok && b.Region != "" {
+		return apps3.GetClientForRegion(ctx, b.Region)
+	}
+	return apps3.GetClient(ctx)
+}
@@ -0,0 +1,98 @@
+package buckets
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("s3", "buckets", generateBucket)
+}
+
+// generateBucket writes the bucket settings loaded by Get. Terraform
+// configures versioning, encryption and public access with separate
+// resources; CloudFormation keeps them on the bucket.
+func generateBucket(res dao.Resource) (*iac.Block, error) {
+	bucket, ok := res.(*BucketResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	name := bucket.BucketName
+	local := iac.LocalName(name)
+	ref := iac.Ref("aws_s3_bucket." + local + ".id")
+
+	tf := iac.NewBody().Set("bucket", name)
+	if bucket.ObjectLockEnabled {
+		tf.Set("object_lock_enabled", true)
+	}
+	tf.Set("tags", iac.UserTags(bucket.GetTags()))
+	resources := []iac.TerraformResource{{Type: "aws_s3_bucket", Name: local, ImportID: name, Body: tf}}
+	props := iac.NewBody().Set("BucketName", name)
+
+	if bucket.Versioning == "Enabled" || bucket.Versioning == "Suspended" {
+		body := iac.NewBody().Set("bucket", ref)
+		body.Block("versioning_configuration").Set("status", bucket.Versioning)
+		resources = append(resources, iac.TerraformResource{Type: "aws_s3_bucket_versioning", Name: local, ImportID: name, Body: body})
+		props.Set("VersioningConfiguration", iac.NewBody().Set("Status", bucket.Versioning))
+	}
+
+	if bucket.EncryptionEnabled {
+		body := iac.NewBody().Set("bucket", ref)
+		rule := body.Block("rule")
+		rule.Block("apply_server_side_encryption_by_default").
+			Set("sse_algorithm", bucket.EncryptionAlgorithm).
+			Set("kms_master_key_id", bucket.EncryptionKMSKeyID)
+		if bucket.BucketKeyEnabled {
+			rule.Set("bucket_key_enabled", true)
+		}
+		resources = append(resources, iac.TerraformResource{Type: "aws_s3_bucket_server_side_encryption_configuration", Name: local, ImportID: name, Body: body})
+
+		cfnRule := iac.NewBody().Set("ServerSideEncryptionByDefault", iac.NewBody().
+			Set("SSEAlgorithm", bucket.EncryptionAlgorithm).
+			Set("KMSMasterKeyID", bucket.EncryptionKMSKeyID))
+		if bucket.BucketKeyEnabled {
+			cfnRule.Set("BucketKeyEnabled", true)
+		}
+		props.Set("BucketEncryption", iac.NewBody().Set("ServerSideEncryptionConfiguration", []any{cfnRule}))
+	}
+
+	if pab := bucket.PublicAccessBlock; pab != nil {
+		body := iac.NewBody().
+			Set("bucket", ref).
+			Set("block_public_acls", pab.BlockPublicAcls).
+			Set("block_public_policy", pab.BlockPublicPolicy).
+			Set("ignore_public_acls", pab.IgnorePublicAcls).
+			Set("restrict_public_buckets", pab.RestrictPublicBuckets)
+		resources = append(resources, iac.TerraformResource{Type: "aws_s3_bucket_public_access_block", Name: local, ImportID: name, Body: body})
+		props.Set("PublicAccessBlockConfiguration", iac.NewBody().
+			Set("BlockPublicAcls", pab.BlockPublicAcls).
+			Set("BlockPublicPolicy", pab.BlockPublicPolicy).
+			Set("IgnorePublicAcls", pab.IgnorePublicAcls).
+			Set("RestrictPublicBuckets", pab.RestrictPublicBuckets))
+	}
+
+	if bucket.ObjectLockEnabled {
+		props.Set("ObjectLockEnabled", true)
+	}
+	props.Set("Tags", iac.CloudFormationTags(bucket.GetTags()))
+
+	block := &iac.Block{
+		Name:      "S3 bucket " + name,
+		Terraform: resources,
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::S3::Bucket",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("BucketName", name),
+			Properties: props,
+		},
+	}
+	if bucket.LifecycleRulesCount > 0 {
+		block.Notes = append(block.Notes, fmt.Sprintf("%d lifecycle rule(s) not included", bucket.LifecycleRulesCount))
+	}
+	if bucket.ObjectLockMode != "" {
+		block.Notes = append(block.Notes, fmt.Sprintf("default retention (%s, %s) not included", bucket.ObjectLockMode, bucket.ObjectLockRetention))
+	}
+	return block, nil
+}
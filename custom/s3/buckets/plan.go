@@ -0,0 +1,59 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// countLimit caps how many object versions the plan counts
+const countLimit = 100000
+
+// planDeleteBucket empties the bucket: every object version and delete
+// marker, then incomplete multipart uploads. Steps list the bucket again
+// when they run, so objects written after planning are removed too.
+func planDeleteBucket(ctx context.Context, resource dao.Resource) (*action.Plan, error) {
+	client, err := bucketClient(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	bucket := resource.GetID()
+	plan := &action.Plan{}
+
+	stats, err := apps3.CountVersions(ctx, client, bucket, "", countLimit)
+	if err != nil {
+		return nil, err
+	}
+	if stats.Total() > 0 {
+		count := fmt.Sprintf("%d", stats.Total())
+		if stats.Truncated {
+			count += "+"
+		}
+		plan.AddStep(
+			fmt.Sprintf("Permanently delete %s object versions and delete markers (%s)", count, render.FormatSize(stats.Size)),
+			func(ctx context.Context) error {
+				_, err := apps3.DeleteAllVersions(ctx, client, bucket, "", nil)
+				return err
+			})
+	}
+
+	uploads, err := apps3.CountMultipartUploads(ctx, client, bucket, "")
+	if err != nil {
+		return nil, err
+	}
+	if uploads > 0 {
+		plan.AddStep(fmt.Sprintf("Abort %d incomplete multipart upload(s)", uploads), func(ctx context.Context) error {
+			_, err := apps3.AbortMultipartUploads(ctx, client, bucket, "")
+			return err
+		})
+	}
+
+	if b, ok := dao.UnwrapResource(resource).(*BucketResource); ok && b.ObjectLockEnabled {
+		plan.Warnings = append(plan.Warnings, "Object Lock is enabled: versions under retention or legal hold cannot be deleted.")
+	}
+	return plan, nil
+}
@@ -3,6 +3,8 @@ package buckets
 import (
 	"fmt"
 
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/custom/s3/objects"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -185,6 +187,24 @@ func (r *BucketRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// Navigations returns navigation shortcuts for a bucket
+func (r *BucketRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	b, ok := resource.(*BucketResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "o",
+			Label:       "Objects",
+			Service:     "s3",
+			Resource:    "objects",
+			FilterField: objects.FilterURI,
+			FilterValue: apps3.URI(b.BucketName, ""),
+		},
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	b, ok := resource.(*BucketResource)
@@ -2,12 +2,17 @@ package s3
 
 import (
 	"context"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
+// bucketRegions caches bucket regions by name; bucket names are global
+var bucketRegions sync.Map
+
 // GetClient returns an S3 client configured for the current context
 func GetClient(ctx context.Context) (*s3.Client, error) {
 	cfg, err := appaws.NewConfig(ctx)
@@ -25,3 +30,35 @@ func GetClientForRegion(ctx context.Context, region string) (*s3.Client, error)
 	}
 	return s3.NewFromConfig(cfg), nil
 }
+
+// GetClientForBucket returns an S3 client for the bucket's region. Object
+// requests to another region's endpoint fail with a redirect.
+func GetClientForBucket(ctx context.Context, bucket string) (*s3.Client, error) {
+	region, err := BucketRegion(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return GetClientForRegion(ctx, region)
+}
+
+// BucketRegion returns the region of a bucket, looked up once per bucket
+func BucketRegion(ctx context.Context, bucket string) (string, error) {
+	if region, ok := bucketRegions.Load(bucket); ok {
+		return region.(string), nil
+	}
+	client, err := GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	output, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: &bucket})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get bucket location for %s", bucket)
+	}
+	// Buckets in us-east-1 have no location constraint
+	region := "us-east-1"
+	if output.LocationConstraint != "" {
+		region = string(output.LocationConstraint)
+	}
+	bucketRegions.Store(bucket, region)
+	return region, nil
+}
@@ -0,0 +1,268 @@
+package objects
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/render"
+)
+
+const (
+	// maxViewSize is the largest object shown in the text view
+	maxViewSize = 1 << 20
+	// presignExpiry is how long presigned URLs are valid
+	presignExpiry = time.Hour
+)
+
+func init() {
+	action.Global.Register("s3", "objects", []action.Action{
+		{
+			Name:      "View",
+			Shortcut:  "v",
+			Type:      action.ActionTypeAPI,
+			Operation: "ViewObject",
+			Filter: func(r dao.Resource) bool {
+				obj, ok := r.(*ObjectResource)
+				return ok && isObject(obj) && obj.Size <= maxViewSize
+			},
+		},
+		{
+			Name:      "Download",
+			Shortcut:  "w",
+			Type:      action.ActionTypeAPI,
+			Operation: "DownloadObject",
+			Confirm:   action.ConfirmSimple,
+			Filter:    isObjectResource,
+		},
+		{
+			Name:      "Copy S3 URI",
+			Shortcut:  "c",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyURI",
+		},
+		{
+			Name:      "Copy presigned URL (1h)",
+			Shortcut:  "p",
+			Type:      action.ActionTypeAPI,
+			Operation: "PresignURL",
+			Filter:    isObjectResource,
+		},
+		{
+			Name:      "Upload here",
+			Shortcut:  "U",
+			Type:      action.ActionTypeAPI,
+			Operation: "UploadObjects",
+			Confirm:   action.ConfirmDangerous,
+			Transfer:  NewUploadTransfer(currentPrefixURI),
+		},
+		{
+			Name:      "Copy to...",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyObjects",
+			Confirm:   action.ConfirmDangerous,
+			Filter:    isCopySource,
+			Transfer:  newCopyTransfer(false),
+		},
+		{
+			Name:      "Move to...",
+			Shortcut:  "M",
+			Type:      action.ActionTypeAPI,
+			Operation: "MoveObjects",
+			Confirm:   action.ConfirmDangerous,
+			Filter:    isMoveSource,
+			Transfer:  newCopyTransfer(true),
+		},
+		{
+			Name:      "Delete prefix (all versions)",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeletePrefix",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				obj, ok := r.(*ObjectResource)
+				return ok && obj.IsFolder
+			},
+			Transfer: deletePrefixTransfer,
+		},
+	})
+
+	action.RegisterExecutor("s3", "objects", executeObjectAction)
+}
+
+// isObject reports whether the resource has content: not a folder or a delete marker
+func isObject(obj *ObjectResource) bool {
+	return !obj.IsFolder && !obj.IsDeleteMarker
+}
+
+func isObjectResource(r dao.Resource) bool {
+	obj, ok := r.(*ObjectResource)
+	return ok && isObject(obj)
+}
+
+// isCopySource reports whether the resource can be copied: a folder, an
+// object or an object version
+func isCopySource(r dao.Resource) bool {
+	obj, ok := r.(*ObjectResource)
+	return ok && !obj.IsDeleteMarker
+}
+
+// isMoveSource excludes noncurrent versions, which a move cannot delete
+// without losing history
+func isMoveSource(r dao.Resource) bool {
+	obj, ok := r.(*ObjectResource)
+	return ok && !obj.IsDeleteMarker && (obj.IsFolder || obj.IsLatest)
+}
+
+func executeObjectAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	obj, ok := dao.UnwrapResource(resource).(*ObjectResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "ViewObject":
+		return executeView(ctx, obj)
+	case "DownloadObject":
+		return executeDownload(ctx, obj)
+	case "CopyURI":
+		clipboard.Copy("S3 URI", obj.URI())()
+		return action.SuccessResult("Copied " + obj.URI())
+	case "PresignURL":
+		return executePresign(ctx, obj)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func getObjectInput(obj *ObjectResource) *s3.GetObjectInput {
+	input := &s3.GetObjectInput{Bucket: &obj.Bucket, Key: &obj.Key}
+	if obj.VersionID != "" {
+		input.VersionId = &obj.VersionID
+	}
+	return input
+}
+
+func executeView(ctx context.Context, obj *ObjectResource) action.ActionResult {
+	client, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	output, err := client.GetObject(ctx, getObjectInput(obj))
+	if err != nil {
+		return action.FailResultf(err, "get object %s", obj.URI())
+	}
+	defer output.Body.Close()
+
+	// Read one byte past the limit to detect objects that grew since listing
+	data, err := io.ReadAll(io.LimitReader(output.Body, maxViewSize+1))
+	if err != nil {
+		return action.FailResultf(err, "read object %s", obj.URI())
+	}
+	content, err := textContent(data)
+	if err != nil {
+		return action.FailResult(fmt.Errorf("%s: %w", obj.URI(), err))
+	}
+	return action.SuccessResultWithFollowUp(
+		fmt.Sprintf("Loaded %s", render.FormatSize(int64(len(data)))),
+		navmsg.ShowTextMsg{Title: obj.URI(), Content: content},
+	)
+}
+
+// textContent returns the object body as text, with JSON indented
+func textContent(data []byte) (string, error) {
+	if len(data) > maxViewSize {
+		return "", fmt.Errorf("object is larger than %s", render.FormatSize(maxViewSize))
+	}
+	if !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0 {
+		return "", errors.New("object is not text; download it instead")
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, trimmed, "", "  "); err == nil {
+			return buf.String(), nil
+		}
+	}
+	return string(data), nil
+}
+
+func executeDownload(ctx context.Context, obj *ObjectResource) action.ActionResult {
+	client, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	output, err := client.GetObject(ctx, getObjectInput(obj))
+	if err != nil {
+		return action.FailResultf(err, "get object %s", obj.URI())
+	}
+	defer output.Body.Close()
+
+	f, err := createDownloadFile(config.File().S3DownloadDir(), path.Base(obj.Key))
+	if err != nil {
+		return action.FailResultf(err, "create download file")
+	}
+	n, err := io.Copy(f, output.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return action.FailResultf(err, "download %s", obj.URI())
+	}
+	return action.SuccessResult(fmt.Sprintf("Downloaded %s to %s", render.FormatSize(n), f.Name()))
+}
+
+// createDownloadFile creates name in dir, adding a counter before the
+// extension instead of overwriting an existing file
+func createDownloadFile(dir, name string) (*os.File, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s (%d)%s", base, i, ext)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, os.ErrExist) && i < 1000 {
+			continue
+		}
+		return f, err
+	}
+}
+
+func executePresign(ctx context.Context, obj *ObjectResource) action.ActionResult {
+	client, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	req, err := s3.NewPresignClient(client).PresignGetObject(ctx, getObjectInput(obj), s3.WithPresignExpires(presignExpiry))
+	if err != nil {
+		return action.FailResultf(err, "presign %s", obj.URI())
+	}
+	clipboard.Copy("presigned URL", req.URL)()
+	return action.SuccessResult(fmt.Sprintf("Copied presigned URL for %s (expires in %s)", obj.GetName(), presignExpiry))
+}
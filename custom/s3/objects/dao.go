@@ -0,0 +1,364 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// FilterURI is the filter holding the s3://bucket/prefix/ being browsed
+	FilterURI = "S3URI"
+	// ToggleVersions lists every object version
+	ToggleVersions = "ShowVersions"
+	// ToggleDeleteMarkers lists delete markers
+	ToggleDeleteMarkers = "ShowDeleteMarkers"
+
+	delimiter   = "/"
+	maxPageSize = 1000 // ListObjectsV2 and ListObjectVersions limit
+)
+
+var errNoLocation = fmt.Errorf("%s required: navigate from s3/buckets using 'o' key", FilterURI)
+
+// ObjectDAO provides data access for S3 objects, one folder level at a time
+type ObjectDAO struct {
+	dao.BaseDAO
+}
+
+// NewObjectDAO creates a new ObjectDAO. Clients are created per bucket,
+// for the bucket's region.
+func NewObjectDAO(ctx context.Context) (dao.DAO, error) {
+	return &ObjectDAO{
+		BaseDAO: dao.NewBaseDAO("s3", "objects"),
+	}, nil
+}
+
+// List returns the first page of objects.
+// For paginated access, use ListPage instead.
+func (d *ObjectDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, maxPageSize, "")
+	return resources, err
+}
+
+// ListPage returns a page of folders and objects directly under the prefix.
+// Implements dao.PaginatedDAO interface.
+func (d *ObjectDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	location := dao.GetFilterFromContext(ctx, FilterURI)
+	if location == "" {
+		return nil, "", errNoLocation
+	}
+	bucket, prefix, _, err := apps3.ParseURI(location)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "new s3 client")
+	}
+	region, _ := apps3.BucketRegion(ctx, bucket)
+
+	limit := int32(min(max(pageSize, 1), maxPageSize))
+	versions := dao.GetFilterFromContext(ctx, ToggleVersions) == "true"
+	markers := dao.GetFilterFromContext(ctx, ToggleDeleteMarkers) == "true"
+	if versions || markers {
+		return listVersions(ctx, client, bucket, prefix, region, limit, pageToken, versions, markers)
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:    &bucket,
+		Delimiter: appaws.StringPtr(delimiter),
+		MaxKeys:   &limit,
+	}
+	if prefix != "" {
+		input.Prefix = &prefix
+	}
+	if pageToken != "" {
+		input.ContinuationToken = &pageToken
+	}
+	output, err := client.ListObjectsV2(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "list objects in %s", location)
+	}
+
+	resources := make([]dao.Resource, 0, len(output.CommonPrefixes)+len(output.Contents))
+	for _, p := range output.CommonPrefixes {
+		resources = append(resources, NewFolderResource(bucket, prefix, appaws.Str(p.Prefix), region))
+	}
+	for _, obj := range output.Contents {
+		// The folder placeholder object created by the console
+		if appaws.Str(obj.Key) == prefix {
+			continue
+		}
+		resources = append(resources, NewObjectResource(bucket, prefix, obj, region))
+	}
+
+	nextToken := ""
+	if appaws.Bool(output.IsTruncated) {
+		nextToken = appaws.Str(output.NextContinuationToken)
+	}
+	return resources, nextToken, nil
+}
+
+// listVersions lists versions and delete markers. Without versions only the
+// latest entries are kept, so markers alone show objects that were deleted.
+func listVersions(ctx context.Context, client *s3.Client, bucket, prefix, region string, limit int32, pageToken string, versions, markers bool) ([]dao.Resource, string, error) {
+	input := &s3.ListObjectVersionsInput{
+		Bucket:    &bucket,
+		Delimiter: appaws.StringPtr(delimiter),
+		MaxKeys:   &limit,
+	}
+	if prefix != "" {
+		input.Prefix = &prefix
+	}
+	if pageToken != "" {
+		// Version listings continue from a key and version ID pair
+		values, err := url.ParseQuery(pageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid page token: %w", err)
+		}
+		input.KeyMarker = appaws.StringPtr(values.Get("key"))
+		if v := values.Get("version"); v != "" {
+			input.VersionIdMarker = &v
+		}
+	}
+	output, err := client.ListObjectVersions(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "list object versions in %s", apps3.URI(bucket, prefix))
+	}
+
+	resources := make([]dao.Resource, 0, len(output.CommonPrefixes)+len(output.Versions)+len(output.DeleteMarkers))
+	for _, p := range output.CommonPrefixes {
+		resources = append(resources, NewFolderResource(bucket, prefix, appaws.Str(p.Prefix), region))
+	}
+	for _, v := range output.Versions {
+		if appaws.Str(v.Key) == prefix || (!versions && !appaws.Bool(v.IsLatest)) {
+			continue
+		}
+		resources = append(resources, NewVersionResource(bucket, prefix, v, region))
+	}
+	if markers {
+		for _, m := range output.DeleteMarkers {
+			if !versions && !appaws.Bool(m.IsLatest) {
+				continue
+			}
+			resources = append(resources, NewDeleteMarkerResource(bucket, prefix, m, region))
+		}
+	}
+
+	nextToken := ""
+	if appaws.Bool(output.IsTruncated) {
+		values := url.Values{}
+		values.Set("key", appaws.Str(output.NextKeyMarker))
+		if v := appaws.Str(output.NextVersionIdMarker); v != "" {
+			values.Set("version", v)
+		}
+		nextToken = values.Encode()
+	}
+	return resources, nextToken, nil
+}
+
+// Get returns an object with its metadata and tags. id is the object's
+// S3 URI, with a versionId query parameter for a specific version.
+func (d *ObjectDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	bucket, key, versionID, err := apps3.ParseURI(id)
+	if err != nil {
+		return nil, err
+	}
+	region, _ := apps3.BucketRegion(ctx, bucket)
+	if key == "" || strings.HasSuffix(key, delimiter) {
+		return NewFolderResource(bucket, apps3.ParentPrefix(key), key, region), nil
+	}
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new s3 client")
+	}
+
+	input := &s3.HeadObjectInput{Bucket: &bucket, Key: &key}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	head, err := client.HeadObject(ctx, input)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "head object %s", id)
+	}
+
+	obj := &ObjectResource{
+		Bucket:       bucket,
+		Key:          key,
+		Prefix:       apps3.ParentPrefix(key),
+		Region:       region,
+		VersionID:    versionID,
+		Size:         appaws.Int64(head.ContentLength),
+		LastModified: appaws.Time(head.LastModified),
+		ETag:         appaws.Str(head.ETag),
+		StorageClass: string(head.StorageClass),
+		Head:         head,
+	}
+	if obj.StorageClass == "" {
+		// HeadObject omits the class for STANDARD objects
+		obj.StorageClass = string(types.StorageClassStandard)
+	}
+	obj.init()
+
+	tagging, err := client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{Bucket: &bucket, Key: &key, VersionId: input.VersionId})
+	if err == nil {
+		obj.Tags = appaws.TagsToMap(tagging.TagSet)
+	}
+	return obj, nil
+}
+
+// Delete deletes an object, or one version of it
+func (d *ObjectDAO) Delete(ctx context.Context, id string) error {
+	bucket, key, versionID, err := apps3.ParseURI(id)
+	if err != nil {
+		return err
+	}
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return apperrors.Wrap(err, "new s3 client")
+	}
+	input := &s3.DeleteObjectInput{Bucket: &bucket, Key: &key}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	if _, err := client.DeleteObject(ctx, input); err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete object %s", id)
+	}
+	return nil
+}
+
+// ObjectResource is an object, an object version, a delete marker or a
+// folder (common prefix)
+type ObjectResource struct {
+	dao.BaseResource
+	Bucket         string
+	Key            string // full key; for folders the prefix ending in /
+	Prefix         string // the folder being listed
+	Region         string // bucket region
+	VersionID      string // set when listing versions
+	IsLatest       bool
+	IsDeleteMarker bool
+	IsFolder       bool
+	Size           int64
+	LastModified   time.Time
+	ETag           string
+	StorageClass   string
+
+	// Head is set by Get: metadata, encryption, retention
+	Head *s3.HeadObjectOutput
+}
+
+// NewObjectResource creates an ObjectResource from ListObjectsV2
+func NewObjectResource(bucket, prefix string, obj types.Object, region string) *ObjectResource {
+	r := &ObjectResource{
+		Bucket:       bucket,
+		Key:          appaws.Str(obj.Key),
+		Prefix:       prefix,
+		Region:       region,
+		IsLatest:     true,
+		Size:         appaws.Int64(obj.Size),
+		LastModified: appaws.Time(obj.LastModified),
+		ETag:         appaws.Str(obj.ETag),
+		StorageClass: string(obj.StorageClass),
+	}
+	r.init()
+	r.Data = obj
+	return r
+}
+
+// NewVersionResource creates an ObjectResource for an object version
+func NewVersionResource(bucket, prefix string, v types.ObjectVersion, region string) *ObjectResource {
+	r := &ObjectResource{
+		Bucket:       bucket,
+		Key:          appaws.Str(v.Key),
+		Prefix:       prefix,
+		Region:       region,
+		VersionID:    appaws.Str(v.VersionId),
+		IsLatest:     appaws.Bool(v.IsLatest),
+		Size:         appaws.Int64(v.Size),
+		LastModified: appaws.Time(v.LastModified),
+		ETag:         appaws.Str(v.ETag),
+		StorageClass: string(v.StorageClass),
+	}
+	r.init()
+	r.Data = v
+	return r
+}
+
+// NewDeleteMarkerResource creates an ObjectResource for a delete marker
+func NewDeleteMarkerResource(bucket, prefix string, m types.DeleteMarkerEntry, region string) *ObjectResource {
+	r := &ObjectResource{
+		Bucket:         bucket,
+		Key:            appaws.Str(m.Key),
+		Prefix:         prefix,
+		Region:         region,
+		VersionID:      appaws.Str(m.VersionId),
+		IsLatest:       appaws.Bool(m.IsLatest),
+		IsDeleteMarker: true,
+		LastModified:   appaws.Time(m.LastModified),
+	}
+	r.init()
+	r.Data = m
+	return r
+}
+
+// NewFolderResource creates an ObjectResource for a common prefix
+func NewFolderResource(bucket, prefix, folder, region string) *ObjectResource {
+	r := &ObjectResource{
+		Bucket:   bucket,
+		Key:      folder,
+		Prefix:   prefix,
+		Region:   region,
+		IsFolder: true,
+	}
+	r.init()
+	r.Data = folder
+	return r
+}
+
+// init sets the ID, name and ARN from the key
+func (r *ObjectResource) init() {
+	r.ID = apps3.VersionURI(r.Bucket, r.Key, r.VersionID)
+	r.Name = strings.TrimPrefix(r.Key, r.Prefix)
+	if r.Name == "" {
+		r.Name = r.Key
+	}
+	r.ARN = fmt.Sprintf("arn:aws:s3:::%s/%s", r.Bucket, r.Key)
+	if r.Head != nil {
+		r.Data = r.Head
+	}
+}
+
+// URI returns the s3://bucket/key URI, without the version
+func (r *ObjectResource) URI() string {
+	return apps3.URI(r.Bucket, r.Key)
+}
+
+// GetRegion returns the bucket region, so actions and refreshes use it
+func (r *ObjectResource) GetRegion() string {
+	return r.Region
+}
+
+// MergeFrom keeps the listing fields HeadObject does not return
+func (r *ObjectResource) MergeFrom(original dao.Resource) {
+	o, ok := original.(*ObjectResource)
+	if !ok {
+		return
+	}
+	r.Prefix = o.Prefix
+	r.IsLatest = o.IsLatest
+	r.init()
+}
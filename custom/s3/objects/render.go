@@ -0,0 +1,261 @@
+package objects
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ObjectRenderer renders S3 objects and folders
+type ObjectRenderer struct {
+	render.BaseRenderer
+}
+
+// NewObjectRenderer creates a new ObjectRenderer
+func NewObjectRenderer() render.Renderer {
+	return &ObjectRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "s3",
+			Resource: "objects",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 50, Getter: getName, Priority: 0},
+				{Name: "SIZE", Width: 10, Getter: getSize, Priority: 1},
+				{Name: "STORAGE CLASS", Width: 20, Getter: getStorageClass, Priority: 2},
+				{Name: "LAST MODIFIED", Width: 17, Getter: getLastModified, Priority: 3},
+				{Name: "VERSION", Width: 36, Getter: getVersion, Priority: 4},
+			},
+		},
+	}
+}
+
+func getName(r dao.Resource) string {
+	return r.GetName()
+}
+
+func getSize(r dao.Resource) string {
+	obj, ok := r.(*ObjectResource)
+	if !ok || obj.IsFolder || obj.IsDeleteMarker {
+		return ""
+	}
+	return render.FormatSize(obj.Size)
+}
+
+func getStorageClass(r dao.Resource) string {
+	obj, ok := r.(*ObjectResource)
+	if !ok {
+		return ""
+	}
+	switch {
+	case obj.IsFolder:
+		return "Folder"
+	case obj.IsDeleteMarker:
+		return "Delete marker"
+	}
+	return obj.StorageClass
+}
+
+func getLastModified(r dao.Resource) string {
+	obj, ok := r.(*ObjectResource)
+	if !ok || obj.LastModified.IsZero() {
+		return ""
+	}
+	return obj.LastModified.Format("2006-01-02 15:04")
+}
+
+func getVersion(r dao.Resource) string {
+	obj, ok := r.(*ObjectResource)
+	if !ok || obj.VersionID == "" {
+		return ""
+	}
+	if obj.IsLatest {
+		return obj.VersionID + " (latest)"
+	}
+	return obj.VersionID
+}
+
+// Breadcrumb returns the location of a key as bucket › folder › ...
+func Breadcrumb(bucket, prefix string) string {
+	parts := []string{bucket}
+	for part := range strings.SplitSeq(strings.TrimSuffix(prefix, "/"), "/") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " › ")
+}
+
+// RenderDetail renders object metadata, encryption and tags
+func (r *ObjectRenderer) RenderDetail(resource dao.Resource) string {
+	obj, ok := resource.(*ObjectResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	switch {
+	case obj.IsFolder:
+		d.Title("S3 Folder", obj.Key)
+	case obj.IsDeleteMarker:
+		d.Title("S3 Delete Marker", obj.Key)
+	default:
+		d.Title("S3 Object", obj.Key)
+	}
+
+	d.Section("Location")
+	d.Field("Bucket", obj.Bucket)
+	d.Field("Key", obj.Key)
+	d.Field("Folder", Breadcrumb(obj.Bucket, apps3.ParentPrefix(obj.Key)))
+	d.Field("S3 URI", obj.URI())
+	d.Field("ARN", obj.GetARN())
+	if obj.Region != "" {
+		d.Field("Region", obj.Region)
+	}
+	if obj.IsFolder {
+		return d.String()
+	}
+
+	if obj.VersionID != "" {
+		d.Section("Version")
+		d.Field("Version ID", obj.VersionID)
+		if obj.IsLatest {
+			d.Field("Latest", "Yes")
+		} else {
+			d.Field("Latest", "No")
+		}
+	}
+	if obj.IsDeleteMarker {
+		if !obj.LastModified.IsZero() {
+			d.Field("Deleted", obj.LastModified.Format("2006-01-02 15:04:05"))
+		}
+		return d.String()
+	}
+
+	d.Section("Object")
+	d.Field("Size", render.FormatSize(obj.Size))
+	if !obj.LastModified.IsZero() {
+		d.Field("Last Modified", obj.LastModified.Format("2006-01-02 15:04:05"))
+	}
+	d.Field("Storage Class", obj.StorageClass)
+	if obj.ETag != "" {
+		d.Field("ETag", obj.ETag)
+	}
+
+	head := obj.Head
+	if head == nil {
+		d.Section("Metadata")
+		d.Field("Content Type", render.NotConfigured)
+		d.Section("Server-Side Encryption")
+		d.Field("Status", render.NotConfigured)
+		return d.String()
+	}
+	if head.Restore != nil {
+		d.Field("Restore", *head.Restore)
+	}
+	if head.ArchiveStatus != "" {
+		d.Field("Archive Status", string(head.ArchiveStatus))
+	}
+	if head.ReplicationStatus != "" {
+		d.Field("Replication", string(head.ReplicationStatus))
+	}
+	if head.Expiration != nil {
+		d.Field("Expiration", *head.Expiration)
+	}
+
+	d.Section("Metadata")
+	d.FieldIf("Content Type", head.ContentType)
+	d.FieldIf("Content Encoding", head.ContentEncoding)
+	d.FieldIf("Content Language", head.ContentLanguage)
+	d.FieldIf("Content Disposition", head.ContentDisposition)
+	d.FieldIf("Cache Control", head.CacheControl)
+	d.FieldIf("Website Redirect", head.WebsiteRedirectLocation)
+	for _, k := range slices.Sorted(maps.Keys(head.Metadata)) {
+		d.Field("x-amz-meta-"+k, head.Metadata[k])
+	}
+
+	d.Section("Server-Side Encryption")
+	if head.ServerSideEncryption != "" {
+		d.Field("Algorithm", string(head.ServerSideEncryption))
+		d.FieldIf("KMS Key ID", head.SSEKMSKeyId)
+		if appaws.Bool(head.BucketKeyEnabled) {
+			d.Field("Bucket Key", "Enabled")
+		}
+	} else if head.SSECustomerAlgorithm != nil {
+		d.Field("Algorithm", "SSE-C ("+*head.SSECustomerAlgorithm+")")
+	} else {
+		d.Field("Status", "None")
+	}
+
+	if head.ObjectLockMode != "" || head.ObjectLockLegalHoldStatus != "" {
+		d.Section("Object Lock")
+		if head.ObjectLockMode != "" {
+			d.Field("Mode", string(head.ObjectLockMode))
+		}
+		if head.ObjectLockRetainUntilDate != nil {
+			d.Field("Retain Until", head.ObjectLockRetainUntilDate.Format("2006-01-02 15:04:05"))
+		}
+		if head.ObjectLockLegalHoldStatus != "" {
+			d.Field("Legal Hold", string(head.ObjectLockLegalHoldStatus))
+		}
+	}
+
+	d.Tags(obj.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ObjectRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	obj, ok := resource.(*ObjectResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Location", Value: Breadcrumb(obj.Bucket, obj.Prefix)},
+		{Label: "Name", Value: obj.GetName()},
+	}
+	if !obj.IsFolder && !obj.IsDeleteMarker {
+		fields = append(fields,
+			render.SummaryField{Label: "Size", Value: render.FormatSize(obj.Size)},
+			render.SummaryField{Label: "Storage Class", Value: obj.StorageClass},
+		)
+	}
+	return fields
+}
+
+// Navigations opens folders and goes up to the parent folder
+func (r *ObjectRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	obj, ok := resource.(*ObjectResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if obj.IsFolder {
+		navs = append(navs, render.Navigation{
+			Key: "enter", Label: "Open", Service: "s3", Resource: "objects",
+			FilterField: FilterURI, FilterValue: apps3.URI(obj.Bucket, obj.Key),
+		})
+	}
+	if obj.Prefix != "" {
+		navs = append(navs, render.Navigation{
+			Key: "u", Label: "Up", Service: "s3", Resource: "objects",
+			FilterField: FilterURI, FilterValue: apps3.URI(obj.Bucket, apps3.ParentPrefix(obj.Prefix)),
+		})
+	}
+	return navs
+}
+
+// ListToggles shows object versions and delete markers
+func (r *ObjectRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "v", ContextKey: ToggleVersions, LabelOn: "all versions", LabelOff: "current"},
+		{Key: "x", ContextKey: ToggleDeleteMarkers, LabelOn: "delete markers", LabelOff: "no markers"},
+	}
+}
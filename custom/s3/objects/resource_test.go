@@ -0,0 +1,186 @@
+package objects
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+)
+
+func TestNewObjectResource(t *testing.T) {
+	obj := NewObjectResource("my-bucket", "logs/", types.Object{
+		Key:  aws.String("logs/app.log"),
+		Size: aws.Int64(2048),
+	}, "eu-west-1")
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"GetID", obj.GetID(), "s3://my-bucket/logs/app.log"},
+		{"GetName", obj.GetName(), "app.log"},
+		{"GetARN", obj.GetARN(), "arn:aws:s3:::my-bucket/logs/app.log"},
+		{"GetRegion", obj.GetRegion(), "eu-west-1"},
+		{"URI", obj.URI(), "s3://my-bucket/logs/app.log"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+	if !obj.IsLatest {
+		t.Error("IsLatest = false, want true for a current object")
+	}
+}
+
+func TestNewVersionResource(t *testing.T) {
+	v := NewVersionResource("my-bucket", "", types.ObjectVersion{
+		Key:       aws.String("a.txt"),
+		VersionId: aws.String("v1"),
+	}, "us-east-1")
+
+	if v.GetID() != "s3://my-bucket/a.txt?versionId=v1" {
+		t.Errorf("GetID() = %q, want version URI", v.GetID())
+	}
+	if v.URI() != "s3://my-bucket/a.txt" {
+		t.Errorf("URI() = %q, want URI without version", v.URI())
+	}
+	if v.IsLatest {
+		t.Error("IsLatest = true, want false")
+	}
+}
+
+func TestNavigations(t *testing.T) {
+	r := NewObjectRenderer().(*ObjectRenderer)
+
+	root := NewFolderResource("b", "", "logs/", "")
+	navs := r.Navigations(root)
+	if len(navs) != 1 || navs[0].Key != "enter" || navs[0].FilterValue != "s3://b/logs/" {
+		t.Errorf("root folder navigations = %+v, want only enter to s3://b/logs/", navs)
+	}
+
+	nested := NewFolderResource("b", "logs/", "logs/2024/", "")
+	navs = r.Navigations(nested)
+	if len(navs) != 2 || navs[1].Key != "u" || navs[1].FilterValue != "s3://b/" {
+		t.Errorf("nested folder navigations = %+v, want enter and u to s3://b/", navs)
+	}
+
+	obj := NewObjectResource("b", "logs/", types.Object{Key: aws.String("logs/app.log")}, "")
+	navs = r.Navigations(obj)
+	if len(navs) != 1 || navs[0].Key != "u" {
+		t.Errorf("object navigations = %+v, want only u", navs)
+	}
+}
+
+func TestBreadcrumb(t *testing.T) {
+	tests := map[string]string{
+		"":           "b",
+		"logs/":      "b › logs",
+		"logs/2024/": "b › logs › 2024",
+	}
+	for prefix, want := range tests {
+		if got := Breadcrumb("b", prefix); got != want {
+			t.Errorf("Breadcrumb(b, %q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestTextContent(t *testing.T) {
+	got, err := textContent([]byte(`{"a":1}`))
+	if err != nil {
+		t.Fatalf("textContent(json) error: %v", err)
+	}
+	if got != "{\n  \"a\": 1\n}" {
+		t.Errorf("textContent(json) = %q, want indented JSON", got)
+	}
+
+	if got, err := textContent([]byte("plain text\n")); err != nil || got != "plain text\n" {
+		t.Errorf("textContent(text) = %q, %v", got, err)
+	}
+	if _, err := textContent([]byte{0x89, 'P', 'N', 'G', 0}); err == nil {
+		t.Error("textContent(binary) expected error")
+	}
+	if _, err := textContent([]byte(strings.Repeat("a", maxViewSize+1))); err == nil {
+		t.Error("textContent(too large) expected error")
+	}
+}
+
+func TestCreateDownloadFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "downloads")
+
+	want := []string{"report.csv", "report (1).csv", "report (2).csv"}
+	for _, name := range want {
+		f, err := createDownloadFile(dir, "report.csv")
+		if err != nil {
+			t.Fatalf("createDownloadFile() error: %v", err)
+		}
+		f.Close()
+		if filepath.Base(f.Name()) != name {
+			t.Errorf("createDownloadFile() = %q, want %q", filepath.Base(f.Name()), name)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(want) {
+		t.Errorf("got %d files, want %d", len(entries), len(want))
+	}
+}
+
+func TestCopyItems_Object(t *testing.T) {
+	obj := NewObjectResource("src", "logs/", types.Object{Key: aws.String("logs/app.log"), Size: aws.Int64(10)}, "")
+
+	tests := []struct {
+		dstKey  string
+		wantKey string
+	}{
+		{"", "app.log"},
+		{"archive/", "archive/app.log"},
+		{"archive/renamed.log", "archive/renamed.log"},
+	}
+	for _, tt := range tests {
+		items, total, target, err := copyItems(t.Context(), apps3.Location{}, obj, "dst", tt.dstKey)
+		if err != nil {
+			t.Fatalf("copyItems(%q) error = %v", tt.dstKey, err)
+		}
+		if len(items) != 1 || items[0].Key != tt.wantKey || items[0].Source != "logs/app.log" || total != 10 {
+			t.Errorf("copyItems(%q) = %+v, %d", tt.dstKey, items, total)
+		}
+		if target != "s3://dst/"+tt.wantKey {
+			t.Errorf("copyItems(%q) target = %q", tt.dstKey, target)
+		}
+	}
+
+	old := NewVersionResource("src", "", types.ObjectVersion{Key: aws.String("a.txt"), VersionId: aws.String("v1")}, "")
+	items, _, _, _ := copyItems(t.Context(), apps3.Location{}, old, "src", "")
+	if items[0].VersionID != "v1" {
+		t.Errorf("noncurrent version copy should keep the version, got %+v", items[0])
+	}
+	if err := checkOverlap(old, items); err != nil {
+		t.Errorf("restoring an old version onto its key should be allowed: %v", err)
+	}
+}
+
+func TestCheckOverlap(t *testing.T) {
+	folder := NewFolderResource("b", "", "logs/", "")
+	inside := []apps3.TransferItem{{Source: "logs/a", Key: "logs/copy/logs/a"}}
+	if err := checkOverlap(folder, inside); err == nil {
+		t.Error("copy into the source folder should fail")
+	}
+	same := []apps3.TransferItem{{Source: "logs/a", Key: "logs/a"}}
+	if err := checkOverlap(folder, same); err == nil {
+		t.Error("copy onto itself should fail")
+	}
+	elsewhere := []apps3.TransferItem{{Source: "logs/a", Key: "archive/logs/a"}}
+	if err := checkOverlap(folder, elsewhere); err != nil {
+		t.Errorf("copy to another prefix should succeed: %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Transfer field keys
+const (
+	fieldPath    = "path"
+	fieldDest    = "dest"
+	fieldProfile = "profile"
+)
+
+// overwriteWarning is shown for uploads and copies, which replace objects
+// with the same keys
+const overwriteWarning = "Existing objects with the same keys are overwritten."
+
+// NewUploadTransfer uploads a local file or directory. dest returns the
+// default destination URI for the selected resource.
+func NewUploadTransfer(dest func(dao.Resource) string) *action.Transfer {
+	return &action.Transfer{
+		Fields: func(r dao.Resource) []action.TransferField {
+			return []action.TransferField{
+				{Key: fieldPath, Label: "Local path", Placeholder: "file or directory"},
+				{Key: fieldDest, Label: "Destination", Value: dest(r), Placeholder: "s3://bucket/prefix/"},
+			}
+		},
+		Prepare: func(ctx context.Context, _ dao.Resource, values map[string]string) (*action.TransferJob, error) {
+			return prepareUpload(ctx, values)
+		},
+	}
+}
+
+// currentPrefixURI is the folder being listed, where uploads go by default
+func currentPrefixURI(r dao.Resource) string {
+	obj, ok := dao.UnwrapResource(r).(*ObjectResource)
+	if !ok {
+		return ""
+	}
+	return apps3.URI(obj.Bucket, obj.Prefix)
+}
+
+func prepareUpload(ctx context.Context, values map[string]string) (*action.TransferJob, error) {
+	localPath, err := config.ExpandPath(values[fieldPath])
+	if err != nil {
+		return nil, err
+	}
+	bucket, key, _, err := apps3.ParseURI(values[fieldDest])
+	if err != nil {
+		return nil, err
+	}
+
+	// A destination without a trailing / names the uploaded file
+	prefix, rename := key, ""
+	if key != "" && !strings.HasSuffix(key, "/") {
+		prefix, rename = apps3.ParentPrefix(key), key
+	}
+	items, total, err := apps3.ScanLocal(localPath, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no files to upload in %s", localPath)
+	}
+	if rename != "" {
+		if len(items) > 1 {
+			prefix = key + "/"
+			if items, total, err = apps3.ScanLocal(localPath, prefix); err != nil {
+				return nil, err
+			}
+		} else {
+			items[0].Key = rename
+		}
+	}
+
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	dst := apps3.Location{Client: client, Bucket: bucket}
+	target := apps3.URI(bucket, prefix)
+	if len(items) == 1 {
+		target = apps3.URI(bucket, items[0].Key)
+	}
+
+	return &action.TransferJob{
+		Description: fmt.Sprintf("Upload %s to %s", localPath, target),
+		Objects:     len(items),
+		Bytes:       total,
+		Token:       target,
+		Warnings:    []string{overwriteWarning},
+		Run: func(ctx context.Context, progress func(action.TransferProgress)) (string, error) {
+			if err := apps3.UploadFiles(ctx, dst, items, progress); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Uploaded %d file(s) (%s) to %s", len(items), render.FormatSize(total), target), nil
+		},
+	}, nil
+}
+
+// newCopyTransfer copies or moves an object or folder to another bucket or
+// prefix, optionally with another profile's credentials
+func newCopyTransfer(move bool) *action.Transfer {
+	return &action.Transfer{
+		Fields: func(r dao.Resource) []action.TransferField {
+			return []action.TransferField{
+				{Key: fieldDest, Label: "Destination", Value: currentPrefixURI(r), Placeholder: "s3://bucket/prefix/"},
+				{Key: fieldProfile, Label: "Profile", Placeholder: "current profile", Optional: true},
+			}
+		},
+		Prepare: func(ctx context.Context, r dao.Resource, values map[string]string) (*action.TransferJob, error) {
+			obj, ok := dao.UnwrapResource(r).(*ObjectResource)
+			if !ok {
+				return nil, action.ErrInvalidResourceType
+			}
+			return prepareCopy(ctx, obj, values, move)
+		},
+	}
+}
+
+func prepareCopy(ctx context.Context, obj *ObjectResource, values map[string]string, move bool) (*action.TransferJob, error) {
+	dstBucket, dstKey, _, err := apps3.ParseURI(values[fieldDest])
+	if err != nil {
+		return nil, err
+	}
+	profile := values[fieldProfile]
+	dstCtx := ctx
+	if profile != "" {
+		if !config.IsValidProfileName(profile) {
+			return nil, fmt.Errorf("invalid profile name %q", profile)
+		}
+		dstCtx = appaws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+
+	srcClient, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	dstClient, err := apps3.GetClientForBucket(dstCtx, dstBucket)
+	if err != nil {
+		return nil, err
+	}
+	src := apps3.Location{Client: srcClient, Bucket: obj.Bucket}
+	dst := apps3.Location{Client: dstClient, Bucket: dstBucket}
+
+	items, total, target, err := copyItems(ctx, src, obj, dstBucket, dstKey)
+	if err != nil {
+		return nil, err
+	}
+	if profile == "" && dstBucket == obj.Bucket {
+		if err := checkOverlap(obj, items); err != nil {
+			return nil, err
+		}
+	}
+
+	verb, done := "Copy", "Copied"
+	if move {
+		verb, done = "Move", "Moved"
+	}
+	source := obj.URI()
+	if obj.VersionID != "" && !obj.IsLatest {
+		source = apps3.VersionURI(obj.Bucket, obj.Key, obj.VersionID)
+	}
+	description := fmt.Sprintf("%s %s to %s", verb, source, target)
+	if profile != "" {
+		description += " with profile " + profile
+	}
+	warnings := []string{overwriteWarning}
+	if move {
+		if len(items) == 1 && items[0].VersionID != "" {
+			warnings = append(warnings, fmt.Sprintf("Version %s of the source is permanently deleted after the copy succeeds; the current object is kept.", items[0].VersionID))
+		} else {
+			warnings = append(warnings, "Source objects are deleted after every copy succeeds; in a versioned bucket this adds delete markers.")
+		}
+	}
+
+	return &action.TransferJob{
+		Description: description,
+		Objects:     len(items),
+		Bytes:       total,
+		Token:       target,
+		Warnings:    warnings,
+		Run: func(ctx context.Context, progress func(action.TransferProgress)) (string, error) {
+			// Stream across profiles: CopyObject reads the source with the
+			// destination's credentials
+			if err := apps3.CopyObjects(ctx, src, dst, items, profile != "", progress); err != nil {
+				return "", err
+			}
+			if move {
+				if err := apps3.DeleteSources(ctx, srcClient, obj.Bucket, items); err != nil {
+					return "", fmt.Errorf("copied, but deleting the source failed: %w", err)
+				}
+			}
+			return fmt.Sprintf("%s %d object(s) (%s) to %s", done, len(items), render.FormatSize(total), target), nil
+		},
+	}, nil
+}
+
+// copyItems lists what to copy and maps each key to the destination. A
+// folder keeps its name under the destination prefix; an object goes under
+// it, or is renamed when the destination does not end in /.
+func copyItems(ctx context.Context, src apps3.Location, obj *ObjectResource, dstBucket, dstKey string) ([]apps3.TransferItem, int64, string, error) {
+	if !obj.IsFolder {
+		key := dstKey
+		if key == "" || strings.HasSuffix(key, "/") {
+			key += path.Base(obj.Key)
+		}
+		item := apps3.TransferItem{Source: obj.Key, Key: key, Size: obj.Size}
+		if !obj.IsLatest {
+			item.VersionID = obj.VersionID
+		}
+		return []apps3.TransferItem{item}, obj.Size, apps3.URI(dstBucket, key), nil
+	}
+
+	prefix := dstKey
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	items, total, err := apps3.ListObjects(ctx, src.Client, src.Bucket, obj.Key)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if len(items) == 0 {
+		return nil, 0, "", fmt.Errorf("no objects under %s", obj.URI())
+	}
+	parent := apps3.ParentPrefix(obj.Key)
+	for i := range items {
+		items[i].Key = prefix + strings.TrimPrefix(items[i].Source, parent)
+	}
+	return items, total, apps3.URI(dstBucket, prefix+strings.TrimPrefix(obj.Key, parent)), nil
+}
+
+// checkOverlap rejects copies onto themselves or into the folder being copied
+func checkOverlap(obj *ObjectResource, items []apps3.TransferItem) error {
+	for _, item := range items {
+		if item.Key == item.Source && item.VersionID == "" {
+			return errors.New("source and destination are the same")
+		}
+		if obj.IsFolder && strings.HasPrefix(item.Key, obj.Key) {
+			return fmt.Errorf("destination is inside %s", obj.URI())
+		}
+	}
+	return nil
+}
+
+// deletePrefixTransfer permanently deletes every version under a folder
+var deletePrefixTransfer = &action.Transfer{
+	Prepare: func(ctx context.Context, r dao.Resource, _ map[string]string) (*action.TransferJob, error) {
+		obj, ok := dao.UnwrapResource(r).(*ObjectResource)
+		if !ok || !obj.IsFolder || obj.Key == "" {
+			return nil, action.ErrInvalidResourceType
+		}
+		return prepareDeletePrefix(ctx, obj)
+	},
+}
+
+func prepareDeletePrefix(ctx context.Context, obj *ObjectResource) (*action.TransferJob, error) {
+	client, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := apps3.CountVersions(ctx, client, obj.Bucket, obj.Key, 0)
+	if err != nil {
+		return nil, err
+	}
+	uploads, err := apps3.CountMultipartUploads(ctx, client, obj.Bucket, obj.Key)
+	if err != nil {
+		return nil, err
+	}
+	if stats.Total() == 0 && uploads == 0 {
+		return nil, fmt.Errorf("no objects under %s", obj.URI())
+	}
+
+	warnings := []string{fmt.Sprintf("Permanently deletes %d object version(s) and %d delete marker(s). This cannot be undone.",
+		stats.Versions, stats.DeleteMarkers)}
+	if uploads > 0 {
+		warnings = append(warnings, fmt.Sprintf("Aborts %d incomplete multipart upload(s).", uploads))
+	}
+
+	return &action.TransferJob{
+		Description: "Delete everything under " + obj.URI(),
+		Objects:     stats.Total(),
+		Bytes:       stats.Size,
+		Token:       obj.URI(),
+		Warnings:    warnings,
+		Run: func(ctx context.Context, progress func(action.TransferProgress)) (string, error) {
+			deleted, err := apps3.DeleteAllVersions(ctx, client, obj.Bucket, obj.Key, func(deleted int) {
+				progress(action.TransferProgress{Objects: deleted})
+			})
+			if err != nil {
+				return "", err
+			}
+			if uploads > 0 {
+				if _, err := apps3.AbortMultipartUploads(ctx, client, obj.Bucket, obj.Key); err != nil {
+					return "", err
+				}
+			}
+			return fmt.Sprintf("Deleted %d version(s) and marker(s) (%s) under %s", deleted, render.FormatSize(stats.Size), obj.URI()), nil
+		},
+	}, nil
+}
@@ -0,0 +1,303 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/clawscli/claws/internal/action"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// copyObjectMaxSize is the largest object CopyObject copies in one request;
+	// larger objects are streamed through a multipart upload
+	copyObjectMaxSize = 5 << 30
+	// transferConcurrency is the number of objects transferred at once
+	transferConcurrency = 4
+	// partConcurrency is the number of parts uploaded at once per object
+	partConcurrency = 3
+	// MaxTransferObjects bounds the objects in one upload or copy
+	MaxTransferObjects = 100_000
+)
+
+// TransferItem is one object to upload or copy
+type TransferItem struct {
+	Source    string // local path for uploads, source key for copies
+	VersionID string // source version for copies; empty for the current one
+	Key       string // destination key
+	Size      int64
+}
+
+// Location is a bucket with the client that reaches it
+type Location struct {
+	Client *s3.Client
+	Bucket string
+}
+
+// ScanLocal lists the files to upload from path to prefix. A directory is
+// uploaded with its name, like the S3 console: dir/a.txt becomes
+// prefix/dir/a.txt. Symlinks and special files are skipped.
+func ScanLocal(path, prefix string) ([]TransferItem, int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !info.IsDir() {
+		key := prefix + filepath.Base(path)
+		return []TransferItem{{Source: path, Key: key, Size: info.Size()}}, info.Size(), nil
+	}
+
+	var items []TransferItem
+	var total int64
+	base := filepath.Dir(filepath.Clean(path))
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if len(items) >= MaxTransferObjects {
+			return fmt.Errorf("more than %d files under %s", MaxTransferObjects, path)
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(base, p)
+		if err != nil {
+			return err
+		}
+		items = append(items, TransferItem{Source: p, Key: prefix + filepath.ToSlash(rel), Size: fi.Size()})
+		total += fi.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// ListObjects lists the current objects under prefix as copy sources
+func ListObjects(ctx context.Context, client *s3.Client, bucket, prefix string) ([]TransferItem, int64, error) {
+	var items []TransferItem
+	var total int64
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: &bucket,
+		Prefix: optional(prefix),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, 0, apperrors.Wrapf(err, "list objects in %s", URI(bucket, prefix))
+		}
+		for _, obj := range output.Contents {
+			if len(items) >= MaxTransferObjects {
+				return nil, 0, fmt.Errorf("more than %d objects under %s", MaxTransferObjects, URI(bucket, prefix))
+			}
+			size := aws.ToInt64(obj.Size)
+			items = append(items, TransferItem{Source: aws.ToString(obj.Key), Size: size})
+			total += size
+		}
+	}
+	return items, total, nil
+}
+
+// tracker sums the progress of concurrent transfers
+type tracker struct {
+	mu     sync.Mutex
+	p      action.TransferProgress
+	report func(action.TransferProgress)
+}
+
+func (t *tracker) add(bytes int64, current string) {
+	if t == nil || t.report == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.p.Bytes += bytes
+	if current != "" {
+		t.p.Current = current
+	}
+	t.report(t.p)
+}
+
+func (t *tracker) done() {
+	if t == nil || t.report == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.p.Objects++
+	t.report(t.p)
+}
+
+// progressReader counts bytes as the transfer manager reads them. It hides
+// io.ReaderAt and io.Seeker so every part is read through it.
+type progressReader struct {
+	r   io.Reader
+	t   *tracker
+	key string
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.t.add(int64(n), r.key)
+	}
+	return n, err
+}
+
+func newUploader(client *s3.Client) *manager.Uploader {
+	return manager.NewUploader(client, func(u *manager.Uploader) {
+		u.Concurrency = partConcurrency
+	})
+}
+
+// UploadFiles uploads local files with the multipart transfer manager
+func UploadFiles(ctx context.Context, dst Location, items []TransferItem, report func(action.TransferProgress)) error {
+	t := &tracker{report: report}
+	uploader := newUploader(dst.Client)
+	return forEach(ctx, items, func(ctx context.Context, item TransferItem) error {
+		f, err := os.Open(item.Source)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = uploader.Upload(ctx, &s3.PutObjectInput{
+			Bucket: &dst.Bucket,
+			Key:    aws.String(item.Key),
+			Body:   &progressReader{r: f, t: t, key: item.Key},
+		})
+		if err != nil {
+			return apperrors.Wrapf(err, "upload %s", item.Source)
+		}
+		t.done()
+		return nil
+	})
+}
+
+// CopyObjects copies objects from src to dst. Within one account, objects
+// up to 5 GiB are copied server side; larger objects, and copies across
+// profiles, are streamed through the multipart transfer manager.
+func CopyObjects(ctx context.Context, src, dst Location, items []TransferItem, stream bool, report func(action.TransferProgress)) error {
+	t := &tracker{report: report}
+	uploader := newUploader(dst.Client)
+	return forEach(ctx, items, func(ctx context.Context, item TransferItem) error {
+		var err error
+		if stream || item.Size > copyObjectMaxSize {
+			err = streamObject(ctx, src, dst, item, uploader, t)
+		} else {
+			err = copyObject(ctx, src, dst, item, t)
+		}
+		if err != nil {
+			return err
+		}
+		t.done()
+		return nil
+	})
+}
+
+func copyObject(ctx context.Context, src, dst Location, item TransferItem, t *tracker) error {
+	input := &s3.CopyObjectInput{
+		Bucket:     &dst.Bucket,
+		Key:        aws.String(item.Key),
+		CopySource: aws.String(copySource(src.Bucket, item.Source, item.VersionID)),
+	}
+	if _, err := dst.Client.CopyObject(ctx, input); err != nil {
+		return apperrors.Wrapf(err, "copy %s", URI(src.Bucket, item.Source))
+	}
+	t.add(item.Size, item.Key)
+	return nil
+}
+
+func streamObject(ctx context.Context, src, dst Location, item TransferItem, uploader *manager.Uploader, t *tracker) error {
+	get := &s3.GetObjectInput{Bucket: &src.Bucket, Key: aws.String(item.Source)}
+	if item.VersionID != "" {
+		get.VersionId = aws.String(item.VersionID)
+	}
+	output, err := src.Client.GetObject(ctx, get)
+	if err != nil {
+		return apperrors.Wrapf(err, "get %s", URI(src.Bucket, item.Source))
+	}
+	defer output.Body.Close()
+
+	_, err = uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:             &dst.Bucket,
+		Key:                aws.String(item.Key),
+		Body:               &progressReader{r: output.Body, t: t, key: item.Key},
+		ContentType:        output.ContentType,
+		ContentEncoding:    output.ContentEncoding,
+		ContentDisposition: output.ContentDisposition,
+		CacheControl:       output.CacheControl,
+		Metadata:           output.Metadata,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "upload %s", URI(dst.Bucket, item.Key))
+	}
+	return nil
+}
+
+// copySource returns the URL-encoded bucket/key[?versionId=] of a copy
+func copySource(bucket, key, versionID string) string {
+	source := (&url.URL{Path: bucket + "/" + key}).EscapedPath()
+	if versionID != "" {
+		source += "?versionId=" + url.QueryEscape(versionID)
+	}
+	return source
+}
+
+// DeleteSources deletes the source of each copied item: the copied version
+// when one was given, otherwise the current version, which in a versioned
+// bucket adds a delete marker
+func DeleteSources(ctx context.Context, client *s3.Client, bucket string, items []TransferItem) error {
+	for start := 0; start < len(items); start += deleteBatchSize {
+		batch := items[start:min(start+deleteBatchSize, len(items))]
+		ids := make([]types.ObjectIdentifier, len(batch))
+		for i, item := range batch {
+			ids[i] = sourceIdentifier(item)
+		}
+		if _, err := deleteObjects(ctx, client, bucket, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceIdentifier identifies the source object of a copied item
+func sourceIdentifier(item TransferItem) types.ObjectIdentifier {
+	id := types.ObjectIdentifier{Key: aws.String(item.Source)}
+	if item.VersionID != "" {
+		id.VersionId = aws.String(item.VersionID)
+	}
+	return id
+}
+
+// forEach runs fn for the items, a few at a time, stopping at the first error
+func forEach(ctx context.Context, items []TransferItem, fn func(context.Context, TransferItem) error) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(transferConcurrency)
+	for _, item := range items {
+		if gctx.Err() != nil {
+			break
+		}
+		g.Go(func() error { return fn(gctx, item) })
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
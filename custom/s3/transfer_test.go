@@ -0,0 +1,83 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestScanLocal(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "site")
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body{}",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	items, total, err := ScanLocal(dir, "web/")
+	if err != nil {
+		t.Fatalf("ScanLocal(dir) error = %v", err)
+	}
+	var keys []string
+	for _, item := range items {
+		keys = append(keys, item.Key)
+	}
+	slices.Sort(keys)
+	want := []string{"web/site/css/style.css", "web/site/index.html"}
+	if !slices.Equal(keys, want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+	if total != int64(len("<html></html>")+len("body{}")) {
+		t.Errorf("total = %d", total)
+	}
+
+	items, total, err = ScanLocal(filepath.Join(dir, "index.html"), "")
+	if err != nil {
+		t.Fatalf("ScanLocal(file) error = %v", err)
+	}
+	if len(items) != 1 || items[0].Key != "index.html" || total != 13 {
+		t.Errorf("ScanLocal(file) = %+v, %d", items, total)
+	}
+
+	if _, _, err := ScanLocal(filepath.Join(root, "missing"), ""); err == nil {
+		t.Error("ScanLocal(missing) expected error")
+	}
+}
+
+func TestCopySource(t *testing.T) {
+	tests := []struct {
+		bucket, key, version string
+		want                 string
+	}{
+		{"b", "logs/app.log", "", "b/logs/app.log"},
+		{"b", "my file+1.txt", "", "b/my%20file+1.txt"},
+		{"b", "a.txt", "3/L4+x", "b/a.txt?versionId=3%2FL4%2Bx"},
+	}
+	for _, tt := range tests {
+		if got := copySource(tt.bucket, tt.key, tt.version); got != tt.want {
+			t.Errorf("copySource(%q, %q, %q) = %q, want %q", tt.bucket, tt.key, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestSourceIdentifier(t *testing.T) {
+	id := sourceIdentifier(TransferItem{Source: "a.txt", Key: "b.txt"})
+	if aws.ToString(id.Key) != "a.txt" || id.VersionId != nil {
+		t.Errorf("current version: got key %q version %v", aws.ToString(id.Key), id.VersionId)
+	}
+	id = sourceIdentifier(TransferItem{Source: "a.txt", VersionID: "v1", Key: "b.txt"})
+	if aws.ToString(id.Key) != "a.txt" || aws.ToString(id.VersionId) != "v1" {
+		t.Errorf("old version: got key %q version %q", aws.ToString(id.Key), aws.ToString(id.VersionId))
+	}
+}
@@ -0,0 +1,53 @@
+package s3
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// URI returns the s3://bucket/key URI of an object or prefix
+func URI(bucket, key string) string {
+	return "s3://" + bucket + "/" + key
+}
+
+// VersionURI returns the URI of an object version, with the version ID as
+// a versionId query parameter. Without a version it is the same as URI.
+func VersionURI(bucket, key, versionID string) string {
+	if versionID == "" {
+		return URI(bucket, key)
+	}
+	return URI(bucket, key) + "?versionId=" + url.QueryEscape(versionID)
+}
+
+// ParseURI splits an s3://bucket/key URI into bucket, key and version ID.
+// The key may be empty (the bucket root) or a prefix ending in /.
+func ParseURI(uri string) (bucket, key, versionID string, err error) {
+	rest, ok := strings.CutPrefix(uri, "s3://")
+	if !ok {
+		return "", "", "", fmt.Errorf("invalid S3 URI %q: must start with s3://", uri)
+	}
+	if i := strings.LastIndex(rest, "?versionId="); i >= 0 {
+		versionID, err = url.QueryUnescape(rest[i+len("?versionId="):])
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid S3 URI %q: %w", uri, err)
+		}
+		rest = rest[:i]
+	}
+	bucket, key, _ = strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", "", fmt.Errorf("invalid S3 URI %q: missing bucket", uri)
+	}
+	return bucket, key, versionID, nil
+}
+
+// ParentPrefix returns the prefix one level above a key or prefix, e.g.
+// logs/2024/ for logs/2024/app.log and logs/ for logs/2024/
+func ParentPrefix(key string) string {
+	trimmed := strings.TrimSuffix(key, "/")
+	i := strings.LastIndex(trimmed, "/")
+	if i < 0 {
+		return ""
+	}
+	return trimmed[:i+1]
+}
@@ -0,0 +1,66 @@
+package s3
+
+import "testing"
+
+func TestVersionURI(t *testing.T) {
+	tests := []struct {
+		bucket, key, version string
+		want                 string
+	}{
+		{"b", "", "", "s3://b/"},
+		{"b", "logs/app.log", "", "s3://b/logs/app.log"},
+		{"b", "a.txt", "3/L4kqtJl+x", "s3://b/a.txt?versionId=3%2FL4kqtJl%2Bx"},
+	}
+	for _, tt := range tests {
+		if got := VersionURI(tt.bucket, tt.key, tt.version); got != tt.want {
+			t.Errorf("VersionURI(%q, %q, %q) = %q, want %q", tt.bucket, tt.key, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		uri                  string
+		bucket, key, version string
+		wantErr              bool
+	}{
+		{uri: "s3://b", bucket: "b"},
+		{uri: "s3://b/", bucket: "b"},
+		{uri: "s3://b/logs/", bucket: "b", key: "logs/"},
+		{uri: "s3://b/a.txt?versionId=3%2FL4kqtJl%2Bx", bucket: "b", key: "a.txt", version: "3/L4kqtJl+x"},
+		{uri: "b/a.txt", wantErr: true},
+		{uri: "s3:///a.txt", wantErr: true},
+	}
+	for _, tt := range tests {
+		bucket, key, version, err := ParseURI(tt.uri)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseURI(%q) expected error", tt.uri)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseURI(%q) error: %v", tt.uri, err)
+			continue
+		}
+		if bucket != tt.bucket || key != tt.key || version != tt.version {
+			t.Errorf("ParseURI(%q) = (%q, %q, %q), want (%q, %q, %q)", tt.uri, bucket, key, version, tt.bucket, tt.key, tt.version)
+		}
+	}
+}
+
+func TestParentPrefix(t *testing.T) {
+	tests := map[string]string{
+		"":                 "",
+		"app.log":          "",
+		"logs/":            "",
+		"logs/app.log":     "logs/",
+		"logs/2024/":       "logs/",
+		"logs/2024/a.json": "logs/2024/",
+	}
+	for key, want := range tests {
+		if got := ParentPrefix(key); got != want {
+			t.Errorf("ParentPrefix(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
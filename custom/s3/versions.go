@@ -0,0 +1,157 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// deleteBatchSize is the maximum number of keys per DeleteObjects call
+const deleteBatchSize = 1000
+
+// VersionStats summarizes the object versions under a prefix
+type VersionStats struct {
+	Versions      int
+	DeleteMarkers int
+	Size          int64
+	Truncated     bool // counting stopped at the limit
+}
+
+// Total returns the number of versions and delete markers
+func (s VersionStats) Total() int {
+	return s.Versions + s.DeleteMarkers
+}
+
+// CountVersions counts object versions and delete markers under prefix,
+// stopping after limit entries when limit is positive
+func CountVersions(ctx context.Context, client *s3.Client, bucket, prefix string, limit int) (VersionStats, error) {
+	var stats VersionStats
+	paginator := s3.NewListObjectVersionsPaginator(client, &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+		Prefix: optional(prefix),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return stats, apperrors.Wrapf(err, "list object versions in %s", bucket)
+		}
+		stats.Versions += len(output.Versions)
+		stats.DeleteMarkers += len(output.DeleteMarkers)
+		for _, v := range output.Versions {
+			stats.Size += aws.ToInt64(v.Size)
+		}
+		if limit > 0 && stats.Total() >= limit {
+			stats.Truncated = paginator.HasMorePages()
+			break
+		}
+	}
+	return stats, nil
+}
+
+// DeleteAllVersions permanently deletes every object version and delete
+// marker under prefix. progress, if set, is called with the running count.
+func DeleteAllVersions(ctx context.Context, client *s3.Client, bucket, prefix string, progress func(deleted int)) (int, error) {
+	deleted := 0
+	paginator := s3.NewListObjectVersionsPaginator(client, &s3.ListObjectVersionsInput{
+		Bucket:  &bucket,
+		Prefix:  optional(prefix),
+		MaxKeys: aws.Int32(deleteBatchSize),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return deleted, apperrors.Wrapf(err, "list object versions in %s", bucket)
+		}
+		ids := make([]types.ObjectIdentifier, 0, len(output.Versions)+len(output.DeleteMarkers))
+		for _, v := range output.Versions {
+			ids = append(ids, types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+		for _, m := range output.DeleteMarkers {
+			ids = append(ids, types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+		}
+		for start := 0; start < len(ids); start += deleteBatchSize {
+			batch := ids[start:min(start+deleteBatchSize, len(ids))]
+			n, err := deleteObjects(ctx, client, bucket, batch)
+			deleted += n
+			if progress != nil {
+				progress(deleted)
+			}
+			if err != nil {
+				return deleted, err
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// AbortMultipartUploads aborts the incomplete multipart uploads under prefix
+func AbortMultipartUploads(ctx context.Context, client *s3.Client, bucket, prefix string) (int, error) {
+	aborted := 0
+	paginator := s3.NewListMultipartUploadsPaginator(client, &s3.ListMultipartUploadsInput{
+		Bucket: &bucket,
+		Prefix: optional(prefix),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return aborted, apperrors.Wrapf(err, "list multipart uploads in %s", bucket)
+		}
+		for _, u := range output.Uploads {
+			if _, err := client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   &bucket,
+				Key:      u.Key,
+				UploadId: u.UploadId,
+			}); err != nil && !apperrors.IsNotFound(err) {
+				return aborted, apperrors.Wrapf(err, "abort multipart upload of %s", aws.ToString(u.Key))
+			}
+			aborted++
+		}
+	}
+	return aborted, nil
+}
+
+// CountMultipartUploads returns the number of incomplete multipart uploads under prefix
+func CountMultipartUploads(ctx context.Context, client *s3.Client, bucket, prefix string) (int, error) {
+	count := 0
+	paginator := s3.NewListMultipartUploadsPaginator(client, &s3.ListMultipartUploadsInput{
+		Bucket: &bucket,
+		Prefix: optional(prefix),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return count, apperrors.Wrapf(err, "list multipart uploads in %s", bucket)
+		}
+		count += len(output.Uploads)
+	}
+	return count, nil
+}
+
+// deleteObjects deletes one batch, reporting per-key failures as an error
+func deleteObjects(ctx context.Context, client *s3.Client, bucket string, ids []types.ObjectIdentifier) (int, error) {
+	output, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: &bucket,
+		Delete: &types.Delete{Objects: ids, Quiet: aws.Bool(true)},
+	})
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "delete objects in %s", bucket)
+	}
+	if len(output.Errors) > 0 {
+		first := output.Errors[0]
+		return len(ids) - len(output.Errors), fmt.Errorf("delete %s: %s: %s (%d of %d failed)",
+			aws.ToString(first.Key), aws.ToString(first.Code), aws.ToString(first.Message), len(output.Errors), len(ids))
+	}
+	return len(ids), nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -15,11 +15,23 @@ import (
 func init() {
 	action.Global.Register("secretsmanager", "secrets", []action.Action{
 		{
-			Name:     "View Value",
-			Shortcut: "v",
-			Type:     action.ActionTypeExec,
-			Command:  `aws secretsmanager get-secret-value --secret-id "${ID}" --query 'SecretString' --output text | less`,
-			Confirm:  action.ConfirmSimple,
+			Name:      "Put new value",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PutSecretValue",
+			Confirm:   action.ConfirmSimple,
+			Compose:   putCompose,
+		},
+		{
+			Name:      "Rotate now",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RotateSecret",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				secret, ok := r.(*SecretResource)
+				return ok && secret.Rotates()
+			},
 		},
 		{
 			Name:     "Describe (JSON)",
@@ -43,6 +55,8 @@ func init() {
 // executeSecretAction executes an action on a secret
 func executeSecretAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
+	case "RotateSecret":
+		return executeRotateSecret(ctx, resource)
 	case "DeleteSecret":
 		return executeDeleteSecret(ctx, resource)
 	default:
@@ -54,6 +68,28 @@ func getSecretsManagerClient(ctx context.Context) (*secretsmanager.Client, error
 	return smClient.GetClient(ctx)
 }
 
+// executeRotateSecret starts a rotation with the secret's rotation
+// function and schedule
+func executeRotateSecret(ctx context.Context, resource dao.Resource) action.ActionResult {
+	secret, ok := resource.(*SecretResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := getSecretsManagerClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	secretId := secret.SecretID()
+	output, err := client.RotateSecret(ctx, &secretsmanager.RotateSecretInput{SecretId: &secretId})
+	if err != nil {
+		return action.FailResultf(err, "rotate secret %s", secret.GetName())
+	}
+	return action.SuccessResult(fmt.Sprintf("Rotation of %s started, new version %s is AWSPENDING until it finishes",
+		secret.GetName(), appaws.Str(output.VersionId)))
+}
+
 func executeDeleteSecret(ctx context.Context, resource dao.Resource) action.ActionResult {
 	secret, ok := resource.(*SecretResource)
 	if !ok {
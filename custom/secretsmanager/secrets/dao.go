@@ -148,6 +148,20 @@ func NewSecretResource(secret types.SecretListEntry) *SecretResource {
 	}
 }
 
+// SecretID returns the ID to read the secret with: its ARN, or its name
+// if the ARN is unknown
+func (r *SecretResource) SecretID() string {
+	if r.GetARN() != "" {
+		return r.GetARN()
+	}
+	return r.GetID()
+}
+
+// Rotates returns whether rotation is configured, so it can be started
+func (r *SecretResource) Rotates() bool {
+	return r.RotationEnabled || appaws.Bool(r.Item.RotationEnabled)
+}
+
 // Description returns the secret description
 func (r *SecretResource) Description() string {
 	return appaws.Str(r.Item.Description)
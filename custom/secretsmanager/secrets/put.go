@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/secretval"
+)
+
+// putCompose edits the current value of a secret and puts it as a new
+// AWSCURRENT version. The previous value stays readable as AWSPREVIOUS.
+var putCompose = &action.Compose{
+	Body: func(dao.Resource) (string, string) {
+		return "", ".json"
+	},
+	LoadBody: loadCurrentValue,
+	Send:     sendPutValue,
+}
+
+// loadCurrentValue loads the value to edit, with JSON indented
+func loadCurrentValue(ctx context.Context, resource dao.Resource) (string, error) {
+	secret, ok := dao.UnwrapResource(resource).(*SecretResource)
+	if !ok {
+		return "", fmt.Errorf("invalid resource type")
+	}
+	client, err := getSecretsManagerClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	value, err := secretval.Get(ctx, client, secret.SecretID(), "")
+	if err != nil {
+		return "", err
+	}
+	if value.Binary != nil {
+		return "", fmt.Errorf("%s is a binary secret: put it with the AWS CLI", secret.GetName())
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(value.String), "", "  "); err == nil {
+		return buf.String(), nil
+	}
+	return value.String, nil
+}
+
+func sendPutValue(ctx context.Context, resource dao.Resource, body string, _ map[string]string) (action.ComposeResult, error) {
+	secret, ok := dao.UnwrapResource(resource).(*SecretResource)
+	if !ok {
+		return action.ComposeResult{}, fmt.Errorf("invalid resource type")
+	}
+	client, err := getSecretsManagerClient(ctx)
+	if err != nil {
+		return action.ComposeResult{}, err
+	}
+	return putSecretValue(ctx, client, secret.SecretID(), body)
+}
+
+type valuePutter interface {
+	PutSecretValue(ctx context.Context, in *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+}
+
+// putSecretValue stores body, trimmed, as the new current value. JSON is
+// stored compact, as the console and SDKs write it.
+func putSecretValue(ctx context.Context, client valuePutter, secretID, body string) (action.ComposeResult, error) {
+	value := strings.TrimSpace(body)
+	if value == "" {
+		return action.ComposeResult{}, fmt.Errorf("the value is empty")
+	}
+	if strings.HasPrefix(value, "{") {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(value)); err != nil {
+			return action.ComposeResult{}, fmt.Errorf("the value looks like JSON but is invalid: %w", err)
+		}
+		value = buf.String()
+	}
+
+	output, err := client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     &secretID,
+		SecretString: &value,
+	})
+	if err != nil {
+		return action.ComposeResult{}, apperrors.Wrapf(err, "put value of secret %s", secretID)
+	}
+	version := appaws.Str(output.VersionId)
+	return action.ComposeResult{
+		Message: fmt.Sprintf("Put version %s of %s (%s)", version, appaws.ExtractResourceName(secretID), strings.Join(output.VersionStages, ", ")),
+		ID:      version,
+	}, nil
+}
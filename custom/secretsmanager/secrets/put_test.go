@@ -0,0 +1,44 @@
+package secrets
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+type fakePutter struct {
+	in *secretsmanager.PutSecretValueInput
+}
+
+func (f *fakePutter) PutSecretValue(_ context.Context, in *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	f.in = in
+	return &secretsmanager.PutSecretValueOutput{VersionId: aws.String("v3"), VersionStages: []string{"AWSCURRENT"}}, nil
+}
+
+func TestPutSecretValue(t *testing.T) {
+	const arn = "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-AbCdEf"
+	client := &fakePutter{}
+
+	result, err := putSecretValue(context.Background(), client, arn, "{\n  \"user\": \"admin\"\n}\n")
+	if err != nil {
+		t.Fatalf("putSecretValue() error = %v", err)
+	}
+	if got := aws.ToString(client.in.SecretString); got != `{"user":"admin"}` {
+		t.Errorf("SecretString = %q, want compact JSON", got)
+	}
+	if result.ID != "v3" || !strings.Contains(result.Message, "AWSCURRENT") {
+		t.Errorf("result = %+v", result)
+	}
+
+	if _, err := putSecretValue(context.Background(), client, arn, "hunter2 "); err != nil || aws.ToString(client.in.SecretString) != "hunter2" {
+		t.Errorf("plain value: err = %v, value = %q", err, aws.ToString(client.in.SecretString))
+	}
+	for _, bad := range []string{"  ", `{"user":`} {
+		if _, err := putSecretValue(context.Background(), client, arn, bad); err == nil {
+			t.Errorf("putSecretValue(%q) should fail", bad)
+		}
+	}
+}
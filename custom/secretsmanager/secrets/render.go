@@ -181,6 +181,10 @@ func (r *SecretRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 // Navigations returns navigation shortcuts
 func (r *SecretRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
-	return nil
+	if _, ok := resource.(*SecretResource); !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "v", Label: "Value", ViewType: render.ViewTypeSecretViewer},
+	}
 }
@@ -13,6 +13,14 @@ import (
 
 func init() {
 	action.Global.Register("sns", "topics", []action.Action{
+		{
+			Name:      "Publish",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "Publish",
+			Confirm:   action.ConfirmSimple,
+			Compose:   publishCompose,
+		},
 		{
 			Name:         "Delete",
 			Shortcut:     "D",
@@ -160,6 +160,12 @@ func (r *TopicResource) IsFIFO() bool {
 	return r.Attrs["FifoTopic"] == "true"
 }
 
+// ContentBasedDeduplication returns whether a FIFO topic derives
+// deduplication IDs from the message body
+func (r *TopicResource) ContentBasedDeduplication() bool {
+	return r.Attrs["ContentBasedDeduplication"] == "true"
+}
+
 // Owner returns the topic owner
 func (r *TopicResource) Owner() string {
 	return r.Attrs["Owner"]
@@ -0,0 +1,63 @@
+package topics
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("sns", "topics", generateTopic)
+}
+
+// topicAttributes maps GetTopicAttributes names to aws_sns_topic and
+// AWS::SNS::Topic properties
+var topicAttributes = []iac.Attribute{
+	{Name: "DisplayName", Terraform: "display_name", CloudFormation: "DisplayName"},
+	{Name: "FifoTopic", Terraform: "fifo_topic", CloudFormation: "FifoTopic", Kind: iac.BoolAttribute},
+	{Name: "ContentBasedDeduplication", Terraform: "content_based_deduplication", CloudFormation: "ContentBasedDeduplication", Kind: iac.BoolAttribute},
+	{Name: "KmsMasterKeyId", Terraform: "kms_master_key_id", CloudFormation: "KmsMasterKeyId"},
+	{Name: "SignatureVersion", Terraform: "signature_version", CloudFormation: "SignatureVersion", Kind: iac.IntAttribute},
+	{Name: "TracingConfig", Terraform: "tracing_config", CloudFormation: "TracingConfig"},
+	{Name: "DeliveryPolicy", Terraform: "delivery_policy", CloudFormation: "DeliveryPolicy", Kind: iac.JSONAttribute},
+	{Name: "ArchivePolicy", Terraform: "archive_policy", CloudFormation: "ArchivePolicy", Kind: iac.JSONAttribute},
+	// CloudFormation attaches topic policies with AWS::SNS::TopicPolicy
+	{Name: "Policy", Terraform: "policy", Kind: iac.JSONAttribute},
+}
+
+func generateTopic(res dao.Resource) (*iac.Block, error) {
+	topic, ok := res.(*TopicResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	name := topic.GetName()
+
+	tf := iac.NewBody().Set("name", name)
+	props := iac.NewBody().Set("TopicName", name)
+	if err := iac.SetAttributes(tf, props, topic.Attrs, topicAttributes); err != nil {
+		return nil, err
+	}
+	tf.Set("tags", iac.UserTags(topic.GetTags()))
+	props.Set("Tags", iac.CloudFormationTags(topic.GetTags()))
+
+	block := &iac.Block{
+		Name: "SNS topic " + name,
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_sns_topic",
+			Name:     iac.LocalName(name),
+			ImportID: topic.ARN(),
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::SNS::Topic",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("TopicArn", topic.ARN()),
+			Properties: props,
+		},
+	}
+	if topic.Attrs["Policy"] != "" {
+		block.Notes = append(block.Notes, "CloudFormation: the topic policy belongs in an AWS::SNS::TopicPolicy resource")
+	}
+	return block, nil
+}
@@ -0,0 +1,196 @@
+package topics
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Publish field keys
+const (
+	fieldSubject    = "subject"
+	fieldStructure  = "structure"
+	fieldAttributes = "attributes"
+	fieldGroup      = "group"
+	fieldDedup      = "dedup"
+)
+
+// Message structures: the body is sent to every protocol as is, or is a
+// JSON object with a message per protocol
+const (
+	structureRaw  = "raw"
+	structureJSON = "json"
+)
+
+// publishCompose publishes the composed body to the topic
+var publishCompose = &action.Compose{
+	Fields: publishFields,
+	Body: func(dao.Resource) (string, string) {
+		return "", ".json"
+	},
+	Send: sendPublish,
+}
+
+func publishFields(resource dao.Resource) []action.ComposeField {
+	fields := []action.ComposeField{
+		{Key: fieldSubject, Label: "Subject", Placeholder: "optional, used by email endpoints"},
+		{Key: fieldStructure, Label: "Structure", Options: []string{structureRaw, structureJSON}},
+		{Key: fieldAttributes, Label: "Attributes", Placeholder: "name=value; count:Number=3; tags:String.Array=[\"a\"]"},
+	}
+	topic, ok := dao.UnwrapResource(resource).(*TopicResource)
+	if !ok || !topic.IsFIFO() {
+		return fields
+	}
+	dedup := "random if empty"
+	if topic.ContentBasedDeduplication() {
+		dedup = "content-based if empty"
+	}
+	return append(fields,
+		action.ComposeField{Key: fieldGroup, Label: "Group ID", Placeholder: "required"},
+		action.ComposeField{Key: fieldDedup, Label: "Dedup ID", Placeholder: dedup},
+	)
+}
+
+func sendPublish(ctx context.Context, resource dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+	topic, ok := dao.UnwrapResource(resource).(*TopicResource)
+	if !ok {
+		return action.ComposeResult{}, fmt.Errorf("invalid resource type")
+	}
+	input, err := buildPublishInput(topic, body, values)
+	if err != nil {
+		return action.ComposeResult{}, err
+	}
+
+	client, err := getSNSClient(ctx)
+	if err != nil {
+		return action.ComposeResult{}, err
+	}
+	output, err := client.Publish(ctx, input)
+	if err != nil {
+		return action.ComposeResult{}, apperrors.Wrapf(err, "publish to %s", topic.GetName())
+	}
+
+	id := appaws.Str(output.MessageId)
+	result := action.ComposeResult{
+		Message: fmt.Sprintf("Published message %s to %s", id, topic.GetName()),
+		ID:      id,
+	}
+	if output.SequenceNumber != nil {
+		result.Output = fmt.Sprintf("MessageId:      %s\nSequenceNumber: %s", id, *output.SequenceNumber)
+	}
+	return result, nil
+}
+
+// buildPublishInput validates the composed message and builds the request
+func buildPublishInput(topic *TopicResource, body string, values map[string]string) (*sns.PublishInput, error) {
+	if strings.TrimSpace(body) == "" {
+		return nil, fmt.Errorf("message body is empty")
+	}
+	topicArn := topic.GetARN()
+	input := &sns.PublishInput{
+		TopicArn: &topicArn,
+		Message:  &body,
+	}
+	if subject := values[fieldSubject]; subject != "" {
+		input.Subject = &subject
+	}
+	if values[fieldStructure] == structureJSON {
+		if err := validateJSONStructure(body); err != nil {
+			return nil, err
+		}
+		input.MessageStructure = appaws.StringPtr(structureJSON)
+	}
+	attrs, err := parseAttributes(values[fieldAttributes])
+	if err != nil {
+		return nil, err
+	}
+	input.MessageAttributes = attrs
+
+	if topic.IsFIFO() {
+		group := values[fieldGroup]
+		if group == "" {
+			return nil, fmt.Errorf("group ID is required for FIFO topics")
+		}
+		input.MessageGroupId = &group
+		dedup := values[fieldDedup]
+		if dedup == "" && !topic.ContentBasedDeduplication() {
+			dedup = fmt.Sprintf("claws-%d", time.Now().UnixNano())
+		}
+		if dedup != "" {
+			input.MessageDeduplicationId = &dedup
+		}
+	}
+	return input, nil
+}
+
+// validateJSONStructure checks a json message structure: an object of
+// messages by protocol, with a "default" message for the others
+func validateJSONStructure(body string) error {
+	var messages map[string]any
+	if err := json.Unmarshal([]byte(body), &messages); err != nil {
+		return fmt.Errorf("json structure: body must be an object of messages by protocol: %w", err)
+	}
+	if _, ok := messages["default"]; !ok {
+		return fmt.Errorf(`json structure: a "default" message is required`)
+	}
+	for protocol, message := range messages {
+		if _, ok := message.(string); !ok {
+			return fmt.Errorf("json structure: message for %q must be a string (escape nested JSON)", protocol)
+		}
+	}
+	return nil
+}
+
+// parseAttributes parses "name=value; name:Type=value" into message
+// attributes. The type defaults to String; Binary values are base64.
+func parseAttributes(s string) (map[string]types.MessageAttributeValue, error) {
+	attrs := make(map[string]types.MessageAttributeValue)
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("attribute %q: want name=value", part)
+		}
+		name, dataType, _ := strings.Cut(strings.TrimSpace(key), ":")
+		if name == "" {
+			return nil, fmt.Errorf("attribute %q: name is empty", part)
+		}
+		if dataType == "" {
+			dataType = "String"
+		}
+		value = strings.TrimSpace(value)
+
+		attr := types.MessageAttributeValue{DataType: &dataType}
+		switch base, _, _ := strings.Cut(dataType, "."); base {
+		case "String", "Number":
+			attr.StringValue = &value
+		case "Binary":
+			data, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %s: Binary values must be base64: %w", name, err)
+			}
+			attr.BinaryValue = data
+		default:
+			return nil, fmt.Errorf("attribute %s: unknown type %q (String, Number, Binary or String.Array)", name, dataType)
+		}
+		attrs[name] = attr
+	}
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	return attrs, nil
+}
@@ -135,3 +135,69 @@ func TestTopicResource_NameExtraction(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildPublishInput(t *testing.T) {
+	standard := NewTopicResource(types.Topic{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:orders")}, map[string]string{})
+	input, err := buildPublishInput(standard, `{"id": 1}`, map[string]string{
+		fieldSubject:    "test",
+		fieldStructure:  structureRaw,
+		fieldAttributes: "env=staging; retries:Number=3; blob:Binary=aGk=",
+	})
+	if err != nil {
+		t.Fatalf("buildPublishInput() error = %v", err)
+	}
+	if aws.ToString(input.Subject) != "test" || input.MessageStructure != nil || input.MessageGroupId != nil {
+		t.Errorf("input = %+v", input)
+	}
+	if len(input.MessageAttributes) != 3 ||
+		aws.ToString(input.MessageAttributes["retries"].DataType) != "Number" ||
+		aws.ToString(input.MessageAttributes["env"].StringValue) != "staging" ||
+		string(input.MessageAttributes["blob"].BinaryValue) != "hi" {
+		t.Errorf("MessageAttributes = %+v", input.MessageAttributes)
+	}
+
+	if _, err := buildPublishInput(standard, "  ", nil); err == nil {
+		t.Error("empty body should fail")
+	}
+	if _, err := buildPublishInput(standard, "x", map[string]string{fieldAttributes: "noequals"}); err == nil {
+		t.Error("attribute without a value should fail")
+	}
+	if _, err := buildPublishInput(standard, "x", map[string]string{fieldAttributes: "a:Date=1"}); err == nil {
+		t.Error("unknown attribute type should fail")
+	}
+}
+
+func TestBuildPublishInput_JSONStructure(t *testing.T) {
+	topic := NewTopicResource(types.Topic{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:orders")}, map[string]string{})
+	values := map[string]string{fieldStructure: structureJSON}
+
+	input, err := buildPublishInput(topic, `{"default": "hi", "sqs": "{\"id\": 1}"}`, values)
+	if err != nil || aws.ToString(input.MessageStructure) != "json" {
+		t.Errorf("buildPublishInput() = %+v, %v", input, err)
+	}
+	for _, body := range []string{`not json`, `{"sqs": "x"}`, `{"default": "x", "sqs": {"id": 1}}`} {
+		if _, err := buildPublishInput(topic, body, values); err == nil {
+			t.Errorf("json structure %s should fail", body)
+		}
+	}
+}
+
+func TestBuildPublishInput_FIFO(t *testing.T) {
+	topic := NewTopicResource(types.Topic{TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:orders.fifo")}, map[string]string{"FifoTopic": "true"})
+	if _, err := buildPublishInput(topic, "x", map[string]string{}); err == nil {
+		t.Error("FIFO publish without a group ID should fail")
+	}
+	input, err := buildPublishInput(topic, "x", map[string]string{fieldGroup: "customer-7"})
+	if err != nil || aws.ToString(input.MessageGroupId) != "customer-7" || aws.ToString(input.MessageDeduplicationId) == "" {
+		t.Errorf("input = %+v, %v; want a generated dedup ID", input, err)
+	}
+
+	topic.Attrs["ContentBasedDeduplication"] = "true"
+	input, err = buildPublishInput(topic, "x", map[string]string{fieldGroup: "customer-7"})
+	if err != nil || input.MessageDeduplicationId != nil {
+		t.Errorf("input = %+v, %v; want content-based deduplication", input, err)
+	}
+	if fields := publishFields(topic); len(fields) != 5 {
+		t.Errorf("publishFields() = %d fields, want group and dedup for FIFO topics", len(fields))
+	}
+}
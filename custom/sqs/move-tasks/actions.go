@@ -0,0 +1,50 @@
+package movetasks
+
+import (
+	"context"
+	"fmt"
+
+	sqsClient "github.com/clawscli/claws/custom/sqs"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("sqs", "move-tasks", []action.Action{
+		{
+			Name:      "Cancel",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelMessageMoveTask",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				t, ok := r.(*MoveTaskResource)
+				return ok && t.IsRunning()
+			},
+		},
+	})
+
+	action.RegisterExecutor("sqs", "move-tasks", executeMoveTaskAction)
+}
+
+func executeMoveTaskAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	t, ok := dao.UnwrapResource(resource).(*MoveTaskResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "CancelMessageMoveTask":
+		client, err := sqsClient.GetClient(ctx)
+		if err != nil {
+			return action.FailResult(err)
+		}
+		moved, err := sqsClient.CancelMoveTask(ctx, client, t.TaskHandle())
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult(fmt.Sprintf("Cancelled move task on %s after %d message(s)", t.GetName(), moved))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,135 @@
+package movetasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	sqsClient "github.com/clawscli/claws/custom/sqs"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterSourceArn is the filter holding the ARN of the dead-letter queue
+const FilterSourceArn = "SourceArn"
+
+// MoveTaskDAO lists the message move tasks of a dead-letter queue
+type MoveTaskDAO struct {
+	dao.BaseDAO
+	client *sqs.Client
+}
+
+// NewMoveTaskDAO creates a new MoveTaskDAO
+func NewMoveTaskDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sqsClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &MoveTaskDAO{
+		BaseDAO: dao.NewBaseDAO("sqs", "move-tasks"),
+		client:  client,
+	}, nil
+}
+
+// List returns the most recent move tasks of the queue, newest first
+func (d *MoveTaskDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	sourceArn := dao.GetFilterFromContext(ctx, FilterSourceArn)
+	if sourceArn == "" {
+		return nil, fmt.Errorf("%s required: navigate from sqs/queues using 'T' key", FilterSourceArn)
+	}
+	tasks, err := sqsClient.MoveTasks(ctx, d.client, sourceArn)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]dao.Resource, len(tasks))
+	for i, task := range tasks {
+		resources[i] = NewMoveTaskResource(task)
+	}
+	return resources, nil
+}
+
+// Get is not supported: tasks are only listed by source queue
+func (d *MoveTaskDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get move task %s: tasks can only be listed by queue", id)
+}
+
+// Delete is not supported: running tasks are cancelled with the Cancel action
+func (d *MoveTaskDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete move task %s: use the Cancel action", id)
+}
+
+// MoveTaskResource is a message move task
+type MoveTaskResource struct {
+	dao.BaseResource
+	Item types.ListMessageMoveTasksResultEntry
+}
+
+// NewMoveTaskResource creates a new MoveTaskResource. Only running tasks
+// have a handle; finished ones are identified by their start time.
+func NewMoveTaskResource(task types.ListMessageMoveTasksResultEntry) *MoveTaskResource {
+	id := appaws.Str(task.TaskHandle)
+	if id == "" {
+		id = strconv.FormatInt(task.StartedTimestamp, 10)
+	}
+	return &MoveTaskResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: appaws.ExtractResourceName(appaws.Str(task.SourceArn)),
+			ARN:  appaws.Str(task.SourceArn),
+			Data: task,
+		},
+		Item: task,
+	}
+}
+
+// Status returns the task status, e.g. RUNNING or COMPLETED
+func (r *MoveTaskResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// IsRunning reports whether the task can be cancelled
+func (r *MoveTaskResource) IsRunning() bool {
+	return r.Status() == sqsClient.MoveTaskRunning
+}
+
+// TaskHandle returns the handle of a running task
+func (r *MoveTaskResource) TaskHandle() string {
+	return appaws.Str(r.Item.TaskHandle)
+}
+
+// StartedTime returns when the task started
+func (r *MoveTaskResource) StartedTime() time.Time {
+	if r.Item.StartedTimestamp == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(r.Item.StartedTimestamp)
+}
+
+// Destination returns the destination queue name, or "(source queues)"
+func (r *MoveTaskResource) Destination() string {
+	if r.Item.DestinationArn == nil {
+		return "(source queues)"
+	}
+	return appaws.ExtractResourceName(*r.Item.DestinationArn)
+}
+
+// Rate returns the messages/sec limit, or "auto"
+func (r *MoveTaskResource) Rate() string {
+	if r.Item.MaxNumberOfMessagesPerSecond == nil {
+		return "auto"
+	}
+	return fmt.Sprintf("%d/s", *r.Item.MaxNumberOfMessagesPerSecond)
+}
+
+// ToMove returns the number of messages the task started with, if known
+func (r *MoveTaskResource) ToMove() string {
+	if r.Item.ApproximateNumberOfMessagesToMove == nil {
+		return ""
+	}
+	return strconv.FormatInt(*r.Item.ApproximateNumberOfMessagesToMove, 10)
+}
@@ -0,0 +1,126 @@
+package movetasks
+
+import (
+	"strconv"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MoveTaskRenderer renders message move tasks
+type MoveTaskRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMoveTaskRenderer creates a new MoveTaskRenderer
+func NewMoveTaskRenderer() render.Renderer {
+	return &MoveTaskRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "sqs",
+			Resource: "move-tasks",
+			Cols: []render.Column{
+				{Name: "STATUS", Width: 11, Getter: getStatus, Priority: 0},
+				{Name: "STARTED", Width: 10, Getter: getStarted, Priority: 1},
+				{Name: "MOVED", Width: 9, Getter: getMoved, Priority: 0},
+				{Name: "TO MOVE", Width: 9, Getter: getToMove, Priority: 2},
+				{Name: "DESTINATION", Width: 30, Getter: getDestination, Priority: 1},
+				{Name: "RATE", Width: 7, Getter: getRate, Priority: 3},
+				{Name: "FAILURE", Width: 40, Getter: getFailure, Priority: 4},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.Status()
+	}
+	return ""
+}
+
+func getStarted(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return render.FormatAge(t.StartedTime())
+	}
+	return ""
+}
+
+func getMoved(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return strconv.FormatInt(t.Item.ApproximateNumberOfMessagesMoved, 10)
+	}
+	return ""
+}
+
+func getToMove(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.ToMove()
+	}
+	return ""
+}
+
+func getDestination(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.Destination()
+	}
+	return ""
+}
+
+func getRate(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.Rate()
+	}
+	return ""
+}
+
+func getFailure(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return appaws.Str(t.Item.FailureReason)
+	}
+	return ""
+}
+
+// RenderDetail renders the task's progress and settings
+func (r *MoveTaskRenderer) RenderDetail(resource dao.Resource) string {
+	t, ok := resource.(*MoveTaskResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("SQS Message Move Task", t.GetName())
+
+	d.Section("Task")
+	d.Field("Status", t.Status())
+	d.FieldIf("Task Handle", t.Item.TaskHandle)
+	if started := t.StartedTime(); !started.IsZero() {
+		d.Field("Started", started.Format("2006-01-02 15:04:05"))
+	}
+	d.Field("Source", t.GetARN())
+	d.Field("Destination", t.Destination())
+	d.Field("Max Messages/sec", t.Rate())
+
+	d.Section("Progress")
+	d.Field("Moved", strconv.FormatInt(t.Item.ApproximateNumberOfMessagesMoved, 10))
+	if toMove := t.ToMove(); toMove != "" {
+		d.Field("To Move", toMove)
+	}
+	d.FieldIf("Failure Reason", t.Item.FailureReason)
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *MoveTaskRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	t, ok := resource.(*MoveTaskResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Source", Value: t.GetName()},
+		{Label: "Status", Value: t.Status()},
+		{Label: "Moved", Value: strconv.FormatInt(t.Item.ApproximateNumberOfMessagesMoved, 10)},
+		{Label: "Destination", Value: t.Destination()},
+	}
+}
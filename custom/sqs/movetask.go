@@ -0,0 +1,121 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Message move task statuses
+const (
+	MoveTaskRunning    = "RUNNING"
+	MoveTaskCompleted  = "COMPLETED"
+	MoveTaskCancelling = "CANCELLING"
+	MoveTaskCancelled  = "CANCELLED"
+	MoveTaskFailed     = "FAILED"
+)
+
+// moveTaskPollInterval is how often a running move task is checked
+var moveTaskPollInterval = 2 * time.Second
+
+// MoveTaskClient is the part of the SQS client move tasks use
+type MoveTaskClient interface {
+	StartMessageMoveTask(ctx context.Context, in *sqs.StartMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error)
+	ListMessageMoveTasks(ctx context.Context, in *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)
+	CancelMessageMoveTask(ctx context.Context, in *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)
+}
+
+// MoveTasks returns the most recent move tasks (up to 10) of a dead-letter queue
+func MoveTasks(ctx context.Context, client MoveTaskClient, sourceArn string) ([]types.ListMessageMoveTasksResultEntry, error) {
+	output, err := client.ListMessageMoveTasks(ctx, &sqs.ListMessageMoveTasksInput{
+		SourceArn:  &sourceArn,
+		MaxResults: appaws.Int32Ptr(10),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "list message move tasks for %s", appaws.ExtractResourceName(sourceArn))
+	}
+	return output.Results, nil
+}
+
+// RunMoveTask starts moving messages out of a dead-letter queue and waits
+// for the task to finish, reporting the number of messages moved. An empty
+// destination moves messages back to their source queues; a rate of 0 lets
+// SQS choose. Cancelling ctx cancels the task.
+func RunMoveTask(ctx context.Context, client MoveTaskClient, sourceArn, destArn string, rate int32, progress func(moved int64)) (int64, error) {
+	input := &sqs.StartMessageMoveTaskInput{SourceArn: &sourceArn}
+	if destArn != "" {
+		input.DestinationArn = &destArn
+	}
+	if rate > 0 {
+		input.MaxNumberOfMessagesPerSecond = &rate
+	}
+	output, err := client.StartMessageMoveTask(ctx, input)
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "start message move task for %s", appaws.ExtractResourceName(sourceArn))
+	}
+	handle := appaws.Str(output.TaskHandle)
+
+	ticker := time.NewTicker(moveTaskPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if _, err := CancelMoveTask(context.WithoutCancel(ctx), client, handle); err != nil {
+				return 0, err
+			}
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+
+		task, err := findMoveTask(ctx, client, sourceArn, handle)
+		if err != nil {
+			return 0, err
+		}
+		if task == nil {
+			continue
+		}
+		progress(task.ApproximateNumberOfMessagesMoved)
+		switch appaws.Str(task.Status) {
+		case MoveTaskCompleted:
+			return task.ApproximateNumberOfMessagesMoved, nil
+		case MoveTaskFailed:
+			return task.ApproximateNumberOfMessagesMoved, fmt.Errorf("message move task failed: %s", appaws.Str(task.FailureReason))
+		case MoveTaskCancelled:
+			return task.ApproximateNumberOfMessagesMoved, errors.New("message move task was cancelled")
+		}
+	}
+}
+
+// findMoveTask returns the task with the handle, or nil if it is not listed yet.
+// Finished tasks have no handle; the latest task is the one that finished.
+func findMoveTask(ctx context.Context, client MoveTaskClient, sourceArn, handle string) (*types.ListMessageMoveTasksResultEntry, error) {
+	tasks, err := MoveTasks(ctx, client, sourceArn)
+	if err != nil {
+		return nil, err
+	}
+	for i, task := range tasks {
+		if appaws.Str(task.TaskHandle) == handle {
+			return &tasks[i], nil
+		}
+	}
+	if len(tasks) > 0 && tasks[0].TaskHandle == nil && appaws.Str(tasks[0].Status) != MoveTaskRunning {
+		return &tasks[0], nil
+	}
+	return nil, nil
+}
+
+// CancelMoveTask cancels a running move task, returning the messages moved so far
+func CancelMoveTask(ctx context.Context, client MoveTaskClient, handle string) (int64, error) {
+	output, err := client.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{TaskHandle: &handle})
+	if err != nil {
+		return 0, apperrors.Wrap(err, "cancel message move task")
+	}
+	return output.ApproximateNumberOfMessagesMoved, nil
+}
@@ -0,0 +1,113 @@
+package sqs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+const testDLQ = "arn:aws:sqs:us-east-1:123456789012:orders-dlq"
+
+// fakeMoveTaskClient reports each listed status in turn
+type fakeMoveTaskClient struct {
+	starts   []*sqs.StartMessageMoveTaskInput
+	statuses []string
+	lists    int
+	cancels  []string
+}
+
+func (f *fakeMoveTaskClient) StartMessageMoveTask(_ context.Context, in *sqs.StartMessageMoveTaskInput, _ ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error) {
+	f.starts = append(f.starts, in)
+	return &sqs.StartMessageMoveTaskOutput{TaskHandle: aws.String("handle-1")}, nil
+}
+
+func (f *fakeMoveTaskClient) ListMessageMoveTasks(_ context.Context, _ *sqs.ListMessageMoveTasksInput, _ ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error) {
+	status := f.statuses[min(f.lists, len(f.statuses)-1)]
+	f.lists++
+	task := types.ListMessageMoveTasksResultEntry{
+		Status:                           aws.String(status),
+		ApproximateNumberOfMessagesMoved: int64(f.lists * 10),
+		FailureReason:                    aws.String("AccessDenied"),
+	}
+	if status == MoveTaskRunning {
+		task.TaskHandle = aws.String("handle-1")
+	}
+	return &sqs.ListMessageMoveTasksOutput{Results: []types.ListMessageMoveTasksResultEntry{task}}, nil
+}
+
+func (f *fakeMoveTaskClient) CancelMessageMoveTask(_ context.Context, in *sqs.CancelMessageMoveTaskInput, _ ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error) {
+	f.cancels = append(f.cancels, aws.ToString(in.TaskHandle))
+	return &sqs.CancelMessageMoveTaskOutput{ApproximateNumberOfMessagesMoved: 5}, nil
+}
+
+func fastMoveTaskPolling(t *testing.T) {
+	t.Helper()
+	interval := moveTaskPollInterval
+	moveTaskPollInterval = time.Millisecond
+	t.Cleanup(func() { moveTaskPollInterval = interval })
+}
+
+func TestRunMoveTask(t *testing.T) {
+	fastMoveTaskPolling(t)
+
+	tests := []struct {
+		name     string
+		statuses []string
+		wantErr  bool
+		wantLast int64
+	}{
+		{"completed", []string{MoveTaskRunning, MoveTaskRunning, MoveTaskCompleted}, false, 30},
+		{"failed", []string{MoveTaskRunning, MoveTaskFailed}, true, 20},
+		{"cancelled elsewhere", []string{MoveTaskCancelled}, true, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeMoveTaskClient{statuses: tt.statuses}
+			var last int64
+			moved, err := RunMoveTask(context.Background(), client, testDLQ, "", 0, func(n int64) { last = n })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RunMoveTask() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if moved != tt.wantLast || last != tt.wantLast {
+				t.Errorf("moved = %d, last progress = %d, want %d", moved, last, tt.wantLast)
+			}
+			in := client.starts[0]
+			if in.DestinationArn != nil || in.MaxNumberOfMessagesPerSecond != nil {
+				t.Errorf("start input = %+v, want defaults left unset", in)
+			}
+		})
+	}
+}
+
+func TestRunMoveTask_CancelledContext(t *testing.T) {
+	fastMoveTaskPolling(t)
+
+	client := &fakeMoveTaskClient{statuses: []string{MoveTaskRunning}}
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := RunMoveTask(ctx, client, testDLQ, testDLQ+"-replay", 50, func(int64) { cancel() })
+	if err != context.Canceled {
+		t.Fatalf("RunMoveTask() error = %v, want context.Canceled", err)
+	}
+	if len(client.cancels) != 1 || client.cancels[0] != "handle-1" {
+		t.Errorf("cancels = %v, want the started task cancelled", client.cancels)
+	}
+	if in := client.starts[0]; aws.ToString(in.DestinationArn) != testDLQ+"-replay" || aws.ToInt32(in.MaxNumberOfMessagesPerSecond) != 50 {
+		t.Errorf("start input = %+v", in)
+	}
+}
+
+func TestParseRedrivePolicy(t *testing.T) {
+	policy, ok := ParseRedrivePolicy(`{"deadLetterTargetArn":"` + testDLQ + `","maxReceiveCount":5}`)
+	if !ok || policy.DeadLetterTargetArn != testDLQ || policy.MaxReceiveCount != 5 {
+		t.Errorf("ParseRedrivePolicy() = %+v, %v", policy, ok)
+	}
+	for _, attr := range []string{"", "not json", `{"maxReceiveCount":5}`} {
+		if _, ok := ParseRedrivePolicy(attr); ok {
+			t.Errorf("ParseRedrivePolicy(%q) should not parse", attr)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// RedrivePolicy is a queue's dead-letter queue configuration
+type RedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
+}
+
+// ParseRedrivePolicy parses the RedrivePolicy queue attribute
+func ParseRedrivePolicy(attr string) (RedrivePolicy, bool) {
+	var policy RedrivePolicy
+	if attr == "" || json.Unmarshal([]byte(attr), &policy) != nil || policy.DeadLetterTargetArn == "" {
+		return RedrivePolicy{}, false
+	}
+	return policy, true
+}
+
+// QueueURL resolves a queue ARN to the queue URL
+func QueueURL(ctx context.Context, client *sqs.Client, queueArn string) (string, error) {
+	arn := appaws.ParseARN(queueArn)
+	if arn == nil || arn.Service != "sqs" {
+		return "", fmt.Errorf("invalid queue ARN %q", queueArn)
+	}
+	input := &sqs.GetQueueUrlInput{QueueName: appaws.StringPtr(appaws.ExtractResourceName(queueArn))}
+	if arn.AccountID != "" {
+		input.QueueOwnerAWSAccountId = &arn.AccountID
+	}
+	output, err := client.GetQueueUrl(ctx, input)
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get queue URL for %s", queueArn)
+	}
+	return appaws.Str(output.QueueUrl), nil
+}
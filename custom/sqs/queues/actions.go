@@ -30,6 +30,14 @@ func init() {
 			Operation: "SendTestMessage",
 			Confirm:   action.ConfirmSimple,
 		},
+		{
+			Name:      "Redrive DLQ messages",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartMessageMoveTask",
+			Confirm:   action.ConfirmDangerous,
+			Transfer:  redriveTransfer,
+		},
 		{
 			Name:      "Delete",
 			Shortcut:  "D",
@@ -7,12 +7,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 
+	sqsClient "github.com/clawscli/claws/custom/sqs"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
 )
 
+// FilterQueueArn limits the list to one queue, e.g. a dead-letter queue
+// reached from its source queue's redrive policy
+const FilterQueueArn = "QueueArn"
+
 // QueueDAO provides data access for SQS queues
 type QueueDAO struct {
 	dao.BaseDAO
@@ -32,6 +37,18 @@ func NewQueueDAO(ctx context.Context) (dao.DAO, error) {
 }
 
 func (d *QueueDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if queueArn := dao.GetFilterFromContext(ctx, FilterQueueArn); queueArn != "" {
+		queueUrl, err := sqsClient.QueueURL(ctx, d.client, queueArn)
+		if err != nil {
+			return nil, err
+		}
+		queue, err := d.Get(ctx, queueUrl)
+		if err != nil {
+			return nil, err
+		}
+		return []dao.Resource{queue}, nil
+	}
+
 	queueUrls, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
 		output, err := d.client.ListQueues(ctx, &sqs.ListQueuesInput{
 			NextToken: token,
@@ -156,6 +173,11 @@ func NewQueueResource(queueUrl string, attrs map[string]string) *QueueResource {
 	}
 }
 
+// QueueURL returns the URL of the queue
+func (r *QueueResource) QueueURL() string {
+	return r.URL
+}
+
 // IsFIFO returns true if this is a FIFO queue
 func (r *QueueResource) IsFIFO() bool {
 	return strings.HasSuffix(r.GetName(), ".fifo")
@@ -241,10 +263,8 @@ func (r *QueueResource) RedrivePolicy() string {
 	return ""
 }
 
-// DeadLetterTargetArn returns the DLQ ARN if configured
+// DeadLetterTargetArn returns the DLQ ARN from the redrive policy, if configured
 func (r *QueueResource) DeadLetterTargetArn() string {
-	if v, ok := r.Attributes["DeadLetterTargetArn"]; ok {
-		return v
-	}
-	return ""
+	policy, _ := sqsClient.ParseRedrivePolicy(r.RedrivePolicy())
+	return policy.DeadLetterTargetArn
 }
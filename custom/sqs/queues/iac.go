@@ -0,0 +1,69 @@
+package queues
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func init() {
+	iac.Register("sqs", "queues", generateQueue)
+}
+
+// queueAttributes maps GetQueueAttributes names to aws_sqs_queue and
+// AWS::SQS::Queue properties
+var queueAttributes = []iac.Attribute{
+	{Name: "FifoQueue", Terraform: "fifo_queue", CloudFormation: "FifoQueue", Kind: iac.BoolAttribute},
+	{Name: "ContentBasedDeduplication", Terraform: "content_based_deduplication", CloudFormation: "ContentBasedDeduplication", Kind: iac.BoolAttribute},
+	{Name: "DeduplicationScope", Terraform: "deduplication_scope", CloudFormation: "DeduplicationScope"},
+	{Name: "FifoThroughputLimit", Terraform: "fifo_throughput_limit", CloudFormation: "FifoThroughputLimit"},
+	{Name: "DelaySeconds", Terraform: "delay_seconds", CloudFormation: "DelaySeconds", Kind: iac.IntAttribute},
+	{Name: "MaximumMessageSize", Terraform: "max_message_size", CloudFormation: "MaximumMessageSize", Kind: iac.IntAttribute},
+	{Name: "MessageRetentionPeriod", Terraform: "message_retention_seconds", CloudFormation: "MessageRetentionPeriod", Kind: iac.IntAttribute},
+	{Name: "ReceiveMessageWaitTimeSeconds", Terraform: "receive_wait_time_seconds", CloudFormation: "ReceiveMessageWaitTimeSeconds", Kind: iac.IntAttribute},
+	{Name: "VisibilityTimeout", Terraform: "visibility_timeout_seconds", CloudFormation: "VisibilityTimeout", Kind: iac.IntAttribute},
+	{Name: "KmsMasterKeyId", Terraform: "kms_master_key_id", CloudFormation: "KmsMasterKeyId"},
+	{Name: "KmsDataKeyReusePeriodSeconds", Terraform: "kms_data_key_reuse_period_seconds", CloudFormation: "KmsDataKeyReusePeriodSeconds", Kind: iac.IntAttribute},
+	{Name: "SqsManagedSseEnabled", Terraform: "sqs_managed_sse_enabled", CloudFormation: "SqsManagedSseEnabled", Kind: iac.BoolAttribute},
+	{Name: "RedrivePolicy", Terraform: "redrive_policy", CloudFormation: "RedrivePolicy", Kind: iac.JSONAttribute},
+	{Name: "RedriveAllowPolicy", Terraform: "redrive_allow_policy", CloudFormation: "RedriveAllowPolicy", Kind: iac.JSONAttribute},
+	// CloudFormation attaches queue policies with AWS::SQS::QueuePolicy
+	{Name: "Policy", Terraform: "policy", Kind: iac.JSONAttribute},
+}
+
+func generateQueue(res dao.Resource) (*iac.Block, error) {
+	queue, ok := res.(*QueueResource)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", res)
+	}
+	name := queue.GetName()
+
+	tf := iac.NewBody().Set("name", name)
+	props := iac.NewBody().Set("QueueName", name)
+	if err := iac.SetAttributes(tf, props, queue.Attributes, queueAttributes); err != nil {
+		return nil, err
+	}
+	tf.Set("tags", iac.UserTags(queue.GetTags()))
+	props.Set("Tags", iac.CloudFormationTags(queue.GetTags()))
+
+	block := &iac.Block{
+		Name: "SQS queue " + name,
+		Terraform: []iac.TerraformResource{{
+			Type:     "aws_sqs_queue",
+			Name:     iac.LocalName(name),
+			ImportID: queue.URL,
+			Body:     tf,
+		}},
+		CloudFormation: &iac.CloudFormationResource{
+			Type:       "AWS::SQS::Queue",
+			LogicalID:  iac.LogicalID(name),
+			Identifier: iac.NewBody().Set("QueueUrl", queue.URL),
+			Properties: props,
+		},
+	}
+	if queue.Attributes["Policy"] != "" {
+		block.Notes = append(block.Notes, "CloudFormation: the queue policy belongs in an AWS::SQS::QueuePolicy resource")
+	}
+	return block, nil
+}
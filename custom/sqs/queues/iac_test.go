@@ -0,0 +1,60 @@
+package queues
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/iac"
+)
+
+func TestGenerateQueue(t *testing.T) {
+	queueURL := "https://sqs.us-east-1.amazonaws.com/123456789012/orders.fifo"
+	resource := NewQueueResource(queueURL, map[string]string{
+		"QueueArn":          "arn:aws:sqs:us-east-1:123456789012:orders.fifo",
+		"FifoQueue":         "true",
+		"VisibilityTimeout": "60",
+		"RedrivePolicy":     `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:orders-dlq.fifo","maxReceiveCount":5}`,
+		"CreatedTimestamp":  "1234567890",
+	})
+
+	block, err := generateQueue(resource)
+	if err != nil {
+		t.Fatalf("generateQueue() error = %v", err)
+	}
+
+	tf, err := iac.Render([]*iac.Block{block}, iac.Terraform)
+	if err != nil {
+		t.Fatalf("Render(Terraform) error = %v", err)
+	}
+	for _, want := range []string{
+		`resource "aws_sqs_queue" "orders_fifo" {`,
+		`fifo_queue                 = true`,
+		`visibility_timeout_seconds = 60`,
+		`redrive_policy = jsonencode({`,
+		`  to = aws_sqs_queue.orders_fifo`,
+		`  id = "` + queueURL + `"`,
+	} {
+		if !strings.Contains(tf, want) {
+			t.Errorf("Terraform output missing %q:\n%s", want, tf)
+		}
+	}
+	if strings.Contains(tf, "CreatedTimestamp") {
+		t.Errorf("Terraform output contains read-only attribute:\n%s", tf)
+	}
+
+	cfn, err := iac.Render([]*iac.Block{block}, iac.CloudFormation)
+	if err != nil {
+		t.Fatalf("Render(CloudFormation) error = %v", err)
+	}
+	for _, want := range []string{
+		"OrdersFifo:",
+		"Type: AWS::SQS::Queue",
+		"QueueName: orders.fifo",
+		"maxReceiveCount: 5",
+		`"QueueUrl": "` + queueURL + `"`,
+	} {
+		if !strings.Contains(cfn, want) {
+			t.Errorf("CloudFormation output missing %q:\n%s", want, cfn)
+		}
+	}
+}
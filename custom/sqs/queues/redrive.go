@@ -0,0 +1,133 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	sqsClient "github.com/clawscli/claws/custom/sqs"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Redrive field keys
+const (
+	fieldDest = "dest"
+	fieldRate = "rate"
+)
+
+// maxMoveRate is the highest MaxNumberOfMessagesPerSecond SQS accepts
+const maxMoveRate = 500
+
+// redriveTransfer moves the messages of a dead-letter queue back to their
+// source queues, or to another queue, with a message move task
+var redriveTransfer = &action.Transfer{
+	Fields: func(dao.Resource) []action.TransferField {
+		return []action.TransferField{
+			{Key: fieldDest, Label: "Destination", Placeholder: "queue name or ARN (default: source queues)", Optional: true},
+			{Key: fieldRate, Label: "Messages/sec", Placeholder: fmt.Sprintf("1-%d (default: SQS optimized)", maxMoveRate), Optional: true},
+		}
+	},
+	Prepare: prepareRedrive,
+}
+
+func prepareRedrive(ctx context.Context, resource dao.Resource, values map[string]string) (*action.TransferJob, error) {
+	queue, ok := dao.UnwrapResource(resource).(*QueueResource)
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type")
+	}
+	destArn := destinationArn(queue.GetARN(), values[fieldDest])
+	rate, err := parseMoveRate(values[fieldRate])
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getSQSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := sqsClient.MoveTasks(ctx, client, queue.GetARN())
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if appaws.Str(task.Status) == sqsClient.MoveTaskRunning {
+			return nil, fmt.Errorf("a message move task is already running on %s", queue.GetName())
+		}
+	}
+	count, err := visibleMessages(ctx, client, queue.URL)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("no messages to move in %s", queue.GetName())
+	}
+
+	target := "their source queues"
+	if destArn != "" {
+		target = appaws.ExtractResourceName(destArn)
+	}
+	return &action.TransferJob{
+		Description: fmt.Sprintf("Move messages from %s to %s", queue.GetName(), target),
+		Objects:     count,
+		Token:       queue.GetName(),
+		Warnings: []string{
+			"Consumers of the destination receive the moved messages again.",
+			"Esc cancels the move task; messages already moved stay moved.",
+		},
+		Run: func(ctx context.Context, progress func(action.TransferProgress)) (string, error) {
+			moved, err := sqsClient.RunMoveTask(ctx, client, queue.GetARN(), destArn, rate, func(moved int64) {
+				progress(action.TransferProgress{Objects: int(moved), Current: target})
+			})
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Moved %d message(s) from %s to %s", moved, queue.GetName(), target), nil
+		},
+	}, nil
+}
+
+// destinationArn accepts a queue ARN or a queue name in the dead-letter
+// queue's account and region. Empty means the messages' source queues.
+func destinationArn(sourceArn, dest string) string {
+	dest = strings.TrimSpace(dest)
+	if dest == "" || strings.HasPrefix(dest, "arn:") {
+		return dest
+	}
+	i := strings.LastIndex(sourceArn, ":")
+	if i < 0 {
+		return dest
+	}
+	return sourceArn[:i+1] + dest
+}
+
+func parseMoveRate(s string) (int32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	rate, err := strconv.Atoi(s)
+	if err != nil || rate < 1 || rate > maxMoveRate {
+		return 0, fmt.Errorf("messages/sec must be between 1 and %d, got %q", maxMoveRate, s)
+	}
+	return int32(rate), nil
+}
+
+// visibleMessages reads the current message count rather than the listed one
+func visibleMessages(ctx context.Context, client *sqs.Client, queueURL string) (int, error) {
+	output, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       &queueURL,
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameApproximateNumberOfMessages},
+	})
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "get attributes of %s", appaws.ExtractResourceName(queueURL))
+	}
+	count, _ := strconv.Atoi(output.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)])
+	return count, nil
+}
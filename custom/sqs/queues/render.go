@@ -1,16 +1,19 @@
 package queues
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
+	sqsClient "github.com/clawscli/claws/custom/sqs"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
+// Ensure QueueRenderer implements render.MetricSpecProvider
+var _ render.MetricSpecProvider = (*QueueRenderer)(nil)
+
 // QueueRenderer renders SQS queues
 type QueueRenderer struct {
 	render.BaseRenderer
@@ -176,17 +179,10 @@ func (r *QueueRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 	// Dead Letter Queue
-	if redrive := q.RedrivePolicy(); redrive != "" {
-		var policy struct {
-			DeadLetterTargetArn string `json:"deadLetterTargetArn"`
-			MaxReceiveCount     int    `json:"maxReceiveCount"`
-		}
-		if err := json.Unmarshal([]byte(redrive), &policy); err == nil {
-			d.Section("Dead Letter Queue")
-			parts := strings.Split(policy.DeadLetterTargetArn, ":")
-			d.Field("Target Queue", parts[len(parts)-1])
-			d.Field("Max Receives", fmt.Sprintf("%d", policy.MaxReceiveCount))
-		}
+	if policy, ok := sqsClient.ParseRedrivePolicy(q.RedrivePolicy()); ok {
+		d.Section("Dead Letter Queue")
+		d.Field("Target Queue", appaws.ExtractResourceName(policy.DeadLetterTargetArn))
+		d.Field("Max Receives", fmt.Sprintf("%d", policy.MaxReceiveCount))
 	}
 
 	// Timestamps
@@ -212,6 +208,31 @@ func (r *QueueRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// Navigations returns navigation shortcuts
+func (r *QueueRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	q, ok := dao.UnwrapResource(resource).(*QueueResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key: "p", Label: "Peek messages", ViewType: render.ViewTypeMessagePeek,
+		},
+		{
+			Key: "T", Label: "Move tasks", Service: "sqs", Resource: "move-tasks",
+			FilterField: "SourceArn", FilterValue: q.GetARN(), AutoReload: true,
+		},
+	}
+	if dlq := q.DeadLetterTargetArn(); dlq != "" {
+		navs = append(navs, render.Navigation{
+			Key: "L", Label: "Dead-letter queue", Service: "sqs", Resource: "queues",
+			FilterField: FilterQueueArn, FilterValue: dlq,
+		})
+	}
+	return navs
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *QueueRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	q, ok := resource.(*QueueResource)
@@ -281,21 +302,32 @@ func (r *QueueRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 	}
 
 	// DLQ
-	if redrive := q.RedrivePolicy(); redrive != "" {
-		var policy struct {
-			DeadLetterTargetArn string `json:"deadLetterTargetArn"`
-			MaxReceiveCount     int    `json:"maxReceiveCount"`
-		}
-		if err := json.Unmarshal([]byte(redrive), &policy); err == nil {
-			// Extract queue name from ARN
-			parts := strings.Split(policy.DeadLetterTargetArn, ":")
-			dlqName := parts[len(parts)-1]
-			fields = append(fields, render.SummaryField{
-				Label: "Dead Letter Queue",
-				Value: fmt.Sprintf("%s (max %d receives)", dlqName, policy.MaxReceiveCount),
-			})
-		}
+	if policy, ok := sqsClient.ParseRedrivePolicy(q.RedrivePolicy()); ok {
+		fields = append(fields, render.SummaryField{
+			Label: "Dead Letter Queue",
+			Value: fmt.Sprintf("%s (max %d receives)", appaws.ExtractResourceName(policy.DeadLetterTargetArn), policy.MaxReceiveCount),
+		})
 	}
 
 	return fields
 }
+
+func (r *QueueRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{
+		{
+			Namespace:     "AWS/SQS",
+			MetricName:    "ApproximateNumberOfMessagesVisible",
+			DimensionName: "QueueName",
+			Stat:          "Maximum",
+			ColumnHeader:  "VISIBLE(15m)",
+		},
+		{
+			Namespace:     "AWS/SQS",
+			MetricName:    "ApproximateAgeOfOldestMessage",
+			DimensionName: "QueueName",
+			Stat:          "Maximum",
+			ColumnHeader:  "OLDEST(15m)",
+			Unit:          "s",
+		},
+	}
+}
@@ -2,6 +2,8 @@ package queues
 
 import (
 	"testing"
+
+	"github.com/clawscli/claws/internal/render"
 )
 
 func TestNewQueueResource(t *testing.T) {
@@ -96,3 +98,57 @@ func TestQueueResource_MissingAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueRenderer_Navigations(t *testing.T) {
+	arn := "arn:aws:sqs:us-east-1:123456789012:orders"
+	queue := NewQueueResource("https://sqs.us-east-1.amazonaws.com/123456789012/orders", map[string]string{
+		"QueueArn":      arn,
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:orders-dlq","maxReceiveCount":3}`,
+	})
+	navs := NewQueueRenderer().(*QueueRenderer).Navigations(queue)
+	keys := make(map[string]string)
+	for _, nav := range navs {
+		keys[nav.Key] = nav.FilterValue
+		// Peeking changes the queue, so it must be a custom view the
+		// relations graph never expands
+		if nav.Key == "p" && nav.ViewType != render.ViewTypeMessagePeek {
+			t.Errorf("peek navigation = %+v, want the message peek view", nav)
+		}
+	}
+	if queue.QueueURL() != queue.URL || keys["T"] != arn || keys["L"] != "arn:aws:sqs:us-east-1:123456789012:orders-dlq" {
+		t.Errorf("Navigations() = %+v", navs)
+	}
+
+	dlq := NewQueueResource("https://sqs.us-east-1.amazonaws.com/123456789012/orders-dlq", map[string]string{})
+	for _, nav := range NewQueueRenderer().(*QueueRenderer).Navigations(dlq) {
+		if nav.Key == "L" {
+			t.Error("queue without a redrive policy should not link a dead-letter queue")
+		}
+	}
+}
+
+func TestRedriveFields(t *testing.T) {
+	dlq := "arn:aws:sqs:us-east-1:123456789012:orders-dlq"
+	tests := []struct {
+		dest string
+		want string
+	}{
+		{"", ""},
+		{"orders-replay", "arn:aws:sqs:us-east-1:123456789012:orders-replay"},
+		{"arn:aws:sqs:eu-west-1:210987654321:other", "arn:aws:sqs:eu-west-1:210987654321:other"},
+	}
+	for _, tt := range tests {
+		if got := destinationArn(dlq, tt.dest); got != tt.want {
+			t.Errorf("destinationArn(%q) = %q, want %q", tt.dest, got, tt.want)
+		}
+	}
+
+	if rate, err := parseMoveRate(" 25 "); err != nil || rate != 25 {
+		t.Errorf("parseMoveRate(25) = %d, %v", rate, err)
+	}
+	for _, s := range []string{"0", "501", "fast"} {
+		if _, err := parseMoveRate(s); err == nil {
+			t.Errorf("parseMoveRate(%q) should fail", s)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 
+	appssm "github.com/clawscli/claws/custom/ssm"
 	"github.com/clawscli/claws/internal/action"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
@@ -14,16 +15,17 @@ import (
 func init() {
 	action.Global.Register("ssm", "parameters", []action.Action{
 		{
-			Name:     "View Value",
-			Shortcut: "v",
-			Type:     action.ActionTypeExec,
-			Command:  `aws ssm get-parameter --name "${ID}" --with-decryption --query 'Parameter.Value' --output text | less -R`,
-		},
-		{
-			Name:     "View History",
-			Shortcut: "h",
-			Type:     action.ActionTypeExec,
-			Command:  `aws ssm get-parameter-history --name "${ID}" --with-decryption | less -R`,
+			Name:      "Put value",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PutParameter",
+			Confirm:   action.ConfirmSimple,
+			Compose: appssm.PutCompose(func(r dao.Resource) appssm.PutTarget {
+				if param, ok := dao.UnwrapResource(r).(*ParameterResource); ok {
+					return param.PutTarget()
+				}
+				return appssm.PutTarget{}
+			}),
 		},
 		{
 			Name:      "Delete",
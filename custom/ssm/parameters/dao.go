@@ -7,9 +7,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 
+	appssm "github.com/clawscli/claws/custom/ssm"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/ssmparam"
 )
 
 // ParameterDAO provides data access for SSM Parameter Store
@@ -166,3 +168,23 @@ func (r *ParameterResource) LastModifiedUser() string {
 func (r *ParameterResource) DataType() string {
 	return appaws.Str(r.Item.DataType)
 }
+
+// ParameterName returns the parameter's full name
+func (r *ParameterResource) ParameterName() string {
+	return appaws.Str(r.Item.Name)
+}
+
+// ComparePath returns the path the parameter is in
+func (r *ParameterResource) ComparePath() string {
+	return ssmparam.Parent(r.ParameterName())
+}
+
+// PutTarget returns the parameter for the put form
+func (r *ParameterResource) PutTarget() appssm.PutTarget {
+	return appssm.PutTarget{
+		Name:   r.ParameterName(),
+		Type:   r.Item.Type,
+		KeyID:  appaws.Str(r.Item.KeyId),
+		Exists: true,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/clawscli/claws/custom/ssm/paths"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -137,8 +138,19 @@ func (r *ParameterRenderer) RenderSummary(resource dao.Resource) []render.Summar
 	return fields
 }
 
-// Navigations returns navigation shortcuts
+// Navigations opens the value viewer, the parameter's path in the path
+// browser and the compare view for that path
 func (r *ParameterRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
-	return nil
+	param, ok := resource.(*ParameterResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "v", Label: "Value", ViewType: render.ViewTypeParameterViewer},
+		{
+			Key: "p", Label: "Path", Service: "ssm", Resource: "paths",
+			FilterField: paths.FilterPath, FilterValue: param.ComparePath(),
+		},
+		{Key: "C", Label: "Compare", ViewType: render.ViewTypeParameterCompare},
+	}
 }
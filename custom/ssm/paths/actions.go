@@ -0,0 +1,68 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "paths", []action.Action{
+		{
+			Name:      "Put value",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PutParameter",
+			Confirm:   action.ConfirmSimple,
+			Compose: appssm.PutCompose(func(r dao.Resource) appssm.PutTarget {
+				if e, ok := dao.UnwrapResource(r).(*EntryResource); ok {
+					return e.PutTarget()
+				}
+				return appssm.PutTarget{}
+			}),
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteParameter",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				e, ok := r.(*EntryResource)
+				return ok && !e.IsFolder()
+			},
+		},
+	})
+
+	action.RegisterExecutor("ssm", "paths", executePathAction)
+}
+
+func executePathAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeleteParameter":
+		return executeDeleteParameter(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeDeleteParameter(ctx context.Context, resource dao.Resource) action.ActionResult {
+	e, ok := resource.(*EntryResource)
+	if !ok || e.IsFolder() {
+		return action.InvalidResourceResult()
+	}
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	name := e.ParameterName()
+	if _, err := client.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: &name}); err != nil {
+		return action.FailResultf(err, "delete parameter %s", name)
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted parameter %s", name))
+}
@@ -0,0 +1,141 @@
+package paths
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/ssmparam"
+)
+
+// FilterPath is the filter holding the path being browsed, e.g. /app/prod.
+// Without it the root is listed.
+const FilterPath = "Path"
+
+// PathDAO lists one level of the Parameter Store hierarchy: the folders and
+// parameters directly under a path
+type PathDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewPathDAO creates a new PathDAO
+func NewPathDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PathDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "paths"),
+		client:  client,
+	}, nil
+}
+
+// List returns the folders and parameters directly under the path;
+// SecureString values are not decrypted
+func (d *PathDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	path := ssmparam.CleanPath(dao.GetFilterFromContext(ctx, FilterPath))
+	entries, err := ssmparam.ListLevel(ctx, d.client, path)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]dao.Resource, len(entries))
+	for i, e := range entries {
+		resources[i] = NewEntryResource(path, e)
+	}
+	return resources, nil
+}
+
+// Get returns a parameter by name, or a folder for a name ending in "/"
+func (d *PathDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	if strings.HasSuffix(id, "/") {
+		name := ssmparam.CleanPath(id)
+		return NewEntryResource(ssmparam.Parent(name), ssmparam.Entry{Name: name, Folder: true}), nil
+	}
+	param, err := ssmparam.Get(ctx, d.client, id, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewEntryResource(ssmparam.Parent(id), ssmparam.Entry{Name: id, Parameter: param}), nil
+}
+
+// Delete deletes a parameter. Folders are not deleted.
+func (d *PathDAO) Delete(ctx context.Context, id string) error {
+	if strings.HasSuffix(id, "/") {
+		return fmt.Errorf("%s is a folder: delete its parameters", id)
+	}
+	if _, err := d.client.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: &id}); err != nil {
+		return apperrors.Wrapf(err, "delete parameter %s", id)
+	}
+	return nil
+}
+
+// EntryResource is a folder or a parameter directly under a path
+type EntryResource struct {
+	dao.BaseResource
+	Path  string // the path listed
+	Entry ssmparam.Entry
+}
+
+// NewEntryResource creates an EntryResource. Folders are named with a
+// trailing "/", so they never clash with a parameter of the same name.
+func NewEntryResource(path string, e ssmparam.Entry) *EntryResource {
+	id, name := e.Name, ssmparam.Relative(path, e.Name)
+	if e.Folder {
+		id += "/"
+		name += "/"
+	}
+	return &EntryResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: name,
+			ARN:  appaws.Str(e.Parameter.ARN),
+			Data: e.Parameter,
+		},
+		Path:  path,
+		Entry: e,
+	}
+}
+
+// IsFolder returns whether the entry is a folder
+func (r *EntryResource) IsFolder() bool {
+	return r.Entry.Folder
+}
+
+// ParameterName returns the full name of a parameter, or "" for a folder
+func (r *EntryResource) ParameterName() string {
+	if r.Entry.Folder {
+		return ""
+	}
+	return r.Entry.Name
+}
+
+// ParameterType returns the type of a parameter
+func (r *EntryResource) ParameterType() types.ParameterType {
+	return r.Entry.Parameter.Type
+}
+
+// ComparePath returns the subtree the compare view opens with: a folder's
+// own path, or the path listed for a parameter
+func (r *EntryResource) ComparePath() string {
+	if r.Entry.Folder {
+		return r.Entry.Name
+	}
+	return r.Path
+}
+
+// PutTarget returns what the put form starts from: the parameter, or a new
+// parameter in the folder
+func (r *EntryResource) PutTarget() appssm.PutTarget {
+	if r.Entry.Folder {
+		return appssm.PutTarget{Name: r.Entry.Name + "/"}
+	}
+	return appssm.PutTarget{Name: r.Entry.Name, Type: r.Entry.Parameter.Type, Exists: true}
+}
@@ -0,0 +1,162 @@
+package paths
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ssmparam"
+)
+
+// Ensure PathRenderer implements render.Navigator
+var _ render.Navigator = (*PathRenderer)(nil)
+
+// PathRenderer renders the folders and parameters under a path
+type PathRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPathRenderer creates a new PathRenderer
+func NewPathRenderer() render.Renderer {
+	return &PathRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "paths",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "TYPE", Width: 12, Getter: getType, Priority: 1},
+				{Name: "VALUE", Width: 40, Getter: getValue, Priority: 2},
+				{Name: "VER", Width: 5, Getter: getVersion, Priority: 3},
+				{Name: "MODIFIED", Width: 12, Getter: getModified, Priority: 4},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	e, ok := r.(*EntryResource)
+	if !ok {
+		return ""
+	}
+	switch {
+	case e.IsFolder():
+		return "Folder"
+	case e.ParameterType() == types.ParameterTypeSecureString:
+		return "Secure"
+	default:
+		return string(e.ParameterType())
+	}
+}
+
+// getValue shows String values on one line and masks SecureString ones,
+// which are listed encrypted
+func getValue(r dao.Resource) string {
+	e, ok := r.(*EntryResource)
+	if !ok {
+		return ""
+	}
+	switch {
+	case e.IsFolder():
+		return ""
+	case e.ParameterType() == types.ParameterTypeSecureString:
+		return ssmparam.Mask
+	default:
+		return strings.Join(strings.Fields(appaws.Str(e.Entry.Parameter.Value)), " ")
+	}
+}
+
+func getVersion(r dao.Resource) string {
+	if e, ok := r.(*EntryResource); ok && !e.IsFolder() {
+		return fmt.Sprintf("%d", e.Entry.Parameter.Version)
+	}
+	return ""
+}
+
+func getModified(r dao.Resource) string {
+	if e, ok := r.(*EntryResource); ok && e.Entry.Parameter.LastModifiedDate != nil {
+		return render.FormatAge(*e.Entry.Parameter.LastModifiedDate)
+	}
+	return ""
+}
+
+// RenderDetail renders a parameter without its value, or a folder
+func (r *PathRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EntryResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	if e.IsFolder() {
+		d.Title("SSM Path", e.Entry.Name)
+		d.Section("Folder")
+		d.Field("Path", e.Entry.Name)
+		return d.String()
+	}
+
+	p := e.Entry.Parameter
+	d.Title("SSM Parameter", e.Entry.Name)
+	d.Section("Basic Information")
+	d.Field("Name", e.Entry.Name)
+	d.Field("ARN", e.GetARN())
+	d.Field("Type", string(p.Type))
+	d.Field("Data Type", appaws.Str(p.DataType))
+	d.Field("Version", fmt.Sprintf("%d", p.Version))
+	if p.LastModifiedDate != nil {
+		d.Field("Last Modified", p.LastModifiedDate.Format("2006-01-02 15:04:05"))
+	}
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PathRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EntryResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Path", Value: e.Path},
+		{Label: "Name", Value: e.GetName()},
+		{Label: "Type", Value: getType(e)},
+	}
+	if !e.IsFolder() {
+		fields = append(fields, render.SummaryField{Label: "Version", Value: getVersion(e)})
+	}
+	return fields
+}
+
+// Navigations opens folders and parameter values, goes up to the parent
+// path and compares a subtree with another profile
+func (r *PathRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	e, ok := resource.(*EntryResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if e.IsFolder() {
+		navs = append(navs, render.Navigation{
+			Key: "enter", Label: "Open", Service: "ssm", Resource: "paths",
+			FilterField: FilterPath, FilterValue: e.Entry.Name,
+		})
+	} else {
+		navs = append(navs, render.Navigation{
+			Key: "v", Label: "Value", ViewType: render.ViewTypeParameterViewer,
+		})
+	}
+	if e.Path != "/" {
+		navs = append(navs, render.Navigation{
+			Key: "u", Label: "Up", Service: "ssm", Resource: "paths",
+			FilterField: FilterPath, FilterValue: ssmparam.Parent(e.Path),
+		})
+	}
+	navs = append(navs, render.Navigation{
+		Key: "C", Label: "Compare", ViewType: render.ViewTypeParameterCompare,
+	})
+	return navs
+}
@@ -0,0 +1,192 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/ssmparam"
+)
+
+const (
+	fieldName = "name"
+	fieldType = "type"
+	fieldKey  = "key"
+	fieldMode = "mode"
+
+	modeCreate    = "create"
+	modeOverwrite = "overwrite"
+
+	// DefaultKey is the AWS managed key SecureString parameters use by default
+	DefaultKey = "alias/aws/ssm"
+)
+
+var parameterTypes = []string{
+	string(types.ParameterTypeString),
+	string(types.ParameterTypeStringList),
+	string(types.ParameterTypeSecureString),
+}
+
+// PutTarget is what a put starts from: an existing parameter, or a path
+// ending in "/" to create a parameter in
+type PutTarget struct {
+	Name   string
+	Type   types.ParameterType // empty for a new parameter
+	KeyID  string
+	Exists bool
+}
+
+// PutCompose puts a parameter value written in the compose view, creating
+// the parameter or overwriting it with a new version. The KMS key is only
+// used for SecureString parameters.
+func PutCompose(target func(dao.Resource) PutTarget) *action.Compose {
+	return &action.Compose{
+		Fields: func(r dao.Resource) []action.ComposeField {
+			t := target(r)
+			typ, key, mode := string(types.ParameterTypeString), DefaultKey, modeCreate
+			if t.Type != "" {
+				typ = string(t.Type)
+			}
+			if t.KeyID != "" {
+				key = t.KeyID
+			}
+			if t.Exists {
+				mode = modeOverwrite
+			}
+			return []action.ComposeField{
+				{Key: fieldName, Label: "Name", Value: t.Name, Placeholder: "/app/prod/db/host"},
+				{Key: fieldType, Label: "Type", Value: typ, Options: parameterTypes},
+				{Key: fieldKey, Label: "KMS key", Value: key, Options: keyOptions(key, nil)},
+				{Key: fieldMode, Label: "Mode", Value: mode, Options: []string{modeCreate, modeOverwrite}},
+			}
+		},
+		Body: func(dao.Resource) (string, string) {
+			return "", ".txt"
+		},
+		LoadBody: func(ctx context.Context, r dao.Resource) (string, error) {
+			return loadValue(ctx, target(r))
+		},
+		Options: func(ctx context.Context, r dao.Resource) (map[string][]string, error) {
+			aliases, err := listKeyAliases(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return map[string][]string{fieldKey: keyOptions(target(r).KeyID, aliases)}, nil
+		},
+		Send: func(ctx context.Context, r dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+			client, err := GetClient(ctx)
+			if err != nil {
+				return action.ComposeResult{}, err
+			}
+			return putParameter(ctx, client, body, values)
+		},
+	}
+}
+
+// loadValue loads the current value of a String or StringList parameter.
+// SecureString values are not decrypted into the form.
+func loadValue(ctx context.Context, t PutTarget) (string, error) {
+	if !t.Exists || t.Type == types.ParameterTypeSecureString {
+		return "", nil
+	}
+	client, err := GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	param, err := ssmparam.Get(ctx, client, t.Name, false)
+	if err != nil {
+		return "", err
+	}
+	return appaws.Str(param.Value), nil
+}
+
+// listKeyAliases lists the aliases of customer managed KMS keys
+func listKeyAliases(ctx context.Context) ([]string, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var aliases []string
+	paginator := kms.NewListAliasesPaginator(kms.NewFromConfig(cfg), &kms.ListAliasesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list KMS aliases")
+		}
+		for _, a := range page.Aliases {
+			name := appaws.Str(a.AliasName)
+			// AWS managed aliases other than the SSM one cannot encrypt parameters
+			if a.TargetKeyId == nil || strings.HasPrefix(name, "alias/aws/") {
+				continue
+			}
+			aliases = append(aliases, name)
+		}
+	}
+	return aliases, nil
+}
+
+// keyOptions offers the default key, the parameter's current key and the
+// given aliases, sorted
+func keyOptions(current string, aliases []string) []string {
+	options := []string{DefaultKey}
+	if current != "" && current != DefaultKey {
+		options = append(options, current)
+	}
+	slices.Sort(aliases)
+	for _, a := range aliases {
+		if !slices.Contains(options, a) {
+			options = append(options, a)
+		}
+	}
+	return options
+}
+
+// putter is the part of the SSM client putParameter uses
+type putter interface {
+	PutParameter(ctx context.Context, in *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+}
+
+func putParameter(ctx context.Context, client putter, body string, values map[string]string) (action.ComposeResult, error) {
+	name := strings.TrimSpace(values[fieldName])
+	if name == "" || strings.HasSuffix(name, "/") {
+		return action.ComposeResult{}, fmt.Errorf("enter the parameter name")
+	}
+	if strings.Contains(name, "/") && !strings.HasPrefix(name, "/") {
+		return action.ComposeResult{}, fmt.Errorf("hierarchical names must start with /: %s", name)
+	}
+	value := strings.TrimSpace(body)
+	if value == "" {
+		return action.ComposeResult{}, fmt.Errorf("the value is empty")
+	}
+	typ := types.ParameterType(values[fieldType])
+	if typ == "" {
+		typ = types.ParameterTypeString
+	}
+
+	input := &ssm.PutParameterInput{
+		Name:      &name,
+		Value:     &value,
+		Type:      typ,
+		Overwrite: appaws.BoolPtr(values[fieldMode] == modeOverwrite),
+	}
+	if key := values[fieldKey]; typ == types.ParameterTypeSecureString && key != "" && key != DefaultKey {
+		input.KeyId = &key
+	}
+	output, err := client.PutParameter(ctx, input)
+	if err != nil {
+		return action.ComposeResult{}, apperrors.Wrapf(err, "put parameter %s", name)
+	}
+	return action.ComposeResult{
+		Message: fmt.Sprintf("Put version %d of %s (%s)", output.Version, name, typ),
+		ID:      name,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package ssm
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+type fakePutter struct {
+	in *ssm.PutParameterInput
+}
+
+func (f *fakePutter) PutParameter(_ context.Context, in *ssm.PutParameterInput, _ ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	f.in = in
+	return &ssm.PutParameterOutput{Version: 4}, nil
+}
+
+func TestPutParameter(t *testing.T) {
+	client := &fakePutter{}
+
+	result, err := putParameter(context.Background(), client, "s3cret\n", map[string]string{
+		fieldName: " /app/prod/db/password ",
+		fieldType: "SecureString",
+		fieldKey:  "alias/app",
+		fieldMode: modeOverwrite,
+	})
+	if err != nil {
+		t.Fatalf("putParameter() error = %v", err)
+	}
+	in := client.in
+	if aws.ToString(in.Name) != "/app/prod/db/password" || aws.ToString(in.Value) != "s3cret" {
+		t.Errorf("Name, Value = %q, %q", aws.ToString(in.Name), aws.ToString(in.Value))
+	}
+	if in.Type != types.ParameterTypeSecureString || aws.ToString(in.KeyId) != "alias/app" || !aws.ToBool(in.Overwrite) {
+		t.Errorf("Type = %s, KeyId = %q, Overwrite = %v", in.Type, aws.ToString(in.KeyId), aws.ToBool(in.Overwrite))
+	}
+	if !strings.Contains(result.Message, "version 4") {
+		t.Errorf("Message = %q", result.Message)
+	}
+
+	// The key is only sent for SecureString parameters, and not for the default key
+	for _, values := range []map[string]string{
+		{fieldName: "/app/host", fieldType: "String", fieldKey: "alias/app", fieldMode: modeCreate},
+		{fieldName: "/app/token", fieldType: "SecureString", fieldKey: DefaultKey, fieldMode: modeCreate},
+	} {
+		if _, err := putParameter(context.Background(), client, "x", values); err != nil {
+			t.Fatalf("putParameter(%v) error = %v", values, err)
+		}
+		if client.in.KeyId != nil || aws.ToBool(client.in.Overwrite) {
+			t.Errorf("putParameter(%v): KeyId = %q, Overwrite = %v", values, aws.ToString(client.in.KeyId), aws.ToBool(client.in.Overwrite))
+		}
+	}
+
+	for _, bad := range []struct{ name, body string }{
+		{"/app/prod/", "x"},
+		{"app/prod/host", "x"},
+		{"/app/host", "  "},
+	} {
+		if _, err := putParameter(context.Background(), client, bad.body, map[string]string{fieldName: bad.name}); err == nil {
+			t.Errorf("putParameter(%q, %q) should fail", bad.name, bad.body)
+		}
+	}
+}
+
+func TestKeyOptions(t *testing.T) {
+	got := keyOptions("alias/legacy", []string{"alias/zeta", "alias/app", "alias/legacy"})
+	want := []string{DefaultKey, "alias/legacy", "alias/app", "alias/zeta"}
+	if !slices.Equal(got, want) {
+		t.Errorf("keyOptions() = %v, want %v", got, want)
+	}
+	if got := keyOptions("", nil); !slices.Equal(got, []string{DefaultKey}) {
+		t.Errorf("keyOptions(\"\", nil) = %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package executionhistory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+
+	sfnClient "github.com/clawscli/claws/custom/stepfunctions"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterExecutionArn is the filter holding the ARN of the execution
+const FilterExecutionArn = "ExecutionArn"
+
+// StateRunDAO lists the state visits of an execution from its history
+type StateRunDAO struct {
+	dao.BaseDAO
+	client *sfn.Client
+}
+
+// NewStateRunDAO creates a new StateRunDAO
+func NewStateRunDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &StateRunDAO{
+		BaseDAO: dao.NewBaseDAO("stepfunctions", "execution-history"),
+		client:  client,
+	}, nil
+}
+
+// List returns the states the execution entered, in order
+func (d *StateRunDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	executionArn := dao.GetFilterFromContext(ctx, FilterExecutionArn)
+	if executionArn == "" {
+		return nil, fmt.Errorf("%s required: navigate from stepfunctions/executions using 'h' key", FilterExecutionArn)
+	}
+	events, err := sfnClient.ExecutionHistory(ctx, d.client, executionArn)
+	if err != nil {
+		return nil, err
+	}
+	runs := sfnClient.StateRuns(events)
+	resources := make([]dao.Resource, len(runs))
+	for i, run := range runs {
+		resources[i] = NewStateRunResource(executionArn, run)
+	}
+	return resources, nil
+}
+
+// Get is not supported: state visits are only listed per execution
+func (d *StateRunDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get state visit %s: visits can only be listed by execution", id)
+}
+
+// Delete is not supported: execution history is read-only
+func (d *StateRunDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete state visit %s: execution history is read-only", id)
+}
+
+// StateRunResource is one visit of a state in an execution
+type StateRunResource struct {
+	dao.BaseResource
+	ExecutionArn string
+	Run          sfnClient.StateRun
+}
+
+// NewStateRunResource creates a new StateRunResource, identified by the ID
+// of its StateEntered event
+func NewStateRunResource(executionArn string, run sfnClient.StateRun) *StateRunResource {
+	return &StateRunResource{
+		BaseResource: dao.BaseResource{
+			ID:   strconv.FormatInt(run.EventID, 10),
+			Name: run.Name,
+			Data: run,
+		},
+		ExecutionArn: executionArn,
+		Run:          run,
+	}
+}
+
+// ExecutionName returns the name of the execution the state ran in
+func (r *StateRunResource) ExecutionName() string {
+	return appaws.ExtractResourceName(r.ExecutionArn)
+}
@@ -0,0 +1,20 @@
+package executionhistory
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("stepfunctions", "execution-history", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewStateRunDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewStateRunRenderer()
+		},
+	})
+}
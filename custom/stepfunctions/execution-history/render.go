@@ -0,0 +1,181 @@
+package executionhistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	sfnClient "github.com/clawscli/claws/custom/stepfunctions"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// StateRunRenderer renders the state visits of an execution
+type StateRunRenderer struct {
+	render.BaseRenderer
+}
+
+// NewStateRunRenderer creates a new StateRunRenderer
+func NewStateRunRenderer() render.Renderer {
+	return &StateRunRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "stepfunctions",
+			Resource: "execution-history",
+			Cols: []render.Column{
+				{Name: "STATE", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "TYPE", Width: 9, Getter: getType, Priority: 2},
+				{Name: "STATUS", Width: 10, Getter: getStatus, Priority: 0},
+				{Name: "ENTERED", Width: 12, Getter: getEntered, Priority: 3},
+				{Name: "DURATION", Width: 10, Getter: getDuration, Priority: 1},
+				{Name: "RETRIES", Width: 8, Getter: getRetries, Priority: 4},
+				{Name: "ERROR", Width: 40, Getter: getError, Priority: 1},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok {
+		return s.Run.Type
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok {
+		return s.Run.Status
+	}
+	return ""
+}
+
+func getEntered(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok {
+		return s.Run.Entered.Format("15:04:05.000")
+	}
+	return ""
+}
+
+func getDuration(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok {
+		return formatDuration(s.Run.Duration())
+	}
+	return ""
+}
+
+func getRetries(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok && s.Run.Retries() > 0 {
+		return strconv.Itoa(s.Run.Retries())
+	}
+	return ""
+}
+
+func getError(r dao.Resource) string {
+	if s, ok := r.(*StateRunResource); ok {
+		return s.Run.Error
+	}
+	return ""
+}
+
+// formatDuration shows milliseconds for short states, which most are
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+	}
+	return render.FormatDuration(d)
+}
+
+// statusColorer colors state visit statuses
+func statusColorer(status string) render.Style {
+	switch status {
+	case sfnClient.RunSucceeded:
+		return ui.SuccessStyle()
+	case sfnClient.RunCaught, sfnClient.RunAborted:
+		return ui.WarningStyle()
+	case sfnClient.RunFailed, sfnClient.RunTimedOut:
+		return ui.DangerStyle()
+	case sfnClient.RunRunning:
+		return ui.PendingStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
+
+// RenderDetail renders the state's timing, error, input and output
+func (r *StateRunRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*StateRunResource)
+	if !ok {
+		return ""
+	}
+	run := s.Run
+
+	d := render.NewDetailBuilder()
+	d.Title("State", run.Name)
+
+	d.Section("State")
+	d.Field("Name", run.Name)
+	d.Field("Type", run.Type)
+	d.FieldStyled("Status", run.Status, statusColorer(run.Status))
+	d.Field("Execution", s.ExecutionName())
+	if run.From != "" {
+		d.Field("Entered From", run.From)
+	}
+
+	d.Section("Timing")
+	d.Field("Entered", run.Entered.Format("2006-01-02 15:04:05.000"))
+	if !run.Exited.IsZero() {
+		d.Field("Exited", run.Exited.Format("2006-01-02 15:04:05.000"))
+	}
+	d.Field("Duration", formatDuration(run.Duration()))
+	if run.Attempts > 0 {
+		d.Field("Attempts", strconv.Itoa(run.Attempts))
+	}
+
+	if run.Error != "" {
+		d.Section("Error")
+		d.FieldStyled("Error", run.Error, statusColorer(run.Status))
+		if run.Status == sfnClient.RunSucceeded || run.Status == sfnClient.RunCaught {
+			d.DimIndent("Handled by a Retry or Catch")
+		}
+		if run.Cause != "" {
+			d.Line(prettyJSON(run.Cause))
+		}
+	}
+
+	d.Section("Input")
+	d.Line(prettyJSON(run.Input))
+	if !run.Exited.IsZero() && run.Output != "" {
+		d.Section("Output")
+		d.Line(prettyJSON(run.Output))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *StateRunRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*StateRunResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "State", Value: s.Run.Name},
+		{Label: "Status", Value: s.Run.Status, Style: statusColorer(s.Run.Status)},
+		{Label: "Duration", Value: formatDuration(s.Run.Duration())},
+		{Label: "Execution", Value: s.ExecutionName()},
+	}
+	if s.Run.Error != "" {
+		fields = append(fields, render.SummaryField{Label: "Error", Value: s.Run.Error})
+	}
+	return fields
+}
+
+// prettyJSON indents JSON, such as state inputs and error causes
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
@@ -8,12 +8,49 @@ import (
 
 	sfnClient "github.com/clawscli/claws/custom/stepfunctions"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	navmsg "github.com/clawscli/claws/internal/msg"
 )
 
 func init() {
+	// Starting from an execution starts its state machine with the same input
+	startCompose := sfnClient.StartCompose(func(r dao.Resource) string {
+		if exec, ok := dao.UnwrapResource(r).(*ExecutionResource); ok {
+			return exec.StateMachineARN()
+		}
+		return ""
+	})
+	startCompose.LoadBody = loadExecutionInput
+
 	// Register actions for Step Functions executions
 	action.Global.Register("stepfunctions", "executions", []action.Action{
+		{
+			Name:      "State graph",
+			Shortcut:  "g",
+			Type:      action.ActionTypeAPI,
+			Operation: "ShowStateGraph",
+		},
+		{
+			Name:      "Start Execution",
+			Shortcut:  "x",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartExecution",
+			Confirm:   action.ConfirmSimple,
+			Compose:   startCompose,
+		},
+		{
+			Name:      "Redrive",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RedriveExecution",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				exec, ok := r.(*ExecutionResource)
+				return ok && exec.Redrivable()
+			},
+		},
 		{
 			Name:      "Stop",
 			Shortcut:  "S",
@@ -30,6 +67,10 @@ func init() {
 // executeExecutionAction executes an action on a Step Functions execution
 func executeExecutionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
+	case "ShowStateGraph":
+		return executeShowStateGraph(ctx, resource)
+	case "RedriveExecution":
+		return executeRedriveExecution(ctx, resource)
 	case "StopExecution":
 		return executeStopExecution(ctx, resource)
 	default:
@@ -37,6 +78,85 @@ func executeExecutionAction(ctx context.Context, act action.Action, resource dao
 	}
 }
 
+// loadExecutionInput loads the input of an execution, which list items lack
+func loadExecutionInput(ctx context.Context, resource dao.Resource) (string, error) {
+	exec, ok := dao.UnwrapResource(resource).(*ExecutionResource)
+	if !ok {
+		return "", fmt.Errorf("invalid resource type")
+	}
+	if exec.Input() != "" {
+		return prettyJSON(exec.Input()), nil
+	}
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	arn := exec.ARN()
+	output, err := client.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: &arn})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "describe execution %s", exec.GetName())
+	}
+	return prettyJSON(appaws.Str(output.Input)), nil
+}
+
+// executeShowStateGraph draws the definition the execution ran with and
+// the path it took
+func executeShowStateGraph(ctx context.Context, resource dao.Resource) action.ActionResult {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := exec.ARN()
+	sm, err := client.DescribeStateMachineForExecution(ctx, &sfn.DescribeStateMachineForExecutionInput{ExecutionArn: &arn})
+	if err != nil {
+		return action.FailResultf(err, "describe state machine for execution %s", exec.GetName())
+	}
+	events, err := sfnClient.ExecutionHistory(ctx, client, arn)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	runs := sfnClient.StateRuns(events)
+	graph, err := sfnClient.RenderGraph(appaws.Str(sm.Definition), runs)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	header := fmt.Sprintf("Execution: %s (%s)\nState machine: %s\n", exec.GetName(), exec.Status(), appaws.Str(sm.Name))
+	if failed, ok := sfnClient.FailedRun(runs); ok {
+		header += fmt.Sprintf("Failed in: %s (%s)\n", failed.Name, failed.Error)
+	}
+	return action.SuccessResultWithFollowUp(
+		fmt.Sprintf("Loaded %d state(s) of %s", len(runs), exec.GetName()),
+		navmsg.ShowTextMsg{Title: exec.GetName() + ": state graph", Content: header + graph},
+	)
+}
+
+// executeRedriveExecution restarts a failed execution from its unsuccessful
+// states, reusing the results of the states that succeeded
+func executeRedriveExecution(ctx context.Context, resource dao.Resource) action.ActionResult {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := exec.ARN()
+	if _, err := client.RedriveExecution(ctx, &sfn.RedriveExecutionInput{ExecutionArn: &arn}); err != nil {
+		return action.FailResultf(err, "redrive execution %s", exec.GetName())
+	}
+	return action.SuccessResult(fmt.Sprintf("Redrive started for execution %s", exec.GetName()))
+}
+
 func executeStopExecution(ctx context.Context, resource dao.Resource) action.ActionResult {
 	exec, ok := resource.(*ExecutionResource)
 	if !ok {
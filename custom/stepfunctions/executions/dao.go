@@ -1,9 +1,12 @@
 package executions
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
@@ -13,6 +16,9 @@ import (
 	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
+// redriveWindow is how long after it stopped an execution can be redriven
+const redriveWindow = 14 * 24 * time.Hour
+
 // ExecutionDAO provides data access for Step Functions executions
 type ExecutionDAO struct {
 	dao.BaseDAO
@@ -228,3 +234,33 @@ func (r *ExecutionResource) Cause() string {
 	}
 	return ""
 }
+
+// Redrivable returns whether the execution can be redriven: it must have
+// failed, timed out or been aborted within the redrive window. Express
+// executions and some failures are not redrivable, which only the
+// execution's details tell.
+func (r *ExecutionResource) Redrivable() bool {
+	switch r.Item.Status {
+	case types.ExecutionStatusFailed, types.ExecutionStatusAborted, types.ExecutionStatusTimedOut:
+	default:
+		return false
+	}
+	if r.Detail != nil && r.Detail.RedriveStatus == types.ExecutionRedriveStatusNotRedrivable {
+		return false
+	}
+	return r.Item.StopDate == nil || time.Since(*r.Item.StopDate) < redriveWindow
+}
+
+// RedriveCount returns how often the execution was redriven
+func (r *ExecutionResource) RedriveCount() int32 {
+	return appaws.Int32(r.Item.RedriveCount)
+}
+
+// prettyJSON indents JSON, returning other text as is
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
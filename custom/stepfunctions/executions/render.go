@@ -1,6 +1,7 @@
 package executions
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/clawscli/claws/internal/dao"
@@ -124,6 +125,19 @@ func (r *ExecutionRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Running For", render.FormatAge(*er.Item.StartDate))
 	}
 
+	// Redrive
+	if er.RedriveCount() > 0 || (er.Detail != nil && er.Detail.RedriveStatus != "") {
+		d.Section("Redrive")
+		if er.Detail != nil {
+			d.Field("Status", string(er.Detail.RedriveStatus))
+			d.FieldIf("Reason", er.Detail.RedriveStatusReason)
+		}
+		d.Field("Redrive Count", fmt.Sprintf("%d", er.RedriveCount()))
+		if er.Item.RedriveDate != nil {
+			d.Field("Last Redrive", er.Item.RedriveDate.Format(time.RFC3339))
+		}
+	}
+
 	// Input/Output
 	if er.Input() != "" {
 		d.Section("Input")
@@ -198,6 +212,12 @@ func (r *ExecutionRenderer) Navigations(resource dao.Resource) []render.Navigati
 
 	var navs []render.Navigation
 
+	// History navigation
+	navs = append(navs, render.Navigation{
+		Key: "h", Label: "History", Service: "stepfunctions", Resource: "execution-history",
+		FilterField: "ExecutionArn", FilterValue: er.ARN(),
+	})
+
 	// State Machine navigation
 	navs = append(navs, render.Navigation{
 		Key: "s", Label: "State Machine", Service: "stepfunctions", Resource: "state-machines",
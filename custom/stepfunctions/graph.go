@@ -0,0 +1,279 @@
+package stepfunctions
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+const (
+	// graphIndent indents the states of Parallel branches and Map iterations
+	graphIndent = "    "
+	// maxPathStates is how many visits the path summary lists
+	maxPathStates = 40
+	// maxCauseLen truncates error causes in the graph
+	maxCauseLen = 200
+)
+
+// definition is the part of an Amazon States Language definition the graph uses
+type definition struct {
+	StartAt string              `json:"StartAt"`
+	States  map[string]stateDef `json:"States"`
+}
+
+type stateDef struct {
+	Type    string `json:"Type"`
+	Next    string `json:"Next"`
+	End     bool   `json:"End"`
+	Default string `json:"Default"`
+	Choices []struct {
+		Next string `json:"Next"`
+	} `json:"Choices"`
+	Catch []struct {
+		ErrorEquals []string `json:"ErrorEquals"`
+		Next        string   `json:"Next"`
+	} `json:"Catch"`
+	Branches      []definition `json:"Branches"`
+	Iterator      *definition  `json:"Iterator"`
+	ItemProcessor *definition  `json:"ItemProcessor"`
+}
+
+// transition is an edge of the graph
+type transition struct {
+	label string
+	to    string
+}
+
+// edge is a transition taken by an execution
+type edge struct {
+	from, to string
+}
+
+func (s stateDef) transitions() []transition {
+	var out []transition
+	if s.Next != "" {
+		out = append(out, transition{"next", s.Next})
+	}
+	for _, c := range s.Choices {
+		out = append(out, transition{"choice", c.Next})
+	}
+	if s.Default != "" {
+		out = append(out, transition{"default", s.Default})
+	}
+	for _, c := range s.Catch {
+		out = append(out, transition{"catch " + strings.Join(c.ErrorEquals, ","), c.Next})
+	}
+	return out
+}
+
+// stateVisits sums up the visits of one state
+type stateVisits struct {
+	count    int
+	status   string // status of the last visit
+	duration time.Duration
+	err      string
+	cause    string
+}
+
+// RenderGraph draws a state machine definition as text, one box per state
+// in the order states are reached from StartAt. States the execution
+// visited have double borders and a status mark, taken transitions have
+// double arrows, and the state the execution failed in shows its error.
+func RenderGraph(definitionJSON string, runs []StateRun) (string, error) {
+	var def definition
+	if err := json.Unmarshal([]byte(definitionJSON), &def); err != nil {
+		return "", fmt.Errorf("parse state machine definition: %w", err)
+	}
+
+	visits := make(map[string]*stateVisits)
+	taken := make(map[edge]bool)
+	for _, r := range runs {
+		v := visits[r.Name]
+		if v == nil {
+			v = &stateVisits{}
+			visits[r.Name] = v
+		}
+		v.count++
+		v.status = r.Status
+		v.duration += r.Duration()
+		if r.Error != "" {
+			v.err, v.cause = r.Error, r.Cause
+		}
+		if r.From != "" {
+			taken[edge{r.From, r.Name}] = true
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("Path: " + pathSummary(runs) + "\n")
+	b.WriteString("Legend: ╔═╗ visited  ┌─┐ not visited  ══▶ taken  ──▷ not taken\n")
+	b.WriteString("        ✓ succeeded  ✗ failed  ↪ caught  … running\n\n")
+	g := graph{b: &b, visits: visits, taken: taken}
+	g.draw(def, "")
+	return b.String(), nil
+}
+
+type graph struct {
+	b      *strings.Builder
+	visits map[string]*stateVisits
+	taken  map[edge]bool
+}
+
+func (g graph) draw(def definition, indent string) {
+	entered := g.visits[def.StartAt] != nil
+	g.line(indent, arrow(entered)+" "+def.StartAt+" (start)")
+
+	order := stateOrder(def)
+	width := 0
+	for _, name := range order {
+		width = max(width, len([]rune(g.label(name, def.States[name]))))
+	}
+	for _, name := range order {
+		s := def.States[name]
+		g.box(indent, g.label(name, s), width, g.visits[name] != nil)
+		if v := g.visits[name]; v != nil && v.err != "" && (v.status == RunFailed || v.status == RunTimedOut || v.status == RunCaught) {
+			g.line(indent, "  "+statusMark(v.status)+" "+v.err+": "+truncate(oneLine(v.cause), maxCauseLen))
+		}
+		for i, branch := range s.Branches {
+			g.line(indent, fmt.Sprintf("  branch %d of %d:", i+1, len(s.Branches)))
+			g.draw(branch, indent+graphIndent)
+		}
+		if it := s.iterator(); it != nil {
+			g.line(indent, "  each item:")
+			g.draw(*it, indent+graphIndent)
+		}
+		for _, t := range s.transitions() {
+			g.line(indent, "  "+arrow(g.taken[edge{name, t.to}])+" "+t.to+" ("+t.label+")")
+		}
+		if s.End || s.Type == "Succeed" || s.Type == "Fail" {
+			g.line(indent, "  ■ end")
+		}
+		g.b.WriteString("\n")
+	}
+}
+
+func (s stateDef) iterator() *definition {
+	if s.ItemProcessor != nil {
+		return s.ItemProcessor
+	}
+	return s.Iterator
+}
+
+// label is the text inside a state's box
+func (g graph) label(name string, s stateDef) string {
+	v := g.visits[name]
+	if v == nil {
+		return fmt.Sprintf("  %s  %s", name, s.Type)
+	}
+	label := fmt.Sprintf("%s %s  %s  %s", statusMark(v.status), name, s.Type, render.FormatDuration(v.duration))
+	if v.count > 1 {
+		label += fmt.Sprintf("  ×%d", v.count)
+	}
+	return label
+}
+
+func (g graph) box(indent, label string, width int, visited bool) {
+	pad := strings.Repeat(" ", width-len([]rune(label)))
+	if visited {
+		g.line(indent, "╔"+strings.Repeat("═", width+2)+"╗")
+		g.line(indent, "║ "+label+pad+" ║")
+		g.line(indent, "╚"+strings.Repeat("═", width+2)+"╝")
+		return
+	}
+	g.line(indent, "┌"+strings.Repeat("─", width+2)+"┐")
+	g.line(indent, "│ "+label+pad+" │")
+	g.line(indent, "└"+strings.Repeat("─", width+2)+"┘")
+}
+
+func (g graph) line(indent, s string) {
+	g.b.WriteString(indent + s + "\n")
+}
+
+func arrow(taken bool) string {
+	if taken {
+		return "══▶"
+	}
+	return "──▷"
+}
+
+func statusMark(status string) string {
+	switch status {
+	case RunSucceeded:
+		return "✓"
+	case RunCaught:
+		return "↪"
+	case RunRunning:
+		return "…"
+	default:
+		return "✗"
+	}
+}
+
+// stateOrder lists states depth-first from StartAt, following transitions
+// in order, then any states that cannot be reached, by name
+func stateOrder(def definition) []string {
+	var order []string
+	seen := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		s, ok := def.States[name]
+		if !ok || seen[name] {
+			return
+		}
+		seen[name] = true
+		order = append(order, name)
+		for _, t := range s.transitions() {
+			visit(t.to)
+		}
+	}
+	visit(def.StartAt)
+
+	var rest []string
+	for name := range def.States {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	slices.Sort(rest)
+	return append(order, rest...)
+}
+
+// pathSummary lists the visited states in order, collapsing repeats
+func pathSummary(runs []StateRun) string {
+	if len(runs) == 0 {
+		return "(no states entered)"
+	}
+	var parts []string
+	for i := 0; i < len(runs); {
+		j := i
+		for j < len(runs) && runs[j].Name == runs[i].Name {
+			j++
+		}
+		part := runs[i].Name
+		if j-i > 1 {
+			part += fmt.Sprintf(" ×%d", j-i)
+		}
+		parts = append(parts, part)
+		i = j
+	}
+	if len(parts) > maxPathStates {
+		parts = append(parts[:maxPathStates], fmt.Sprintf("… %d more", len(parts)-maxPathStates))
+	}
+	return strings.Join(parts, " → ")
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "..."
+}
@@ -0,0 +1,228 @@
+package stepfunctions
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Status of a StateRun
+const (
+	RunRunning   = "RUNNING"
+	RunSucceeded = "SUCCEEDED"
+	RunCaught    = "CAUGHT" // failed, and a Catch moved on to another state
+	RunFailed    = "FAILED"
+	RunAborted   = "ABORTED"
+	RunTimedOut  = "TIMED_OUT"
+)
+
+// HistoryClient is the part of the Step Functions client ExecutionHistory uses
+type HistoryClient interface {
+	GetExecutionHistory(ctx context.Context, in *sfn.GetExecutionHistoryInput, optFns ...func(*sfn.Options)) (*sfn.GetExecutionHistoryOutput, error)
+}
+
+// ExecutionHistory returns all events of an execution, oldest first, with
+// state inputs and outputs
+func ExecutionHistory(ctx context.Context, client HistoryClient, executionArn string) ([]types.HistoryEvent, error) {
+	var events []types.HistoryEvent
+	paginator := sfn.NewGetExecutionHistoryPaginator(client, &sfn.GetExecutionHistoryInput{
+		ExecutionArn:         &executionArn,
+		IncludeExecutionData: appaws.BoolPtr(true),
+		MaxResults:           1000,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "get execution history of %s", appaws.ExtractResourceName(executionArn))
+		}
+		events = append(events, page.Events...)
+	}
+	return events, nil
+}
+
+// StateRun is one visit of a state: from its StateEntered event to its
+// StateExited event, or to the end of the execution
+type StateRun struct {
+	EventID  int64  // ID of the StateEntered event
+	Name     string // state name
+	Type     string // e.g. "Task", "Choice", "Map"
+	From     string // state exited right before this one was entered, "" for the first
+	Entered  time.Time
+	Exited   time.Time // zero while running
+	Input    string
+	Output   string
+	Status   string
+	Error    string // last error of the state, even if a retry or Catch handled it
+	Cause    string
+	Attempts int // task attempts, more than 1 after retries
+
+	failing bool // the last attempt failed
+}
+
+// Duration returns how long the state ran, so far if it still runs
+func (r StateRun) Duration() time.Duration {
+	if r.Exited.IsZero() {
+		return time.Since(r.Entered)
+	}
+	return r.Exited.Sub(r.Entered)
+}
+
+// Retries returns how often the state's task was retried
+func (r StateRun) Retries() int {
+	return max(r.Attempts-1, 0)
+}
+
+// StateRuns turns execution history events into state visits, in the order
+// the states were entered. Events are tied to a visit through their
+// PreviousEventId chain; exits are matched by state name, which is unique
+// within a state machine (concurrent Map iterations match the latest visit).
+func StateRuns(events []types.HistoryEvent) []StateRun {
+	var runs []StateRun
+	runOf := make(map[int64]int)     // event ID -> visit
+	open := make(map[string]int)     // state name -> latest visit not exited
+	exited := make(map[int64]string) // StateExited event ID -> state name
+
+	for _, e := range events {
+		ts := appaws.Time(e.Timestamp)
+		switch {
+		case e.StateEnteredEventDetails != nil:
+			d := e.StateEnteredEventDetails
+			name := appaws.Str(d.Name)
+			runs = append(runs, StateRun{
+				EventID: e.Id,
+				Name:    name,
+				Type:    strings.TrimSuffix(string(e.Type), "StateEntered"),
+				From:    exited[e.PreviousEventId],
+				Entered: ts,
+				Input:   appaws.Str(d.Input),
+				Status:  RunRunning,
+			})
+			open[name] = len(runs) - 1
+			runOf[e.Id] = len(runs) - 1
+
+		case e.StateExitedEventDetails != nil:
+			d := e.StateExitedEventDetails
+			name := appaws.Str(d.Name)
+			i, ok := open[name]
+			if !ok {
+				continue
+			}
+			delete(open, name)
+			runOf[e.Id] = i
+			exited[e.Id] = name
+			r := &runs[i]
+			r.Exited = ts
+			r.Output = appaws.Str(d.Output)
+			r.Status = RunSucceeded
+			if r.failing {
+				r.Status = RunCaught
+			}
+
+		default:
+			i, ok := runOf[e.PreviousEventId]
+			if ok {
+				runOf[e.Id] = i
+				if isScheduled(e) {
+					runs[i].Attempts++
+					runs[i].failing = false
+				}
+			}
+			if code, cause, failed := failure(e); failed && ok {
+				r := &runs[i]
+				// The execution's failure repeats the state's, unless the
+				// state is a Fail state, which has no failure of its own
+				if r.Error == "" || !isExecutionEnd(e) {
+					r.Error, r.Cause = code, cause
+				}
+				r.failing = true
+			}
+			if status := executionEndStatus(e); status != "" {
+				for name, j := range open {
+					runs[j].Status = status
+					runs[j].Exited = ts
+					delete(open, name)
+				}
+			}
+		}
+	}
+	return runs
+}
+
+// FailedRun returns the visit the execution failed in, if any
+func FailedRun(runs []StateRun) (StateRun, bool) {
+	for i := len(runs) - 1; i >= 0; i-- {
+		if runs[i].Status == RunFailed || runs[i].Status == RunTimedOut {
+			return runs[i], true
+		}
+	}
+	return StateRun{}, false
+}
+
+func isScheduled(e types.HistoryEvent) bool {
+	return e.TaskScheduledEventDetails != nil ||
+		e.LambdaFunctionScheduledEventDetails != nil ||
+		e.ActivityScheduledEventDetails != nil
+}
+
+func isExecutionEnd(e types.HistoryEvent) bool {
+	return executionEndStatus(e) != ""
+}
+
+// executionEndStatus returns the status open visits end with when the
+// execution ends unsuccessfully
+func executionEndStatus(e types.HistoryEvent) string {
+	switch {
+	case e.ExecutionFailedEventDetails != nil:
+		return RunFailed
+	case e.ExecutionTimedOutEventDetails != nil:
+		return RunTimedOut
+	case e.ExecutionAbortedEventDetails != nil:
+		return RunAborted
+	}
+	return ""
+}
+
+// failure returns the error and cause of a failure event
+func failure(e types.HistoryEvent) (code, cause string, ok bool) {
+	switch {
+	case e.TaskFailedEventDetails != nil:
+		return appaws.Str(e.TaskFailedEventDetails.Error), appaws.Str(e.TaskFailedEventDetails.Cause), true
+	case e.TaskTimedOutEventDetails != nil:
+		return appaws.Str(e.TaskTimedOutEventDetails.Error), appaws.Str(e.TaskTimedOutEventDetails.Cause), true
+	case e.TaskStartFailedEventDetails != nil:
+		return appaws.Str(e.TaskStartFailedEventDetails.Error), appaws.Str(e.TaskStartFailedEventDetails.Cause), true
+	case e.TaskSubmitFailedEventDetails != nil:
+		return appaws.Str(e.TaskSubmitFailedEventDetails.Error), appaws.Str(e.TaskSubmitFailedEventDetails.Cause), true
+	case e.LambdaFunctionFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionFailedEventDetails.Error), appaws.Str(e.LambdaFunctionFailedEventDetails.Cause), true
+	case e.LambdaFunctionTimedOutEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionTimedOutEventDetails.Error), appaws.Str(e.LambdaFunctionTimedOutEventDetails.Cause), true
+	case e.LambdaFunctionStartFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionStartFailedEventDetails.Error), appaws.Str(e.LambdaFunctionStartFailedEventDetails.Cause), true
+	case e.LambdaFunctionScheduleFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionScheduleFailedEventDetails.Error), appaws.Str(e.LambdaFunctionScheduleFailedEventDetails.Cause), true
+	case e.ActivityFailedEventDetails != nil:
+		return appaws.Str(e.ActivityFailedEventDetails.Error), appaws.Str(e.ActivityFailedEventDetails.Cause), true
+	case e.ActivityTimedOutEventDetails != nil:
+		return appaws.Str(e.ActivityTimedOutEventDetails.Error), appaws.Str(e.ActivityTimedOutEventDetails.Cause), true
+	case e.ActivityScheduleFailedEventDetails != nil:
+		return appaws.Str(e.ActivityScheduleFailedEventDetails.Error), appaws.Str(e.ActivityScheduleFailedEventDetails.Cause), true
+	case e.MapRunFailedEventDetails != nil:
+		return appaws.Str(e.MapRunFailedEventDetails.Error), appaws.Str(e.MapRunFailedEventDetails.Cause), true
+	case e.EvaluationFailedEventDetails != nil:
+		return appaws.Str(e.EvaluationFailedEventDetails.Error), appaws.Str(e.EvaluationFailedEventDetails.Cause), true
+	case e.ExecutionFailedEventDetails != nil:
+		return appaws.Str(e.ExecutionFailedEventDetails.Error), appaws.Str(e.ExecutionFailedEventDetails.Cause), true
+	case e.ExecutionTimedOutEventDetails != nil:
+		return appaws.Str(e.ExecutionTimedOutEventDetails.Error), appaws.Str(e.ExecutionTimedOutEventDetails.Cause), true
+	case e.ExecutionAbortedEventDetails != nil:
+		return appaws.Str(e.ExecutionAbortedEventDetails.Error), appaws.Str(e.ExecutionAbortedEventDetails.Cause), true
+	}
+	return "", "", false
+}
@@ -0,0 +1,240 @@
+package stepfunctions
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+var testStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// historyBuilder numbers events and chains them through PreviousEventId
+type historyBuilder struct {
+	events []types.HistoryEvent
+}
+
+// add appends an event following prev, one second after the previous event
+func (h *historyBuilder) add(prev int64, typ types.HistoryEventType, fill func(*types.HistoryEvent)) int64 {
+	id := int64(len(h.events) + 1)
+	e := types.HistoryEvent{
+		Id:              id,
+		PreviousEventId: prev,
+		Type:            typ,
+		Timestamp:       aws.Time(testStart.Add(time.Duration(id) * time.Second)),
+	}
+	if fill != nil {
+		fill(&e)
+	}
+	h.events = append(h.events, e)
+	return id
+}
+
+func (h *historyBuilder) enter(prev int64, typ types.HistoryEventType, name, input string) int64 {
+	return h.add(prev, typ, func(e *types.HistoryEvent) {
+		e.StateEnteredEventDetails = &types.StateEnteredEventDetails{Name: aws.String(name), Input: aws.String(input)}
+	})
+}
+
+func (h *historyBuilder) exit(prev int64, typ types.HistoryEventType, name, output string) int64 {
+	return h.add(prev, typ, func(e *types.HistoryEvent) {
+		e.StateExitedEventDetails = &types.StateExitedEventDetails{Name: aws.String(name), Output: aws.String(output)}
+	})
+}
+
+func (h *historyBuilder) schedule(prev int64) int64 {
+	return h.add(prev, types.HistoryEventTypeTaskScheduled, func(e *types.HistoryEvent) {
+		e.TaskScheduledEventDetails = &types.TaskScheduledEventDetails{}
+	})
+}
+
+func (h *historyBuilder) taskFailed(prev int64, code, cause string) int64 {
+	return h.add(prev, types.HistoryEventTypeTaskFailed, func(e *types.HistoryEvent) {
+		e.TaskFailedEventDetails = &types.TaskFailedEventDetails{Error: aws.String(code), Cause: aws.String(cause)}
+	})
+}
+
+func (h *historyBuilder) executionFailed(prev int64, code, cause string) int64 {
+	return h.add(prev, types.HistoryEventTypeExecutionFailed, func(e *types.HistoryEvent) {
+		e.ExecutionFailedEventDetails = &types.ExecutionFailedEventDetails{Error: aws.String(code), Cause: aws.String(cause)}
+	})
+}
+
+// failedHistory is Validate (retried once) -> Charge (fails, caught) ->
+// Refund (fails, ends the execution)
+func failedHistory() []types.HistoryEvent {
+	h := &historyBuilder{}
+	start := h.add(0, types.HistoryEventTypeExecutionStarted, nil)
+
+	validate := h.enter(start, types.HistoryEventTypeTaskStateEntered, "Validate", `{"order":1}`)
+	failed := h.taskFailed(h.schedule(validate), "Lambda.ServiceException", "throttled")
+	validated := h.exit(h.schedule(failed), types.HistoryEventTypeTaskStateExited, "Validate", `{"valid":true}`)
+
+	charge := h.enter(validated, types.HistoryEventTypeTaskStateEntered, "Charge", `{"valid":true}`)
+	chargeFailed := h.taskFailed(h.schedule(charge), "CardDeclined", "insufficient funds")
+	charged := h.exit(chargeFailed, types.HistoryEventTypeTaskStateExited, "Charge", `{"error":"CardDeclined"}`)
+
+	refund := h.enter(charged, types.HistoryEventTypeTaskStateEntered, "Refund", `{}`)
+	refundFailed := h.taskFailed(h.schedule(refund), "States.TaskFailed", `{"errorMessage":"boom"}`)
+	h.executionFailed(refundFailed, "States.TaskFailed", `{"errorMessage":"boom"}`)
+	return h.events
+}
+
+func TestStateRuns(t *testing.T) {
+	runs := StateRuns(failedHistory())
+	if len(runs) != 3 {
+		t.Fatalf("runs = %d, want 3: %+v", len(runs), runs)
+	}
+
+	validate, charge, refund := runs[0], runs[1], runs[2]
+	if validate.Name != "Validate" || validate.Type != "Task" || validate.From != "" {
+		t.Errorf("validate = %+v", validate)
+	}
+	if validate.Status != RunSucceeded || validate.Retries() != 1 || validate.Error != "Lambda.ServiceException" {
+		t.Errorf("retried state should succeed and keep its last error: %+v", validate)
+	}
+	if validate.Input != `{"order":1}` || validate.Output != `{"valid":true}` {
+		t.Errorf("validate input/output = %q, %q", validate.Input, validate.Output)
+	}
+	if validate.Duration() != 4*time.Second {
+		t.Errorf("validate duration = %v, want 4s", validate.Duration())
+	}
+
+	if charge.Status != RunCaught || charge.From != "Validate" || charge.Cause != "insufficient funds" {
+		t.Errorf("caught state = %+v", charge)
+	}
+
+	if refund.Status != RunFailed || refund.Exited.IsZero() || refund.Error != "States.TaskFailed" {
+		t.Errorf("failing state = %+v", refund)
+	}
+	failed, ok := FailedRun(runs)
+	if !ok || failed.Name != "Refund" {
+		t.Errorf("FailedRun = %+v, %v", failed, ok)
+	}
+}
+
+func TestStateRuns_FailState(t *testing.T) {
+	h := &historyBuilder{}
+	start := h.add(0, types.HistoryEventTypeExecutionStarted, nil)
+	choice := h.enter(start, types.HistoryEventTypeChoiceStateEntered, "Check", `{}`)
+	checked := h.exit(choice, types.HistoryEventTypeChoiceStateExited, "Check", `{}`)
+	fail := h.enter(checked, types.HistoryEventTypeFailStateEntered, "Reject", `{}`)
+	h.executionFailed(fail, "Rejected", "order is invalid")
+
+	runs := StateRuns(h.events)
+	if len(runs) != 2 {
+		t.Fatalf("runs = %d, want 2", len(runs))
+	}
+	if runs[1].Type != "Fail" || runs[1].Status != RunFailed || runs[1].Error != "Rejected" || runs[1].Cause != "order is invalid" {
+		t.Errorf("Fail state should take the execution's error: %+v", runs[1])
+	}
+	if _, ok := FailedRun(runs[:1]); ok {
+		t.Error("FailedRun should not report a succeeded state")
+	}
+}
+
+func TestRenderGraph(t *testing.T) {
+	definition := `{
+		"StartAt": "Validate",
+		"States": {
+			"Validate": {"Type": "Task", "Next": "Charge"},
+			"Charge": {"Type": "Task", "Next": "Ship", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Refund"}]},
+			"Ship": {"Type": "Task", "End": true},
+			"Refund": {"Type": "Task", "End": true},
+			"Orphan": {"Type": "Pass", "End": true}
+		}
+	}`
+	graph, err := RenderGraph(definition, StateRuns(failedHistory()))
+	if err != nil {
+		t.Fatalf("RenderGraph() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"Path: Validate → Charge → Refund",
+		"══▶ Validate (start)",
+		"║ ✓ Validate  Task  4s",
+		"║ ↪ Charge  Task",
+		"══▶ Refund (catch States.ALL)",
+		"──▷ Ship (next)",
+		"│   Ship  Task",
+		"✗ States.TaskFailed: {\"errorMessage\":\"boom\"}",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Errorf("graph missing %q:\n%s", want, graph)
+		}
+	}
+	// States are drawn in the order they are reached, unreachable ones last
+	order := []string{"Validate  Task", "Charge  Task", "Ship  Task", "Refund  Task", "Orphan  Pass"}
+	last := -1
+	for _, name := range order {
+		i := strings.Index(graph, name)
+		if i < last {
+			t.Errorf("%q drawn out of order:\n%s", name, graph)
+		}
+		last = i
+	}
+
+	if _, err := RenderGraph("not json", nil); err == nil {
+		t.Error("RenderGraph() should fail on an invalid definition")
+	}
+}
+
+func TestRenderGraph_Nested(t *testing.T) {
+	definition := `{
+		"StartAt": "Fan",
+		"States": {
+			"Fan": {"Type": "Parallel", "End": true, "Branches": [
+				{"StartAt": "Left", "States": {"Left": {"Type": "Pass", "End": true}}},
+				{"StartAt": "Right", "States": {"Right": {"Type": "Pass", "End": true}}}
+			]}
+		}
+	}`
+	graph, err := RenderGraph(definition, nil)
+	if err != nil {
+		t.Fatalf("RenderGraph() error = %v", err)
+	}
+	for _, want := range []string{"Path: (no states entered)", "branch 1 of 2:", "    ──▷ Left (start)", "    │   Right  Pass"} {
+		if !strings.Contains(graph, want) {
+			t.Errorf("graph missing %q:\n%s", want, graph)
+		}
+	}
+}
+
+type fakeStarter struct {
+	in *sfn.StartExecutionInput
+}
+
+func (f *fakeStarter) StartExecution(_ context.Context, in *sfn.StartExecutionInput, _ ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error) {
+	f.in = in
+	return &sfn.StartExecutionOutput{
+		ExecutionArn: aws.String("arn:aws:states:us-east-1:123456789012:execution:orders:run-1"),
+		StartDate:    aws.Time(testStart),
+	}, nil
+}
+
+func TestStartExecution(t *testing.T) {
+	const smArn = "arn:aws:states:us-east-1:123456789012:stateMachine:orders"
+	client := &fakeStarter{}
+
+	result, err := startExecution(context.Background(), client, smArn, "", "  ")
+	if err != nil {
+		t.Fatalf("startExecution() error = %v", err)
+	}
+	if aws.ToString(client.in.Input) != "{}" || client.in.Name != nil {
+		t.Errorf("empty input should default to {} with a generated name: %+v", client.in)
+	}
+	if result.Message != "Started execution run-1" || !strings.HasSuffix(result.ID, ":run-1") {
+		t.Errorf("result = %+v", result)
+	}
+
+	if _, err := startExecution(context.Background(), client, smArn, "run-2", `{"a":`); err == nil {
+		t.Error("invalid JSON input should fail")
+	}
+	if _, err := startExecution(context.Background(), client, smArn, "run-2", `{"a":1}`); err != nil || aws.ToString(client.in.Name) != "run-2" {
+		t.Errorf("named start: err = %v, name = %v", err, aws.ToString(client.in.Name))
+	}
+}
@@ -0,0 +1,82 @@
+package stepfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const fieldExecutionName = "name"
+
+// StartCompose starts executions of the state machine stateMachineArn
+// returns for a resource, with an input written in the compose view. Inputs
+// are saved as test events per state machine.
+func StartCompose(stateMachineArn func(dao.Resource) string) *action.Compose {
+	return &action.Compose{
+		Fields: func(dao.Resource) []action.ComposeField {
+			return []action.ComposeField{
+				{Key: fieldExecutionName, Label: "Name", Placeholder: "generated if empty"},
+			}
+		},
+		Body: func(dao.Resource) (string, string) {
+			return "{}", ".json"
+		},
+		Events: func(r dao.Resource) []string {
+			return []string{"stepfunctions", appaws.ExtractResourceName(stateMachineArn(r))}
+		},
+		Send: func(ctx context.Context, r dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+			client, err := GetClient(ctx)
+			if err != nil {
+				return action.ComposeResult{}, err
+			}
+			return startExecution(ctx, client, stateMachineArn(r), values[fieldExecutionName], body)
+		},
+	}
+}
+
+// starter is the part of the Step Functions client startExecution uses
+type starter interface {
+	StartExecution(ctx context.Context, in *sfn.StartExecutionInput, optFns ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error)
+}
+
+func startExecution(ctx context.Context, client starter, stateMachineArn, name, body string) (action.ComposeResult, error) {
+	if stateMachineArn == "" {
+		return action.ComposeResult{}, fmt.Errorf("state machine ARN is unknown")
+	}
+	input := strings.TrimSpace(body)
+	if input == "" {
+		input = "{}"
+	}
+	if !json.Valid([]byte(input)) {
+		return action.ComposeResult{}, fmt.Errorf("input must be valid JSON")
+	}
+
+	in := &sfn.StartExecutionInput{
+		StateMachineArn: &stateMachineArn,
+		Input:           &input,
+	}
+	if name != "" {
+		in.Name = &name
+	}
+	output, err := client.StartExecution(ctx, in)
+	if err != nil {
+		return action.ComposeResult{}, apperrors.Wrapf(err, "start execution of %s", appaws.ExtractResourceName(stateMachineArn))
+	}
+
+	arn := appaws.Str(output.ExecutionArn)
+	execName := appaws.ExtractResourceName(arn)
+	return action.ComposeResult{
+		Message: fmt.Sprintf("Started execution %s", execName),
+		ID:      arn,
+		Output: fmt.Sprintf("Execution: %s\nARN:       %s\nStarted:   %s",
+			execName, arn, appaws.Time(output.StartDate).Format("2006-01-02 15:04:05")),
+	}, nil
+}
@@ -13,6 +13,16 @@ import (
 
 func init() {
 	action.Global.Register("stepfunctions", "state-machines", []action.Action{
+		{
+			Name:      "Start Execution",
+			Shortcut:  "x",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartExecution",
+			Confirm:   action.ConfirmSimple,
+			Compose: sfnClient.StartCompose(func(r dao.Resource) string {
+				return r.GetARN()
+			}),
+		},
 		{
 			Name:         "Delete",
 			Shortcut:     "D",
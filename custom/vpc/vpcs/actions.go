@@ -19,6 +19,7 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "DeleteVpc",
 			Confirm:   action.ConfirmDangerous,
+			Plan:      planDeleteVPC,
 		},
 	})
 
@@ -45,7 +46,7 @@ func executeDeleteVPC(ctx context.Context, resource dao.Resource) action.ActionR
 		VpcId: &vpcID,
 	})
 	if err != nil {
-		return action.ActionResult{Success: false, Error: fmt.Errorf("delete vpc: %w", err)}
+		return action.FailResultf(err, "delete vpc %s", vpcID)
 	}
 
 	return action.ActionResult{
@@ -0,0 +1,450 @@
+package vpcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// teardownTimeout bounds waiting for asynchronous deletes (NAT gateways,
+// VPC endpoints) whose network interfaces block the subnets
+const teardownTimeout = 10 * time.Minute
+
+// vpcPlanner collects the dependencies of one VPC
+type vpcPlanner struct {
+	client *ec2.Client
+	vpcID  string
+	filter []types.Filter
+	plan   *action.Plan
+}
+
+// planDeleteVPC orders the removal of everything DeleteVpc would fail on:
+// endpoints and NAT gateways first (their interfaces sit in the subnets),
+// then gateways, subnets, route tables, network ACLs and security groups.
+// Instances and interfaces owned by other services are in use.
+func planDeleteVPC(ctx context.Context, resource dao.Resource) (*action.Plan, error) {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vpcID := resource.GetID()
+	p := &vpcPlanner{
+		client: client,
+		vpcID:  vpcID,
+		filter: []types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcID}}},
+		plan:   &action.Plan{},
+	}
+
+	for _, add := range []func(context.Context) error{
+		p.addInstances,
+		p.addEndpoints,
+		p.addNatGateways,
+		p.addNetworkInterfaces,
+		p.addInternetGateways,
+		p.addEgressOnlyGateways,
+		p.addVpnGateways,
+		p.addPeeringConnections,
+		p.addSubnets,
+		p.addRouteTables,
+		p.addNetworkAcls,
+		p.addSecurityGroups,
+	} {
+		if err := add(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return p.plan, nil
+}
+
+func (p *vpcPlanner) addInstances(ctx context.Context) error {
+	reservations, err := appaws.Paginate(ctx, func(token *string) ([]types.Reservation, *string, error) {
+		output, err := p.client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			Filters: append(p.filter, types.Filter{
+				Name:   aws.String("instance-state-name"),
+				Values: []string{"pending", "running", "shutting-down", "stopping", "stopped"},
+			}),
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe instances")
+		}
+		return output.Reservations, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range reservations {
+		for _, inst := range r.Instances {
+			label := appaws.Str(inst.InstanceId)
+			if name := appaws.EC2NameTag(inst.Tags); name != "" {
+				label += " (" + name + ")"
+			}
+			state := ""
+			if inst.State != nil {
+				state = string(inst.State.Name)
+			}
+			p.plan.AddInUse("instance %s is %s", label, state)
+		}
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addEndpoints(ctx context.Context) error {
+	endpoints, err := appaws.Paginate(ctx, func(token *string) ([]types.VpcEndpoint, *string, error) {
+		output, err := p.client.DescribeVpcEndpoints(ctx, &ec2.DescribeVpcEndpointsInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe vpc endpoints")
+		}
+		return output.VpcEndpoints, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, ep := range endpoints {
+		if ep.State == types.StateDeleted || ep.State == types.StateDeleting {
+			continue
+		}
+		id := appaws.Str(ep.VpcEndpointId)
+		p.plan.AddStep(fmt.Sprintf("Delete VPC endpoint %s (%s)", id, appaws.Str(ep.ServiceName)), func(ctx context.Context) error {
+			if _, err := p.client.DeleteVpcEndpoints(ctx, &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []string{id}}); err != nil {
+				return apperrors.Wrapf(err, "delete vpc endpoint %s", id)
+			}
+			return waitUntil(ctx, func() (bool, error) {
+				output, err := p.client.DescribeVpcEndpoints(ctx, &ec2.DescribeVpcEndpointsInput{VpcEndpointIds: []string{id}})
+				if err != nil {
+					return apperrors.IsNotFound(err), nil
+				}
+				return len(output.VpcEndpoints) == 0 || output.VpcEndpoints[0].State == types.StateDeleted, nil
+			})
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addNatGateways(ctx context.Context) error {
+	gateways, err := appaws.Paginate(ctx, func(token *string) ([]types.NatGateway, *string, error) {
+		output, err := p.client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{Filter: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe nat gateways")
+		}
+		return output.NatGateways, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, gw := range gateways {
+		if gw.State == types.NatGatewayStateDeleted || gw.State == types.NatGatewayStateDeleting || gw.State == types.NatGatewayStateFailed {
+			continue
+		}
+		id := appaws.Str(gw.NatGatewayId)
+		p.plan.AddStep(fmt.Sprintf("Delete NAT gateway %s and wait for it", label(id, gw.Tags)), func(ctx context.Context) error {
+			if _, err := p.client.DeleteNatGateway(ctx, &ec2.DeleteNatGatewayInput{NatGatewayId: &id}); err != nil {
+				return apperrors.Wrapf(err, "delete nat gateway %s", id)
+			}
+			waiter := ec2.NewNatGatewayDeletedWaiter(p.client)
+			return waiter.Wait(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []string{id}}, teardownTimeout)
+		})
+	}
+	return nil
+}
+
+// addNetworkInterfaces deletes detached interfaces; attached ones that are
+// not removed with an endpoint, NAT gateway or instance are in use
+func (p *vpcPlanner) addNetworkInterfaces(ctx context.Context) error {
+	enis, err := appaws.Paginate(ctx, func(token *string) ([]types.NetworkInterface, *string, error) {
+		output, err := p.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe network interfaces")
+		}
+		return output.NetworkInterfaces, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, eni := range enis {
+		id := appaws.Str(eni.NetworkInterfaceId)
+		switch {
+		case eni.InterfaceType == types.NetworkInterfaceTypeNatGateway,
+			eni.InterfaceType == types.NetworkInterfaceTypeVpcEndpoint,
+			eni.InterfaceType == types.NetworkInterfaceTypeGatewayLoadBalancerEndpoint:
+			// Removed with the endpoint or gateway
+		case eni.Attachment != nil && eni.Attachment.InstanceId != nil && appaws.Str(eni.Attachment.InstanceOwnerId) == appaws.Str(eni.OwnerId):
+			// Counted with the instance
+		case eni.Status == types.NetworkInterfaceStatusAvailable && !appaws.Bool(eni.RequesterManaged):
+			p.plan.AddStep("Delete detached network interface "+id, func(ctx context.Context) error {
+				_, err := p.client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: &id})
+				return apperrors.Wrapf(err, "delete network interface %s", id)
+			})
+		default:
+			p.plan.AddInUse("network interface %s: %s", id, appaws.Str(eni.Description))
+		}
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addInternetGateways(ctx context.Context) error {
+	output, err := p.client.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+		Filters: []types.Filter{{Name: aws.String("attachment.vpc-id"), Values: []string{p.vpcID}}},
+	})
+	if err != nil {
+		return apperrors.Wrap(err, "describe internet gateways")
+	}
+	for _, igw := range output.InternetGateways {
+		id := appaws.Str(igw.InternetGatewayId)
+		p.plan.AddStep(fmt.Sprintf("Detach and delete internet gateway %s", label(id, igw.Tags)), func(ctx context.Context) error {
+			if _, err := p.client.DetachInternetGateway(ctx, &ec2.DetachInternetGatewayInput{
+				InternetGatewayId: &id,
+				VpcId:             &p.vpcID,
+			}); err != nil && !apperrors.IsNotFound(err) {
+				return apperrors.Wrapf(err, "detach internet gateway %s", id)
+			}
+			_, err := p.client.DeleteInternetGateway(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: &id})
+			return apperrors.Wrapf(err, "delete internet gateway %s", id)
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addEgressOnlyGateways(ctx context.Context) error {
+	gateways, err := appaws.Paginate(ctx, func(token *string) ([]types.EgressOnlyInternetGateway, *string, error) {
+		output, err := p.client.DescribeEgressOnlyInternetGateways(ctx, &ec2.DescribeEgressOnlyInternetGatewaysInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe egress-only internet gateways")
+		}
+		return output.EgressOnlyInternetGateways, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, gw := range gateways {
+		attached := false
+		for _, a := range gw.Attachments {
+			attached = attached || appaws.Str(a.VpcId) == p.vpcID
+		}
+		if !attached {
+			continue
+		}
+		id := appaws.Str(gw.EgressOnlyInternetGatewayId)
+		p.plan.AddStep("Delete egress-only internet gateway "+id, func(ctx context.Context) error {
+			_, err := p.client.DeleteEgressOnlyInternetGateway(ctx, &ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: &id})
+			return apperrors.Wrapf(err, "delete egress-only internet gateway %s", id)
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addVpnGateways(ctx context.Context) error {
+	output, err := p.client.DescribeVpnGateways(ctx, &ec2.DescribeVpnGatewaysInput{
+		Filters: []types.Filter{
+			{Name: aws.String("attachment.vpc-id"), Values: []string{p.vpcID}},
+			{Name: aws.String("attachment.state"), Values: []string{"attaching", "attached"}},
+		},
+	})
+	if err != nil {
+		return apperrors.Wrap(err, "describe vpn gateways")
+	}
+	for _, gw := range output.VpnGateways {
+		id := appaws.Str(gw.VpnGatewayId)
+		p.plan.AddStep(fmt.Sprintf("Detach virtual private gateway %s", label(id, gw.Tags)), func(ctx context.Context) error {
+			_, err := p.client.DetachVpnGateway(ctx, &ec2.DetachVpnGatewayInput{VpnGatewayId: &id, VpcId: &p.vpcID})
+			return apperrors.Wrapf(err, "detach vpn gateway %s", id)
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addPeeringConnections(ctx context.Context) error {
+	seen := make(map[string]bool)
+	for _, side := range []string{"requester-vpc-info.vpc-id", "accepter-vpc-info.vpc-id"} {
+		conns, err := appaws.Paginate(ctx, func(token *string) ([]types.VpcPeeringConnection, *string, error) {
+			output, err := p.client.DescribeVpcPeeringConnections(ctx, &ec2.DescribeVpcPeeringConnectionsInput{
+				Filters: []types.Filter{
+					{Name: aws.String(side), Values: []string{p.vpcID}},
+					{Name: aws.String("status-code"), Values: []string{"pending-acceptance", "provisioning", "active"}},
+				},
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "describe vpc peering connections")
+			}
+			return output.VpcPeeringConnections, output.NextToken, nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, pc := range conns {
+			id := appaws.Str(pc.VpcPeeringConnectionId)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			p.plan.AddStep(fmt.Sprintf("Delete VPC peering connection %s", label(id, pc.Tags)), func(ctx context.Context) error {
+				_, err := p.client.DeleteVpcPeeringConnection(ctx, &ec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: &id})
+				return apperrors.Wrapf(err, "delete vpc peering connection %s", id)
+			})
+		}
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addSubnets(ctx context.Context) error {
+	subnets, err := appaws.Paginate(ctx, func(token *string) ([]types.Subnet, *string, error) {
+		output, err := p.client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe subnets")
+		}
+		return output.Subnets, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		id := appaws.Str(subnet.SubnetId)
+		p.plan.AddStep(fmt.Sprintf("Delete subnet %s", label(id, subnet.Tags)), func(ctx context.Context) error {
+			_, err := p.client.DeleteSubnet(ctx, &ec2.DeleteSubnetInput{SubnetId: &id})
+			return apperrors.Wrapf(err, "delete subnet %s", id)
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addRouteTables(ctx context.Context) error {
+	tables, err := appaws.Paginate(ctx, func(token *string) ([]types.RouteTable, *string, error) {
+		output, err := p.client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe route tables")
+		}
+		return output.RouteTables, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, rt := range tables {
+		main := false
+		for _, a := range rt.Associations {
+			main = main || appaws.Bool(a.Main)
+		}
+		// The main route table is deleted with the VPC
+		if main {
+			continue
+		}
+		id := appaws.Str(rt.RouteTableId)
+		p.plan.AddStep(fmt.Sprintf("Delete route table %s", label(id, rt.Tags)), func(ctx context.Context) error {
+			_, err := p.client.DeleteRouteTable(ctx, &ec2.DeleteRouteTableInput{RouteTableId: &id})
+			return apperrors.Wrapf(err, "delete route table %s", id)
+		})
+	}
+	return nil
+}
+
+func (p *vpcPlanner) addNetworkAcls(ctx context.Context) error {
+	acls, err := appaws.Paginate(ctx, func(token *string) ([]types.NetworkAcl, *string, error) {
+		output, err := p.client.DescribeNetworkAcls(ctx, &ec2.DescribeNetworkAclsInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe network acls")
+		}
+		return output.NetworkAcls, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, acl := range acls {
+		if appaws.Bool(acl.IsDefault) {
+			continue
+		}
+		id := appaws.Str(acl.NetworkAclId)
+		p.plan.AddStep(fmt.Sprintf("Delete network ACL %s", label(id, acl.Tags)), func(ctx context.Context) error {
+			_, err := p.client.DeleteNetworkAcl(ctx, &ec2.DeleteNetworkAclInput{NetworkAclId: &id})
+			return apperrors.Wrapf(err, "delete network acl %s", id)
+		})
+	}
+	return nil
+}
+
+// addSecurityGroups revokes rules between the VPC's groups first, since
+// groups that reference each other cannot be deleted one at a time
+func (p *vpcPlanner) addSecurityGroups(ctx context.Context) error {
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.SecurityGroup, *string, error) {
+		output, err := p.client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{Filters: p.filter, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe security groups")
+		}
+		return output.SecurityGroups, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var deletable []types.SecurityGroup
+	for _, g := range groups {
+		// The default group is deleted with the VPC
+		if appaws.Str(g.GroupName) != "default" {
+			deletable = append(deletable, g)
+		}
+	}
+
+	for _, g := range groups {
+		groupID := appaws.Str(g.GroupId)
+		for _, target := range deletable {
+			targetID := appaws.Str(target.GroupId)
+			if targetID == groupID {
+				continue
+			}
+			ingress, egress := appec2.ReferencingRules(g, targetID)
+			if len(ingress)+len(egress) == 0 {
+				continue
+			}
+			p.plan.AddStep(
+				fmt.Sprintf("Revoke %d rule(s) of %s referencing %s", len(ingress)+len(egress), appec2.GroupLabel(g), targetID),
+				func(ctx context.Context) error {
+					return appec2.RevokeRules(ctx, p.client, groupID, ingress, egress)
+				})
+		}
+	}
+
+	for _, g := range deletable {
+		id := appaws.Str(g.GroupId)
+		p.plan.AddStep("Delete security group "+appec2.GroupLabel(g), func(ctx context.Context) error {
+			_, err := p.client.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: &id})
+			return apperrors.Wrapf(err, "delete security group %s", id)
+		})
+	}
+	return nil
+}
+
+// waitUntil polls done until it reports true or teardownTimeout passes
+func waitUntil(ctx context.Context, done func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, teardownTimeout)
+	defer cancel()
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// label returns "id (Name tag)" or the ID
+func label(id string, tags []types.Tag) string {
+	if name := appaws.EC2NameTag(tags); name != "" {
+		return id + " (" + name + ")"
+	}
+	return id
+}
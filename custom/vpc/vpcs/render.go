@@ -207,6 +207,7 @@ func (r *VPCRenderer) Navigations(resource dao.Resource) []render.Navigation {
 		{Key: "t", Label: "Route Tables", Service: "vpc", Resource: "route-tables", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "i", Label: "Internet GWs", Service: "vpc", Resource: "internet-gateways", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "n", Label: "NAT GWs", Service: "vpc", Resource: "nat-gateways", FilterField: "VpcId", FilterValue: vpcId},
+		{Key: "p", Label: "Endpoints", Service: "vpc", Resource: "endpoints", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "g", Label: "Security Groups", Service: "ec2", Resource: "security-groups", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "e", Label: "Instances", Service: "ec2", Resource: "instances", FilterField: "VpcId", FilterValue: vpcId},
 	}
@@ -85,6 +85,19 @@ type Action struct {
 	// If nil, defaults to resource.GetID().
 	// Use when the action operates on a different identifier (e.g., Name vs ARN).
 	ConfirmToken func(resource dao.Resource) string
+
+	// Plan computes the dependencies to remove before a delete action.
+	// If set, the action shows the plan and can run the ordered teardown
+	// under one dangerous confirmation.
+	Plan PlanFunc
+
+	// Transfer, if set, runs the action in the transfer view, which asks
+	// for its inputs and shows progress instead of blocking the menu.
+	Transfer *Transfer
+
+	// Compose, if set, runs the action in the compose view, which edits
+	// a body and a few fields and sends them, e.g. publishing a message.
+	Compose *Compose
 }
 
 // ActionResult represents the result of an action
@@ -161,6 +174,17 @@ var ReadOnlyAllowlist = map[string]bool{
 	"DetectStackDrift": true,
 	// InvokeFunctionDryRun: Validation mode, function is not actually invoked
 	"InvokeFunctionDryRun": true,
+	// ViewObject, DownloadObject: Read S3 objects (GetObject) into the TUI or a local file
+	"ViewObject":     true,
+	"DownloadObject": true,
+	// CopyURI: Clipboard only, no API call
+	"CopyURI": true,
+	// PresignURL: Signs a GET request locally, no API call
+	"PresignURL": true,
+	// ViewMessage: Shows an already peeked SQS message, no API call
+	"ViewMessage": true,
+	// ShowStateGraph: Reads a Step Functions definition and execution history
+	"ShowStateGraph": true,
 }
 
 var ReadOnlyExecAllowlist = map[string]bool{
@@ -0,0 +1,66 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ComposeField is a single-line value of a compose form. A field with
+// Options is picked from them instead of typed.
+type ComposeField struct {
+	Key         string
+	Label       string
+	Value       string // initial value
+	Placeholder string
+	Options     []string
+}
+
+// ComposeResult is the outcome of a send
+type ComposeResult struct {
+	Message string // e.g. "Published message 1f2e..."
+	ID      string // copyable identifier, e.g. the message ID
+	Output  string // details shown below the form, e.g. a response payload
+	Failed  bool   // sent, but the target reported an error, e.g. a function error
+
+	// LogGroup, if set, lets the view open the logs of the send, filtered
+	// to lines containing LogFilter (e.g. the request ID)
+	LogGroup  string
+	LogFilter string
+}
+
+// Compose is an action that sends a body written in the compose view, such
+// as a message or a payload. The view shows Fields and the body, edited in
+// place or in $EDITOR, and stays open after a send so it can be sent again.
+type Compose struct {
+	Fields func(resource dao.Resource) []ComposeField
+	// Body returns the initial body and the file extension used for $EDITOR
+	Body func(resource dao.Resource) (body, ext string)
+	// LoadBody, if set, loads the initial body in the background, e.g. the
+	// input of a previous execution. It replaces Body unless that was edited.
+	LoadBody func(ctx context.Context, resource dao.Resource) (string, error)
+	// Options, if set, loads the options of fields in the background, by
+	// field key, e.g. the versions and aliases of a function
+	Options func(ctx context.Context, resource dao.Resource) (map[string][]string, error)
+	// Events, if set, returns the key of the resource's test events (see
+	// package testevents), so bodies can be saved and loaded by name
+	Events func(resource dao.Resource) []string
+	Send   func(ctx context.Context, resource dao.Resource, body string, values map[string]string) (ComposeResult, error)
+}
+
+// SendCompose checks the action may run and sends the composed body
+func SendCompose(ctx context.Context, act Action, resource dao.Resource, body string, values map[string]string) (ComposeResult, error) {
+	if act.Compose == nil {
+		return ComposeResult{}, fmt.Errorf("%s is not a compose action", act.Name)
+	}
+	// Defense-in-depth, as in ExecuteWithDAO
+	if config.Global().ReadOnly() && !IsAllowedInReadOnly(act) {
+		log.Info("read-only denied action", "action", act.Name, "type", act.Type)
+		return ComposeResult{}, ErrReadOnlyDenied
+	}
+	log.Info("sending compose action", "action", act.Name, "resourceID", resource.GetID())
+	return act.Compose.Send(ctx, resource, body, values)
+}
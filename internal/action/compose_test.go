@@ -0,0 +1,39 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestSendCompose(t *testing.T) {
+	resource := &dao.BaseResource{ID: "orders"}
+	act := Action{
+		Name:      "Publish",
+		Type:      ActionTypeAPI,
+		Operation: "PublishThings",
+		Compose: &Compose{
+			Send: func(_ context.Context, _ dao.Resource, body string, values map[string]string) (ComposeResult, error) {
+				return ComposeResult{Message: values["subject"] + ": " + body}, nil
+			},
+		},
+	}
+
+	result, err := SendCompose(context.Background(), act, resource, "hello", map[string]string{"subject": "greeting"})
+	if err != nil || result.Message != "greeting: hello" {
+		t.Errorf("SendCompose() = %+v, %v", result, err)
+	}
+
+	if _, err := SendCompose(context.Background(), Action{Name: "Plain"}, resource, "", nil); err == nil {
+		t.Error("SendCompose() without Compose should fail")
+	}
+
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+	if _, err := SendCompose(context.Background(), act, resource, "hello", nil); !errors.Is(err, ErrReadOnlyDenied) {
+		t.Errorf("SendCompose() in read-only mode = %v, want %v", err, ErrReadOnlyDenied)
+	}
+}
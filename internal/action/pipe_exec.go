@@ -0,0 +1,73 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// PipeExec runs a shell command with Input connected to its stdin.
+// Implements tea.ExecCommand interface.
+// Used to hand data (e.g., log lines) to local tools such as jq or less.
+type PipeExec struct {
+	Command string
+	Input   io.Reader
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// SetStdin sets the terminal stdin (used to wait for the return keypress)
+func (e *PipeExec) SetStdin(r io.Reader) { e.stdin = r }
+
+// SetStdout sets the stdout for the command
+func (e *PipeExec) SetStdout(w io.Writer) { e.stdout = w }
+
+// SetStderr sets the stderr for the command
+func (e *PipeExec) SetStderr(w io.Writer) { e.stderr = w }
+
+// Run executes the command and waits for Enter before returning to the TUI,
+// so that output of non-interactive commands stays visible.
+func (e *PipeExec) Run() error {
+	if e.Command == "" {
+		return ErrEmptyCommand
+	}
+
+	stdin := e.stdin
+	stdout := e.stdout
+	stderr := e.stderr
+	if stdin == nil {
+		stdin = os.Stdin
+	}
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+
+	cmd := exec.CommandContext(context.Background(), "/bin/sh", "-c", e.Command)
+	cmd.Stdin = e.Input
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+
+	_, _ = fmt.Fprintln(stdout)
+	if err != nil {
+		_, _ = fmt.Fprintln(stdout, ui.BoldDangerStyle().Render("Command failed: ")+err.Error())
+	}
+	_, _ = fmt.Fprint(stdout, "Press Enter to return to claws...")
+
+	buf := make([]byte, 1)
+	if f, ok := stdin.(*os.File); ok {
+		_, _ = f.Read(buf)
+	}
+
+	return err
+}
@@ -0,0 +1,87 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// StackNameTag is the tag CloudFormation puts on the resources it creates
+const StackNameTag = "aws:cloudformation:stack-name"
+
+// PlanFunc computes what must be removed before a resource can be deleted
+type PlanFunc func(ctx context.Context, resource dao.Resource) (*Plan, error)
+
+// PlanStep is one removal in a delete plan
+type PlanStep struct {
+	Description string // e.g. "Delete NAT gateway nat-0123 (public-a)"
+	Run         func(ctx context.Context) error
+}
+
+// Plan describes the dependencies of a resource to delete. Steps run in
+// order before the delete action itself; InUse lists dependencies the plan
+// does not remove, which make the delete fail while they exist.
+type Plan struct {
+	Steps    []PlanStep
+	InUse    []string
+	Warnings []string
+}
+
+// AddStep appends a step to the plan
+func (p *Plan) AddStep(description string, run func(ctx context.Context) error) {
+	p.Steps = append(p.Steps, PlanStep{Description: description, Run: run})
+}
+
+// AddInUse records a dependency the plan does not remove
+func (p *Plan) AddInUse(format string, args ...any) {
+	p.InUse = append(p.InUse, fmt.Sprintf(format, args...))
+}
+
+// Blocked returns true if the teardown cannot succeed
+func (p *Plan) Blocked() bool {
+	return len(p.InUse) > 0
+}
+
+// RunStep runs a plan step. Dependencies that are already gone count as removed.
+func RunStep(ctx context.Context, step PlanStep) error {
+	if err := step.Run(ctx); err != nil && !apperrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// BuildPlan computes the delete plan for a resource. Resources managed by a
+// CloudFormation stack get a warning, since deleting them outside the stack
+// makes it drift; act.Plan, if set, adds the dependencies.
+func BuildPlan(ctx context.Context, act Action, resource dao.Resource) (*Plan, error) {
+	plan := &Plan{}
+	if act.Plan != nil {
+		p, err := act.Plan(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		plan = p
+	}
+	if stack := resource.GetTags()[StackNameTag]; stack != "" {
+		plan.Warnings = append(plan.Warnings,
+			fmt.Sprintf("Managed by CloudFormation stack %s: deleting it outside the stack makes the stack drift. Prefer deleting or updating the stack.", stack))
+	}
+	return plan, nil
+}
+
+// NeedsPlan returns true if the action should show a delete plan before
+// the dangerous confirmation
+func NeedsPlan(act Action, resource dao.Resource) bool {
+	if act.Confirm != ConfirmDangerous || act.Type != ActionTypeAPI {
+		return false
+	}
+	return act.Plan != nil || (IsDeleteOperation(act.Operation) && resource.GetTags()[StackNameTag] != "")
+}
+
+// IsDeleteOperation returns true for operations that delete the resource
+func IsDeleteOperation(operation string) bool {
+	return strings.HasPrefix(operation, "Delete")
+}
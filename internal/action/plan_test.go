@@ -0,0 +1,85 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestNeedsPlan(t *testing.T) {
+	plain := &dao.BaseResource{ID: "r-1"}
+	stackOwned := &dao.BaseResource{ID: "r-2", Tags: map[string]string{StackNameTag: "app"}}
+	planFn := func(context.Context, dao.Resource) (*Plan, error) { return &Plan{}, nil }
+	del := Action{Type: ActionTypeAPI, Operation: "DeleteThing", Confirm: ConfirmDangerous}
+
+	tests := []struct {
+		name     string
+		act      Action
+		resource dao.Resource
+		want     bool
+	}{
+		{"plain delete", del, plain, false},
+		{"delete with planner", Action{Type: ActionTypeAPI, Operation: "DeleteThing", Confirm: ConfirmDangerous, Plan: planFn}, plain, true},
+		{"stack-owned delete", del, stackOwned, true},
+		{"stack-owned non-delete", Action{Type: ActionTypeAPI, Operation: "StopThing", Confirm: ConfirmDangerous}, stackOwned, false},
+		{"simple confirm", Action{Type: ActionTypeAPI, Operation: "DeleteThing", Confirm: ConfirmSimple, Plan: planFn}, plain, false},
+		{"exec action", Action{Type: ActionTypeExec, Operation: "DeleteThing", Confirm: ConfirmDangerous, Plan: planFn}, plain, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsPlan(tt.act, tt.resource); got != tt.want {
+				t.Errorf("NeedsPlan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPlan(t *testing.T) {
+	act := Action{
+		Type:      ActionTypeAPI,
+		Operation: "DeleteThing",
+		Confirm:   ConfirmDangerous,
+		Plan: func(_ context.Context, r dao.Resource) (*Plan, error) {
+			p := &Plan{}
+			p.AddStep("Delete child of "+r.GetID(), func(context.Context) error { return nil })
+			p.AddInUse("instance %s", "i-1")
+			return p, nil
+		},
+	}
+	resource := &dao.BaseResource{ID: "r-1", Tags: map[string]string{StackNameTag: "app"}}
+
+	plan, err := BuildPlan(context.Background(), act, resource)
+	if err != nil {
+		t.Fatalf("BuildPlan() error = %v", err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Description != "Delete child of r-1" {
+		t.Errorf("Steps = %+v", plan.Steps)
+	}
+	if !plan.Blocked() || plan.InUse[0] != "instance i-1" {
+		t.Errorf("InUse = %v, want blocked by instance i-1", plan.InUse)
+	}
+	if len(plan.Warnings) != 1 || !strings.Contains(plan.Warnings[0], "stack app") {
+		t.Errorf("Warnings = %v, want CloudFormation warning", plan.Warnings)
+	}
+
+	act.Plan = func(context.Context, dao.Resource) (*Plan, error) { return nil, errors.New("boom") }
+	if _, err := BuildPlan(context.Background(), act, resource); err == nil {
+		t.Error("BuildPlan() should return the planner error")
+	}
+}
+
+func TestRunStep(t *testing.T) {
+	notFound := &smithy.GenericAPIError{Code: "InvalidGroup.NotFound", Message: "gone"}
+	if err := RunStep(context.Background(), PlanStep{Run: func(context.Context) error { return notFound }}); err != nil {
+		t.Errorf("RunStep() with NotFound = %v, want nil", err)
+	}
+	failed := errors.New("failed")
+	if err := RunStep(context.Background(), PlanStep{Run: func(context.Context) error { return failed }}); !errors.Is(err, failed) {
+		t.Errorf("RunStep() = %v, want %v", err, failed)
+	}
+}
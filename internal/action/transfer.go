@@ -0,0 +1,67 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// TransferField is a value the transfer view asks for before preparing
+// the transfer, such as a local path or a destination URI
+type TransferField struct {
+	Key         string
+	Label       string
+	Value       string // initial value
+	Placeholder string
+	Optional    bool
+}
+
+// TransferProgress is the running total of a transfer
+type TransferProgress struct {
+	Objects int
+	Bytes   int64
+	Current string // object in flight
+}
+
+// TransferJob is a prepared transfer: what it will do and how to run it.
+// Run reports progress as it goes and returns a summary message.
+type TransferJob struct {
+	Description string // e.g. "Copy s3://a/logs/ to s3://b/archive/"
+	Objects     int
+	Bytes       int64
+	Token       string // typed to confirm; defaults to the resource ID
+	Warnings    []string
+	Run         func(ctx context.Context, progress func(TransferProgress)) (string, error)
+}
+
+// Transfer is a long-running action with progress, such as copying many
+// objects. The transfer view asks for Fields, calls Prepare to count what
+// will be transferred, confirms with the count and size, then runs the job.
+type Transfer struct {
+	Fields  func(resource dao.Resource) []TransferField
+	Prepare func(ctx context.Context, resource dao.Resource, values map[string]string) (*TransferJob, error)
+}
+
+// PrepareTransfer checks the action may run and prepares its job
+func PrepareTransfer(ctx context.Context, act Action, resource dao.Resource, values map[string]string) (*TransferJob, error) {
+	if act.Transfer == nil {
+		return nil, fmt.Errorf("%s is not a transfer action", act.Name)
+	}
+	// Defense-in-depth, as in ExecuteWithDAO
+	if config.Global().ReadOnly() && !IsAllowedInReadOnly(act) {
+		log.Info("read-only denied action", "action", act.Name, "type", act.Type)
+		return nil, ErrReadOnlyDenied
+	}
+	log.Info("preparing transfer", "action", act.Name, "resourceID", resource.GetID())
+	job, err := act.Transfer.Prepare(ctx, resource, values)
+	if err != nil {
+		return nil, err
+	}
+	if job.Token == "" {
+		job.Token = resource.GetID()
+	}
+	return job, nil
+}
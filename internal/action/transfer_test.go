@@ -0,0 +1,46 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestPrepareTransfer(t *testing.T) {
+	resource := &dao.BaseResource{ID: "s3://b/logs/"}
+	act := Action{
+		Name:      "Copy",
+		Type:      ActionTypeAPI,
+		Operation: "CopyThings",
+		Transfer: &Transfer{
+			Prepare: func(_ context.Context, _ dao.Resource, values map[string]string) (*TransferJob, error) {
+				return &TransferJob{Description: "Copy to " + values["dest"]}, nil
+			},
+		},
+	}
+
+	job, err := PrepareTransfer(context.Background(), act, resource, map[string]string{"dest": "s3://c/"})
+	if err != nil {
+		t.Fatalf("PrepareTransfer() error = %v", err)
+	}
+	if job.Description != "Copy to s3://c/" {
+		t.Errorf("Description = %q", job.Description)
+	}
+	if job.Token != "s3://b/logs/" {
+		t.Errorf("Token = %q, want the resource ID by default", job.Token)
+	}
+
+	if _, err := PrepareTransfer(context.Background(), Action{Name: "Plain"}, resource, nil); err == nil {
+		t.Error("PrepareTransfer() without Transfer should fail")
+	}
+
+	failed := errors.New("no such bucket")
+	act.Transfer.Prepare = func(context.Context, dao.Resource, map[string]string) (*TransferJob, error) {
+		return nil, failed
+	}
+	if _, err := PrepareTransfer(context.Background(), act, resource, nil); !errors.Is(err, failed) {
+		t.Errorf("PrepareTransfer() = %v, want %v", err, failed)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/clawscli/claws/internal/log"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/snapshot"
 	"github.com/clawscli/claws/internal/ui"
 	"github.com/clawscli/claws/internal/view"
 )
@@ -57,6 +58,14 @@ type startupResourceMsg struct {
 
 type noOpMsg struct{}
 
+// snapshotSavedMsg is sent when a :snapshot save capture completes
+type snapshotSavedMsg struct {
+	name      string
+	resources int
+	failed    int
+	err       error
+}
+
 // App is the main application model
 // appStyles holds cached lipgloss styles for performance
 type appStyles struct {
@@ -309,7 +318,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch {
 		case key.Matches(msg, a.keys.Quit):
 			switch a.currentView.(type) {
-			case *view.DetailView, *view.DiffView, *view.LogView:
+			case *view.DetailView, *view.DiffView, *view.CompareView, *view.SnapshotDiffView, *view.ResourceHistoryView, *view.ConfigHistoryView, *view.TimelineView, *view.GraphView, *view.DeletePlanView, *view.TransferView, *view.ComposeView, *view.IaCView, *view.TextView, *view.ItemExplorerView, *view.RecordView, *view.SecretView, *view.ParameterView, *view.ParameterCompareView, *view.LogView, *view.MetricsChartView:
 				if cmd := a.navigateBack(); cmd != nil {
 					return a, cmd
 				}
@@ -375,6 +384,24 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case view.NavigateMsg:
 		return a.handleNavigate(msg)
 
+	case navmsg.ShowTextMsg:
+		return a.handleNavigate(view.NavigateMsg{View: view.NewTextView(msg.Title, msg.Content)})
+
+	case view.SnapshotSaveMsg:
+		return a, a.saveSnapshot(msg)
+
+	case snapshotSavedMsg:
+		if msg.err != nil {
+			a.err = fmt.Errorf("snapshot %s: %w", msg.name, msg.err)
+			return a, tea.Tick(3*time.Second, func(t time.Time) tea.Msg { return clearErrorMsg{} })
+		}
+		a.clipboardFlash = fmt.Sprintf("Snapshot %s saved (%d resources)", msg.name, msg.resources)
+		a.clipboardWarning = msg.failed > 0
+		if msg.failed > 0 {
+			a.clipboardFlash += fmt.Sprintf(", %d list(s) failed", msg.failed)
+		}
+		return a, tea.Tick(flashDuration, func(t time.Time) tea.Msg { return clearFlashMsg{} })
+
 	case view.ClearHistoryMsg:
 		log.Debug("clearing navigation history", "stackDepth", len(a.viewStack))
 		a.viewStack = nil
@@ -625,6 +652,10 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.clearModalState()
 		return a.handleProfilesChanged(msg)
 
+	case navmsg.ShowTextMsg:
+		a.clearModalState()
+		return a.handleNavigate(view.NavigateMsg{View: view.NewTextView(msg.Title, msg.Content)})
+
 	case tea.KeyPressMsg:
 		if view.IsEscKey(msg) || msg.Code == tea.KeyBackspace || msg.String() == "q" || msg.String() == "ctrl+c" {
 			if ic, ok := a.modal.Content.(view.InputCapture); ok && ic.HasActiveInput() {
@@ -901,6 +932,32 @@ func (a *App) resolveStartupView(viewName string) view.View {
 	}
 }
 
+// saveSnapshot captures the requested resource types in the current profiles
+// and regions and writes them to a snapshot file.
+func (a *App) saveSnapshot(msg view.SnapshotSaveMsg) tea.Cmd {
+	args := msg.Targets
+	if len(args) == 0 {
+		if rb, ok := a.currentView.(*view.ResourceBrowser); ok {
+			args = []string{rb.Service() + "/" + rb.ResourceType()}
+		}
+	}
+	targets, err := snapshot.ParseTargets(a.registry, args)
+	if err != nil {
+		return func() tea.Msg { return snapshotSavedMsg{name: msg.Name, err: err} }
+	}
+
+	a.clipboardFlash = "Saving snapshot " + msg.Name + "..."
+	a.clipboardWarning = false
+	ctx, reg := a.ctx, a.registry
+	return func() tea.Msg {
+		s := snapshot.Capture(ctx, reg, msg.Name, snapshot.CurrentScope(targets))
+		if _, err := snapshot.Save(s); err != nil {
+			return snapshotSavedMsg{name: msg.Name, err: err}
+		}
+		return snapshotSavedMsg{name: msg.Name, resources: len(s.Resources), failed: len(s.Errors)}
+	}
+}
+
 func (a *App) buildAIContext() *ai.Context {
 	regions := config.Global().Regions()
 	selections := config.Global().Selections()
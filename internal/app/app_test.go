@@ -3,10 +3,12 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/dao"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/view"
@@ -467,6 +469,29 @@ func TestKeyOpensModal(t *testing.T) {
 	}
 }
 
+func TestDetailViewOpensGraph(t *testing.T) {
+	app := newTestApp(t)
+	resource := &dao.BaseResource{ID: "i-123", Name: "web"}
+	detail := view.NewDetailView(app.ctx, resource, nil, "ec2", "instances", app.registry, nil)
+	app.viewStack = []view.View{&MockView{name: "ResourceBrowser"}}
+	app.currentView = detail
+	if status := detail.StatusLine(); !strings.Contains(status, "X:relations") {
+		t.Errorf("StatusLine() = %q, want X:relations", status)
+	}
+
+	_, cmd := app.Update(tea.KeyPressMsg{Code: 'X', Text: "X"})
+	if cmd == nil {
+		t.Fatal("Expected X in the detail view to open the relations graph")
+	}
+	app.Update(cmd())
+	if _, ok := app.currentView.(*view.GraphView); !ok {
+		t.Errorf("Expected GraphView, got %T", app.currentView)
+	}
+	if app.modal != nil {
+		t.Error("X should not open a modal")
+	}
+}
+
 func TestCommandModeActivation(t *testing.T) {
 	app := newTestApp(t)
 	app.currentView = &MockView{name: "Dashboard"}
@@ -263,6 +263,25 @@ var arnToRegistryType = map[string]string{
 	"organizations/ou":                  "ous",
 }
 
+// ResourceIDForGet returns the ID the resource's DAO expects in Get.
+// Most DAOs take the resource ID; some take the full ARN or a prefix of it.
+func (a *ARN) ResourceIDForGet() string {
+	switch a.Service {
+	case "states":
+		return a.Raw
+	case "bedrock-agentcore":
+		if idx := strings.Index(a.ResourceID, "/"); idx > 0 {
+			return a.ResourceID[:idx]
+		}
+		return a.ResourceID
+	default:
+		if a.ResourceID != "" {
+			return a.ResourceID
+		}
+		return a.Raw
+	}
+}
+
 // CanNavigate returns true if this ARN can be navigated to in claws.
 func (a *ARN) CanNavigate() bool {
 	if a == nil {
@@ -364,6 +364,38 @@ func TestARN_ShortID(t *testing.T) {
 	}
 }
 
+func TestARN_ResourceIDForGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		arn    string
+		wantID string
+	}{
+		{
+			name:   "resource ID",
+			arn:    "arn:aws:ec2:us-east-1:123456789012:instance/i-1234",
+			wantID: "i-1234",
+		},
+		{
+			name:   "step functions uses full ARN",
+			arn:    "arn:aws:states:us-east-1:123456789012:stateMachine:my-machine",
+			wantID: "arn:aws:states:us-east-1:123456789012:stateMachine:my-machine",
+		},
+		{
+			name:   "agentcore uses first segment",
+			arn:    "arn:aws:bedrock-agentcore:us-east-1:123456789012:runtime/rt-123/runtime-endpoint/DEFAULT",
+			wantID: "rt-123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseARN(tt.arn).ResourceIDForGet(); got != tt.wantID {
+				t.Errorf("ResourceIDForGet() = %q, want %q", got, tt.wantID)
+			}
+		})
+	}
+}
+
 func TestARN_CanNavigate(t *testing.T) {
 	tests := []struct {
 		name   string
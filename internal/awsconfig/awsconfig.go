@@ -0,0 +1,51 @@
+// Package awsconfig maps claws resource types to the resource types
+// recorded by AWS Config.
+package awsconfig
+
+// resourceTypes maps "service/resource" to the AWS Config resource type
+var resourceTypes = map[string]string{
+	"apigateway/rest-apis":         "AWS::ApiGateway::RestApi",
+	"autoscaling/groups":           "AWS::AutoScaling::AutoScalingGroup",
+	"cloudformation/stacks":        "AWS::CloudFormation::Stack",
+	"cloudfront/distributions":     "AWS::CloudFront::Distribution",
+	"dynamodb/tables":              "AWS::DynamoDB::Table",
+	"ec2/elastic-ips":              "AWS::EC2::EIP",
+	"ec2/instances":                "AWS::EC2::Instance",
+	"ec2/launch-templates":         "AWS::EC2::LaunchTemplate",
+	"ec2/security-groups":          "AWS::EC2::SecurityGroup",
+	"ec2/volumes":                  "AWS::EC2::Volume",
+	"ecr/repositories":             "AWS::ECR::Repository",
+	"ecs/clusters":                 "AWS::ECS::Cluster",
+	"ecs/services":                 "AWS::ECS::Service",
+	"ecs/task-definitions":         "AWS::ECS::TaskDefinition",
+	"eks/clusters":                 "AWS::EKS::Cluster",
+	"elbv2/load-balancers":         "AWS::ElasticLoadBalancingV2::LoadBalancer",
+	"iam/groups":                   "AWS::IAM::Group",
+	"iam/policies":                 "AWS::IAM::Policy",
+	"iam/roles":                    "AWS::IAM::Role",
+	"iam/users":                    "AWS::IAM::User",
+	"kinesis/streams":              "AWS::Kinesis::Stream",
+	"kms/keys":                     "AWS::KMS::Key",
+	"lambda/functions":             "AWS::Lambda::Function",
+	"rds/instances":                "AWS::RDS::DBInstance",
+	"rds/snapshots":                "AWS::RDS::DBSnapshot",
+	"s3/buckets":                   "AWS::S3::Bucket",
+	"secretsmanager/secrets":       "AWS::SecretsManager::Secret",
+	"sns/topics":                   "AWS::SNS::Topic",
+	"sqs/queues":                   "AWS::SQS::Queue",
+	"stepfunctions/state-machines": "AWS::StepFunctions::StateMachine",
+	"vpc/endpoints":                "AWS::EC2::VPCEndpoint",
+	"vpc/internet-gateways":        "AWS::EC2::InternetGateway",
+	"vpc/nat-gateways":             "AWS::EC2::NatGateway",
+	"vpc/route-tables":             "AWS::EC2::RouteTable",
+	"vpc/subnets":                  "AWS::EC2::Subnet",
+	"vpc/transit-gateways":         "AWS::EC2::TransitGateway",
+	"vpc/vpcs":                     "AWS::EC2::VPC",
+}
+
+// ResourceType returns the AWS Config resource type (e.g. AWS::EC2::Instance)
+// for a claws service and resource type.
+func ResourceType(service, resourceType string) (string, bool) {
+	t, ok := resourceTypes[service+"/"+resourceType]
+	return t, ok
+}
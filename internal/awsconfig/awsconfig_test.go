@@ -0,0 +1,21 @@
+package awsconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceType(t *testing.T) {
+	if got, ok := ResourceType("ec2", "security-groups"); !ok || got != "AWS::EC2::SecurityGroup" {
+		t.Errorf("ResourceType(ec2, security-groups) = %q, %v", got, ok)
+	}
+	if _, ok := ResourceType("cloudtrail", "events"); ok {
+		t.Error("cloudtrail/events should not be mapped")
+	}
+
+	for key, configType := range resourceTypes {
+		if !strings.HasPrefix(configType, "AWS::") || strings.Count(configType, "::") != 2 {
+			t.Errorf("%s: malformed config type %q", key, configType)
+		}
+	}
+}
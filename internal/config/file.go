@@ -27,6 +27,8 @@ const (
 	DefaultMaxConcurrentFetches    = 50
 	DefaultMaxStackSize            = 100
 	DefaultAIMaxToolCallsPerQuery  = 50
+	DefaultLogPipeCommand          = "less"
+	DefaultMetricColumnStat        = "Average"
 )
 
 var (
@@ -46,6 +48,11 @@ func expandTilde(path string) (string, error) {
 	return path, nil
 }
 
+// ExpandPath expands a leading ~ in a user-supplied path to the home directory.
+func ExpandPath(path string) (string, error) {
+	return expandTilde(path)
+}
+
 // SetConfigPath sets custom config file path. Must be called before File().
 // Returns error if file doesn't exist or isn't readable.
 func SetConfigPath(path string) error {
@@ -111,7 +118,31 @@ type TimeoutConfig struct {
 }
 
 type CloudWatchConfig struct {
-	Window Duration `yaml:"window,omitempty"`
+	Window  Duration                        `yaml:"window,omitempty"`
+	Columns map[string][]MetricColumnConfig `yaml:"columns,omitempty"` // Keyed by "service/resource" (e.g., "sqs/queues")
+}
+
+// MetricColumnConfig defines a user metric column shown next to the built-in inline metrics.
+type MetricColumnConfig struct {
+	Header     string `yaml:"header,omitempty"`     // Column header (default: metric name)
+	Namespace  string `yaml:"namespace"`            // CloudWatch namespace (e.g., "MyApp/Consumers")
+	Metric     string `yaml:"metric"`               // Metric name
+	Dimension  string `yaml:"dimension"`            // Dimension name (e.g., "QueueName")
+	Field      string `yaml:"field,omitempty"`      // Resource field for the dimension value: ID (default), Name, ARN, tag:<Key>, or a path in the API object
+	Stat       string `yaml:"stat,omitempty"`       // Statistic (default: Average)
+	Unit       string `yaml:"unit,omitempty"`       // Display unit suffix
+	Expression string `yaml:"expression,omitempty"` // Optional metric math expression; "m" refers to the metric (e.g., "m / 60")
+}
+
+// LogsConfig holds settings for the CloudWatch Logs viewer.
+type LogsConfig struct {
+	PipeCommand string `yaml:"pipe_command,omitempty"` // Shell command that receives log lines on stdin (e.g., "jq -R .")
+	ExportDir   string `yaml:"export_dir,omitempty"`   // Default directory for saved log files (default: current directory)
+}
+
+// S3Config holds settings for the S3 object browser.
+type S3Config struct {
+	DownloadDir string `yaml:"download_dir,omitempty"` // Directory for downloaded objects (default: current directory)
 }
 
 type ConcurrencyConfig struct {
@@ -204,6 +235,8 @@ type FileConfig struct {
 	Timeouts            TimeoutConfig     `yaml:"timeouts,omitempty"`
 	Concurrency         ConcurrencyConfig `yaml:"concurrency,omitempty"`
 	CloudWatch          CloudWatchConfig  `yaml:"cloudwatch,omitempty"`
+	Logs                LogsConfig        `yaml:"logs,omitempty"`
+	S3                  S3Config          `yaml:"s3,omitempty"`
 	Autosave            PersistenceConfig `yaml:"autosave,omitempty"`
 	Startup             StartupConfig     `yaml:"startup,omitempty"`
 	Theme               ThemeConfig       `yaml:"theme,omitempty"`
@@ -403,6 +436,67 @@ func (c *FileConfig) MetricsWindow() time.Duration {
 	})
 }
 
+// MetricColumns returns the user-defined metric columns for a resource type.
+// Entries missing namespace, metric or dimension are skipped.
+func (c *FileConfig) MetricColumns(service, resourceType string) []MetricColumnConfig {
+	return withRLock(&c.mu, func() []MetricColumnConfig {
+		configured := c.CloudWatch.Columns[service+"/"+resourceType]
+		if len(configured) == 0 {
+			return nil
+		}
+		columns := make([]MetricColumnConfig, 0, len(configured))
+		for _, col := range configured {
+			if col.Namespace == "" || col.Metric == "" || col.Dimension == "" {
+				log.Warn("skipping metric column: namespace, metric and dimension are required",
+					"resource", service+"/"+resourceType, "header", col.Header)
+				continue
+			}
+			if col.Stat == "" {
+				col.Stat = DefaultMetricColumnStat
+			}
+			if col.Header == "" {
+				col.Header = col.Metric
+			}
+			columns = append(columns, col)
+		}
+		return columns
+	})
+}
+
+// LogPipeCommand returns the shell command that log lines are piped to.
+func (c *FileConfig) LogPipeCommand() string {
+	return withRLock(&c.mu, func() string {
+		if c.Logs.PipeCommand == "" {
+			return DefaultLogPipeCommand
+		}
+		return c.Logs.PipeCommand
+	})
+}
+
+// LogExportDir returns the directory used for saved log files, with ~ expanded.
+// Returns empty string (current directory) if not configured.
+func (c *FileConfig) LogExportDir() string {
+	dir := withRLock(&c.mu, func() string { return c.Logs.ExportDir })
+	expanded, err := expandTilde(dir)
+	if err != nil {
+		log.Warn("failed to expand logs.export_dir", "dir", dir, "error", err)
+		return dir
+	}
+	return expanded
+}
+
+// S3DownloadDir returns the directory used for downloaded objects, with ~ expanded.
+// Returns empty string (current directory) if not configured.
+func (c *FileConfig) S3DownloadDir() string {
+	dir := withRLock(&c.mu, func() string { return c.S3.DownloadDir })
+	expanded, err := expandTilde(dir)
+	if err != nil {
+		log.Warn("failed to expand s3.download_dir", "dir", dir, "error", err)
+		return dir
+	}
+	return expanded
+}
+
 // MaxStackSize returns the maximum navigation stack size.
 func (c *FileConfig) MaxStackSize() int {
 	return withRLock(&c.mu, func() int {
@@ -826,3 +826,86 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestLogsConfig_Getters(t *testing.T) {
+	cfg := &FileConfig{}
+	if got := cfg.LogPipeCommand(); got != DefaultLogPipeCommand {
+		t.Errorf("LogPipeCommand() = %q, want %q", got, DefaultLogPipeCommand)
+	}
+	if got := cfg.LogExportDir(); got != "" {
+		t.Errorf("LogExportDir() = %q, want empty", got)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home dir: %v", err)
+	}
+	cfg.Logs = LogsConfig{PipeCommand: "jq -R .", ExportDir: "~/logs"}
+	if got := cfg.LogPipeCommand(); got != "jq -R ." {
+		t.Errorf("LogPipeCommand() = %q, want %q", got, "jq -R .")
+	}
+	if got, want := cfg.LogExportDir(), filepath.Join(home, "logs"); got != want {
+		t.Errorf("LogExportDir() = %q, want %q", got, want)
+	}
+}
+
+func TestS3Config_DownloadDir(t *testing.T) {
+	cfg := &FileConfig{}
+	if got := cfg.S3DownloadDir(); got != "" {
+		t.Errorf("S3DownloadDir() = %q, want empty", got)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home dir: %v", err)
+	}
+	cfg.S3 = S3Config{DownloadDir: "~/Downloads"}
+	if got, want := cfg.S3DownloadDir(), filepath.Join(home, "Downloads"); got != want {
+		t.Errorf("S3DownloadDir() = %q, want %q", got, want)
+	}
+}
+
+func TestMetricColumns(t *testing.T) {
+	yamlData := `
+cloudwatch:
+  columns:
+    sqs/queues:
+      - header: LAG(15m)
+        namespace: MyApp/Consumers
+        metric: ConsumerLag
+        dimension: QueueName
+        stat: Maximum
+        expression: "m / 60"
+      - namespace: MyApp/Business
+        metric: OrdersPlaced
+        dimension: Service
+        field: tag:Service
+      - header: broken
+        metric: MissingNamespace
+`
+	var cfg FileConfig
+	if err := yaml.Unmarshal([]byte(yamlData), &cfg); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if cols := cfg.MetricColumns("lambda", "functions"); cols != nil {
+		t.Errorf("MetricColumns(lambda/functions) = %v, want nil", cols)
+	}
+
+	cols := cfg.MetricColumns("sqs", "queues")
+	if len(cols) != 2 {
+		t.Fatalf("MetricColumns(sqs/queues) len = %d, want 2 (invalid entry skipped)", len(cols))
+	}
+	if cols[0].Header != "LAG(15m)" || cols[0].Stat != "Maximum" || cols[0].Expression != "m / 60" {
+		t.Errorf("cols[0] = %+v", cols[0])
+	}
+	if cols[1].Header != "OrdersPlaced" {
+		t.Errorf("cols[1].Header = %q, want metric name default", cols[1].Header)
+	}
+	if cols[1].Stat != DefaultMetricColumnStat {
+		t.Errorf("cols[1].Stat = %q, want %q", cols[1].Stat, DefaultMetricColumnStat)
+	}
+	if cols[1].Field != "tag:Service" {
+		t.Errorf("cols[1].Field = %q, want tag:Service", cols[1].Field)
+	}
+}
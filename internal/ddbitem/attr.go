@@ -0,0 +1,319 @@
+// Package ddbitem converts DynamoDB items for display and editing, and
+// builds Query and Scan requests for the item explorer.
+package ddbitem
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Item is one DynamoDB item
+type Item = map[string]types.AttributeValue
+
+// Plain converts an attribute value to plain JSON values: numbers become
+// json.Number, binary values base64 strings and sets arrays. The attribute
+// types are lost, so this is for display only; see DynamoJSON for editing.
+func Plain(av types.AttributeValue) any {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *types.AttributeValueMemberBOOL:
+		return v.Value
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberB:
+		return base64.StdEncoding.EncodeToString(v.Value)
+	case *types.AttributeValueMemberSS:
+		return v.Value
+	case *types.AttributeValueMemberNS:
+		nums := make([]json.Number, len(v.Value))
+		for i, n := range v.Value {
+			nums[i] = json.Number(n)
+		}
+		return nums
+	case *types.AttributeValueMemberBS:
+		bs := make([]string, len(v.Value))
+		for i, b := range v.Value {
+			bs[i] = base64.StdEncoding.EncodeToString(b)
+		}
+		return bs
+	case *types.AttributeValueMemberM:
+		return PlainItem(v.Value)
+	case *types.AttributeValueMemberL:
+		list := make([]any, len(v.Value))
+		for i, e := range v.Value {
+			list[i] = Plain(e)
+		}
+		return list
+	}
+	return nil
+}
+
+// PlainItem converts every attribute of an item with Plain
+func PlainItem(item Item) map[string]any {
+	m := make(map[string]any, len(item))
+	for k, v := range item {
+		m[k] = Plain(v)
+	}
+	return m
+}
+
+// JSON returns an item as indented plain JSON with sorted keys
+func JSON(item Item) string {
+	data, err := json.MarshalIndent(PlainItem(item), "", "  ")
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	return string(data)
+}
+
+// DynamoJSON returns an item in DynamoDB JSON, the format of the AWS CLI,
+// which keeps the attribute types: {"id": {"S": "1"}}
+func DynamoJSON(item Item) string {
+	m := make(map[string]any, len(item))
+	for k, v := range item {
+		m[k] = dynamoValue(v)
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	return string(data)
+}
+
+func dynamoValue(av types.AttributeValue) map[string]any {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]any{"S": v.Value}
+	case *types.AttributeValueMemberN:
+		return map[string]any{"N": v.Value}
+	case *types.AttributeValueMemberBOOL:
+		return map[string]any{"BOOL": v.Value}
+	case *types.AttributeValueMemberNULL:
+		return map[string]any{"NULL": true}
+	case *types.AttributeValueMemberB:
+		return map[string]any{"B": v.Value} // []byte marshals as base64
+	case *types.AttributeValueMemberSS:
+		return map[string]any{"SS": v.Value}
+	case *types.AttributeValueMemberNS:
+		return map[string]any{"NS": v.Value}
+	case *types.AttributeValueMemberBS:
+		return map[string]any{"BS": v.Value}
+	case *types.AttributeValueMemberM:
+		m := make(map[string]any, len(v.Value))
+		for k, e := range v.Value {
+			m[k] = dynamoValue(e)
+		}
+		return map[string]any{"M": m}
+	case *types.AttributeValueMemberL:
+		list := make([]any, len(v.Value))
+		for i, e := range v.Value {
+			list[i] = dynamoValue(e)
+		}
+		return map[string]any{"L": list}
+	}
+	return map[string]any{"NULL": true}
+}
+
+// ParseDynamoJSON parses an item in DynamoDB JSON
+func ParseDynamoJSON(data string) (Item, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if raw == nil {
+		return nil, errors.New("item must be a JSON object")
+	}
+	item := make(Item, len(raw))
+	for name, value := range raw {
+		av, err := parseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		item[name] = av
+	}
+	return item, nil
+}
+
+func parseValue(data json.RawMessage) (types.AttributeValue, error) {
+	var typed map[string]json.RawMessage
+	if err := json.Unmarshal(data, &typed); err != nil || len(typed) != 1 {
+		return nil, errors.New(`want one type key, e.g. {"S": "text"}`)
+	}
+	for typ, raw := range typed {
+		switch typ {
+		case "S":
+			var s string
+			err := json.Unmarshal(raw, &s)
+			return &types.AttributeValueMemberS{Value: s}, err
+		case "N":
+			n, err := parseNumber(raw)
+			return &types.AttributeValueMemberN{Value: n}, err
+		case "BOOL":
+			var b bool
+			err := json.Unmarshal(raw, &b)
+			return &types.AttributeValueMemberBOOL{Value: b}, err
+		case "NULL":
+			return &types.AttributeValueMemberNULL{Value: true}, nil
+		case "B":
+			var b []byte
+			err := json.Unmarshal(raw, &b)
+			return &types.AttributeValueMemberB{Value: b}, err
+		case "SS":
+			var ss []string
+			err := json.Unmarshal(raw, &ss)
+			return &types.AttributeValueMemberSS{Value: ss}, err
+		case "NS":
+			var raws []json.RawMessage
+			if err := json.Unmarshal(raw, &raws); err != nil {
+				return nil, err
+			}
+			ns := make([]string, len(raws))
+			for i, r := range raws {
+				n, err := parseNumber(r)
+				if err != nil {
+					return nil, err
+				}
+				ns[i] = n
+			}
+			return &types.AttributeValueMemberNS{Value: ns}, nil
+		case "BS":
+			var bs [][]byte
+			err := json.Unmarshal(raw, &bs)
+			return &types.AttributeValueMemberBS{Value: bs}, err
+		case "M":
+			var m map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &m); err != nil {
+				return nil, err
+			}
+			item := make(Item, len(m))
+			for k, v := range m {
+				av, err := parseValue(v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				item[k] = av
+			}
+			return &types.AttributeValueMemberM{Value: item}, nil
+		case "L":
+			var raws []json.RawMessage
+			if err := json.Unmarshal(raw, &raws); err != nil {
+				return nil, err
+			}
+			list := make([]types.AttributeValue, len(raws))
+			for i, r := range raws {
+				av, err := parseValue(r)
+				if err != nil {
+					return nil, fmt.Errorf("[%d]: %w", i, err)
+				}
+				list[i] = av
+			}
+			return &types.AttributeValueMemberL{Value: list}, nil
+		default:
+			return nil, fmt.Errorf("unknown type %q", typ)
+		}
+	}
+	return nil, nil
+}
+
+// parseNumber accepts a number as a JSON string, like the AWS CLI, or as a
+// bare JSON number
+func parseNumber(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return "", errors.New("N must be a number")
+		}
+		s = n.String()
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return "", fmt.Errorf("invalid number %q", s)
+	}
+	return s, nil
+}
+
+// Cell returns a one-line rendering of a value for a table cell
+func Cell(av types.AttributeValue) string {
+	switch v := av.(type) {
+	case nil:
+		return ""
+	case *types.AttributeValueMemberS:
+		return strings.ReplaceAll(v.Value, "\n", " ")
+	case *types.AttributeValueMemberN:
+		return v.Value
+	case *types.AttributeValueMemberBOOL:
+		return strconv.FormatBool(v.Value)
+	case *types.AttributeValueMemberNULL:
+		return "null"
+	case *types.AttributeValueMemberB:
+		return fmt.Sprintf("<binary %d bytes>", len(v.Value))
+	}
+	data, err := json.Marshal(Plain(av))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Key returns the key attributes of an item
+func Key(item Item, names ...string) Item {
+	key := make(Item, len(names))
+	for _, n := range names {
+		if v, ok := item[n]; ok && n != "" {
+			key[n] = v
+		}
+	}
+	return key
+}
+
+// KeyString formats key attributes as name=value, in the order of names
+func KeyString(key Item, names ...string) string {
+	var parts []string
+	for _, n := range names {
+		if v, ok := key[n]; ok {
+			parts = append(parts, n+"="+Cell(v))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Columns orders the attribute names found in items: keys first, in order,
+// then the other attributes by how many items have them, then by name
+func Columns(items []Item, keys []string) []string {
+	counts := make(map[string]int)
+	for _, item := range items {
+		for name := range item {
+			counts[name]++
+		}
+	}
+
+	var cols []string
+	for _, k := range keys {
+		if _, ok := counts[k]; ok && !slices.Contains(cols, k) {
+			cols = append(cols, k)
+		}
+	}
+	var rest []string
+	for name := range counts {
+		if !slices.Contains(cols, name) {
+			rest = append(rest, name)
+		}
+	}
+	slices.SortFunc(rest, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		return strings.Compare(a, b)
+	})
+	return append(cols, rest...)
+}
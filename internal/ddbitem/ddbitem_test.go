@@ -0,0 +1,290 @@
+package ddbitem
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestDynamoJSONRoundTrip(t *testing.T) {
+	item := Item{
+		"id":    &types.AttributeValueMemberS{Value: "a-1"},
+		"count": &types.AttributeValueMemberN{Value: "42"},
+		"ok":    &types.AttributeValueMemberBOOL{Value: true},
+		"none":  &types.AttributeValueMemberNULL{Value: true},
+		"blob":  &types.AttributeValueMemberB{Value: []byte("hi")},
+		"tags":  &types.AttributeValueMemberSS{Value: []string{"x", "y"}},
+		"nums":  &types.AttributeValueMemberNS{Value: []string{"1", "2.5"}},
+		"meta": &types.AttributeValueMemberM{Value: Item{
+			"list": &types.AttributeValueMemberL{Value: []types.AttributeValue{
+				&types.AttributeValueMemberS{Value: "z"},
+			}},
+		}},
+	}
+	got, err := ParseDynamoJSON(DynamoJSON(item))
+	if err != nil {
+		t.Fatalf("ParseDynamoJSON() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, item) {
+		t.Errorf("round trip = %#v, want %#v", got, item)
+	}
+}
+
+func TestParseDynamoJSONErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`[]`, "invalid JSON"},
+		{`null`, "JSON object"},
+		{`{"id": "plain"}`, "id: want one type key"},
+		{`{"id": {"X": "1"}}`, `unknown type "X"`},
+		{`{"n": {"N": "abc"}}`, "invalid number"},
+		{`{"m": {"M": {"inner": {"S": 1}}}}`, "m: inner:"},
+	}
+	for _, tt := range tests {
+		_, err := ParseDynamoJSON(tt.input)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseDynamoJSON(%s) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+
+	// Bare JSON numbers are accepted too
+	item, err := ParseDynamoJSON(`{"n": {"N": 7}}`)
+	if err != nil || item["n"].(*types.AttributeValueMemberN).Value != "7" {
+		t.Errorf("bare number = %v, %v", item, err)
+	}
+}
+
+func TestCell(t *testing.T) {
+	tests := []struct {
+		av   types.AttributeValue
+		want string
+	}{
+		{nil, ""},
+		{&types.AttributeValueMemberS{Value: "a\nb"}, "a b"},
+		{&types.AttributeValueMemberN{Value: "1.5"}, "1.5"},
+		{&types.AttributeValueMemberBOOL{Value: false}, "false"},
+		{&types.AttributeValueMemberNULL{Value: true}, "null"},
+		{&types.AttributeValueMemberB{Value: []byte("abc")}, "<binary 3 bytes>"},
+		{&types.AttributeValueMemberNS{Value: []string{"1", "2"}}, "[1,2]"},
+		{&types.AttributeValueMemberM{Value: Item{"k": &types.AttributeValueMemberS{Value: "v"}}}, `{"k":"v"}`},
+	}
+	for _, tt := range tests {
+		if got := Cell(tt.av); got != tt.want {
+			t.Errorf("Cell(%#v) = %q, want %q", tt.av, got, tt.want)
+		}
+	}
+}
+
+func TestKeyString(t *testing.T) {
+	item := Item{
+		"pk":    &types.AttributeValueMemberS{Value: "user#1"},
+		"sk":    &types.AttributeValueMemberN{Value: "3"},
+		"other": &types.AttributeValueMemberS{Value: "x"},
+	}
+	key := Key(item, "pk", "sk")
+	if len(key) != 2 {
+		t.Fatalf("Key() = %v, want 2 attributes", key)
+	}
+	if got := KeyString(key, "pk", "sk"); got != "pk=user#1, sk=3" {
+		t.Errorf("KeyString() = %q", got)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	items := []Item{
+		{"pk": &types.AttributeValueMemberS{}, "b": &types.AttributeValueMemberS{}, "a": &types.AttributeValueMemberS{}},
+		{"pk": &types.AttributeValueMemberS{}, "b": &types.AttributeValueMemberS{}, "c": &types.AttributeValueMemberS{}},
+	}
+	got := Columns(items, []string{"pk", "sk"})
+	want := []string{"pk", "b", "a", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Columns() = %v, want %v", got, want)
+	}
+}
+
+func testSchema() Schema {
+	return NewSchema(types.TableDescription{
+		TableName: aws.String("orders"),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("sk"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("tenant"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("created"), AttributeType: types.ScalarAttributeTypeN},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+			{AttributeName: aws.String("sk"), KeyType: types.KeyTypeRange},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{{
+			IndexName: aws.String("byTenant"),
+			KeySchema: []types.KeySchemaElement{
+				{AttributeName: aws.String("tenant"), KeyType: types.KeyTypeHash},
+				{AttributeName: aws.String("created"), KeyType: types.KeyTypeRange},
+			},
+		}},
+	})
+}
+
+func TestNewSchema(t *testing.T) {
+	s := testSchema()
+	if len(s.Indexes) != 2 {
+		t.Fatalf("Indexes = %v, want 2", s.Indexes)
+	}
+	if got := s.Indexes[0].Label(); got != "table (pk, sk)" {
+		t.Errorf("table Label() = %q", got)
+	}
+	if got := s.Indexes[1].Label(); got != "GSI byTenant (tenant, created)" {
+		t.Errorf("GSI Label() = %q", got)
+	}
+	if got := s.KeyNames(); !reflect.DeepEqual(got, []string{"pk", "sk"}) {
+		t.Errorf("KeyNames() = %v", got)
+	}
+	if got := s.AllKeyNames(); !reflect.DeepEqual(got, []string{"pk", "sk", "tenant", "created"}) {
+		t.Errorf("AllKeyNames() = %v", got)
+	}
+}
+
+func TestBuildQuery(t *testing.T) {
+	s := testSchema()
+	tests := []struct {
+		name   string
+		q      Query
+		keys   string
+		filter string
+		index  string
+	}{
+		{
+			name: "partition key only",
+			q:    Query{Index: s.Indexes[0], PartitionKey: "user#1"},
+			keys: "#n0 = :v0",
+		},
+		{
+			name: "sort key equality",
+			q:    Query{Index: s.Indexes[0], PartitionKey: "user#1", SortKey: "ORDER#1"},
+			keys: "#n0 = :v0 AND #n1 = :v1",
+		},
+		{
+			name: "begins_with",
+			q:    Query{Index: s.Indexes[0], PartitionKey: "user#1", SortKey: "begins_with ORDER#"},
+			keys: "#n0 = :v0 AND begins_with(#n1, :v1)",
+		},
+		{
+			name:  "between on GSI",
+			q:     Query{Index: s.Indexes[1], PartitionKey: "acme", SortKey: "between 1 9"},
+			keys:  "#n0 = :v0 AND #n1 BETWEEN :v1 AND :v2",
+			index: "byTenant",
+		},
+		{
+			name:   "with filter",
+			q:      Query{Index: s.Indexes[0], PartitionKey: "user#1", Filter: "status = active and total >= 10"},
+			keys:   "#n2 = :v2",
+			filter: "#n0 = :v0 AND #n1 >= :v1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := Build(s, tt.q, 50)
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if req.Query == nil || req.Scan != nil {
+				t.Fatalf("Build() = %+v, want a Query", req)
+			}
+			in := req.Query
+			if *in.KeyConditionExpression != tt.keys {
+				t.Errorf("KeyConditionExpression = %q, want %q", *in.KeyConditionExpression, tt.keys)
+			}
+			if tt.filter != "" && (in.FilterExpression == nil || *in.FilterExpression != tt.filter) {
+				t.Errorf("FilterExpression = %v, want %q", in.FilterExpression, tt.filter)
+			}
+			if got := aws.ToString(in.IndexName); got != tt.index {
+				t.Errorf("IndexName = %q, want %q", got, tt.index)
+			}
+			if aws.ToInt32(in.Limit) != 50 {
+				t.Errorf("Limit = %v, want 50", in.Limit)
+			}
+		})
+	}
+}
+
+func TestBuildTypesKeyValues(t *testing.T) {
+	s := testSchema()
+	req, err := Build(s, Query{Index: s.Indexes[1], PartitionKey: "acme", SortKey: "> 100"}, 10)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	in := req.Query
+	if *in.KeyConditionExpression != "#n0 = :v0 AND #n1 > :v1" {
+		t.Errorf("KeyConditionExpression = %q", *in.KeyConditionExpression)
+	}
+	if _, ok := in.ExpressionAttributeValues[":v1"].(*types.AttributeValueMemberN); !ok {
+		t.Errorf(":v1 = %#v, want a number", in.ExpressionAttributeValues[":v1"])
+	}
+	if in.ExpressionAttributeNames["#n1"] != "created" {
+		t.Errorf("names = %v", in.ExpressionAttributeNames)
+	}
+
+	if _, err := Build(s, Query{Index: s.Indexes[1], PartitionKey: "acme", SortKey: "> soon"}, 10); err == nil {
+		t.Error("Build() with a non-numeric number key should fail")
+	}
+}
+
+func TestBuildScan(t *testing.T) {
+	s := testSchema()
+	req, err := Build(s, Query{Scan: true, Filter: `name begins_with "A" AND deleted not_exists AND n != 3 AND ok = true`}, 25)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if req.Scan == nil || req.Query != nil {
+		t.Fatalf("Build() = %+v, want a Scan", req)
+	}
+	in := req.Scan
+	want := "begins_with(#n0, :v0) AND attribute_not_exists(#n1) AND #n2 <> :v1 AND #n3 = :v2"
+	if aws.ToString(in.FilterExpression) != want {
+		t.Errorf("FilterExpression = %q, want %q", aws.ToString(in.FilterExpression), want)
+	}
+	if v, ok := in.ExpressionAttributeValues[":v0"].(*types.AttributeValueMemberS); !ok || v.Value != "A" {
+		t.Errorf(":v0 = %#v, want string A", in.ExpressionAttributeValues[":v0"])
+	}
+	if _, ok := in.ExpressionAttributeValues[":v1"].(*types.AttributeValueMemberN); !ok {
+		t.Errorf(":v1 = %#v, want a number", in.ExpressionAttributeValues[":v1"])
+	}
+	if _, ok := in.ExpressionAttributeValues[":v2"].(*types.AttributeValueMemberBOOL); !ok {
+		t.Errorf(":v2 = %#v, want a bool", in.ExpressionAttributeValues[":v2"])
+	}
+
+	plain, err := Build(s, Query{Scan: true}, 25)
+	if err != nil || plain.Scan.FilterExpression != nil || plain.Scan.ExpressionAttributeNames != nil {
+		t.Errorf("unfiltered scan = %+v, %v", plain.Scan, err)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	s := testSchema()
+	noSort := Index{PartitionKey: "pk"}
+	tests := []struct {
+		name string
+		q    Query
+		want string
+	}{
+		{"missing partition key", Query{Index: s.Indexes[0]}, "pk value is required"},
+		{"no sort key", Query{Index: noSort, PartitionKey: "a", SortKey: "b"}, "has no sort key"},
+		{"between one value", Query{Index: s.Indexes[0], PartitionKey: "a", SortKey: "between x"}, "two values"},
+		{"unsupported sort op", Query{Index: s.Indexes[0], PartitionKey: "a", SortKey: "<> x"}, "do not support"},
+		{"bad filter", Query{Scan: true, Filter: "status"}, "want attr op value"},
+		{"filter without value", Query{Scan: true, Filter: "status ="}, "needs a value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Build(s, tt.q, 10)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Build() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package ddbitem
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// Index is the table's primary key or one of its secondary indexes
+type Index struct {
+	Name         string // empty for the table itself
+	Kind         string // "table", "GSI" or "LSI"
+	PartitionKey string
+	SortKey      string
+}
+
+// Label describes the index and its key attributes
+func (i Index) Label() string {
+	keys := i.PartitionKey
+	if i.SortKey != "" {
+		keys += ", " + i.SortKey
+	}
+	if i.Name == "" {
+		return fmt.Sprintf("table (%s)", keys)
+	}
+	return fmt.Sprintf("%s %s (%s)", i.Kind, i.Name, keys)
+}
+
+// Schema is what the item explorer needs to know about a table
+type Schema struct {
+	Table          string
+	Indexes        []Index // the table first
+	AttributeTypes map[string]types.ScalarAttributeType
+}
+
+// NewSchema reads the keys and indexes of a table
+func NewSchema(table types.TableDescription) Schema {
+	s := Schema{AttributeTypes: make(map[string]types.ScalarAttributeType)}
+	if table.TableName != nil {
+		s.Table = *table.TableName
+	}
+	for _, def := range table.AttributeDefinitions {
+		if def.AttributeName != nil {
+			s.AttributeTypes[*def.AttributeName] = def.AttributeType
+		}
+	}
+	s.Indexes = append(s.Indexes, newIndex("", "table", table.KeySchema))
+	for _, gsi := range table.GlobalSecondaryIndexes {
+		s.Indexes = append(s.Indexes, newIndex(deref(gsi.IndexName), "GSI", gsi.KeySchema))
+	}
+	for _, lsi := range table.LocalSecondaryIndexes {
+		s.Indexes = append(s.Indexes, newIndex(deref(lsi.IndexName), "LSI", lsi.KeySchema))
+	}
+	return s
+}
+
+func newIndex(name, kind string, schema []types.KeySchemaElement) Index {
+	idx := Index{Name: name, Kind: kind}
+	for _, k := range schema {
+		switch k.KeyType {
+		case types.KeyTypeHash:
+			idx.PartitionKey = deref(k.AttributeName)
+		case types.KeyTypeRange:
+			idx.SortKey = deref(k.AttributeName)
+		}
+	}
+	return idx
+}
+
+// KeyNames returns the table's key attributes: partition key, then sort key
+func (s Schema) KeyNames() []string {
+	if len(s.Indexes) == 0 {
+		return nil
+	}
+	t := s.Indexes[0]
+	if t.SortKey == "" {
+		return []string{t.PartitionKey}
+	}
+	return []string{t.PartitionKey, t.SortKey}
+}
+
+// AllKeyNames returns the key attributes of the table and its indexes,
+// which the explorer shows as the first columns
+func (s Schema) AllKeyNames() []string {
+	var names []string
+	for _, idx := range s.Indexes {
+		for _, n := range []string{idx.PartitionKey, idx.SortKey} {
+			if n != "" && !contains(names, n) {
+				names = append(names, n)
+			}
+		}
+	}
+	return names
+}
+
+// Query describes a Query against an index or, with Scan set, a Scan
+type Query struct {
+	Scan         bool
+	Index        Index
+	PartitionKey string // partition key value
+	SortKey      string // sort key condition, e.g. "begins_with ORDER#"
+	Filter       string // filter conditions, e.g. "status = active AND age > 30"
+}
+
+// Request is a prepared Query or Scan; exactly one is set
+type Request struct {
+	Query *dynamodb.QueryInput
+	Scan  *dynamodb.ScanInput
+}
+
+// Build prepares the request for a query, reading up to limit items per page
+func Build(schema Schema, q Query, limit int32) (Request, error) {
+	b := newExprBuilder()
+	filter, err := b.filter(schema, q.Filter)
+	if err != nil {
+		return Request{}, err
+	}
+
+	if q.Scan {
+		input := &dynamodb.ScanInput{TableName: &schema.Table, Limit: &limit, FilterExpression: filter}
+		input.ExpressionAttributeNames, input.ExpressionAttributeValues = b.maps()
+		return Request{Scan: input}, nil
+	}
+
+	idx := q.Index
+	if strings.TrimSpace(q.PartitionKey) == "" {
+		return Request{}, fmt.Errorf("%s value is required for a query", idx.PartitionKey)
+	}
+	pk, err := keyValue(schema, idx.PartitionKey, unquote(strings.TrimSpace(q.PartitionKey)))
+	if err != nil {
+		return Request{}, err
+	}
+	keyCond := fmt.Sprintf("%s = %s", b.name(idx.PartitionKey), b.value(pk))
+	if strings.TrimSpace(q.SortKey) != "" {
+		if idx.SortKey == "" {
+			return Request{}, fmt.Errorf("%s has no sort key", idx.Label())
+		}
+		cond, err := b.sortKey(schema, idx.SortKey, q.SortKey)
+		if err != nil {
+			return Request{}, err
+		}
+		keyCond += " AND " + cond
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              &schema.Table,
+		KeyConditionExpression: &keyCond,
+		FilterExpression:       filter,
+		Limit:                  &limit,
+	}
+	if idx.Name != "" {
+		input.IndexName = &idx.Name
+	}
+	input.ExpressionAttributeNames, input.ExpressionAttributeValues = b.maps()
+	return Request{Query: input}, nil
+}
+
+// exprBuilder collects expression attribute names and values
+type exprBuilder struct {
+	names  map[string]string // placeholder -> attribute name
+	byName map[string]string // attribute name -> placeholder
+	values map[string]types.AttributeValue
+}
+
+func newExprBuilder() *exprBuilder {
+	return &exprBuilder{
+		names:  make(map[string]string),
+		byName: make(map[string]string),
+		values: make(map[string]types.AttributeValue),
+	}
+}
+
+func (b *exprBuilder) name(attr string) string {
+	if p, ok := b.byName[attr]; ok {
+		return p
+	}
+	p := fmt.Sprintf("#n%d", len(b.names))
+	b.names[p] = attr
+	b.byName[attr] = p
+	return p
+}
+
+func (b *exprBuilder) value(av types.AttributeValue) string {
+	p := fmt.Sprintf(":v%d", len(b.values))
+	b.values[p] = av
+	return p
+}
+
+func (b *exprBuilder) maps() (map[string]string, map[string]types.AttributeValue) {
+	if len(b.names) == 0 {
+		return nil, nil
+	}
+	if len(b.values) == 0 {
+		return b.names, nil
+	}
+	return b.names, b.values
+}
+
+// sortKey builds a sort key condition: "value" (equality), "op value" with
+// op one of = < <= > >= begins_with, or "between low high"
+func (b *exprBuilder) sortKey(schema Schema, attr, cond string) (string, error) {
+	op, rest := splitOp(strings.TrimSpace(cond))
+	switch op {
+	case "":
+		op, rest = "=", strings.TrimSpace(cond)
+	case "<>", "!=", "contains":
+		return "", fmt.Errorf("sort key conditions do not support %s", op)
+	}
+	if op == "between" {
+		bounds := strings.Fields(rest)
+		if len(bounds) != 2 {
+			return "", fmt.Errorf("between needs two values, e.g. between 2024-01 2024-06")
+		}
+		low, err := keyValue(schema, attr, unquote(bounds[0]))
+		if err != nil {
+			return "", err
+		}
+		high, err := keyValue(schema, attr, unquote(bounds[1]))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s BETWEEN %s AND %s", b.name(attr), b.value(low), b.value(high)), nil
+	}
+	if rest == "" {
+		return "", fmt.Errorf("%s needs a value", op)
+	}
+	av, err := keyValue(schema, attr, unquote(rest))
+	if err != nil {
+		return "", err
+	}
+	if op == "begins_with" {
+		return fmt.Sprintf("begins_with(%s, %s)", b.name(attr), b.value(av)), nil
+	}
+	return fmt.Sprintf("%s %s %s", b.name(attr), op, b.value(av)), nil
+}
+
+var andPattern = regexp.MustCompile(`(?i)\s+and\s+`)
+
+// filter builds a filter expression from conditions joined by AND. Each is
+// "attr exists", "attr not_exists" or "attr op value" with op one of
+// = <> != < <= > >= begins_with contains. Values are numbers, true, false,
+// null or strings; quote a value to keep it a string.
+func (b *exprBuilder) filter(schema Schema, filter string) (*string, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+	var parts []string
+	for _, cond := range andPattern.Split(filter, -1) {
+		attr, rest, _ := strings.Cut(strings.TrimSpace(cond), " ")
+		if attr == "" {
+			return nil, fmt.Errorf("empty condition in filter %q", filter)
+		}
+		op, value := splitOp(strings.TrimSpace(rest))
+		switch op {
+		case "exists":
+			parts = append(parts, fmt.Sprintf("attribute_exists(%s)", b.name(attr)))
+			continue
+		case "not_exists":
+			parts = append(parts, fmt.Sprintf("attribute_not_exists(%s)", b.name(attr)))
+			continue
+		case "", "between":
+			return nil, fmt.Errorf("condition %q: want attr op value, e.g. status = active", cond)
+		}
+		if value == "" {
+			return nil, fmt.Errorf("condition %q needs a value", cond)
+		}
+		av := literal(value)
+		switch op {
+		case "begins_with", "contains":
+			parts = append(parts, fmt.Sprintf("%s(%s, %s)", op, b.name(attr), b.value(av)))
+		case "!=":
+			parts = append(parts, fmt.Sprintf("%s <> %s", b.name(attr), b.value(av)))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %s %s", b.name(attr), op, b.value(av)))
+		}
+	}
+	expr := strings.Join(parts, " AND ")
+	return &expr, nil
+}
+
+var operators = []string{"<=", ">=", "<>", "!=", "=", "<", ">", "begins_with", "contains", "between", "exists", "not_exists"}
+
+// splitOp splits a leading operator from its operand
+func splitOp(s string) (string, string) {
+	for _, op := range operators {
+		rest, ok := strings.CutPrefix(s, op)
+		if !ok {
+			continue
+		}
+		// Word operators must be followed by a space or end the condition
+		if isWord(op) && rest != "" && rest[0] != ' ' {
+			continue
+		}
+		return op, strings.TrimSpace(rest)
+	}
+	return "", s
+}
+
+func isWord(op string) bool {
+	return op[0] >= 'a' && op[0] <= 'z'
+}
+
+// literal types a filter value: quoted strings stay strings, otherwise
+// numbers, booleans and null are recognized
+func literal(s string) types.AttributeValue {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return &types.AttributeValueMemberS{Value: s[1 : len(s)-1]}
+	}
+	switch s {
+	case "true", "false":
+		return &types.AttributeValueMemberBOOL{Value: s == "true"}
+	case "null":
+		return &types.AttributeValueMemberNULL{Value: true}
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return &types.AttributeValueMemberN{Value: s}
+	}
+	return &types.AttributeValueMemberS{Value: s}
+}
+
+// keyValue types a key value by the table's attribute definitions
+func keyValue(schema Schema, attr, value string) (types.AttributeValue, error) {
+	switch schema.AttributeTypes[attr] {
+	case types.ScalarAttributeTypeN:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%s is a number, got %q", attr, value)
+		}
+		return &types.AttributeValueMemberN{Value: value}, nil
+	case types.ScalarAttributeTypeB:
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is binary: want base64, got %q", attr, value)
+		}
+		return &types.AttributeValueMemberB{Value: data}, nil
+	default:
+		return &types.AttributeValueMemberS{Value: value}, nil
+	}
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// Package editor opens text in the user's editor from the TUI.
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// defaultEditor is used when neither $VISUAL nor $EDITOR is set
+const defaultEditor = "vi"
+
+// Command returns the editor command line: $VISUAL, then $EDITOR, then vi
+func Command() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{defaultEditor}
+}
+
+// Edit writes content to a temporary file with the extension ext (e.g.
+// ".json"), suspends the TUI while the editor runs, and reports the edited
+// content through done. The temporary file is removed afterwards.
+func Edit(content, ext string, done func(edited string, err error) tea.Msg) tea.Cmd {
+	f, err := os.CreateTemp("", "claws-*"+ext)
+	if err != nil {
+		return func() tea.Msg { return done("", fmt.Errorf("create temp file: %w", err)) }
+	}
+	path := f.Name()
+	_, err = f.WriteString(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return func() tea.Msg { return done("", fmt.Errorf("write temp file: %w", err)) }
+	}
+
+	args := Command()
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return done("", fmt.Errorf("run %s: %w", args[0], err))
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return done("", fmt.Errorf("read edited file: %w", err))
+		}
+		return done(string(data), nil)
+	})
+}
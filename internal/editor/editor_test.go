@@ -0,0 +1,25 @@
+package editor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		visual, editor string
+		want           []string
+	}{
+		{"", "", []string{"vi"}},
+		{"", "nano", []string{"nano"}},
+		{"code --wait", "nano", []string{"code", "--wait"}},
+		{"  ", "emacs -nw", []string{"emacs", "-nw"}},
+	}
+	for _, tt := range tests {
+		t.Setenv("VISUAL", tt.visual)
+		t.Setenv("EDITOR", tt.editor)
+		if got := Command(); !slices.Equal(got, tt.want) {
+			t.Errorf("Command() with VISUAL=%q EDITOR=%q = %v, want %v", tt.visual, tt.editor, got, tt.want)
+		}
+	}
+}
@@ -21,6 +21,7 @@ const (
 	NotFound               // Resource not found errors
 	InUse                  // Resource in use / dependency errors
 	Validation             // Input validation errors
+	Dependency             // Dependent resources must be removed first (DependencyViolation)
 )
 
 // String returns the string representation of the error kind.
@@ -36,6 +37,8 @@ func (k Kind) String() string {
 		return "InUse"
 	case Validation:
 		return "Validation"
+	case Dependency:
+		return "Dependency"
 	default:
 		return "Unknown"
 	}
@@ -53,6 +56,8 @@ func Classify(err error) Kind {
 		return Auth
 	case IsThrottling(err):
 		return Throttling
+	case IsDependencyViolation(err):
+		return Dependency
 	case IsResourceInUse(err):
 		return InUse
 	case IsValidationError(err):
@@ -131,6 +136,19 @@ func IsResourceInUse(err error) bool {
 	)
 }
 
+// IsDependencyViolation returns true if the error indicates the resource
+// cannot be deleted until dependent resources are removed.
+func IsDependencyViolation(err error) bool {
+	return hasErrorCode(err,
+		ErrCodeDependencyViolation,
+		"DeleteConflict",
+		"BucketNotEmpty",
+		"ClusterContainsServicesException",
+		"ClusterContainsTasksException",
+		"ClusterContainsContainerInstancesException",
+	)
+}
+
 // IsValidationError returns true if the error indicates invalid input.
 func IsValidationError(err error) bool {
 	return hasErrorCode(err,
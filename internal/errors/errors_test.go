@@ -28,6 +28,7 @@ func TestKindString(t *testing.T) {
 		{NotFound, "NotFound"},
 		{InUse, "InUse"},
 		{Validation, "Validation"},
+		{Dependency, "Dependency"},
 	}
 	for _, tt := range tests {
 		if got := tt.kind.String(); got != tt.want {
@@ -47,6 +48,8 @@ func TestClassify(t *testing.T) {
 		{"access denied", &mockAPIError{code: "AccessDenied"}, Auth},
 		{"throttling", &mockAPIError{code: "Throttling"}, Throttling},
 		{"in use", &mockAPIError{code: "ResourceInUseException"}, InUse},
+		{"dependency violation", &mockAPIError{code: "DependencyViolation"}, Dependency},
+		{"bucket not empty", &mockAPIError{code: "BucketNotEmpty"}, Dependency},
 		{"validation", &mockAPIError{code: "ValidationError"}, Validation},
 		{"unknown code", &mockAPIError{code: "SomeOtherError"}, Unknown},
 		{"plain error", errors.New("some error"), Unknown},
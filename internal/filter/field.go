@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// MatchesField checks if a resource matches a navigation filter
+// (render.Navigation FilterField/FilterValue).
+// DAOs may already have applied the filter from the context, so resources
+// without the field are assumed to match.
+func MatchesField(res dao.Resource, field, value string) bool {
+	// First, try matching by ID or Name with the original filter value
+	// This handles cases where ID is the full ARN (e.g., LoadBalancer, StateMachine)
+	if res.GetID() == value || res.GetName() == value {
+		return true
+	}
+
+	// Extract resource name from ARN if the filter value is an ARN
+	// e.g., "arn:aws:iam::123456789012:role/MyRole" -> "MyRole"
+	// This handles cases where ID is the resource name (e.g., IAM Role)
+	if strings.HasPrefix(value, "arn:aws:") {
+		extractedName := appaws.ExtractResourceName(value)
+		if res.GetID() == extractedName || res.GetName() == extractedName {
+			return true
+		}
+	}
+
+	// Then try field-based matching using reflection
+	data := res.Raw()
+	if data == nil {
+		// No raw data - assume DAO already filtered
+		return true
+	}
+
+	fieldValue := FieldValue(data, field)
+
+	// If field not found (empty string), assume DAO already filtered correctly
+	// This handles cases like ECS where DAO uses "ClusterName" context filter
+	// but the actual struct has "ClusterArn" field
+	if fieldValue == "" {
+		return true
+	}
+
+	return fieldValue == value
+}
+
+// FieldValue extracts a field value from an AWS resource using reflection
+func FieldValue(data any, fieldName string) string {
+	if data == nil {
+		return ""
+	}
+
+	v := reflect.ValueOf(data)
+
+	// Handle pointer
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	// Must be a struct
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	// Get the field
+	field := v.FieldByName(fieldName)
+	if !field.IsValid() {
+		return ""
+	}
+
+	// Handle pointer fields (common in AWS SDK)
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+
+	// Return string representation
+	switch field.Kind() {
+	case reflect.String:
+		return field.String()
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", field.Int())
+	case reflect.Bool:
+		return fmt.Sprintf("%v", field.Bool())
+	default:
+		return fmt.Sprintf("%v", field.Interface())
+	}
+}
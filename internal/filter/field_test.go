@@ -0,0 +1,40 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+type fieldTestData struct {
+	VpcId *string
+	Port  int32
+}
+
+func TestMatchesField(t *testing.T) {
+	vpc := "vpc-1"
+	res := &dao.BaseResource{ID: "subnet-1", Name: "app", Data: fieldTestData{VpcId: &vpc, Port: 443}}
+
+	tests := []struct {
+		name  string
+		res   dao.Resource
+		field string
+		value string
+		want  bool
+	}{
+		{"field match", res, "VpcId", "vpc-1", true},
+		{"field mismatch", res, "VpcId", "vpc-2", false},
+		{"int field", res, "Port", "443", true},
+		{"by ID", res, "SubnetId", "subnet-1", true},
+		{"by name from ARN", res, "RoleName", "arn:aws:iam::123456789012:role/app", true},
+		{"missing field assumes DAO filtered", res, "ClusterName", "prod", true},
+		{"no raw data", &dao.BaseResource{ID: "x"}, "VpcId", "vpc-2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesField(tt.res, tt.field, tt.value); got != tt.want {
+				t.Errorf("MatchesField(%s=%s) = %v, want %v", tt.field, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Package graph builds the relationships between resources.
+//
+// Neighbors of a resource come from two sources: the navigations its
+// renderer declares (render.Navigator), listed with the same field filter
+// the resource browser applies, and ARNs referenced anywhere in its raw API
+// data. The graph is expanded lazily, one resource at a time.
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/filter"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// DefaultLimit is the maximum number of resources listed per group
+const DefaultLimit = 50
+
+// listPageSize is the page size used for paginated DAOs
+const listPageSize = 100
+
+// Node is a resource in the graph
+type Node struct {
+	Ctx          context.Context // context the resource was fetched with (region, filters)
+	Service      string
+	ResourceType string
+	Resource     dao.Resource
+	// Partial is set for resources known only by ARN; Neighbors fetches
+	// them with DAO.Get before expanding.
+	Partial bool
+}
+
+// Group holds the neighbors of one kind: a navigation or an ARN reference
+type Group struct {
+	Label        string // navigation label or the JSON path of the reference
+	Service      string
+	ResourceType string
+	Nodes        []*Node
+	More         bool // more resources matched than the limit
+	Err          error
+}
+
+// Reference is an ARN found in a resource's raw data
+type Reference struct {
+	Path string // dotted path to the value, e.g. VpcConfig.SecurityGroupIds[0]
+	ARN  *aws.ARN
+}
+
+// Builder expands nodes using the registry
+type Builder struct {
+	Registry *registry.Registry
+	Limit    int // resources per group; DefaultLimit when zero
+}
+
+// NewBuilder creates a Builder
+func NewBuilder(reg *registry.Registry) *Builder {
+	return &Builder{Registry: reg, Limit: DefaultLimit}
+}
+
+// Resolve fetches a partial node's resource. Full nodes are left as is.
+func (b *Builder) Resolve(node *Node) error {
+	if !node.Partial {
+		return nil
+	}
+	d, err := b.Registry.GetDAO(node.Ctx, node.Service, node.ResourceType)
+	if err != nil {
+		return err
+	}
+	res, err := d.Get(node.Ctx, node.Resource.GetID())
+	if err != nil {
+		return err
+	}
+	node.Resource = res
+	node.Partial = false
+	return nil
+}
+
+// Neighbors returns the resources related to node, grouped by relation.
+// Navigation groups come first in renderer order, followed by ARN references.
+// Resources reachable both ways are only listed under the navigation.
+func (b *Builder) Neighbors(node *Node) ([]Group, error) {
+	if err := b.Resolve(node); err != nil {
+		return nil, err
+	}
+
+	groups := b.navigationGroups(node)
+
+	seen := make(map[string]bool)
+	seen[nodeKey(node.Service, node.ResourceType, node.Resource.GetID())] = true
+	if arn := node.Resource.GetARN(); arn != "" {
+		seen[arn] = true
+	}
+	for _, g := range groups {
+		for _, n := range g.Nodes {
+			seen[nodeKey(n.Service, n.ResourceType, n.Resource.GetID())] = true
+			if arn := n.Resource.GetARN(); arn != "" {
+				seen[arn] = true
+			}
+		}
+	}
+
+	for _, ref := range References(node.Resource.Raw()) {
+		service, resourceType := ref.ARN.ServiceResourceType()
+		if !ref.ARN.CanNavigate() || !b.Registry.HasResource(service, resourceType) {
+			continue
+		}
+		if seen[ref.ARN.Raw] ||
+			seen[nodeKey(service, resourceType, ref.ARN.ResourceIDForGet())] ||
+			seen[nodeKey(service, resourceType, ref.ARN.ShortID())] {
+			continue
+		}
+		seen[ref.ARN.Raw] = true
+
+		groups = append(groups, Group{
+			Label:        ref.Path,
+			Service:      service,
+			ResourceType: resourceType,
+			Nodes:        []*Node{b.referenceNode(node.Ctx, ref.ARN, service, resourceType)},
+		})
+	}
+
+	return groups, nil
+}
+
+// navigationGroups lists the targets of the node's navigations in parallel
+func (b *Builder) navigationGroups(node *Node) []Group {
+	renderer, err := b.Registry.GetRenderer(node.Service, node.ResourceType)
+	if err != nil {
+		return nil
+	}
+	navigator, ok := renderer.(render.Navigator)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	for _, nav := range navigator.Navigations(dao.UnwrapResource(node.Resource)) {
+		// Custom views (logs, metrics, ...) are not resources
+		if nav.ViewType != "" || !b.Registry.HasResource(nav.Service, nav.Resource) {
+			continue
+		}
+		navs = append(navs, nav)
+	}
+
+	groups := make([]Group, len(navs))
+	var wg sync.WaitGroup
+	for i, nav := range navs {
+		wg.Go(func() {
+			groups[i] = b.listNavigation(node.Ctx, nav)
+		})
+	}
+	wg.Wait()
+
+	// Filters also match by ID, which can list the node as its own neighbor
+	self := nodeKey(node.Service, node.ResourceType, node.Resource.GetID())
+	for i := range groups {
+		groups[i].Nodes = slices.DeleteFunc(groups[i].Nodes, func(n *Node) bool {
+			return nodeKey(n.Service, n.ResourceType, n.Resource.GetID()) == self
+		})
+	}
+	return groups
+}
+
+// listNavigation lists the resources a navigation leads to, filtered the
+// same way the resource browser filters them
+func (b *Builder) listNavigation(ctx context.Context, nav render.Navigation) Group {
+	group := Group{Label: nav.Label, Service: nav.Service, ResourceType: nav.Resource}
+
+	listCtx := ctx
+	if nav.FilterField != "" && nav.FilterValue != "" {
+		listCtx = dao.WithFilter(ctx, nav.FilterField, nav.FilterValue)
+	}
+
+	d, err := b.Registry.GetDAO(listCtx, nav.Service, nav.Resource)
+	if err != nil {
+		group.Err = err
+		return group
+	}
+
+	var resources []dao.Resource
+	if pd, ok := d.(dao.PaginatedDAO); ok {
+		resources, _, err = pd.ListPage(listCtx, listPageSize, "")
+	} else {
+		resources, err = d.List(listCtx)
+	}
+	if err != nil {
+		group.Err = err
+		return group
+	}
+
+	limit := b.limit()
+	for _, res := range resources {
+		if nav.FilterField != "" && nav.FilterValue != "" && !filter.MatchesField(res, nav.FilterField, nav.FilterValue) {
+			continue
+		}
+		if len(group.Nodes) == limit {
+			group.More = true
+			break
+		}
+		group.Nodes = append(group.Nodes, &Node{
+			Ctx:          listCtx,
+			Service:      nav.Service,
+			ResourceType: nav.Resource,
+			Resource:     res,
+		})
+	}
+	return group
+}
+
+// referenceNode creates a partial node for an ARN reference
+func (b *Builder) referenceNode(ctx context.Context, arn *aws.ARN, service, resourceType string) *Node {
+	if arn.Region != "" {
+		ctx = aws.WithRegionOverride(ctx, arn.Region)
+	}
+	if key, value := arn.ExtractParentFilter(); key != "" {
+		ctx = dao.WithFilter(ctx, key, value)
+	}
+	return &Node{
+		Ctx:          ctx,
+		Service:      service,
+		ResourceType: resourceType,
+		Resource: &dao.BaseResource{
+			ID:   arn.ResourceIDForGet(),
+			Name: arn.ShortID(),
+			ARN:  arn.Raw,
+		},
+		Partial: true,
+	}
+}
+
+func (b *Builder) limit() int {
+	if b.Limit > 0 {
+		return b.Limit
+	}
+	return DefaultLimit
+}
+
+func nodeKey(service, resourceType, id string) string {
+	return service + "/" + resourceType + "/" + id
+}
+
+// References returns the ARNs found in raw API data, in path order.
+// Each ARN is reported once, at its first path.
+func References(raw any) []Reference {
+	if raw == nil {
+		return nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+
+	var refs []Reference
+	seen := make(map[string]bool)
+	walk(v, "", func(path, s string) {
+		if !strings.HasPrefix(s, "arn:") || seen[s] {
+			return
+		}
+		if arn := aws.ParseARN(s); arn != nil {
+			seen[s] = true
+			refs = append(refs, Reference{Path: path, ARN: arn})
+		}
+	})
+	return refs
+}
+
+// walk calls fn for every string in v, visiting map keys in sorted order
+func walk(v any, path string, fn func(path, s string)) {
+	switch val := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			walk(val[k], p, fn)
+		}
+	case []any:
+		for i, item := range val {
+			walk(item, fmt.Sprintf("%s[%d]", path, i), fn)
+		}
+	case string:
+		fn(path, val)
+	}
+}
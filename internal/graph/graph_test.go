@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+type testSubnet struct {
+	SubnetId string
+	VpcId    string
+}
+
+type testVpc struct {
+	VpcId    string
+	OwnerArn string
+	Flow     []map[string]string
+}
+
+// testRenderer navigates from VPCs to their subnets
+type testRenderer struct {
+	render.BaseRenderer
+}
+
+func (r *testRenderer) Navigations(res dao.Resource) []render.Navigation {
+	return []render.Navigation{
+		{Key: "s", Label: "Subnets", Service: "vpc", Resource: "subnets", FilterField: "VpcId", FilterValue: res.GetID()},
+		{Key: "l", Label: "Logs", Service: "cloudwatch", Resource: "log-groups", ViewType: "log-view"},
+	}
+}
+
+// testDAO lists fixed resources and gets them by ID
+type testDAO struct {
+	dao.BaseDAO
+	items []dao.Resource
+}
+
+func (d *testDAO) List(ctx context.Context) ([]dao.Resource, error) { return d.items, nil }
+func (d *testDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	for _, r := range d.items {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("not found: %s", id)
+}
+func (d *testDAO) Delete(ctx context.Context, id string) error { return nil }
+
+func newTestRegistry() *registry.Registry {
+	reg := registry.New()
+	reg.RegisterCustom("vpc", "vpcs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &testDAO{BaseDAO: dao.NewBaseDAO("vpc", "vpcs")}, nil
+		},
+		RendererFactory: func() render.Renderer {
+			return &testRenderer{BaseRenderer: render.BaseRenderer{Service: "vpc", Resource: "vpcs"}}
+		},
+	})
+	reg.RegisterCustom("vpc", "subnets", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			var items []dao.Resource
+			for _, s := range []testSubnet{{"subnet-1", "vpc-1"}, {"subnet-2", "vpc-2"}, {"subnet-3", "vpc-1"}} {
+				items = append(items, &dao.BaseResource{ID: s.SubnetId, Name: s.SubnetId, Data: s})
+			}
+			return &testDAO{BaseDAO: dao.NewBaseDAO("vpc", "subnets"), items: items}, nil
+		},
+		RendererFactory: func() render.Renderer {
+			return &render.BaseRenderer{Service: "vpc", Resource: "subnets"}
+		},
+	})
+	reg.RegisterCustom("iam", "roles", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &testDAO{BaseDAO: dao.NewBaseDAO("iam", "roles"), items: []dao.Resource{
+				&dao.BaseResource{ID: "flow-logs", Name: "flow-logs", ARN: "arn:aws:iam::123456789012:role/flow-logs"},
+			}}, nil
+		},
+		RendererFactory: func() render.Renderer {
+			return &render.BaseRenderer{Service: "iam", Resource: "roles"}
+		},
+	})
+	return reg
+}
+
+func TestReferences(t *testing.T) {
+	raw := testVpc{
+		VpcId:    "vpc-1",
+		OwnerArn: "arn:aws:iam::123456789012:role/flow-logs",
+		Flow: []map[string]string{
+			{"Role": "arn:aws:iam::123456789012:role/flow-logs"},
+			{"Topic": "arn:aws:sns:us-east-1:123456789012:alerts", "Name": "not-an-arn"},
+		},
+	}
+
+	refs := References(raw)
+	if len(refs) != 2 {
+		t.Fatalf("References() = %d refs, want 2", len(refs))
+	}
+	// Each ARN once, at its first path in sorted key order
+	if refs[0].Path != "Flow[0].Role" || refs[0].ARN.ResourceID != "flow-logs" {
+		t.Errorf("refs[0] = %s %s, want Flow[0].Role", refs[0].Path, refs[0].ARN.Raw)
+	}
+	if refs[1].Path != "Flow[1].Topic" || refs[1].ARN.Service != "sns" {
+		t.Errorf("refs[1] = %s %s, want Flow[1].Topic", refs[1].Path, refs[1].ARN.Raw)
+	}
+
+	if refs := References(nil); refs != nil {
+		t.Errorf("References(nil) = %v, want nil", refs)
+	}
+}
+
+func TestBuilderNeighbors(t *testing.T) {
+	b := NewBuilder(newTestRegistry())
+	root := &Node{
+		Ctx:          context.Background(),
+		Service:      "vpc",
+		ResourceType: "vpcs",
+		Resource: &dao.BaseResource{ID: "vpc-1", Name: "main", Data: testVpc{
+			VpcId:    "vpc-1",
+			OwnerArn: "arn:aws:iam::123456789012:role/flow-logs",
+			Flow:     []map[string]string{{"Topic": "arn:aws:sns:us-east-1:123456789012:alerts"}},
+		}},
+	}
+
+	groups, err := b.Neighbors(root)
+	if err != nil {
+		t.Fatalf("Neighbors() error: %v", err)
+	}
+	// Subnets navigation, then the role reference; the log view and the
+	// unregistered SNS topic are skipped
+	if len(groups) != 2 {
+		t.Fatalf("groups = %d, want 2: %+v", len(groups), groups)
+	}
+
+	subnets := groups[0]
+	if subnets.Label != "Subnets" || len(subnets.Nodes) != 2 {
+		t.Fatalf("subnets group = %s with %d nodes, want 2 subnets of vpc-1", subnets.Label, len(subnets.Nodes))
+	}
+	if subnets.Nodes[0].Resource.GetID() != "subnet-1" || subnets.Nodes[1].Resource.GetID() != "subnet-3" {
+		t.Errorf("subnets = %s, %s", subnets.Nodes[0].Resource.GetID(), subnets.Nodes[1].Resource.GetID())
+	}
+	if got := dao.GetFilterFromContext(subnets.Nodes[0].Ctx, "VpcId"); got != "vpc-1" {
+		t.Errorf("subnet ctx filter = %q, want vpc-1", got)
+	}
+
+	role := groups[1]
+	if role.Label != "OwnerArn" || role.Service != "iam" || role.ResourceType != "roles" {
+		t.Fatalf("role group = %+v", role)
+	}
+	node := role.Nodes[0]
+	if !node.Partial || node.Resource.GetID() != "flow-logs" {
+		t.Fatalf("role node = %+v, want partial flow-logs", node)
+	}
+
+	// Partial nodes are fetched before expanding
+	if _, err := b.Neighbors(node); err != nil {
+		t.Fatalf("Neighbors(role) error: %v", err)
+	}
+	if node.Partial || node.Resource.GetARN() != "arn:aws:iam::123456789012:role/flow-logs" {
+		t.Errorf("role node should be resolved, got %+v", node.Resource)
+	}
+}
+
+func TestBuilderLimit(t *testing.T) {
+	b := &Builder{Registry: newTestRegistry(), Limit: 1}
+	root := &Node{
+		Ctx:          context.Background(),
+		Service:      "vpc",
+		ResourceType: "vpcs",
+		Resource:     &dao.BaseResource{ID: "vpc-1", Data: testVpc{VpcId: "vpc-1"}},
+	}
+
+	groups, err := b.Neighbors(root)
+	if err != nil {
+		t.Fatalf("Neighbors() error: %v", err)
+	}
+	if len(groups) != 1 || len(groups[0].Nodes) != 1 || !groups[0].More {
+		t.Errorf("groups = %+v, want one subnet and More", groups)
+	}
+}
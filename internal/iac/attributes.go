@@ -0,0 +1,64 @@
+package iac
+
+import "strconv"
+
+// AttributeKind is the type of a string-valued API attribute
+type AttributeKind int
+
+const (
+	StringAttribute AttributeKind = iota
+	IntAttribute
+	BoolAttribute
+	JSONAttribute
+)
+
+// Attribute maps an attribute of a string map (SQS queue and SNS topic
+// attributes) to its Terraform and CloudFormation names. An empty name
+// leaves the attribute out of that format.
+type Attribute struct {
+	Name           string
+	Terraform      string
+	CloudFormation string
+	Kind           AttributeKind
+}
+
+// SetAttributes converts attrs with the table and sets them on the
+// Terraform and CloudFormation bodies, in table order
+func SetAttributes(tf, cfn *Body, attrs map[string]string, table []Attribute) error {
+	for _, a := range table {
+		raw, ok := attrs[a.Name]
+		if !ok || raw == "" {
+			continue
+		}
+		var value any = raw
+		switch a.Kind {
+		case IntAttribute:
+			if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+				value = n
+			}
+		case BoolAttribute:
+			if b, err := strconv.ParseBool(raw); err == nil {
+				value = b
+			}
+		case JSONAttribute:
+			doc, err := DecodeDocument(raw)
+			if err != nil {
+				return err
+			}
+			if a.Terraform != "" {
+				tf.Set(a.Terraform, JSONEncode(doc))
+			}
+			if a.CloudFormation != "" {
+				cfn.Set(a.CloudFormation, doc)
+			}
+			continue
+		}
+		if a.Terraform != "" {
+			tf.Set(a.Terraform, value)
+		}
+		if a.CloudFormation != "" {
+			cfn.Set(a.CloudFormation, value)
+		}
+	}
+	return nil
+}
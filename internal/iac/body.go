@@ -0,0 +1,93 @@
+package iac
+
+import "reflect"
+
+// Body is an ordered list of attributes and nested blocks. In Terraform
+// nested blocks use block syntax (ingress { ... }); in CloudFormation
+// every entry is a mapping key.
+//
+// Values can be strings, bools, numbers, slices, maps, nested *Body values
+// (objects), Ref and Call.
+type Body struct {
+	items []bodyItem
+}
+
+type bodyItem struct {
+	name  string
+	value any
+	block *Body // set for nested blocks
+}
+
+// Ref is a Terraform expression written as is, e.g. aws_iam_role.app.name
+type Ref string
+
+// Call is a Terraform function call with one argument, e.g. jsonencode({...}).
+// CloudFormation gets the argument itself.
+type Call struct {
+	Func string
+	Arg  any
+}
+
+// JSONEncode wraps a decoded JSON document in jsonencode()
+func JSONEncode(doc any) Call {
+	return Call{Func: "jsonencode", Arg: doc}
+}
+
+// NewBody creates an empty body
+func NewBody() *Body {
+	return &Body{}
+}
+
+// Set adds an attribute. Nil values, empty strings and empty collections
+// are skipped; zero numbers and false are kept.
+func (b *Body) Set(name string, value any) *Body {
+	if !isEmpty(value) {
+		b.items = append(b.items, bodyItem{name: name, value: value})
+	}
+	return b
+}
+
+// Block adds a nested block and returns it
+func (b *Body) Block(name string) *Body {
+	child := NewBody()
+	b.items = append(b.items, bodyItem{name: name, block: child})
+	return child
+}
+
+// Len returns the number of attributes and blocks
+func (b *Body) Len() int {
+	return len(b.items)
+}
+
+// Get returns the value of the first attribute with the given name
+func (b *Body) Get(name string) (any, bool) {
+	for _, item := range b.items {
+		if item.name == name && item.block == nil {
+			return item.value, true
+		}
+	}
+	return nil, false
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case *Body:
+		return v == nil || v.Len() == 0
+	case Ref:
+		return v == ""
+	case Call:
+		return false
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		return rv.IsNil()
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return false
+}
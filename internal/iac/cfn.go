@@ -0,0 +1,176 @@
+package iac
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// importEntry is one element of the --resources-to-import file of an
+// IMPORT change set
+type importEntry struct {
+	ResourceType       string         `json:"ResourceType"`
+	LogicalResourceID  string         `json:"LogicalResourceId"`
+	ResourceIdentifier map[string]any `json:"ResourceIdentifier"`
+}
+
+// renderCloudFormation writes the blocks as a template Resources section.
+// Resources keep DeletionPolicy Retain, which import requires, and the
+// resources-to-import list follows as a comment.
+func renderCloudFormation(blocks []*Block) (string, error) {
+	resources := &yaml.Node{Kind: yaml.MappingNode}
+	var imports []importEntry
+	for _, block := range blocks {
+		res := block.CloudFormation
+		if res == nil {
+			continue
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: res.LogicalID}
+		var comments []string
+		if block.Name != "" {
+			comments = append(comments, block.Name)
+		}
+		for _, note := range block.Notes {
+			comments = append(comments, "NOTE: "+note)
+		}
+		key.HeadComment = strings.Join(comments, "\n")
+
+		def := NewBody().
+			Set("Type", res.Type).
+			Set("DeletionPolicy", "Retain").
+			Set("UpdateReplacePolicy", "Retain").
+			Set("Properties", res.Properties)
+		resources.Content = append(resources.Content, key, yamlValue(def))
+
+		if res.Identifier != nil {
+			imports = append(imports, importEntry{
+				ResourceType:       res.Type,
+				LogicalResourceID:  res.LogicalID,
+				ResourceIdentifier: plainValue(res.Identifier).(map[string]any),
+			})
+		}
+	}
+
+	doc := &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{
+		{Kind: yaml.ScalarNode, Value: "Resources"}, resources,
+	}}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+
+	if len(imports) > 0 {
+		data, err := json.MarshalIndent(imports, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString("\n# Resources to import, e.g. aws cloudformation create-change-set --change-set-type IMPORT \\\n")
+		buf.WriteString("#   --resources-to-import file://import.json:\n")
+		for _, l := range strings.Split(string(data), "\n") {
+			buf.WriteString("# " + l + "\n")
+		}
+	}
+	return buf.String(), nil
+}
+
+// yamlValue builds a YAML node, keeping the order of Body entries
+func yamlValue(value any) *yaml.Node {
+	switch v := normalize(value).(type) {
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	case Ref:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: string(v)}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}
+	case int64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(v, 10)}
+	case float64:
+		// Decoded JSON numbers are float64; whole numbers stay integers
+		tag := "!!float"
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			tag = "!!int"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: strconv.FormatFloat(v, 'f', -1, 64)}
+	case Call:
+		return yamlValue(v.Arg)
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, item := range v {
+			node.Content = append(node.Content, yamlValue(item))
+		}
+		return node
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, k := range keys {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: k}, yamlValue(v[k]))
+		}
+		return node
+	case *Body:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, item := range v.items {
+			var child *yaml.Node
+			if item.block != nil {
+				child = yamlValue(item.block)
+			} else {
+				child = yamlValue(item.value)
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: item.name}, child)
+		}
+		return node
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: fmt.Sprint(v)}
+	}
+}
+
+// plainValue converts a value to plain Go values for JSON encoding
+func plainValue(value any) any {
+	switch v := normalize(value).(type) {
+	case Call:
+		return plainValue(v.Arg)
+	case Ref:
+		return string(v)
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = plainValue(item)
+		}
+		return items
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[k] = plainValue(item)
+		}
+		return m
+	case *Body:
+		m := make(map[string]any, v.Len())
+		for _, item := range v.items {
+			if item.block != nil {
+				m[item.name] = plainValue(item.block)
+			} else {
+				m[item.name] = plainValue(item.value)
+			}
+		}
+		return m
+	default:
+		return v
+	}
+}
@@ -0,0 +1,264 @@
+package iac
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// inlineListWidth is the longest list of scalars written on one line
+const inlineListWidth = 80
+
+var hclIdent = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// renderTerraform writes the blocks as resource blocks, each followed by
+// the import blocks of its resources
+func renderTerraform(blocks []*Block) string {
+	var sb strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		writeComments(&sb, block)
+		for j, res := range block.Terraform {
+			if j > 0 {
+				sb.WriteString("\n")
+			}
+			fmt.Fprintf(&sb, "resource %s %s {\n", hclString(res.Type), hclString(res.Name))
+			writeHCLBody(&sb, res.Body, 1)
+			sb.WriteString("}\n")
+		}
+		for _, res := range block.Terraform {
+			if res.ImportID == "" {
+				continue
+			}
+			fmt.Fprintf(&sb, "\nimport {\n  to = %s\n  id = %s\n}\n", res.Address(), hclString(res.ImportID))
+		}
+	}
+	return sb.String()
+}
+
+func writeComments(sb *strings.Builder, block *Block) {
+	if block.Name != "" {
+		sb.WriteString("# " + block.Name + "\n")
+	}
+	for _, note := range block.Notes {
+		sb.WriteString("# NOTE: " + note + "\n")
+	}
+}
+
+// writeHCLBody writes attributes and nested blocks, aligning the equals
+// signs of consecutive single-line attributes the way terraform fmt does
+func writeHCLBody(sb *strings.Builder, body *Body, depth int) {
+	if body == nil {
+		return
+	}
+	indent := strings.Repeat("  ", depth)
+
+	type line struct {
+		name, value string
+	}
+	var run []line
+	flush := func() {
+		width := 0
+		for _, l := range run {
+			width = max(width, len(l.name))
+		}
+		for _, l := range run {
+			fmt.Fprintf(sb, "%s%-*s = %s\n", indent, width, l.name, l.value)
+		}
+		run = nil
+	}
+
+	for i, item := range body.items {
+		if item.block != nil {
+			flush()
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(indent + item.name + " {\n")
+			writeHCLBody(sb, item.block, depth+1)
+			sb.WriteString(indent + "}\n")
+			continue
+		}
+		if i > 0 && body.items[i-1].block != nil {
+			sb.WriteString("\n")
+		}
+		name := item.name
+		if !hclIdent.MatchString(name) {
+			name = hclString(name)
+		}
+		value := hclValue(item.value, depth)
+		if strings.Contains(value, "\n") {
+			// Multi-line values are not aligned with their neighbors
+			flush()
+			run = append(run, line{name, value})
+			flush()
+			continue
+		}
+		run = append(run, line{name, value})
+	}
+	flush()
+}
+
+// hclValue renders a value as an HCL expression at the given nesting depth
+func hclValue(value any, depth int) string {
+	switch v := normalize(value).(type) {
+	case nil:
+		return "null"
+	case string:
+		return hclString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case Ref:
+		return string(v)
+	case Call:
+		return v.Func + "(" + hclValue(v.Arg, depth) + ")"
+	case []any:
+		return hclList(v, depth)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		body := NewBody()
+		for _, k := range keys {
+			body.items = append(body.items, bodyItem{name: k, value: v[k]})
+		}
+		return hclObject(body, depth)
+	case *Body:
+		return hclObject(v, depth)
+	default:
+		return hclString(fmt.Sprint(v))
+	}
+}
+
+func hclList(items []any, depth int) string {
+	if len(items) == 0 {
+		return "[]"
+	}
+	parts := make([]string, len(items))
+	inline := true
+	width := 0
+	for i, item := range items {
+		parts[i] = hclValue(item, depth+1)
+		width += len(parts[i]) + 2
+		switch normalize(item).(type) {
+		case []any, map[string]any, *Body, Call:
+			inline = false
+		}
+	}
+	if inline && width <= inlineListWidth {
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	indent := strings.Repeat("  ", depth)
+	var sb strings.Builder
+	sb.WriteString("[\n")
+	for _, p := range parts {
+		sb.WriteString(indent + "  " + p + ",\n")
+	}
+	sb.WriteString(indent + "]")
+	return sb.String()
+}
+
+func hclObject(body *Body, depth int) string {
+	if body.Len() == 0 {
+		return "{}"
+	}
+	// Objects take attributes only; nested blocks become object attributes
+	flat := NewBody()
+	for _, item := range body.items {
+		if item.block != nil {
+			flat.items = append(flat.items, bodyItem{name: item.name, value: item.block})
+		} else {
+			flat.items = append(flat.items, item)
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	writeHCLBody(&sb, flat, depth+1)
+	sb.WriteString(strings.Repeat("  ", depth) + "}")
+	return sb.String()
+}
+
+// hclString quotes a string, escaping template sequences
+func hclString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '$', '%':
+			// ${ and %{ start template sequences
+			if i+1 < len(s) && s[i+1] == '{' {
+				sb.WriteByte(c)
+			}
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// normalize converts values to the few kinds the writers handle:
+// pointers are dereferenced, numbers become int64 or float64, string kinds
+// (SDK enums) become string, and slices and maps become []any and map[string]any
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil, string, bool, int64, float64, Ref, Call, *Body, []any, map[string]any:
+		return v
+	}
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Slice, reflect.Array:
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+		return items
+	case reflect.Map:
+		m := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = iter.Value().Interface()
+		}
+		return m
+	}
+	return rv.Interface()
+}
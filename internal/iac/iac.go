@@ -0,0 +1,230 @@
+// Package iac generates infrastructure-as-code from live resources.
+//
+// Generators registered per resource type translate a resource's API data
+// into a Block: Terraform resources with their import IDs and a
+// CloudFormation resource with its import identifier. Render writes blocks
+// as Terraform HCL (resource and import blocks) or a CloudFormation
+// template snippet, so existing resources can be adopted into code.
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// Format is an output format
+type Format int
+
+const (
+	Terraform Format = iota
+	CloudFormation
+)
+
+// String returns the display name of the format
+func (f Format) String() string {
+	if f == CloudFormation {
+		return "CloudFormation"
+	}
+	return "Terraform"
+}
+
+// Ext returns the file extension for the format
+func (f Format) Ext() string {
+	if f == CloudFormation {
+		return ".yaml"
+	}
+	return ".tf"
+}
+
+// Block is the code generated for one resource
+type Block struct {
+	Name           string                  // resource name, shown in comments
+	Terraform      []TerraformResource     // the resource first, then dependents (attachments, ...)
+	CloudFormation *CloudFormationResource // nil if the type has no CloudFormation equivalent
+	Notes          []string                // what the code does not capture
+}
+
+// TerraformResource is a Terraform resource block
+type TerraformResource struct {
+	Type     string // e.g. aws_security_group
+	Name     string // local name, see LocalName
+	ImportID string // ID for the import block; empty to skip it
+	Body     *Body
+}
+
+// Address returns the resource address, e.g. aws_sqs_queue.orders
+func (r TerraformResource) Address() string {
+	return r.Type + "." + r.Name
+}
+
+// CloudFormationResource is a resource in a CloudFormation template
+type CloudFormationResource struct {
+	Type       string // e.g. AWS::SQS::Queue
+	LogicalID  string // see LogicalID
+	Identifier *Body  // resource identifier for an IMPORT change set; nil if import is not supported
+	Properties *Body
+}
+
+// Generator translates a resource into code
+type Generator func(res dao.Resource) (*Block, error)
+
+// Registry holds the generators by resource type
+type Registry struct {
+	mu         sync.RWMutex
+	generators map[string]Generator
+}
+
+// Global is the registry generators register with
+var Global = NewRegistry()
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{generators: make(map[string]Generator)}
+}
+
+// Register registers the generator for a resource type
+func (r *Registry) Register(service, resource string, gen Generator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generators[service+"/"+resource] = gen
+}
+
+// Get returns the generator for a resource type, or nil
+func (r *Registry) Get(service, resource string) Generator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.generators[service+"/"+resource]
+}
+
+// Register is a convenience function to register with the global registry
+func Register(service, resource string, gen Generator) {
+	Global.Register(service, resource, gen)
+}
+
+// Supported returns true if code can be generated for the resource type
+func Supported(service, resource string) bool {
+	return Global.Get(service, resource) != nil
+}
+
+// Generate translates a resource with the global registry
+func Generate(service, resource string, res dao.Resource) (*Block, error) {
+	gen := Global.Get(service, resource)
+	if gen == nil {
+		return nil, fmt.Errorf("code generation is not supported for %s/%s", service, resource)
+	}
+	return gen(dao.UnwrapResource(res))
+}
+
+var nonIdentChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// LocalName turns a resource name into a Terraform local name:
+// lowercase letters, digits and underscores, not starting with a digit
+func LocalName(name string) string {
+	s := nonIdentChars.ReplaceAllString(strings.ToLower(name), "_")
+	s = strings.Trim(s, "_")
+	if s == "" {
+		return "this"
+	}
+	if s[0] >= '0' && s[0] <= '9' {
+		s = "r_" + s
+	}
+	return s
+}
+
+// LogicalID turns a resource name into a CloudFormation logical ID:
+// alphanumeric, in PascalCase
+func LogicalID(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !(r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "Resource" + s
+	}
+	return s
+}
+
+// Render writes the blocks in the given format
+func Render(blocks []*Block, format Format) (string, error) {
+	if format == CloudFormation {
+		var skipped strings.Builder
+		for _, block := range blocks {
+			if block.CloudFormation == nil {
+				fmt.Fprintf(&skipped, "# %s: no CloudFormation resource\n", block.Name)
+			}
+		}
+		out, err := renderCloudFormation(blocks)
+		if err != nil {
+			return "", err
+		}
+		if skipped.Len() > 0 {
+			out = skipped.String() + "\n" + out
+		}
+		return out, nil
+	}
+	return renderTerraform(blocks), nil
+}
+
+// DecodeDocument decodes a JSON policy document. IAM returns documents
+// URL-encoded; other services return plain JSON.
+func DecodeDocument(s string) (any, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(strings.TrimSpace(s), "{") {
+		decoded, err := url.QueryUnescape(s)
+		if err != nil {
+			return nil, fmt.Errorf("decode policy document: %w", err)
+		}
+		s = decoded
+	}
+	var doc any
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("parse policy document: %w", err)
+	}
+	return doc, nil
+}
+
+// UserTags drops the aws: tags, which cannot be set
+func UserTags(tags map[string]string) map[string]string {
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if !strings.HasPrefix(k, "aws:") {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// CloudFormationTags converts tags to a CloudFormation Tags list, sorted by key
+func CloudFormationTags(tags map[string]string) []any {
+	tags = UserTags(tags)
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]any, len(keys))
+	for i, k := range keys {
+		out[i] = NewBody().Set("Key", k).Set("Value", tags[k])
+	}
+	return out
+}
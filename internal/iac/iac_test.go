@@ -0,0 +1,148 @@
+package iac
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func testBlock() *Block {
+	body := NewBody().
+		Set("name", "orders").
+		Set("delay_seconds", int32(0)).
+		Set("fifo_queue", false).
+		Set("kms_master_key_id", "").
+		Set("tags", map[string]string{"env": "prod", "aws:x": "y"}).
+		Set("redrive_policy", JSONEncode(map[string]any{"maxReceiveCount": 5.0, "deadLetterTargetArn": "arn:aws:sqs:us-east-1:123:dlq"}))
+	body.Block("ingress").Set("cidr_blocks", []string{"10.0.0.0/8"}).Set("description", "vpn ${x}")
+
+	return &Block{
+		Name:  "sqs/queues orders",
+		Notes: []string{"queue policy not included"},
+		Terraform: []TerraformResource{
+			{Type: "aws_sqs_queue", Name: LocalName("orders"), ImportID: "https://sqs/orders", Body: body},
+		},
+		CloudFormation: &CloudFormationResource{
+			Type:       "AWS::SQS::Queue",
+			LogicalID:  LogicalID("orders"),
+			Identifier: NewBody().Set("QueueUrl", "https://sqs/orders"),
+			Properties: NewBody().Set("QueueName", "orders").Set("DelaySeconds", 0).Set("Version", "2012-10-17").Set("FifoQueue", "true"),
+		},
+	}
+}
+
+func TestRenderTerraform(t *testing.T) {
+	out, err := Render([]*Block{testBlock()}, Terraform)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	want := `# sqs/queues orders
+# NOTE: queue policy not included
+resource "aws_sqs_queue" "orders" {
+  name          = "orders"
+  delay_seconds = 0
+  fifo_queue    = false
+  tags = {
+    "aws:x" = "y"
+    env     = "prod"
+  }
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:123:dlq"
+    maxReceiveCount     = 5
+  })
+
+  ingress {
+    cidr_blocks = ["10.0.0.0/8"]
+    description = "vpn $${x}"
+  }
+}
+
+import {
+  to = aws_sqs_queue.orders
+  id = "https://sqs/orders"
+}
+`
+	if out != want {
+		t.Errorf("Render() =\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestRenderCloudFormation(t *testing.T) {
+	out, err := Render([]*Block{testBlock(), {Name: "route53 only"}}, CloudFormation)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		"# route53 only: no CloudFormation resource",
+		"Resources:\n  # sqs/queues orders\n  # NOTE: queue policy not included\n  Orders:\n    Type: AWS::SQS::Queue\n    DeletionPolicy: Retain",
+		"      QueueName: orders\n      DelaySeconds: 0\n",
+		`Version: "2012-10-17"`,
+		`FifoQueue: "true"`,
+		`#     "LogicalResourceId": "Orders",`,
+		`#       "QueueUrl": "https://sqs/orders"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestHCLListWrapping(t *testing.T) {
+	long := make([]string, 8)
+	for i := range long {
+		long[i] = "sg-0123456789abcdef"
+	}
+	out := hclValue(long, 1)
+	if !strings.HasPrefix(out, "[\n    \"sg-") || !strings.HasSuffix(out, ",\n  ]") {
+		t.Errorf("long list should wrap, got:\n%s", out)
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		in, local, logical string
+	}{
+		{"my-app.fifo", "my_app_fifo", "MyAppFifo"},
+		{"123-queue", "r_123_queue", "Resource123Queue"},
+		{"", "this", "Resource"},
+		{"*.example.com.", "example_com", "ExampleCom"},
+	}
+	for _, tt := range tests {
+		if got := LocalName(tt.in); got != tt.local {
+			t.Errorf("LocalName(%q) = %q, want %q", tt.in, got, tt.local)
+		}
+		if got := LogicalID(tt.in); got != tt.logical {
+			t.Errorf("LogicalID(%q) = %q, want %q", tt.in, got, tt.logical)
+		}
+	}
+}
+
+func TestDecodeDocument(t *testing.T) {
+	for _, in := range []string{
+		`{"Version":"2012-10-17"}`,
+		`%7B%22Version%22%3A%222012-10-17%22%7D`,
+	} {
+		doc, err := DecodeDocument(in)
+		if err != nil {
+			t.Fatalf("DecodeDocument(%q) error = %v", in, err)
+		}
+		if m, ok := doc.(map[string]any); !ok || m["Version"] != "2012-10-17" {
+			t.Errorf("DecodeDocument(%q) = %v", in, doc)
+		}
+	}
+	if _, err := DecodeDocument("not json"); err == nil {
+		t.Error("DecodeDocument should fail on invalid documents")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	if r.Get("sqs", "queues") != nil {
+		t.Error("empty registry should have no generator")
+	}
+	r.Register("sqs", "queues", func(res dao.Resource) (*Block, error) { return testBlock(), nil })
+	if r.Get("sqs", "queues") == nil {
+		t.Error("registered generator should be returned")
+	}
+}
@@ -0,0 +1,361 @@
+// Package jsondiff computes structural differences between two values
+// by walking their JSON representations.
+package jsondiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Kind describes how a path differs between the left and right value.
+type Kind int
+
+const (
+	Changed Kind = iota
+	Added
+	Removed
+)
+
+// Symbol returns the diff marker for the kind ("~", "+" or "-").
+func (k Kind) Symbol() string {
+	switch k {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// Change is a single difference at a path.
+// Left is nil for Added changes and Right is nil for Removed changes.
+type Change struct {
+	Path  string
+	Kind  Kind
+	Left  any
+	Right any
+}
+
+// Options controls which differences are reported.
+type Options struct {
+	// IgnoreVolatile skips fields that change without user intent,
+	// such as timestamps (see IsVolatile).
+	IgnoreVolatile bool
+}
+
+// arrayKeys lists the fields used to match array elements between both sides,
+// in order of preference. The first set whose leading field is present in
+// every element and that identifies elements uniquely is used.
+var arrayKeys = [][]string{
+	{"Key"},                              // tags
+	{"IpProtocol", "FromPort", "ToPort"}, // security group rules
+	{"ParameterKey"},                     // CloudFormation parameters
+	{"OutputKey"},                        // CloudFormation outputs
+	{"AttributeName"},                    // DynamoDB key schema and attributes
+	{"DeviceName"},                       // block device mappings
+	{"Name"},
+	{"Id"},
+	{"Arn"},
+}
+
+// volatileSuffixes are field name suffixes treated as volatile.
+var volatileSuffixes = []string{"Time", "Timestamp", "Date", "At", "Modified", "Updated"}
+
+// IsVolatile reports whether a field name looks like a timestamp that
+// changes on its own (e.g., LaunchTime, CreatedAt, LastModified).
+func IsVolatile(field string) bool {
+	for _, suffix := range volatileSuffixes {
+		if len(field) > len(suffix) && strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+	return field == "Timestamp"
+}
+
+// Normalize converts v into its generic JSON form (maps, slices, strings,
+// json.Number, bool and nil) so that values of different Go types compare
+// structurally.
+func Normalize(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	return out, nil
+}
+
+// Diff returns the changed, added and removed paths between left and right.
+// Both values are normalized first; object keys are walked in sorted order.
+func Diff(left, right any, opts Options) ([]Change, error) {
+	l, err := Normalize(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := Normalize(right)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	walk("", l, r, opts, &changes)
+	return changes, nil
+}
+
+func walk(path string, left, right any, opts Options, changes *[]Change) {
+	switch l := left.(type) {
+	case map[string]any:
+		if r, ok := right.(map[string]any); ok {
+			walkObject(path, l, r, opts, changes)
+			return
+		}
+	case []any:
+		if r, ok := right.([]any); ok {
+			walkArray(path, l, r, opts, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(left, right) {
+		*changes = append(*changes, Change{Path: path, Kind: Changed, Left: left, Right: right})
+	}
+}
+
+func walkObject(path string, left, right map[string]any, opts Options, changes *[]Change) {
+	keys := make([]string, 0, len(left)+len(right))
+	for k := range left {
+		keys = append(keys, k)
+	}
+	for k := range right {
+		if _, ok := left[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if opts.IgnoreVolatile && IsVolatile(k) {
+			continue
+		}
+		childPath := joinField(path, k)
+		lv, inLeft := left[k]
+		rv, inRight := right[k]
+		switch {
+		case !inRight:
+			*changes = append(*changes, Change{Path: childPath, Kind: Removed, Left: lv})
+		case !inLeft:
+			*changes = append(*changes, Change{Path: childPath, Kind: Added, Right: rv})
+		default:
+			walk(childPath, lv, rv, opts, changes)
+		}
+	}
+}
+
+func walkArray(path string, left, right []any, opts Options, changes *[]Change) {
+	if fields := matchKeys(left, right); fields != nil {
+		walkKeyed(path, left, right, fields, opts, changes)
+		return
+	}
+	if scalarSet(left) && scalarSet(right) {
+		walkScalarSet(path, left, right, changes)
+		return
+	}
+
+	for i := range max(len(left), len(right)) {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(right):
+			*changes = append(*changes, Change{Path: childPath, Kind: Removed, Left: left[i]})
+		case i >= len(left):
+			*changes = append(*changes, Change{Path: childPath, Kind: Added, Right: right[i]})
+		default:
+			walk(childPath, left[i], right[i], opts, changes)
+		}
+	}
+}
+
+// walkKeyed matches elements by their key label, keeping the left order
+// and appending elements only present on the right.
+func walkKeyed(path string, left, right []any, fields []string, opts Options, changes *[]Change) {
+	rightByKey := make(map[string]any, len(right))
+	for _, elem := range right {
+		rightByKey[keyLabel(elem, fields)] = elem
+	}
+
+	seen := make(map[string]bool, len(left))
+	for _, elem := range left {
+		label := keyLabel(elem, fields)
+		seen[label] = true
+		childPath := path + "[" + label + "]"
+		if rv, ok := rightByKey[label]; ok {
+			walk(childPath, elem, rv, opts, changes)
+		} else {
+			*changes = append(*changes, Change{Path: childPath, Kind: Removed, Left: elem})
+		}
+	}
+	for _, elem := range right {
+		label := keyLabel(elem, fields)
+		if !seen[label] {
+			*changes = append(*changes, Change{Path: path + "[" + label + "]", Kind: Added, Right: elem})
+		}
+	}
+}
+
+// walkScalarSet compares arrays of unique scalars regardless of order.
+func walkScalarSet(path string, left, right []any, changes *[]Change) {
+	contains := func(s []any, v any) bool {
+		return slices.ContainsFunc(s, func(e any) bool { return reflect.DeepEqual(e, v) })
+	}
+	for _, v := range left {
+		if !contains(right, v) {
+			*changes = append(*changes, Change{Path: path + "[=" + FormatValue(v) + "]", Kind: Removed, Left: v})
+		}
+	}
+	for _, v := range right {
+		if !contains(left, v) {
+			*changes = append(*changes, Change{Path: path + "[=" + FormatValue(v) + "]", Kind: Added, Right: v})
+		}
+	}
+}
+
+// matchKeys returns the key fields identifying elements of both arrays,
+// or nil if elements must be matched by index.
+func matchKeys(left, right []any) []string {
+	if len(left)+len(right) == 0 {
+		return nil
+	}
+	for _, fields := range arrayKeys {
+		if uniqueKeys(left, fields) && uniqueKeys(right, fields) {
+			return fields
+		}
+	}
+	return nil
+}
+
+func uniqueKeys(elems []any, fields []string) bool {
+	seen := make(map[string]bool, len(elems))
+	for _, elem := range elems {
+		obj, ok := elem.(map[string]any)
+		if !ok {
+			return false
+		}
+		if _, ok := obj[fields[0]]; !ok {
+			return false
+		}
+		label := keyLabel(obj, fields)
+		if seen[label] {
+			return false
+		}
+		seen[label] = true
+	}
+	return true
+}
+
+// keyLabel formats the key fields of an element, e.g. "Key=env" or
+// "IpProtocol=tcp,FromPort=443,ToPort=443". Absent fields are omitted.
+func keyLabel(elem any, fields []string) string {
+	obj, _ := elem.(map[string]any)
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if v, ok := obj[f]; ok {
+			parts = append(parts, f+"="+FormatValue(v))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func scalarSet(elems []any) bool {
+	for i, elem := range elems {
+		switch elem.(type) {
+		case map[string]any, []any:
+			return false
+		}
+		for _, other := range elems[:i] {
+			if reflect.DeepEqual(elem, other) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func joinField(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// FormatValue renders a normalized value on a single line.
+// Strings are shown without quotes; other values as compact JSON.
+func FormatValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// Flatten returns the leaf values of v keyed by path, using the same path
+// syntax as Diff. Arrays of unique scalars are kept as a single sorted leaf
+// so that their order does not matter; empty arrays and objects are leaves.
+func Flatten(v any, opts Options) (map[string]any, error) {
+	n, err := Normalize(v)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]any)
+	flatten("", n, opts, out)
+	return out, nil
+}
+
+func flatten(path string, v any, opts Options, out map[string]any) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 {
+			out[path] = val
+			return
+		}
+		for k, child := range val {
+			if opts.IgnoreVolatile && IsVolatile(k) {
+				continue
+			}
+			flatten(joinField(path, k), child, opts, out)
+		}
+	case []any:
+		fields := matchKeys(val, nil)
+		switch {
+		case len(val) == 0:
+			out[path] = val
+		case fields != nil:
+			for _, elem := range val {
+				flatten(path+"["+keyLabel(elem, fields)+"]", elem, opts, out)
+			}
+		case scalarSet(val):
+			sorted := slices.Clone(val)
+			slices.SortFunc(sorted, func(a, b any) int { return strings.Compare(FormatValue(a), FormatValue(b)) })
+			out[path] = sorted
+		default:
+			for i, elem := range val {
+				flatten(fmt.Sprintf("%s[%d]", path, i), elem, opts, out)
+			}
+		}
+	default:
+		out[path] = v
+	}
+}
@@ -0,0 +1,192 @@
+package jsondiff
+
+import (
+	"testing"
+)
+
+type tag struct {
+	Key   string
+	Value string
+}
+
+type rule struct {
+	IpProtocol string
+	FromPort   *int32
+	ToPort     *int32
+	CidrIp     []string
+}
+
+func port(p int32) *int32 { return &p }
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  any
+		right any
+		opts  Options
+		want  []Change
+	}{
+		{
+			name:  "equal",
+			left:  map[string]any{"a": 1, "b": []string{"x"}},
+			right: map[string]any{"a": 1, "b": []string{"x"}},
+		},
+		{
+			name:  "changed added removed",
+			left:  map[string]any{"a": 1, "b": "old", "c": true},
+			right: map[string]any{"a": 2, "b": "old", "d": "new"},
+			want: []Change{
+				{Path: "a", Kind: Changed},
+				{Path: "c", Kind: Removed},
+				{Path: "d", Kind: Added},
+			},
+		},
+		{
+			name:  "nested object",
+			left:  map[string]any{"State": map[string]any{"Name": "running"}},
+			right: map[string]any{"State": map[string]any{"Name": "stopped"}},
+			want:  []Change{{Path: "State.Name", Kind: Changed}},
+		},
+		{
+			name:  "tags matched by key",
+			left:  map[string]any{"Tags": []tag{{"env", "prod"}, {"team", "a"}}},
+			right: map[string]any{"Tags": []tag{{"owner", "b"}, {"env", "staging"}}},
+			want: []Change{
+				{Path: "Tags[Key=env].Value", Kind: Changed},
+				{Path: "Tags[Key=team]", Kind: Removed},
+				{Path: "Tags[Key=owner]", Kind: Added},
+			},
+		},
+		{
+			name: "security group rules matched by protocol and ports",
+			left: map[string]any{"Rules": []rule{
+				{IpProtocol: "tcp", FromPort: port(22), ToPort: port(22), CidrIp: []string{"10.0.0.0/8"}},
+				{IpProtocol: "tcp", FromPort: port(443), ToPort: port(443), CidrIp: []string{"0.0.0.0/0"}},
+			}},
+			right: map[string]any{"Rules": []rule{
+				{IpProtocol: "tcp", FromPort: port(443), ToPort: port(443), CidrIp: []string{"0.0.0.0/0"}},
+				{IpProtocol: "tcp", FromPort: port(22), ToPort: port(22), CidrIp: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+			}},
+			want: []Change{
+				{Path: "Rules[IpProtocol=tcp,FromPort=22,ToPort=22].CidrIp[=192.168.0.0/16]", Kind: Added},
+			},
+		},
+		{
+			name:  "scalar array order ignored",
+			left:  []string{"a", "b"},
+			right: []string{"b", "a"},
+		},
+		{
+			name:  "index fallback",
+			left:  []any{[]int{1}, []int{2}},
+			right: []any{[]int{1}},
+			want:  []Change{{Path: "[1]", Kind: Removed}},
+		},
+		{
+			name:  "type change",
+			left:  map[string]any{"a": "1"},
+			right: map[string]any{"a": 1},
+			want:  []Change{{Path: "a", Kind: Changed}},
+		},
+		{
+			name:  "volatile shown",
+			left:  map[string]any{"LaunchTime": "2024-01-01", "Name": "a"},
+			right: map[string]any{"LaunchTime": "2024-02-01", "Name": "a"},
+			want:  []Change{{Path: "LaunchTime", Kind: Changed}},
+		},
+		{
+			name:  "volatile hidden",
+			left:  map[string]any{"LaunchTime": "2024-01-01", "Name": "a"},
+			right: map[string]any{"LaunchTime": "2024-02-01", "Name": "a"},
+			opts:  Options{IgnoreVolatile: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.left, tt.right, tt.opts)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Diff() = %+v, want %d changes", got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got[i].Path != w.Path || got[i].Kind != w.Kind {
+					t.Errorf("change[%d] = %s %s, want %s %s", i, got[i].Kind.Symbol(), got[i].Path, w.Kind.Symbol(), w.Path)
+				}
+			}
+		})
+	}
+}
+
+func TestIsVolatile(t *testing.T) {
+	tests := []struct {
+		field string
+		want  bool
+	}{
+		{"LaunchTime", true},
+		{"CreatedAt", true},
+		{"LastModified", true},
+		{"CreationDate", true},
+		{"Timestamp", true},
+		{"Time", false},
+		{"InstanceType", false},
+		{"Format", false},
+	}
+	for _, tt := range tests {
+		if got := IsVolatile(tt.field); got != tt.want {
+			t.Errorf("IsVolatile(%q) = %v, want %v", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	v, err := Normalize(map[string]any{"a": []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{nil, "null"},
+		{"text", "text"},
+		{true, "true"},
+		{v, `{"a":[1,2]}`},
+	}
+	for _, tt := range tests {
+		if got := FormatValue(tt.in); got != tt.want {
+			t.Errorf("FormatValue(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	got, err := Flatten(map[string]any{
+		"Name":       "web",
+		"LaunchTime": "2024-01-01",
+		"Tags":       []tag{{"env", "prod"}},
+		"Groups":     []string{"sg-2", "sg-1"},
+		"Empty":      []string{},
+	}, Options{IgnoreVolatile: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"Name":                "web",
+		"Tags[Key=env].Key":   "env",
+		"Tags[Key=env].Value": "prod",
+		"Groups":              `["sg-1","sg-2"]`,
+		"Empty":               "[]",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Flatten() = %v, want %d leaves", got, len(want))
+	}
+	for path, w := range want {
+		if v, ok := got[path]; !ok || FormatValue(v) != w {
+			t.Errorf("Flatten()[%q] = %v, want %s", path, v, w)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package kinesisrec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Format is how record data is shown
+type Format int
+
+const (
+	FormatText Format = iota
+	FormatJSON
+	FormatBase64
+	FormatHex
+	formatCount
+)
+
+// String returns the format name
+func (f Format) String() string {
+	switch f {
+	case FormatJSON:
+		return "json"
+	case FormatBase64:
+		return "base64"
+	case FormatHex:
+		return "hex"
+	default:
+		return "text"
+	}
+}
+
+// Next returns the format after f, wrapping around
+func (f Format) Next() Format {
+	return (f + 1) % formatCount
+}
+
+// kplMagic starts records aggregated by the Kinesis Producer Library
+var kplMagic = []byte{0xF3, 0x89, 0x9A, 0xC2}
+
+// Decode shows record data in a format. Data that is not valid in the
+// format, such as binary data as text, falls back to a hex dump with a note.
+func Decode(data []byte, f Format) string {
+	switch f {
+	case FormatBase64:
+		return base64.StdEncoding.EncodeToString(data)
+	case FormatHex:
+		return strings.TrimRight(hex.Dump(data), "\n")
+	case FormatJSON:
+		trimmed := bytes.TrimSpace(data)
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, trimmed, "", "  "); err == nil {
+			return buf.String()
+		}
+		if !isText(data) {
+			return binaryNote(data)
+		}
+		return "(not JSON) " + string(data)
+	default:
+		if !isText(data) {
+			return binaryNote(data)
+		}
+		return string(data)
+	}
+}
+
+// isText returns whether data is UTF-8 without control characters other
+// than whitespace
+func isText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func binaryNote(data []byte) string {
+	note := fmt.Sprintf("(binary, %d bytes)", len(data))
+	if bytes.HasPrefix(data, kplMagic) {
+		note = fmt.Sprintf("(KPL aggregated record, %d bytes)", len(data))
+	}
+	return note + "\n" + strings.TrimRight(hex.Dump(data), "\n")
+}
@@ -0,0 +1,253 @@
+// Package kinesisrec reads records from Kinesis data streams for the record
+// viewer: it lists shards, follows them from a start position and decodes
+// record data.
+package kinesisrec
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// recordLimit is the GetRecords limit per shard and poll
+const recordLimit = 100
+
+// Client is the part of the Kinesis client the reader uses
+type Client interface {
+	ListShards(ctx context.Context, in *kinesis.ListShardsInput, optFns ...func(*kinesis.Options)) (*kinesis.ListShardsOutput, error)
+	GetShardIterator(ctx context.Context, in *kinesis.GetShardIteratorInput, optFns ...func(*kinesis.Options)) (*kinesis.GetShardIteratorOutput, error)
+	GetRecords(ctx context.Context, in *kinesis.GetRecordsInput, optFns ...func(*kinesis.Options)) (*kinesis.GetRecordsOutput, error)
+}
+
+// ListShards returns all shards of a stream still in its retention period,
+// closed ones included
+func ListShards(ctx context.Context, client Client, streamARN string) ([]types.Shard, error) {
+	var shards []types.Shard
+	in := &kinesis.ListShardsInput{StreamARN: &streamARN}
+	for {
+		out, err := client.ListShards(ctx, in)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list shards of %s", appaws.ExtractResourceName(streamARN))
+		}
+		shards = append(shards, out.Shards...)
+		if out.NextToken == nil {
+			return shards, nil
+		}
+		// A NextToken request must not name the stream
+		in = &kinesis.ListShardsInput{NextToken: out.NextToken}
+	}
+}
+
+// IsOpen returns whether a shard still takes records
+func IsOpen(shard types.Shard) bool {
+	return shard.SequenceNumberRange == nil || shard.SequenceNumberRange.EndingSequenceNumber == nil
+}
+
+// maxHashKey is 2^128 - 1, the end of the partition key hash space
+var maxHashKey = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// KeyspaceShare returns the percentage of the hash key space a shard's
+// range covers, or -1 if the range cannot be parsed
+func KeyspaceShare(r *types.HashKeyRange) float64 {
+	if r == nil {
+		return -1
+	}
+	start, ok1 := new(big.Int).SetString(appaws.Str(r.StartingHashKey), 10)
+	end, ok2 := new(big.Int).SetString(appaws.Str(r.EndingHashKey), 10)
+	if !ok1 || !ok2 {
+		return -1
+	}
+	size := new(big.Int).Sub(end, start)
+	size.Add(size, big.NewInt(1))
+	share, _ := new(big.Rat).SetFrac(size.Mul(size, big.NewInt(100)), new(big.Int).Add(maxHashKey, big.NewInt(1))).Float64()
+	return share
+}
+
+// Position is where reading starts in each shard
+type Position struct {
+	Type      types.ShardIteratorType // LATEST, TRIM_HORIZON or AT_TIMESTAMP
+	Timestamp time.Time
+}
+
+// Latest reads only records put after the reader starts
+var Latest = Position{Type: types.ShardIteratorTypeLatest}
+
+// String describes the position, e.g. "LATEST" or "AT 2026-01-02 15:04:05"
+func (p Position) String() string {
+	if p.Type == types.ShardIteratorTypeAtTimestamp {
+		return "AT " + p.Timestamp.Format("2006-01-02 15:04:05")
+	}
+	return string(p.Type)
+}
+
+// ParsePosition reads "latest", "trim_horizon" (or "trim"), a time
+// (RFC3339 or local "2006-01-02 15:04[:05]"), or a duration ago such as "15m"
+func ParsePosition(s string, now time.Time) (Position, error) {
+	s = strings.TrimSpace(s)
+	switch strings.ToUpper(s) {
+	case "", "LATEST":
+		return Latest, nil
+	case "TRIM_HORIZON", "TRIM":
+		return Position{Type: types.ShardIteratorTypeTrimHorizon}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: now.Add(-d)}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: t}, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: t}, nil
+		}
+	}
+	return Position{}, fmt.Errorf("position %q: want latest, trim_horizon, a time (2006-01-02 15:04) or a duration ago (15m)", s)
+}
+
+// Record is a record read from a shard
+type Record struct {
+	ShardID        string
+	SequenceNumber string
+	PartitionKey   string
+	Arrival        time.Time
+	Data           []byte
+}
+
+// errExpiredIterator is returned for iterators unused for 5 minutes
+const errExpiredIterator = "ExpiredIteratorException"
+
+// Reader follows a set of shards from a position. It is not safe for
+// concurrent use: polls must not overlap.
+type Reader struct {
+	client    Client
+	streamARN string
+	shards    []string
+	pos       Position
+	iterators map[string]string // shard -> next iterator, removed once a shard is read to its end
+	lastSeq   map[string]string // shard -> sequence number of the last record read
+}
+
+// NewReader creates a reader for shards of a stream
+func NewReader(client Client, streamARN string, shardIDs []string) *Reader {
+	return &Reader{
+		client:    client,
+		streamARN: streamARN,
+		shards:    shardIDs,
+		iterators: make(map[string]string),
+		lastSeq:   make(map[string]string),
+	}
+}
+
+// Start gets an iterator at the position for each shard
+func (r *Reader) Start(ctx context.Context, pos Position) error {
+	r.pos = pos
+	clear(r.iterators)
+	clear(r.lastSeq)
+	for _, id := range r.shards {
+		if err := r.seek(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seek gets a shard's iterator after its last record read, or at the start
+// position if none was read yet
+func (r *Reader) seek(ctx context.Context, id string) error {
+	in := &kinesis.GetShardIteratorInput{
+		StreamARN:         &r.streamARN,
+		ShardId:           &id,
+		ShardIteratorType: r.pos.Type,
+	}
+	if seq := r.lastSeq[id]; seq != "" {
+		in.ShardIteratorType = types.ShardIteratorTypeAfterSequenceNumber
+		in.StartingSequenceNumber = &seq
+	} else if r.pos.Type == types.ShardIteratorTypeAtTimestamp {
+		in.Timestamp = &r.pos.Timestamp
+	}
+	out, err := r.client.GetShardIterator(ctx, in)
+	if err != nil {
+		return apperrors.Wrapf(err, "get shard iterator for %s", id)
+	}
+	if it := appaws.Str(out.ShardIterator); it != "" {
+		r.iterators[id] = it
+	}
+	return nil
+}
+
+// Poll is the outcome of one Poll
+type Poll struct {
+	Records []Record      // ordered by arrival
+	Open    int           // shards that may have more records
+	Behind  time.Duration // how far the furthest shard is behind the tip
+}
+
+// Poll reads the next records of each shard once. Iterators that expired,
+// e.g. while the viewer was paused, are renewed after the last record read.
+// Records read before an error are returned with it, as their shards moved on.
+func (r *Reader) Poll(ctx context.Context) (Poll, error) {
+	var p Poll
+	var err error
+	for _, id := range r.shards {
+		if _, ok := r.iterators[id]; !ok {
+			continue
+		}
+		if err = r.pollShard(ctx, id, &p); err != nil {
+			break
+		}
+	}
+	p.Open = len(r.iterators)
+	slices.SortStableFunc(p.Records, func(a, b Record) int {
+		return a.Arrival.Compare(b.Arrival)
+	})
+	return p, err
+}
+
+func (r *Reader) pollShard(ctx context.Context, id string, p *Poll) error {
+	out, err := r.getRecords(ctx, id)
+	if apperrors.GetErrorCode(err) == errExpiredIterator {
+		if err := r.seek(ctx, id); err != nil {
+			return err
+		}
+		out, err = r.getRecords(ctx, id)
+	}
+	if err != nil {
+		return apperrors.Wrapf(err, "get records from %s", id)
+	}
+	for _, rec := range out.Records {
+		p.Records = append(p.Records, Record{
+			ShardID:        id,
+			SequenceNumber: appaws.Str(rec.SequenceNumber),
+			PartitionKey:   appaws.Str(rec.PartitionKey),
+			Arrival:        appaws.Time(rec.ApproximateArrivalTimestamp),
+			Data:           rec.Data,
+		})
+		r.lastSeq[id] = appaws.Str(rec.SequenceNumber)
+	}
+	if next := appaws.Str(out.NextShardIterator); next != "" {
+		r.iterators[id] = next
+	} else {
+		// The shard was closed by a reshard and is read to its end
+		delete(r.iterators, id)
+	}
+	p.Behind = max(p.Behind, time.Duration(appaws.Int64(out.MillisBehindLatest))*time.Millisecond)
+	return nil
+}
+
+func (r *Reader) getRecords(ctx context.Context, id string) (*kinesis.GetRecordsOutput, error) {
+	it := r.iterators[id]
+	return r.client.GetRecords(ctx, &kinesis.GetRecordsInput{
+		StreamARN:     &r.streamARN,
+		ShardIterator: &it,
+		Limit:         appaws.Int32Ptr(recordLimit),
+	})
+}
@@ -0,0 +1,241 @@
+package kinesisrec
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/aws/smithy-go"
+)
+
+const testStreamARN = "arn:aws:kinesis:us-east-1:123456789012:stream/orders"
+
+var testNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// fakeStream serves shards whose iterator is the shard ID
+type fakeStream struct {
+	shards    []types.Shard
+	records   map[string][]types.Record // unread records per shard
+	closed    map[string]bool           // shards that end once read
+	expire    map[string]bool           // shards whose next GetRecords finds the iterator expired
+	iterators []*kinesis.GetShardIteratorInput
+	lists     []*kinesis.ListShardsInput
+	getErr    map[string]error
+}
+
+func (f *fakeStream) ListShards(_ context.Context, in *kinesis.ListShardsInput, _ ...func(*kinesis.Options)) (*kinesis.ListShardsOutput, error) {
+	f.lists = append(f.lists, in)
+	// Serve one shard per page
+	i := 0
+	if in.NextToken != nil {
+		i = int(aws.ToString(in.NextToken)[0] - '0')
+	}
+	out := &kinesis.ListShardsOutput{Shards: f.shards[i : i+1]}
+	if i+1 < len(f.shards) {
+		out.NextToken = aws.String(string(rune('0' + i + 1)))
+	}
+	return out, nil
+}
+
+func (f *fakeStream) GetShardIterator(_ context.Context, in *kinesis.GetShardIteratorInput, _ ...func(*kinesis.Options)) (*kinesis.GetShardIteratorOutput, error) {
+	f.iterators = append(f.iterators, in)
+	return &kinesis.GetShardIteratorOutput{ShardIterator: in.ShardId}, nil
+}
+
+func (f *fakeStream) GetRecords(_ context.Context, in *kinesis.GetRecordsInput, _ ...func(*kinesis.Options)) (*kinesis.GetRecordsOutput, error) {
+	id := aws.ToString(in.ShardIterator)
+	if err := f.getErr[id]; err != nil {
+		return nil, err
+	}
+	if f.expire[id] {
+		delete(f.expire, id)
+		return nil, &smithy.GenericAPIError{Code: errExpiredIterator, Message: "iterator expired"}
+	}
+	out := &kinesis.GetRecordsOutput{Records: f.records[id], MillisBehindLatest: aws.Int64(0)}
+	delete(f.records, id)
+	if !f.closed[id] {
+		out.NextShardIterator = aws.String(id)
+	}
+	return out, nil
+}
+
+func record(seq, key, data string, at time.Duration) types.Record {
+	return types.Record{
+		SequenceNumber:              aws.String(seq),
+		PartitionKey:                aws.String(key),
+		Data:                        []byte(data),
+		ApproximateArrivalTimestamp: aws.Time(testNow.Add(at)),
+	}
+}
+
+func shard(id, start, end string, closed bool) types.Shard {
+	s := types.Shard{
+		ShardId:             aws.String(id),
+		HashKeyRange:        &types.HashKeyRange{StartingHashKey: aws.String(start), EndingHashKey: aws.String(end)},
+		SequenceNumberRange: &types.SequenceNumberRange{StartingSequenceNumber: aws.String("1")},
+	}
+	if closed {
+		s.SequenceNumberRange.EndingSequenceNumber = aws.String("9")
+	}
+	return s
+}
+
+func TestListShards(t *testing.T) {
+	f := &fakeStream{shards: []types.Shard{
+		shard("shardId-0", "0", "1", true),
+		shard("shardId-1", "0", "1", false),
+	}}
+	shards, err := ListShards(context.Background(), f, testStreamARN)
+	if err != nil || len(shards) != 2 {
+		t.Fatalf("ListShards() = %d shards, %v", len(shards), err)
+	}
+	if f.lists[1].StreamARN != nil || f.lists[1].NextToken == nil {
+		t.Errorf("next page should only pass the token: %+v", f.lists[1])
+	}
+	if IsOpen(shards[0]) || !IsOpen(shards[1]) {
+		t.Error("IsOpen should be false only for shards with an ending sequence number")
+	}
+}
+
+func TestKeyspaceShare(t *testing.T) {
+	const half = "170141183460469231731687303715884105727" // 2^127 - 1
+	tests := []struct {
+		start, end string
+		want       float64
+	}{
+		{"0", "340282366920938463463374607431768211455", 100},
+		{"0", half, 50},
+		{"170141183460469231731687303715884105728", "340282366920938463463374607431768211455", 50},
+		{"x", half, -1},
+	}
+	for _, tt := range tests {
+		got := KeyspaceShare(&types.HashKeyRange{StartingHashKey: &tt.start, EndingHashKey: &tt.end})
+		if got != tt.want {
+			t.Errorf("KeyspaceShare(%s, %s) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+	if KeyspaceShare(nil) != -1 {
+		t.Error("KeyspaceShare(nil) should be -1")
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	local := time.FixedZone("test", 2*60*60)
+	now := testNow.In(local)
+	tests := []struct {
+		in   string
+		want Position
+	}{
+		{"", Latest},
+		{"latest", Latest},
+		{"TRIM", Position{Type: types.ShardIteratorTypeTrimHorizon}},
+		{"trim_horizon", Position{Type: types.ShardIteratorTypeTrimHorizon}},
+		{"15m", Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: now.Add(-15 * time.Minute)}},
+		{"2026-01-02T01:00:00Z", Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)}},
+		{"2026-01-02 01:00", Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: time.Date(2026, 1, 2, 1, 0, 0, 0, local)}},
+	}
+	for _, tt := range tests {
+		got, err := ParsePosition(tt.in, now)
+		if err != nil {
+			t.Errorf("ParsePosition(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got.Type != tt.want.Type || !got.Timestamp.Equal(tt.want.Timestamp) {
+			t.Errorf("ParsePosition(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	for _, bad := range []string{"yesterday", "-5m"} {
+		if _, err := ParsePosition(bad, now); err == nil {
+			t.Errorf("ParsePosition(%q) should fail", bad)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	f := &fakeStream{
+		records: map[string][]types.Record{
+			"shardId-0": {record("10", "a", "first", 2*time.Second)},
+			"shardId-1": {record("20", "b", "earlier", time.Second)},
+		},
+		closed: map[string]bool{"shardId-1": true},
+	}
+	r := NewReader(f, testStreamARN, []string{"shardId-0", "shardId-1"})
+	ctx := context.Background()
+	at := Position{Type: types.ShardIteratorTypeAtTimestamp, Timestamp: testNow}
+	if err := r.Start(ctx, at); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if in := f.iterators[0]; in.ShardIteratorType != types.ShardIteratorTypeAtTimestamp || !in.Timestamp.Equal(testNow) {
+		t.Errorf("iterator input = %+v, want AT_TIMESTAMP", in)
+	}
+
+	p, err := r.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+	if len(p.Records) != 2 || string(p.Records[0].Data) != "earlier" || p.Records[0].ShardID != "shardId-1" {
+		t.Errorf("records = %+v, want both ordered by arrival", p.Records)
+	}
+	if p.Open != 1 {
+		t.Errorf("Open = %d, want 1 after the closed shard was read to its end", p.Open)
+	}
+
+	// An expired iterator is renewed after the last record read
+	f.expire = map[string]bool{"shardId-0": true}
+	f.records["shardId-0"] = []types.Record{record("11", "a", "second", 3*time.Second)}
+	p, err = r.Poll(ctx)
+	if err != nil || len(p.Records) != 1 || string(p.Records[0].Data) != "second" {
+		t.Fatalf("Poll() after expiry = %+v, %v", p.Records, err)
+	}
+	last := f.iterators[len(f.iterators)-1]
+	if last.ShardIteratorType != types.ShardIteratorTypeAfterSequenceNumber || aws.ToString(last.StartingSequenceNumber) != "10" {
+		t.Errorf("re-seek = %+v, want AFTER_SEQUENCE_NUMBER 10", last)
+	}
+}
+
+func TestReader_PartialPoll(t *testing.T) {
+	f := &fakeStream{
+		records: map[string][]types.Record{"shardId-0": {record("10", "a", "kept", 0)}},
+		getErr:  map[string]error{"shardId-1": &smithy.GenericAPIError{Code: "ProvisionedThroughputExceededException"}},
+	}
+	r := NewReader(f, testStreamARN, []string{"shardId-0", "shardId-1"})
+	if err := r.Start(context.Background(), Latest); err != nil {
+		t.Fatal(err)
+	}
+	p, err := r.Poll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "shardId-1") {
+		t.Errorf("Poll() error = %v, want the failing shard named", err)
+	}
+	if len(p.Records) != 1 {
+		t.Errorf("records read before the error should be returned, got %+v", p.Records)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		f    Format
+		want string
+	}{
+		{"text", []byte("hello"), FormatText, "hello"},
+		{"json", []byte(`{"a":1}`), FormatJSON, "{\n  \"a\": 1\n}"},
+		{"not json", []byte("plain"), FormatJSON, "(not JSON) plain"},
+		{"base64", []byte("hi"), FormatBase64, "aGk="},
+		{"hex", []byte("hi"), FormatHex, "00000000  68 69"},
+		{"binary", []byte{0x00, 0xff}, FormatText, "(binary, 2 bytes)"},
+		{"kpl", append([]byte{0xF3, 0x89, 0x9A, 0xC2}, 1), FormatJSON, "(KPL aggregated record, 5 bytes)"},
+	}
+	for _, tt := range tests {
+		if got := Decode(tt.data, tt.f); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%s: Decode() = %q, want prefix %q", tt.name, got, tt.want)
+		}
+	}
+	if FormatHex.Next() != FormatText {
+		t.Error("Next() should wrap around")
+	}
+}
@@ -0,0 +1,200 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	chartLabelWidth = 8
+	minChartWidth   = 10
+	minChartHeight  = 2
+	brailleBase     = 0x2800
+)
+
+// brailleDots maps [column][row] within a 2x4 braille cell to its dot bit.
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// ChartOptions controls the layout of RenderChart.
+type ChartOptions struct {
+	Width      int // Total width including the y-axis labels
+	Height     int // Number of plot rows (excluding the x-axis)
+	Start      time.Time
+	End        time.Time
+	Unit       string
+	Thresholds []float64 // Drawn as dashed horizontal lines
+}
+
+// RenderChart renders a series as a braille line chart with y-axis labels
+// and a time x-axis. Returns plain text without styling.
+func RenderChart(s *Series, opts ChartOptions) string {
+	plotW := max(opts.Width-chartLabelWidth-2, minChartWidth)
+	plotH := max(opts.Height, minChartHeight)
+	dotsW, dotsH := plotW*2, plotH*4
+
+	minV, maxV := chartRange(s, opts.Thresholds)
+	yDot := func(v float64) int {
+		y := int(math.Round((maxV - v) / (maxV - minV) * float64(dotsH-1)))
+		return min(max(y, 0), dotsH-1)
+	}
+	span := opts.End.Sub(opts.Start)
+	xDot := func(t time.Time) int {
+		if span <= 0 {
+			return 0
+		}
+		x := int(math.Round(float64(t.Sub(opts.Start)) / float64(span) * float64(dotsW-1)))
+		return min(max(x, 0), dotsW-1)
+	}
+
+	grid := make([][]rune, plotH)
+	for i := range grid {
+		grid[i] = make([]rune, plotW)
+	}
+	set := func(x, y int) {
+		grid[y/4][x/2] |= brailleDots[x%2][y%4]
+	}
+
+	for _, th := range opts.Thresholds {
+		y := yDot(th)
+		for x := 0; x < dotsW; x++ {
+			if x%4 < 2 {
+				set(x, y)
+			}
+		}
+	}
+
+	if s != nil {
+		prevX, prevY := -1, -1
+		for i, v := range s.Values {
+			if i >= len(s.Timestamps) {
+				break
+			}
+			x, y := xDot(s.Timestamps[i]), yDot(v)
+			if prevX < 0 {
+				set(x, y)
+			} else {
+				drawLine(prevX, prevY, x, y, set)
+			}
+			prevX, prevY = x, y
+		}
+	}
+
+	var sb strings.Builder
+	blank := strings.Repeat(" ", chartLabelWidth)
+	for row := range plotH {
+		switch row {
+		case 0:
+			fmt.Fprintf(&sb, "%*s ┤", chartLabelWidth, FormatChartValue(maxV, opts.Unit))
+		case plotH - 1:
+			fmt.Fprintf(&sb, "%*s ┤", chartLabelWidth, FormatChartValue(minV, opts.Unit))
+		case plotH / 2:
+			fmt.Fprintf(&sb, "%*s ┤", chartLabelWidth, FormatChartValue((maxV+minV)/2, opts.Unit))
+		default:
+			sb.WriteString(blank + " │")
+		}
+		for _, cell := range grid[row] {
+			if cell == 0 {
+				sb.WriteRune(' ')
+			} else {
+				sb.WriteRune(brailleBase + cell)
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(blank + " └" + strings.Repeat("─", plotW) + "\n")
+	sb.WriteString(blank + "  " + timeAxis(opts.Start, opts.End, plotW))
+	return sb.String()
+}
+
+// chartRange returns the y-axis bounds covering values and thresholds.
+// Non-negative data is anchored at zero.
+func chartRange(s *Series, thresholds []float64) (float64, float64) {
+	minV, maxV := math.Inf(1), math.Inf(-1)
+	if s != nil {
+		for _, v := range s.Values {
+			minV, maxV = math.Min(minV, v), math.Max(maxV, v)
+		}
+	}
+	for _, th := range thresholds {
+		minV, maxV = math.Min(minV, th), math.Max(maxV, th)
+	}
+	if math.IsInf(minV, 1) {
+		return 0, 1
+	}
+	if minV > 0 {
+		minV = 0
+	}
+	if maxV <= minV {
+		maxV = minV + 1
+	}
+	return minV, maxV
+}
+
+// drawLine plots dots from (x0, y0) to (x1, y1).
+func drawLine(x0, y0, x1, y1 int, set func(x, y int)) {
+	dx, dy := x1-x0, y1-y0
+	steps := max(abs(dx), abs(dy))
+	if steps == 0 {
+		set(x0, y0)
+		return
+	}
+	for i := 0; i <= steps; i++ {
+		x := x0 + int(math.Round(float64(dx*i)/float64(steps)))
+		y := y0 + int(math.Round(float64(dy*i)/float64(steps)))
+		set(x, y)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// timeAxis renders start, middle and end time labels across width columns.
+func timeAxis(start, end time.Time, width int) string {
+	layout := "15:04"
+	if end.Sub(start) > 24*time.Hour {
+		layout = "01-02 15:04"
+	}
+	left := start.Local().Format(layout)
+	right := end.Local().Format(layout)
+	mid := start.Add(end.Sub(start) / 2).Local().Format(layout)
+
+	if width < len(left)+len(mid)+len(right)+2 {
+		if width < len(left)+len(right)+1 {
+			return left
+		}
+		return left + strings.Repeat(" ", width-len(left)-len(right)) + right
+	}
+
+	midPos := (width - len(mid)) / 2
+	line := left + strings.Repeat(" ", midPos-len(left)) + mid
+	return line + strings.Repeat(" ", width-len(line)-len(right)) + right
+}
+
+// FormatChartValue formats an axis or legend value with SI suffixes.
+func FormatChartValue(v float64, unit string) string {
+	a := math.Abs(v)
+	var s string
+	switch {
+	case a >= 1e9:
+		s = fmt.Sprintf("%.1fG", v/1e9)
+	case a >= 1e6:
+		s = fmt.Sprintf("%.1fM", v/1e6)
+	case a >= 1e3:
+		s = fmt.Sprintf("%.1fk", v/1e3)
+	case a >= 10 || v == math.Trunc(v):
+		s = fmt.Sprintf("%.0f", v)
+	default:
+		s = fmt.Sprintf("%.2f", v)
+	}
+	return s + unit
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderChart_Layout(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	s := &Series{
+		Timestamps: []time.Time{start, start.Add(30 * time.Minute), end},
+		Values:     []float64{10, 50, 100},
+	}
+
+	out := RenderChart(s, ChartOptions{Width: 40, Height: 5, Start: start, End: end, Unit: "%"})
+	lines := strings.Split(out, "\n")
+
+	// 5 plot rows + x-axis + time labels
+	if len(lines) != 7 {
+		t.Fatalf("RenderChart() lines = %d, want 7\n%s", len(lines), out)
+	}
+	if !strings.Contains(lines[0], "100%") {
+		t.Errorf("top label should show max value, got %q", lines[0])
+	}
+	if !strings.Contains(lines[4], "0%") {
+		t.Errorf("bottom label should show zero, got %q", lines[4])
+	}
+	if !strings.Contains(lines[5], "└") {
+		t.Errorf("expected x-axis, got %q", lines[5])
+	}
+	for _, line := range lines[:5] {
+		if w := len([]rune(line)); w != 40 {
+			t.Errorf("plot row width = %d, want 40: %q", w, line)
+		}
+	}
+
+	hasDots := false
+	for _, r := range out {
+		if r > brailleBase && r <= brailleBase+0xFF {
+			hasDots = true
+			break
+		}
+	}
+	if !hasDots {
+		t.Error("expected braille dots in chart")
+	}
+}
+
+func TestRenderChart_ThresholdExtendsRange(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	s := &Series{Timestamps: []time.Time{start}, Values: []float64{10}}
+
+	out := RenderChart(s, ChartOptions{Width: 40, Height: 4, Start: start, End: end, Thresholds: []float64{80}})
+	if first := strings.Split(out, "\n")[0]; !strings.Contains(first, "80") {
+		t.Errorf("top label should include threshold, got %q", first)
+	}
+}
+
+func TestRenderChart_NoData(t *testing.T) {
+	start := time.Now()
+	out := RenderChart(nil, ChartOptions{Width: 30, Height: 3, Start: start, End: start.Add(time.Hour)})
+	if !strings.Contains(out, "└") {
+		t.Errorf("expected empty chart with axes, got %q", out)
+	}
+}
+
+func TestFormatChartValue(t *testing.T) {
+	tests := []struct {
+		v    float64
+		unit string
+		want string
+	}{
+		{0, "%", "0%"},
+		{5, "", "5"},
+		{0.25, "", "0.25"},
+		{42.4, "%", "42%"},
+		{1500, "B", "1.5kB"},
+		{2500000, "", "2.5M"},
+		{3e9, "B", "3.0GB"},
+	}
+	for _, tt := range tests {
+		if got := FormatChartValue(tt.v, tt.unit); got != tt.want {
+			t.Errorf("FormatChartValue(%v, %q) = %q, want %q", tt.v, tt.unit, got, tt.want)
+		}
+	}
+}
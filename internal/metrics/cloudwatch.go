@@ -4,6 +4,7 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -12,6 +13,7 @@ import (
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -32,18 +34,20 @@ func NewFetcher(ctx context.Context) (*Fetcher, error) {
 	return &Fetcher{client: cloudwatch.NewFromConfig(cfg)}, nil
 }
 
-func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.MetricSpec) (*MetricData, error) {
-	if len(resourceIDs) == 0 || spec == nil {
-		return NewMetricData(spec), nil
+// Fetch fetches every spec for every resource, batching all queries into as
+// few GetMetricData calls as possible. Results are keyed by resource ID.
+func (f *Fetcher) Fetch(ctx context.Context, resources []dao.Resource, specs []render.MetricSpec) (*MetricData, error) {
+	if len(resources) == 0 || len(specs) == 0 {
+		return NewMetricData(specs), nil
 	}
 
-	queries := f.buildQueries(resourceIDs, spec)
+	queries := f.buildQueries(resources, specs)
 	endTime := time.Now().Truncate(time.Minute)
 	startTime := endTime.Add(-config.File().MetricsWindow())
 
-	data := NewMetricData(spec)
+	data := NewMetricData(specs)
 
-	for i := 0; i < len(queries); i += maxQueriesPerRequest {
+	for i := 0; i < len(queries); {
 		if ctx.Err() != nil {
 			return data, ctx.Err()
 		}
@@ -52,7 +56,12 @@ func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.
 		if end > len(queries) {
 			end = len(queries)
 		}
+		// Keep a metric math input in the same request as its expression
+		if end < len(queries) && !aws.ToBool(queries[end-1].ReturnData) {
+			end--
+		}
 		batch := queries[i:end]
+		i = end
 
 		input := &cloudwatch.GetMetricDataInput{
 			StartTime:         aws.Time(startTime),
@@ -66,57 +75,93 @@ func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.
 			return nil, fmt.Errorf("GetMetricData failed: %w", err)
 		}
 
-		f.processResults(output.MetricDataResults, resourceIDs, data)
+		f.processResults(output.MetricDataResults, resources, data)
 	}
 
 	return data, nil
 }
 
-func (f *Fetcher) buildQueries(resourceIDs []string, spec *render.MetricSpec) []types.MetricDataQuery {
-	queries := make([]types.MetricDataQuery, len(resourceIDs))
-	for i, resourceID := range resourceIDs {
-		queries[i] = types.MetricDataQuery{
-			Id: aws.String(fmt.Sprintf("m%d", i)),
-			MetricStat: &types.MetricStat{
-				Metric: &types.Metric{
-					Namespace:  aws.String(spec.Namespace),
-					MetricName: aws.String(spec.MetricName),
-					Dimensions: []types.Dimension{
-						{
-							Name:  aws.String(spec.DimensionName),
-							Value: aws.String(resourceID),
-						},
-					},
+// queryID returns the GetMetricData query ID for a spec/resource pair.
+func queryID(specIdx, resourceIdx int) string {
+	return fmt.Sprintf("m%d_%d", specIdx, resourceIdx)
+}
+
+// expressionVar matches the "m" placeholder in a metric math expression.
+var expressionVar = regexp.MustCompile(`\bm\b`)
+
+// specQueries returns the queries for one spec and dimension value under id.
+// Metric math specs produce a hidden input query followed by the expression.
+func specQueries(id string, spec render.MetricSpec, dimValue string, period int32, stat string) []types.MetricDataQuery {
+	metricStat := &types.MetricStat{
+		Metric: &types.Metric{
+			Namespace:  aws.String(spec.Namespace),
+			MetricName: aws.String(spec.MetricName),
+			Dimensions: []types.Dimension{
+				{
+					Name:  aws.String(spec.DimensionName),
+					Value: aws.String(dimValue),
 				},
-				Period: aws.Int32(metricPeriod),
-				Stat:   aws.String(spec.Stat),
 			},
+		},
+		Period: aws.Int32(period),
+		Stat:   aws.String(stat),
+	}
+
+	if spec.Expression == "" {
+		return []types.MetricDataQuery{{Id: aws.String(id), MetricStat: metricStat}}
+	}
+
+	inputID := id + "_in"
+	return []types.MetricDataQuery{
+		{Id: aws.String(inputID), MetricStat: metricStat, ReturnData: aws.Bool(false)},
+		{
+			Id:         aws.String(id),
+			Expression: aws.String(expressionVar.ReplaceAllString(spec.Expression, inputID)),
+			Period:     aws.Int32(period),
+		},
+	}
+}
+
+func (f *Fetcher) buildQueries(resources []dao.Resource, specs []render.MetricSpec) []types.MetricDataQuery {
+	queries := make([]types.MetricDataQuery, 0, len(resources)*len(specs))
+	for s, spec := range specs {
+		for i, res := range resources {
+			dimValue := ResolveDimension(res, spec.DimensionField)
+			if dimValue == "" {
+				continue
+			}
+			queries = append(queries, specQueries(queryID(s, i), spec, dimValue, metricPeriod, spec.Stat)...)
 		}
 	}
 	return queries
 }
 
-func (f *Fetcher) processResults(results []types.MetricDataResult, resourceIDs []string, data *MetricData) {
-	idToResource := make(map[string]string, len(resourceIDs))
-	for i, id := range resourceIDs {
-		idToResource[fmt.Sprintf("m%d", i)] = id
+func (f *Fetcher) processResults(results []types.MetricDataResult, resources []dao.Resource, data *MetricData) {
+	type target struct {
+		specIdx    int
+		resourceID string
+	}
+	idToTarget := make(map[string]target, len(resources)*len(data.Specs))
+	for s := range data.Specs {
+		for i, res := range resources {
+			idToTarget[queryID(s, i)] = target{specIdx: s, resourceID: res.GetID()}
+		}
 	}
 
 	for _, result := range results {
-		queryID := aws.ToString(result.Id)
-		resourceID, ok := idToResource[queryID]
+		t, ok := idToTarget[aws.ToString(result.Id)]
 		if !ok {
 			continue
 		}
 
 		metricResult := &MetricResult{
-			ResourceID: resourceID,
+			ResourceID: t.resourceID,
 			Values:     result.Values,
 			HasData:    len(result.Values) > 0,
 		}
 		if metricResult.HasData {
 			metricResult.Latest = result.Values[len(result.Values)-1]
 		}
-		data.Results[resourceID] = metricResult
+		data.Set(t.specIdx, t.resourceID, metricResult)
 	}
 }
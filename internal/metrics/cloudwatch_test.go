@@ -6,17 +6,26 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 
+	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
+func testResources(ids ...string) []dao.Resource {
+	resources := make([]dao.Resource, len(ids))
+	for i, id := range ids {
+		resources[i] = &dao.BaseResource{ID: id, Name: id}
+	}
+	return resources
+}
+
 func TestFetcher_buildQueries(t *testing.T) {
 	f := &Fetcher{}
-	spec := &render.MetricSpec{
+	specs := []render.MetricSpec{{
 		Namespace:     "AWS/EC2",
 		MetricName:    "CPUUtilization",
 		DimensionName: "InstanceId",
 		Stat:          "Average",
-	}
+	}}
 
 	tests := []struct {
 		name        string
@@ -30,7 +39,7 @@ func TestFetcher_buildQueries(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			queries := f.buildQueries(tt.resourceIDs, spec)
+			queries := f.buildQueries(testResources(tt.resourceIDs...), specs)
 			if len(queries) != tt.wantLen {
 				t.Errorf("buildQueries() len = %d, want %d", len(queries), tt.wantLen)
 			}
@@ -40,21 +49,21 @@ func TestFetcher_buildQueries(t *testing.T) {
 
 func TestFetcher_buildQueries_correctStructure(t *testing.T) {
 	f := &Fetcher{}
-	spec := &render.MetricSpec{
+	specs := []render.MetricSpec{{
 		Namespace:     "AWS/EC2",
 		MetricName:    "CPUUtilization",
 		DimensionName: "InstanceId",
 		Stat:          "Average",
-	}
+	}}
 
-	queries := f.buildQueries([]string{"i-abc123"}, spec)
+	queries := f.buildQueries(testResources("i-abc123"), specs)
 	if len(queries) != 1 {
 		t.Fatalf("expected 1 query, got %d", len(queries))
 	}
 
 	q := queries[0]
-	if *q.Id != "m0" {
-		t.Errorf("Id = %s, want m0", *q.Id)
+	if *q.Id != "m0_0" {
+		t.Errorf("Id = %s, want m0_0", *q.Id)
 	}
 	if q.MetricStat == nil {
 		t.Fatal("MetricStat is nil")
@@ -76,6 +85,30 @@ func TestFetcher_buildQueries_correctStructure(t *testing.T) {
 	}
 }
 
+func TestFetcher_buildQueries_multipleSpecs(t *testing.T) {
+	f := &Fetcher{}
+	specs := []render.MetricSpec{
+		{Namespace: "AWS/Lambda", MetricName: "Invocations", DimensionName: "FunctionName", Stat: "Sum"},
+		{Namespace: "AWS/Lambda", MetricName: "Duration", DimensionName: "FunctionName", Stat: "p99"},
+	}
+
+	queries := f.buildQueries(testResources("fn-a", "fn-b"), specs)
+	if len(queries) != 4 {
+		t.Fatalf("expected 4 queries, got %d", len(queries))
+	}
+
+	last := queries[3]
+	if *last.Id != "m1_1" {
+		t.Errorf("Id = %s, want m1_1", *last.Id)
+	}
+	if *last.MetricStat.Stat != "p99" {
+		t.Errorf("Stat = %s, want p99", *last.MetricStat.Stat)
+	}
+	if *last.MetricStat.Metric.Dimensions[0].Value != "fn-b" {
+		t.Errorf("Dimension value = %s, want fn-b", *last.MetricStat.Metric.Dimensions[0].Value)
+	}
+}
+
 func TestBatchSplitting(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -106,32 +139,33 @@ func TestBatchSplitting(t *testing.T) {
 func TestProcessResults(t *testing.T) {
 	f := &Fetcher{}
 	resourceIDs := []string{"i-1", "i-2", "i-3"}
-	data := NewMetricData(nil)
+	data := NewMetricData([]render.MetricSpec{{MetricName: "CPUUtilization"}})
 
-	f.processResults(nil, resourceIDs, data)
-	if len(data.Results) != 0 {
-		t.Errorf("expected 0 results, got %d", len(data.Results))
+	f.processResults(nil, testResources(resourceIDs...), data)
+	if len(data.Results[0]) != 0 {
+		t.Errorf("expected 0 results, got %d", len(data.Results[0]))
 	}
 }
 
 func TestProcessResults_WithData(t *testing.T) {
 	f := &Fetcher{}
 	resourceIDs := []string{"i-abc", "i-def", "i-ghi"}
-	data := NewMetricData(nil)
+	data := NewMetricData([]render.MetricSpec{{MetricName: "CPUUtilization"}, {MetricName: "NetworkIn"}})
 
 	results := []types.MetricDataResult{
-		{Id: aws.String("m0"), Values: []float64{10.0, 20.0, 30.0}},
-		{Id: aws.String("m1"), Values: []float64{5.0, 15.0}},
-		{Id: aws.String("m2"), Values: []float64{}},
+		{Id: aws.String("m0_0"), Values: []float64{10.0, 20.0, 30.0}},
+		{Id: aws.String("m0_1"), Values: []float64{5.0, 15.0}},
+		{Id: aws.String("m0_2"), Values: []float64{}},
+		{Id: aws.String("m1_0"), Values: []float64{1000.0}},
 	}
 
-	f.processResults(results, resourceIDs, data)
+	f.processResults(results, testResources(resourceIDs...), data)
 
-	if len(data.Results) != 3 {
-		t.Fatalf("expected 3 results, got %d", len(data.Results))
+	if len(data.Results[0]) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(data.Results[0]))
 	}
 
-	r0 := data.Results["i-abc"]
+	r0 := data.Get(0, "i-abc")
 	if r0 == nil {
 		t.Fatal("i-abc not found")
 	}
@@ -139,7 +173,7 @@ func TestProcessResults_WithData(t *testing.T) {
 		t.Errorf("i-abc: HasData=%v, Latest=%v, len=%d", r0.HasData, r0.Latest, len(r0.Values))
 	}
 
-	r1 := data.Results["i-def"]
+	r1 := data.Get(0, "i-def")
 	if r1 == nil {
 		t.Fatal("i-def not found")
 	}
@@ -147,27 +181,84 @@ func TestProcessResults_WithData(t *testing.T) {
 		t.Errorf("i-def: HasData=%v, Latest=%v", r1.HasData, r1.Latest)
 	}
 
-	r2 := data.Results["i-ghi"]
+	r2 := data.Get(0, "i-ghi")
 	if r2 == nil {
 		t.Fatal("i-ghi not found")
 	}
 	if r2.HasData {
 		t.Errorf("i-ghi should have no data")
 	}
+
+	n0 := data.Get(1, "i-abc")
+	if n0 == nil || n0.Latest != 1000.0 {
+		t.Errorf("i-abc NetworkIn = %v, want latest 1000", n0)
+	}
+	if data.Get(1, "i-def") != nil {
+		t.Errorf("i-def NetworkIn should be missing")
+	}
 }
 
 func TestProcessResults_UnknownQueryID(t *testing.T) {
 	f := &Fetcher{}
 	resourceIDs := []string{"i-abc"}
-	data := NewMetricData(nil)
+	data := NewMetricData([]render.MetricSpec{{MetricName: "CPUUtilization"}})
 
 	results := []types.MetricDataResult{
-		{Id: aws.String("m99"), Values: []float64{100.0}},
+		{Id: aws.String("m0_99"), Values: []float64{100.0}},
 	}
 
-	f.processResults(results, resourceIDs, data)
+	f.processResults(results, testResources(resourceIDs...), data)
 
-	if len(data.Results) != 0 {
-		t.Errorf("expected 0 results for unknown query ID, got %d", len(data.Results))
+	if len(data.Results[0]) != 0 {
+		t.Errorf("expected 0 results for unknown query ID, got %d", len(data.Results[0]))
+	}
+}
+
+func TestFetcher_buildQueries_expression(t *testing.T) {
+	f := &Fetcher{}
+	specs := []render.MetricSpec{{
+		Namespace:     "MyApp",
+		MetricName:    "ConsumerLag",
+		DimensionName: "QueueName",
+		Stat:          "Maximum",
+		Expression:    "m / 60",
+	}}
+
+	queries := f.buildQueries(testResources("orders"), specs)
+	if len(queries) != 2 {
+		t.Fatalf("expected input and expression queries, got %d", len(queries))
+	}
+	input, expr := queries[0], queries[1]
+	if *input.Id != "m0_0_in" || aws.ToBool(input.ReturnData) {
+		t.Errorf("input query = %s (ReturnData=%v), want hidden m0_0_in", *input.Id, aws.ToBool(input.ReturnData))
+	}
+	if *expr.Id != "m0_0" {
+		t.Errorf("expression Id = %s, want m0_0", *expr.Id)
+	}
+	if *expr.Expression != "m0_0_in / 60" {
+		t.Errorf("Expression = %q, want %q", *expr.Expression, "m0_0_in / 60")
+	}
+}
+
+func TestFetcher_buildQueries_dimensionField(t *testing.T) {
+	f := &Fetcher{}
+	specs := []render.MetricSpec{{
+		Namespace:      "MyApp",
+		MetricName:     "Orders",
+		DimensionName:  "Service",
+		DimensionField: "tag:Service",
+		Stat:           "Sum",
+	}}
+	resources := []dao.Resource{
+		&dao.BaseResource{ID: "a", Tags: map[string]string{"Service": "checkout"}},
+		&dao.BaseResource{ID: "b"},
+	}
+
+	queries := f.buildQueries(resources, specs)
+	if len(queries) != 1 {
+		t.Fatalf("expected resources without dimension value to be skipped, got %d queries", len(queries))
+	}
+	if v := *queries[0].MetricStat.Metric.Dimensions[0].Value; v != "checkout" {
+		t.Errorf("Dimension value = %s, want checkout", v)
 	}
 }
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// ResolveDimension returns the dimension value of res for a MetricSpec.DimensionField.
+// Returns empty string if the field is missing.
+func ResolveDimension(res dao.Resource, field string) string {
+	switch {
+	case field == "" || field == "ID":
+		return res.GetID()
+	case field == "Name":
+		return res.GetName()
+	case field == "ARN":
+		return res.GetARN()
+	case strings.HasPrefix(field, "tag:"):
+		return res.GetTags()[strings.TrimPrefix(field, "tag:")]
+	}
+	return rawField(res.Raw(), field)
+}
+
+// rawField looks up a dotted path (e.g., "Configuration.FunctionName") in the
+// JSON representation of an API object.
+func rawField(raw any, path string) string {
+	if raw == nil {
+		return ""
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return ""
+	}
+	var node any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&node); err != nil {
+		return ""
+	}
+	for _, key := range strings.Split(path, ".") {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return ""
+		}
+		node = m[key]
+	}
+	switch v := node.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
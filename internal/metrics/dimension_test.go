@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestResolveDimension(t *testing.T) {
+	type config struct {
+		FunctionName string
+		MemorySize   int64
+	}
+	res := &dao.BaseResource{
+		ID:   "id-1",
+		Name: "name-1",
+		ARN:  "arn:aws:lambda:us-east-1:123456789012:function:name-1",
+		Tags: map[string]string{"Team": "payments"},
+		Data: struct {
+			Configuration config
+			Labels        []string
+		}{
+			Configuration: config{FunctionName: "fn-raw", MemorySize: 1024},
+		},
+	}
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"", "id-1"},
+		{"ID", "id-1"},
+		{"Name", "name-1"},
+		{"ARN", "arn:aws:lambda:us-east-1:123456789012:function:name-1"},
+		{"tag:Team", "payments"},
+		{"tag:Missing", ""},
+		{"Configuration.FunctionName", "fn-raw"},
+		{"Configuration.MemorySize", "1024"},
+		{"Configuration", ""},
+		{"Labels", ""},
+		{"Missing.Path", ""},
+	}
+	for _, tt := range tests {
+		if got := ResolveDimension(res, tt.field); got != tt.want {
+			t.Errorf("ResolveDimension(%q) = %q, want %q", tt.field, got, tt.want)
+		}
+	}
+}
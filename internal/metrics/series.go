@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// SeriesQuery describes the time range and aggregation for FetchSeries.
+type SeriesQuery struct {
+	Start  time.Time
+	End    time.Time
+	Period time.Duration
+	Stat   string // Overrides MetricSpec.Stat when set (e.g., "p99", "Maximum")
+}
+
+// Series holds the datapoints of one metric for a single resource,
+// ordered by ascending timestamp.
+type Series struct {
+	Spec       render.MetricSpec
+	Timestamps []time.Time
+	Values     []float64
+}
+
+// HasData reports whether the series contains any datapoints.
+func (s *Series) HasData() bool {
+	return s != nil && len(s.Values) > 0
+}
+
+// AlarmThreshold is a static threshold of a CloudWatch alarm on a metric.
+type AlarmThreshold struct {
+	AlarmName  string
+	Threshold  float64
+	Comparison string
+	State      string
+}
+
+// FetchSeries fetches the full time series of each spec for res.
+// The returned slice has the same order and length as specs.
+func (f *Fetcher) FetchSeries(ctx context.Context, res dao.Resource, specs []render.MetricSpec, q SeriesQuery) ([]Series, error) {
+	series := make([]Series, len(specs))
+	for i, spec := range specs {
+		series[i].Spec = spec
+	}
+	queries := buildSeriesQueries(res, specs, q)
+	if len(queries) == 0 {
+		return series, nil
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		StartTime:         aws.Time(q.Start),
+		EndTime:           aws.Time(q.End),
+		MetricDataQueries: queries,
+		ScanBy:            types.ScanByTimestampAscending,
+	}
+
+	paginator := cloudwatch.NewGetMetricDataPaginator(f.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("GetMetricData failed: %w", err)
+		}
+		for _, result := range output.MetricDataResults {
+			var idx int
+			id := aws.ToString(result.Id)
+			if _, err := fmt.Sscanf(id, "s%d", &idx); err != nil || id != fmt.Sprintf("s%d", idx) || idx >= len(series) {
+				continue
+			}
+			series[idx].Timestamps = append(series[idx].Timestamps, result.Timestamps...)
+			series[idx].Values = append(series[idx].Values, result.Values...)
+		}
+	}
+
+	return series, nil
+}
+
+func buildSeriesQueries(res dao.Resource, specs []render.MetricSpec, q SeriesQuery) []types.MetricDataQuery {
+	period := int32(q.Period / time.Second)
+	if period < metricPeriod {
+		period = metricPeriod
+	}
+
+	var queries []types.MetricDataQuery
+	for i, spec := range specs {
+		dimValue := ResolveDimension(res, spec.DimensionField)
+		if dimValue == "" {
+			continue
+		}
+		stat := spec.Stat
+		if q.Stat != "" {
+			stat = q.Stat
+		}
+		queries = append(queries, specQueries(fmt.Sprintf("s%d", i), spec, dimValue, period, stat)...)
+	}
+	return queries
+}
+
+// FetchAlarmThresholds returns the static thresholds of alarms defined on spec for res.
+// Alarms without a static threshold (e.g., anomaly detection) and metric math
+// specs, whose values are not comparable with the raw metric, are skipped.
+func (f *Fetcher) FetchAlarmThresholds(ctx context.Context, res dao.Resource, spec render.MetricSpec) ([]AlarmThreshold, error) {
+	dimValue := ResolveDimension(res, spec.DimensionField)
+	if spec.Expression != "" || dimValue == "" {
+		return nil, nil
+	}
+
+	output, err := f.client.DescribeAlarmsForMetric(ctx, &cloudwatch.DescribeAlarmsForMetricInput{
+		Namespace:  aws.String(spec.Namespace),
+		MetricName: aws.String(spec.MetricName),
+		Dimensions: []types.Dimension{
+			{
+				Name:  aws.String(spec.DimensionName),
+				Value: aws.String(dimValue),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DescribeAlarmsForMetric failed: %w", err)
+	}
+
+	var thresholds []AlarmThreshold
+	for _, alarm := range output.MetricAlarms {
+		if alarm.Threshold == nil {
+			continue
+		}
+		thresholds = append(thresholds, AlarmThreshold{
+			AlarmName:  aws.ToString(alarm.AlarmName),
+			Threshold:  aws.ToFloat64(alarm.Threshold),
+			Comparison: string(alarm.ComparisonOperator),
+			State:      string(alarm.StateValue),
+		})
+	}
+	return thresholds, nil
+}
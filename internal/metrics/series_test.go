@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestBuildSeriesQueries(t *testing.T) {
+	specs := []render.MetricSpec{
+		{Namespace: "AWS/EC2", MetricName: "CPUUtilization", DimensionName: "InstanceId", Stat: "Average"},
+		{Namespace: "AWS/EC2", MetricName: "StatusCheckFailed", DimensionName: "InstanceId", Stat: "Maximum"},
+	}
+
+	queries := buildSeriesQueries(&dao.BaseResource{ID: "i-abc"}, specs, SeriesQuery{Period: 5 * time.Minute})
+	if len(queries) != 2 {
+		t.Fatalf("len = %d, want 2", len(queries))
+	}
+	if *queries[1].Id != "s1" {
+		t.Errorf("Id = %s, want s1", *queries[1].Id)
+	}
+	if *queries[0].MetricStat.Period != 300 {
+		t.Errorf("Period = %d, want 300", *queries[0].MetricStat.Period)
+	}
+	if *queries[1].MetricStat.Stat != "Maximum" {
+		t.Errorf("Stat = %s, want spec default Maximum", *queries[1].MetricStat.Stat)
+	}
+	if *queries[0].MetricStat.Metric.Dimensions[0].Value != "i-abc" {
+		t.Errorf("Dimension value = %s, want i-abc", *queries[0].MetricStat.Metric.Dimensions[0].Value)
+	}
+}
+
+func TestBuildSeriesQueries_StatOverrideAndMinPeriod(t *testing.T) {
+	specs := []render.MetricSpec{
+		{Namespace: "AWS/Lambda", MetricName: "Duration", DimensionName: "FunctionName", Stat: "Average"},
+	}
+
+	queries := buildSeriesQueries(&dao.BaseResource{ID: "fn"}, specs, SeriesQuery{Period: 10 * time.Second, Stat: "p99"})
+	if *queries[0].MetricStat.Stat != "p99" {
+		t.Errorf("Stat = %s, want p99", *queries[0].MetricStat.Stat)
+	}
+	if *queries[0].MetricStat.Period != metricPeriod {
+		t.Errorf("Period = %d, want %d", *queries[0].MetricStat.Period, metricPeriod)
+	}
+}
@@ -10,22 +10,39 @@ type MetricResult struct {
 	HasData    bool
 }
 
-// MetricData holds metric results for multiple resources.
+// MetricData holds metric results for multiple resources and metrics.
+// Results is indexed like Specs and keyed by resource ID.
 type MetricData struct {
-	Results map[string]*MetricResult
-	Spec    *render.MetricSpec
+	Results []map[string]*MetricResult
+	Specs   []render.MetricSpec
 }
 
-func NewMetricData(spec *render.MetricSpec) *MetricData {
+func NewMetricData(specs []render.MetricSpec) *MetricData {
+	results := make([]map[string]*MetricResult, len(specs))
+	for i := range results {
+		results[i] = make(map[string]*MetricResult)
+	}
 	return &MetricData{
-		Results: make(map[string]*MetricResult),
-		Spec:    spec,
+		Results: results,
+		Specs:   specs,
 	}
 }
 
-func (m *MetricData) Get(resourceID string) *MetricResult {
-	if m == nil || m.Results == nil {
+// Get returns the result of the spec at specIdx for resourceID.
+func (m *MetricData) Get(specIdx int, resourceID string) *MetricResult {
+	if m == nil || specIdx < 0 || specIdx >= len(m.Results) || m.Results[specIdx] == nil {
 		return nil
 	}
-	return m.Results[resourceID]
+	return m.Results[specIdx][resourceID]
+}
+
+// Set stores the result of the spec at specIdx for resourceID.
+func (m *MetricData) Set(specIdx int, resourceID string, result *MetricResult) {
+	if m == nil || specIdx < 0 || specIdx >= len(m.Results) {
+		return
+	}
+	if m.Results[specIdx] == nil {
+		m.Results[specIdx] = make(map[string]*MetricResult)
+	}
+	m.Results[specIdx][resourceID] = result
 }
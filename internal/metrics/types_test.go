@@ -1,10 +1,14 @@
 package metrics
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/render"
+)
 
 func TestMetricData_Get_Nil(t *testing.T) {
 	var data *MetricData
-	result := data.Get("test-id")
+	result := data.Get(0, "test-id")
 	if result != nil {
 		t.Errorf("Get on nil MetricData = %v, want nil", result)
 	}
@@ -12,27 +16,39 @@ func TestMetricData_Get_Nil(t *testing.T) {
 
 func TestMetricData_Get_NilResults(t *testing.T) {
 	data := &MetricData{Results: nil}
-	result := data.Get("test-id")
+	result := data.Get(0, "test-id")
 	if result != nil {
 		t.Errorf("Get on nil Results = %v, want nil", result)
 	}
 }
 
 func TestMetricData_Get_NotFound(t *testing.T) {
-	data := NewMetricData(nil)
-	result := data.Get("nonexistent")
+	data := NewMetricData([]render.MetricSpec{{MetricName: "CPUUtilization"}})
+	result := data.Get(0, "nonexistent")
 	if result != nil {
 		t.Errorf("Get(nonexistent) = %v, want nil", result)
 	}
 }
 
+func TestMetricData_Get_OutOfRange(t *testing.T) {
+	data := NewMetricData([]render.MetricSpec{{MetricName: "CPUUtilization"}})
+	if result := data.Get(1, "test-id"); result != nil {
+		t.Errorf("Get(1, test-id) = %v, want nil", result)
+	}
+	if result := data.Get(-1, "test-id"); result != nil {
+		t.Errorf("Get(-1, test-id) = %v, want nil", result)
+	}
+}
+
 func TestMetricData_Get_Found(t *testing.T) {
-	data := NewMetricData(nil)
+	data := NewMetricData([]render.MetricSpec{{MetricName: "Invocations"}, {MetricName: "Errors"}})
 	expected := &MetricResult{ResourceID: "test-id", HasData: true}
-	data.Results["test-id"] = expected
+	data.Set(1, "test-id", expected)
 
-	result := data.Get("test-id")
-	if result != expected {
-		t.Errorf("Get(test-id) = %v, want %v", result, expected)
+	if result := data.Get(1, "test-id"); result != expected {
+		t.Errorf("Get(1, test-id) = %v, want %v", result, expected)
+	}
+	if result := data.Get(0, "test-id"); result != nil {
+		t.Errorf("Get(0, test-id) = %v, want nil", result)
 	}
 }
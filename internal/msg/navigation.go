@@ -11,3 +11,10 @@ type ProfilesChangedMsg struct {
 type RegionChangedMsg struct {
 	Regions []string
 }
+
+// ShowTextMsg asks the app to open a read-only text view, e.g. for
+// content fetched by an action
+type ShowTextMsg struct {
+	Title   string
+	Content string
+}
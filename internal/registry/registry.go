@@ -623,6 +623,7 @@ var subResourceSet = map[string]struct{}{
 	"cloudwatch/log-streams":           {},
 	"service-quotas/quotas":            {},
 	"route53/record-sets":              {},
+	"s3/objects":                       {},
 	"apigateway/stages":                {},
 	"apigateway/stages-v2":             {},
 	"elbv2/targets":                    {},
@@ -631,6 +632,8 @@ var subResourceSet = map[string]struct{}{
 	"cognito-idp/users":                {},
 	"codepipeline/executions":          {},
 	"stepfunctions/executions":         {},
+	"stepfunctions/execution-history":  {},
+	"kinesis/shards":                   {},
 	"codebuild/builds":                 {},
 	"backup/recovery-points":           {},
 	"backup/selections":                {},
@@ -657,10 +660,15 @@ var subResourceSet = map[string]struct{}{
 	"license-manager/grants":           {},
 	"appsync/data-sources":             {},
 	"eks/node-groups":                  {},
+	"sqs/move-tasks":                   {},
 	"eks/fargate-profiles":             {},
 	"eks/addons":                       {},
 	"eks/access-entries":               {},
 	"redshift/snapshots":               {},
+	"configservice/history":            {},
+	"cloudwatch/alarm-history":         {},
+	"ecs/service-events":               {},
+	"lambda/event-source-mappings":     {},
 }
 
 // isSubResource returns true if the resource is only accessible via navigation
@@ -29,6 +29,24 @@ type SummaryField struct {
 // ViewTypeLogView indicates navigation should open a LogView instead of ResourceBrowser
 const ViewTypeLogView = "log-view"
 
+// ViewTypeItemExplorer opens the DynamoDB item explorer for a table
+const ViewTypeItemExplorer = "item-explorer"
+
+// ViewTypeRecordViewer opens the Kinesis record viewer for a stream or shard
+const ViewTypeRecordViewer = "record-viewer"
+
+// ViewTypeSecretViewer opens the masked value viewer for a secret
+const ViewTypeSecretViewer = "secret-viewer"
+
+// ViewTypeParameterViewer opens the value and history viewer for an SSM parameter
+const ViewTypeParameterViewer = "parameter-viewer"
+
+// ViewTypeParameterCompare compares an SSM path subtree with another profile's
+const ViewTypeParameterCompare = "parameter-compare"
+
+// ViewTypeMessagePeek peeks at the messages in an SQS queue
+const ViewTypeMessagePeek = "message-peek"
+
 // Navigation defines a navigation shortcut to related resources or custom views
 type Navigation struct {
 	Key            string
@@ -85,8 +103,16 @@ type Toggler interface {
 }
 
 // MetricSpecProvider is an optional interface for renderers that support inline metrics.
+// Each returned spec is displayed as its own sparkline column.
 type MetricSpecProvider interface {
-	MetricSpec() *MetricSpec
+	MetricSpecs() []MetricSpec
+}
+
+// MetricChartProvider is an optional interface for renderers that plot several
+// related metrics in the metrics chart view. Renderers that only implement
+// MetricSpecProvider get one chart per inline metric.
+type MetricChartProvider interface {
+	MetricCharts() []MetricSpec
 }
 
 // MetricSpec defines which CloudWatch metric to fetch for inline display.
@@ -97,6 +123,12 @@ type MetricSpec struct {
 	Stat          string
 	ColumnHeader  string
 	Unit          string // Display unit (e.g., "%", "", "ms"). Empty for count-based metrics.
+
+	// DimensionField selects the resource field used as dimension value:
+	// "" or "ID" (default), "Name", "ARN", "tag:<Key>", or a dotted path in Raw().
+	DimensionField string
+	// Expression is an optional metric math expression; "m" refers to the metric above.
+	Expression string
 }
 
 // BaseRenderer provides a default implementation
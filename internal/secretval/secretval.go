@@ -0,0 +1,223 @@
+// Package secretval reads Secrets Manager secret values and versions for
+// the secret viewer. It splits JSON secrets into keys so each can be
+// revealed and copied alone, and compares versions key by key.
+package secretval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Mask replaces hidden values. It has a fixed length so it does not give
+// away the length of the value.
+const Mask = "••••••••"
+
+// Version stages set by Secrets Manager
+const (
+	StageCurrent  = "AWSCURRENT"
+	StagePrevious = "AWSPREVIOUS"
+	StagePending  = "AWSPENDING"
+)
+
+// Client is the part of the Secrets Manager client the viewer uses
+type Client interface {
+	GetSecretValue(ctx context.Context, in *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	ListSecretVersionIds(ctx context.Context, in *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error)
+}
+
+// Version is a version of a secret and the stages attached to it
+type Version struct {
+	ID      string
+	Stages  []string
+	Created time.Time
+}
+
+// HasStage returns whether the stage is attached to the version
+func (v Version) HasStage(stage string) bool {
+	return slices.Contains(v.Stages, stage)
+}
+
+// Versions lists the versions of a secret that have a stage, newest first.
+// Versions without a stage are deprecated and can no longer be read by
+// stage, so they are left out.
+func Versions(ctx context.Context, client Client, secretID string) ([]Version, error) {
+	var versions []Version
+	in := &secretsmanager.ListSecretVersionIdsInput{SecretId: &secretID, MaxResults: appaws.Int32Ptr(100)}
+	for {
+		out, err := client.ListSecretVersionIds(ctx, in)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list versions of secret %s", secretID)
+		}
+		for _, v := range out.Versions {
+			versions = append(versions, newVersion(v))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	slices.SortStableFunc(versions, func(a, b Version) int {
+		return b.Created.Compare(a.Created)
+	})
+	return versions, nil
+}
+
+func newVersion(v types.SecretVersionsListEntry) Version {
+	return Version{
+		ID:      appaws.Str(v.VersionId),
+		Stages:  v.VersionStages,
+		Created: appaws.Time(v.CreatedDate),
+	}
+}
+
+// Value is the value of one version of a secret
+type Value struct {
+	VersionID string
+	Stages    []string
+	Created   time.Time
+	String    string
+	Binary    []byte // set instead of String for binary secrets
+}
+
+// Get reads the value of a version of a secret, or of AWSCURRENT if
+// versionID is empty
+func Get(ctx context.Context, client Client, secretID, versionID string) (Value, error) {
+	in := &secretsmanager.GetSecretValueInput{SecretId: &secretID}
+	if versionID != "" {
+		in.VersionId = &versionID
+	}
+	out, err := client.GetSecretValue(ctx, in)
+	if err != nil {
+		return Value{}, apperrors.Wrapf(err, "get value of secret %s", secretID)
+	}
+	return Value{
+		VersionID: appaws.Str(out.VersionId),
+		Stages:    out.VersionStages,
+		Created:   appaws.Time(out.CreatedDate),
+		String:    appaws.Str(out.SecretString),
+		Binary:    out.SecretBinary,
+	}, nil
+}
+
+// Text returns the value as text; binary secrets are described, not shown
+func (v Value) Text() string {
+	if v.Binary != nil {
+		return fmt.Sprintf("(binary secret, %d bytes)", len(v.Binary))
+	}
+	return v.String
+}
+
+// Field is a key of a JSON secret, or the whole value of a plain one
+type Field struct {
+	Key   string // empty for a plain value
+	Value string // strings unquoted, other JSON values as compact JSON
+}
+
+// Fields splits a JSON object secret into its keys, in the order they are
+// written. Any other value is a single field without a key.
+func Fields(value string) []Field {
+	if fields, ok := objectFields(value); ok {
+		return fields
+	}
+	return []Field{{Value: value}}
+}
+
+func objectFields(value string) ([]Field, bool) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(value)))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false
+	}
+	var fields []Field
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		key, _ := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, false
+		}
+		fields = append(fields, Field{Key: key, Value: fieldValue(raw)})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false // trailing data
+	}
+	return fields, true
+}
+
+func fieldValue(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err == nil {
+		return buf.String()
+	}
+	return string(raw)
+}
+
+// ChangeKind says how a key differs between two versions
+type ChangeKind string
+
+const (
+	Unchanged ChangeKind = "unchanged"
+	Changed   ChangeKind = "changed"
+	Added     ChangeKind = "added"
+	Removed   ChangeKind = "removed"
+)
+
+// Change is a key compared between two versions
+type Change struct {
+	Key      string
+	Kind     ChangeKind
+	Old, New string
+}
+
+// Diff compares two values key by key: the keys of to in order, then the
+// keys only in from. Plain values compare as a single keyless field.
+func Diff(from, to string) []Change {
+	old := Fields(from)
+	oldByKey := make(map[string]string, len(old))
+	for _, f := range old {
+		oldByKey[f.Key] = f.Value
+	}
+
+	var changes []Change
+	seen := make(map[string]bool)
+	for _, f := range Fields(to) {
+		seen[f.Key] = true
+		c := Change{Key: f.Key, New: f.Value}
+		prev, ok := oldByKey[f.Key]
+		switch {
+		case !ok:
+			c.Kind = Added
+		case prev != f.Value:
+			c.Kind, c.Old = Changed, prev
+		default:
+			c.Kind, c.Old = Unchanged, prev
+		}
+		changes = append(changes, c)
+	}
+	for _, f := range old {
+		if !seen[f.Key] {
+			changes = append(changes, Change{Key: f.Key, Kind: Removed, Old: f.Value})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,97 @@
+package secretval
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+type fakeSecrets struct {
+	versions []types.SecretVersionsListEntry
+	values   map[string]string // version ID -> value
+	gets     []*secretsmanager.GetSecretValueInput
+}
+
+func (f *fakeSecrets) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	f.gets = append(f.gets, in)
+	id := aws.ToString(in.VersionId)
+	if id == "" {
+		id = "v2"
+	}
+	return &secretsmanager.GetSecretValueOutput{VersionId: aws.String(id), SecretString: aws.String(f.values[id])}, nil
+}
+
+func (f *fakeSecrets) ListSecretVersionIds(_ context.Context, in *secretsmanager.ListSecretVersionIdsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	// One version per page
+	if in.NextToken == nil {
+		return &secretsmanager.ListSecretVersionIdsOutput{Versions: f.versions[:1], NextToken: aws.String("next")}, nil
+	}
+	return &secretsmanager.ListSecretVersionIdsOutput{Versions: f.versions[1:]}, nil
+}
+
+func TestVersionsAndGet(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f := &fakeSecrets{
+		versions: []types.SecretVersionsListEntry{
+			{VersionId: aws.String("v1"), VersionStages: []string{StagePrevious}, CreatedDate: aws.Time(created)},
+			{VersionId: aws.String("v2"), VersionStages: []string{StageCurrent}, CreatedDate: aws.Time(created.Add(time.Hour))},
+		},
+		values: map[string]string{"v1": "old", "v2": "new"},
+	}
+	versions, err := Versions(context.Background(), f, "db")
+	if err != nil {
+		t.Fatalf("Versions() error = %v", err)
+	}
+	if len(versions) != 2 || versions[0].ID != "v2" || !versions[0].HasStage(StageCurrent) {
+		t.Errorf("versions = %+v, want newest first over both pages", versions)
+	}
+
+	value, err := Get(context.Background(), f, "db", "")
+	if err != nil || value.String != "new" || f.gets[0].VersionId != nil {
+		t.Errorf("Get(current) = %+v, %v; input %+v", value, err, f.gets[0])
+	}
+	if value, _ := Get(context.Background(), f, "db", "v1"); value.String != "old" {
+		t.Errorf("Get(v1) = %q, want old", value.String)
+	}
+}
+
+func TestFields(t *testing.T) {
+	got := Fields(`{"username":"admin","password":"p@ss","port":5432,"opts":{"ssl": true}}`)
+	want := []Field{
+		{Key: "username", Value: "admin"},
+		{Key: "password", Value: "p@ss"},
+		{Key: "port", Value: "5432"},
+		{Key: "opts", Value: `{"ssl":true}`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %+v, want keys in written order", got)
+	}
+
+	for _, plain := range []string{"hunter2", `["a"]`, `{"a":1} trailing`, ""} {
+		if got := Fields(plain); len(got) != 1 || got[0].Key != "" || got[0].Value != plain {
+			t.Errorf("Fields(%q) = %+v, want the whole value", plain, got)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := Diff(`{"user":"admin","password":"old","legacy":"x"}`, `{"user":"admin","password":"new","token":"t"}`)
+	want := []Change{
+		{Key: "user", Kind: Unchanged, Old: "admin", New: "admin"},
+		{Key: "password", Kind: Changed, Old: "old", New: "new"},
+		{Key: "token", Kind: Added, New: "t"},
+		{Key: "legacy", Kind: Removed, Old: "x"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %+v\nwant %+v", got, want)
+	}
+
+	if got := Diff("a", "b"); len(got) != 1 || got[0].Kind != Changed || got[0].Key != "" {
+		t.Errorf("plain Diff() = %+v", got)
+	}
+}
@@ -0,0 +1,148 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// ParseTargets resolves "service" or "service/resource" arguments (aliases
+// allowed) into targets. Sub-resources that need a parent are rejected.
+func ParseTargets(reg *registry.Registry, args []string) ([]Target, error) {
+	var targets []Target
+	for _, arg := range args {
+		service, resourceType, err := reg.ParseServiceResource(arg)
+		if err != nil {
+			return nil, err
+		}
+		if reg.IsSubResource(service, resourceType) {
+			return nil, fmt.Errorf("%s/%s requires a parent resource and cannot be snapshotted", service, resourceType)
+		}
+		t := Target{Service: service, ResourceType: resourceType}
+		if !slices.Contains(targets, t) {
+			targets = append(targets, t)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no resource types given")
+	}
+	return targets, nil
+}
+
+// CurrentScope returns a scope for targets using the current profile and
+// region selection.
+func CurrentScope(targets []Target) Scope {
+	scope := Scope{Targets: targets, Regions: config.Global().Regions()}
+	for _, sel := range config.Global().Selections() {
+		scope.Profiles = append(scope.Profiles, sel.ID())
+	}
+	return scope
+}
+
+type captureKey struct {
+	target  Target
+	profile string
+	region  string
+}
+
+// Capture lists every target in every profile and region of scope.
+// Failures of individual lists are recorded in Snapshot.Errors.
+func Capture(ctx context.Context, reg *registry.Registry, name string, scope Scope) *Snapshot {
+	profiles := scope.Profiles
+	if len(profiles) == 0 {
+		profiles = []string{""}
+	}
+	regions := scope.Regions
+	if len(regions) == 0 {
+		regions = []string{""}
+	}
+
+	var keys []captureKey
+	for _, t := range scope.Targets {
+		for _, p := range profiles {
+			for _, r := range regions {
+				keys = append(keys, captureKey{target: t, profile: p, region: r})
+			}
+		}
+	}
+
+	type result struct {
+		resources []Resource
+		err       error
+	}
+	results := make([]result, len(keys))
+	sem := make(chan struct{}, config.File().MaxConcurrentFetches())
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			resources, err := captureOne(ctx, reg, key)
+			results[i] = result{resources: resources, err: err}
+		}()
+	}
+	wg.Wait()
+
+	s := &Snapshot{Name: name, CreatedAt: time.Now().UTC(), Scope: scope}
+	for i, res := range results {
+		if res.err != nil {
+			key := keys[i]
+			log.Debug("snapshot capture failed", "target", key.target, "profile", key.profile, "region", key.region, "error", res.err)
+			s.Errors = append(s.Errors, fmt.Sprintf("%s %s/%s: %v", key.target, key.profile, key.region, res.err))
+			continue
+		}
+		s.Resources = append(s.Resources, res.resources...)
+	}
+	return s
+}
+
+func captureOne(ctx context.Context, reg *registry.Registry, key captureKey) ([]Resource, error) {
+	if key.profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(key.profile))
+	}
+	if key.region != "" {
+		ctx = aws.WithRegionOverride(ctx, key.region)
+	}
+
+	d, err := reg.GetDAO(ctx, key.target.Service, key.target.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	listed, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, 0, len(listed))
+	for _, res := range listed {
+		res = dao.UnwrapResource(res)
+		data, err := json.Marshal(res.Raw())
+		if err != nil {
+			log.Debug("snapshot: cannot marshal resource", "id", res.GetID(), "error", err)
+			data = nil
+		}
+		resources = append(resources, Resource{
+			Service:      key.target.Service,
+			ResourceType: key.target.ResourceType,
+			Profile:      key.profile,
+			Region:       key.region,
+			ID:           res.GetID(),
+			Name:         res.GetName(),
+			ARN:          res.GetARN(),
+			Tags:         res.GetTags(),
+			Data:         data,
+		})
+	}
+	return resources, nil
+}
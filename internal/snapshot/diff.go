@@ -0,0 +1,65 @@
+package snapshot
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/clawscli/claws/internal/jsondiff"
+)
+
+// ResourceChange is a resource that was added, removed or changed between
+// two snapshots. Old is nil for added resources and New for removed ones.
+type ResourceChange struct {
+	Kind    jsondiff.Kind
+	Old     *Resource
+	New     *Resource
+	Changes []jsondiff.Change // field changes of a changed resource
+}
+
+// Resource returns the newest recorded version of the resource.
+func (c ResourceChange) Resource() *Resource {
+	if c.New != nil {
+		return c.New
+	}
+	return c.Old
+}
+
+// Compare returns the resources that differ between before and after, ordered
+// by service, resource type, profile, region and ID. Resources whose data
+// differs only in fields skipped by opts are not reported.
+func Compare(before, after *Snapshot, opts jsondiff.Options) ([]ResourceChange, error) {
+	oldByKey := make(map[string]*Resource, len(before.Resources))
+	for i := range before.Resources {
+		oldByKey[before.Resources[i].Key()] = &before.Resources[i]
+	}
+	newByKey := make(map[string]*Resource, len(after.Resources))
+	for i := range after.Resources {
+		newByKey[after.Resources[i].Key()] = &after.Resources[i]
+	}
+
+	var changes []ResourceChange
+	for key, o := range oldByKey {
+		n, ok := newByKey[key]
+		if !ok {
+			changes = append(changes, ResourceChange{Kind: jsondiff.Removed, Old: o})
+			continue
+		}
+		fieldChanges, err := jsondiff.Diff(o.Raw(), n.Raw(), opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(fieldChanges) > 0 {
+			changes = append(changes, ResourceChange{Kind: jsondiff.Changed, Old: o, New: n, Changes: fieldChanges})
+		}
+	}
+	for key, n := range newByKey {
+		if _, ok := oldByKey[key]; !ok {
+			changes = append(changes, ResourceChange{Kind: jsondiff.Added, New: n})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b ResourceChange) int {
+		return strings.Compare(a.Resource().Key(), b.Resource().Key())
+	})
+	return changes, nil
+}
@@ -0,0 +1,188 @@
+// Package snapshot records resource inventories to local files and compares
+// them over time.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+const (
+	snapshotDir = "snapshots"
+	fileExt     = ".json"
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// IsValidName reports whether name can be used as a snapshot name.
+// Valid characters: alphanumeric, hyphen, underscore, period
+func IsValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// Target is a service/resource type recorded in a snapshot.
+type Target struct {
+	Service      string `json:"service"`
+	ResourceType string `json:"resource_type"`
+}
+
+func (t Target) String() string {
+	return t.Service + "/" + t.ResourceType
+}
+
+// Scope describes what a snapshot covers, so that live state can be
+// captured the same way for comparison.
+type Scope struct {
+	Targets  []Target `json:"targets"`
+	Profiles []string `json:"profiles"` // profile selection IDs
+	Regions  []string `json:"regions"`
+}
+
+// Snapshot is a point-in-time inventory of resources.
+type Snapshot struct {
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"created_at"`
+	Scope     Scope      `json:"scope"`
+	Resources []Resource `json:"resources"`
+	Errors    []string   `json:"errors,omitempty"` // targets that could not be listed
+}
+
+// Resource is a recorded resource with its raw API data.
+// It implements dao.Resource so it can be shown in diff views.
+type Resource struct {
+	Service      string            `json:"service"`
+	ResourceType string            `json:"resource_type"`
+	Profile      string            `json:"profile,omitempty"`
+	Region       string            `json:"region,omitempty"`
+	ID           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	ARN          string            `json:"arn,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Data         json.RawMessage   `json:"data,omitempty"`
+}
+
+func (r *Resource) GetID() string              { return r.ID }
+func (r *Resource) GetName() string            { return r.Name }
+func (r *Resource) GetARN() string             { return r.ARN }
+func (r *Resource) GetTags() map[string]string { return r.Tags }
+func (r *Resource) GetRegion() string          { return r.Region }
+func (r *Resource) GetProfile() string         { return r.Profile }
+func (r *Resource) GetAccountID() string       { return "" }
+
+// Raw returns the recorded API data as JSON.
+func (r *Resource) Raw() any {
+	if len(r.Data) == 0 {
+		return nil
+	}
+	return r.Data
+}
+
+// Key identifies the resource across snapshots.
+func (r *Resource) Key() string {
+	return strings.Join([]string{r.Service, r.ResourceType, r.Profile, r.Region, r.ID}, "|")
+}
+
+// Info summarizes a saved snapshot.
+type Info struct {
+	Name      string
+	CreatedAt time.Time
+	Path      string
+}
+
+// Dir returns the directory where snapshots are stored.
+func Dir() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, snapshotDir), nil
+}
+
+// Path returns the file path of the named snapshot.
+func Path(name string) (string, error) {
+	if !IsValidName(name) {
+		return "", fmt.Errorf("invalid snapshot name: %q", name)
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+fileExt), nil
+}
+
+// Save writes the snapshot to its file, replacing any snapshot with the same name.
+func Save(s *Snapshot) (string, error) {
+	path, err := Path(s.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", fmt.Errorf("create snapshot dir: %w", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("write snapshot: %w", err)
+	}
+	return path, nil
+}
+
+// Load reads the named snapshot.
+func Load(name string) (*Snapshot, error) {
+	path, err := Path(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot not found: %s", name)
+		}
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse snapshot %s: %w", name, err)
+	}
+	return &s, nil
+}
+
+// List returns the saved snapshots, newest first.
+func List() ([]Info, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var infos []Info
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), fileExt)
+		if e.IsDir() || !ok || !IsValidName(name) {
+			continue
+		}
+		fi, err := e.Info()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, Info{Name: name, CreatedAt: fi.ModTime(), Path: filepath.Join(dir, e.Name())})
+	}
+	slices.SortFunc(infos, func(a, b Info) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return infos, nil
+}
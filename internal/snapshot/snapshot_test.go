@@ -0,0 +1,119 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/clawscli/claws/internal/jsondiff"
+)
+
+func TestIsValidName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"prod-2024-06-07", true},
+		{"before_deploy.v2", true},
+		{"", false},
+		{"../etc", false},
+		{"-leading", false},
+		{"has space", false},
+	}
+	for _, tt := range tests {
+		if got := IsValidName(tt.name); got != tt.want {
+			t.Errorf("IsValidName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSaveLoadList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	s := &Snapshot{
+		Name:  "friday",
+		Scope: Scope{Targets: []Target{{Service: "lambda", ResourceType: "functions"}}, Regions: []string{"us-east-1"}},
+		Resources: []Resource{
+			{Service: "lambda", ResourceType: "functions", Region: "us-east-1", ID: "fn", Data: json.RawMessage(`{"MemorySize":128}`)},
+		},
+	}
+	if _, err := Save(s); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load("friday")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Resources) != 1 || loaded.Resources[0].ID != "fn" {
+		t.Errorf("Load() resources = %+v", loaded.Resources)
+	}
+	if loaded.Scope.Targets[0].String() != "lambda/functions" {
+		t.Errorf("Load() scope = %+v", loaded.Scope)
+	}
+
+	infos, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(infos) != 1 || infos[0].Name != "friday" {
+		t.Errorf("List() = %+v", infos)
+	}
+
+	if _, err := Load("missing"); err == nil {
+		t.Error("Load(missing) should fail")
+	}
+	if _, err := Save(&Snapshot{Name: "../bad"}); err == nil {
+		t.Error("Save() with invalid name should fail")
+	}
+}
+
+func res(id, data string) Resource {
+	return Resource{Service: "ec2", ResourceType: "instances", Region: "us-east-1", ID: id, Data: json.RawMessage(data)}
+}
+
+func TestCompare(t *testing.T) {
+	before := &Snapshot{Resources: []Resource{
+		res("i-1", `{"InstanceType":"t3.micro"}`),
+		res("i-2", `{"InstanceType":"t3.micro"}`),
+		res("i-3", `{"InstanceType":"t3.micro","LaunchTime":"2024-01-01"}`),
+	}}
+	after := &Snapshot{Resources: []Resource{
+		res("i-1", `{"InstanceType":"t3.large"}`),
+		res("i-3", `{"InstanceType":"t3.micro","LaunchTime":"2024-02-01"}`),
+		res("i-4", `{"InstanceType":"t3.micro"}`),
+	}}
+
+	changes, err := Compare(before, after, jsondiff.Options{IgnoreVolatile: true})
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+
+	want := []struct {
+		id   string
+		kind jsondiff.Kind
+	}{
+		{"i-1", jsondiff.Changed},
+		{"i-2", jsondiff.Removed},
+		{"i-4", jsondiff.Added},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Compare() = %d changes, want %d", len(changes), len(want))
+	}
+	for i, w := range want {
+		if changes[i].Resource().ID != w.id || changes[i].Kind != w.kind {
+			t.Errorf("change[%d] = %s %s, want %s %s", i, changes[i].Kind.Symbol(), changes[i].Resource().ID, w.kind.Symbol(), w.id)
+		}
+	}
+	if len(changes[0].Changes) != 1 || changes[0].Changes[0].Path != "InstanceType" {
+		t.Errorf("field changes = %+v", changes[0].Changes)
+	}
+
+	// Volatile fields count when not ignored
+	changes, err = Compare(before, after, jsondiff.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 4 {
+		t.Errorf("Compare() without IgnoreVolatile = %d changes, want 4", len(changes))
+	}
+}
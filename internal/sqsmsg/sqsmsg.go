@@ -0,0 +1,169 @@
+// Package sqsmsg peeks at SQS messages for the message peek view: it
+// receives a sample of messages, makes them visible again and deletes
+// them by receipt handle.
+package sqsmsg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// MaxPeekMessages is how many messages a peek collects
+	MaxPeekMessages = 50
+	// peekRounds is how many receive calls a peek makes. Short polling
+	// samples a subset of servers, so repeated calls find more messages.
+	peekRounds = 10
+	// peekEmptyRounds ends a peek after this many calls without new messages
+	peekEmptyRounds = 2
+	// receiveBatch is the ReceiveMessage and ChangeMessageVisibilityBatch limit
+	receiveBatch = 10
+	// peekVisibilityTimeout hides peeked messages for the length of a peek,
+	// in seconds. Peek makes them visible again when it ends; the timeout
+	// bounds how long they stay hidden if that fails.
+	peekVisibilityTimeout = 30
+)
+
+// Client is the part of the SQS client the peek view uses
+type Client interface {
+	ReceiveMessage(ctx context.Context, in *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	ChangeMessageVisibilityBatch(ctx context.Context, in *sqs.ChangeMessageVisibilityBatchInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityBatchOutput, error)
+	DeleteMessage(ctx context.Context, in *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
+}
+
+// Peek collects distinct messages over several short-polling calls. The
+// messages stay hidden while the peek runs, so later calls find others,
+// and are made visible again when it ends. A visibility timeout of 0 on
+// the receive itself is not sent by the SDK, so it cannot do this. Every
+// receive counts towards the queue's maxReceiveCount.
+func Peek(ctx context.Context, client Client, queueURL string) (_ []types.Message, err error) {
+	var messages []types.Message
+	var handles []string
+	defer func() {
+		// Release even when the peek was cancelled
+		if rerr := release(context.WithoutCancel(ctx), client, queueURL, handles); rerr != nil && err == nil {
+			err = rerr
+		}
+	}()
+
+	seen := make(map[string]bool)
+	empty := 0
+	for round := 0; round < peekRounds && len(messages) < MaxPeekMessages && empty < peekEmptyRounds; round++ {
+		output, err := client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:                    &queueURL,
+			MaxNumberOfMessages:         receiveBatch,
+			VisibilityTimeout:           peekVisibilityTimeout,
+			MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
+			MessageAttributeNames:       []string{"All"},
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "receive messages from %s", appaws.ExtractResourceName(queueURL))
+		}
+		added := 0
+		for _, m := range output.Messages {
+			if h := appaws.Str(m.ReceiptHandle); h != "" {
+				handles = append(handles, h)
+			}
+			id := appaws.Str(m.MessageId)
+			if seen[id] || len(messages) == MaxPeekMessages {
+				continue
+			}
+			seen[id] = true
+			messages = append(messages, m)
+			added++
+		}
+		if added == 0 {
+			empty++
+		}
+	}
+	return messages, nil
+}
+
+// release makes received messages visible again. Entries that fail, e.g.
+// for a message a consumer deleted meanwhile, are ignored.
+func release(ctx context.Context, client Client, queueURL string, handles []string) error {
+	for start := 0; start < len(handles); start += receiveBatch {
+		batch := handles[start:min(start+receiveBatch, len(handles))]
+		entries := make([]types.ChangeMessageVisibilityBatchRequestEntry, len(batch))
+		for i, h := range batch {
+			entries[i] = types.ChangeMessageVisibilityBatchRequestEntry{
+				Id:                aws.String(strconv.Itoa(i)),
+				ReceiptHandle:     aws.String(h),
+				VisibilityTimeout: 0,
+			}
+		}
+		if _, err := client.ChangeMessageVisibilityBatch(ctx, &sqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: &queueURL,
+			Entries:  entries,
+		}); err != nil {
+			return apperrors.Wrapf(err, "make peeked messages visible again in %s (they reappear within %ds)",
+				appaws.ExtractResourceName(queueURL), peekVisibilityTimeout)
+		}
+	}
+	return nil
+}
+
+// Delete deletes a message with the receipt handle from a peek. If the
+// message was received again since, SQS accepts the stale handle but
+// deletes nothing.
+func Delete(ctx context.Context, client Client, queueURL string, m types.Message) error {
+	if _, err := client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      &queueURL,
+		ReceiptHandle: m.ReceiptHandle,
+	}); err != nil {
+		return apperrors.Wrapf(err, "delete message %s (peek again if it was received since)", appaws.Str(m.MessageId))
+	}
+	return nil
+}
+
+// PrettyBody returns a message body with JSON indented
+func PrettyBody(m types.Message) string {
+	body := appaws.Str(m.Body)
+	trimmed := bytes.TrimSpace([]byte(body))
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, trimmed, "", "  "); err == nil {
+			return buf.String()
+		}
+	}
+	return body
+}
+
+// Attribute returns a system attribute, e.g. ApproximateReceiveCount
+func Attribute(m types.Message, name types.MessageSystemAttributeName) string {
+	return m.Attributes[string(name)]
+}
+
+// ReceiveCount returns how often a message was received, the peek included
+func ReceiveCount(m types.Message) string {
+	return Attribute(m, types.MessageSystemAttributeNameApproximateReceiveCount)
+}
+
+// SentTime returns when a message was sent
+func SentTime(m types.Message) time.Time {
+	return epochMillis(Attribute(m, types.MessageSystemAttributeNameSentTimestamp))
+}
+
+// FirstReceiveTime returns when a message was first received
+func FirstReceiveTime(m types.Message) time.Time {
+	return epochMillis(Attribute(m, types.MessageSystemAttributeNameApproximateFirstReceiveTimestamp))
+}
+
+// epochMillis parses the millisecond timestamps of message attributes
+func epochMillis(s string) time.Time {
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,118 @@
+package sqsmsg
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// fakeClient returns the batches in turn, then nothing
+type fakeClient struct {
+	batches  [][]string
+	inputs   []*sqs.ReceiveMessageInput
+	released []string
+}
+
+func (f *fakeClient) ReceiveMessage(_ context.Context, in *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	f.inputs = append(f.inputs, in)
+	var output sqs.ReceiveMessageOutput
+	if len(f.inputs) <= len(f.batches) {
+		for _, id := range f.batches[len(f.inputs)-1] {
+			output.Messages = append(output.Messages, types.Message{MessageId: aws.String(id), ReceiptHandle: aws.String("rh-" + id)})
+		}
+	}
+	return &output, nil
+}
+
+func (f *fakeClient) ChangeMessageVisibilityBatch(_ context.Context, in *sqs.ChangeMessageVisibilityBatchInput, _ ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityBatchOutput, error) {
+	if len(in.Entries) > receiveBatch {
+		return nil, fmt.Errorf("%d entries", len(in.Entries))
+	}
+	for _, e := range in.Entries {
+		if e.VisibilityTimeout != 0 {
+			return nil, fmt.Errorf("visibility timeout %d", e.VisibilityTimeout)
+		}
+		f.released = append(f.released, aws.ToString(e.ReceiptHandle))
+	}
+	return &sqs.ChangeMessageVisibilityBatchOutput{}, nil
+}
+
+func (f *fakeClient) DeleteMessage(_ context.Context, _ *sqs.DeleteMessageInput, _ ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+	return &sqs.DeleteMessageOutput{}, nil
+}
+
+func TestPeek(t *testing.T) {
+	client := &fakeClient{batches: [][]string{{"a", "b"}, {"b", "c"}, {"a"}}}
+	messages, err := Peek(context.Background(), client, "https://sqs.us-east-1.amazonaws.com/123456789012/orders")
+	if err != nil {
+		t.Fatalf("Peek() error = %v", err)
+	}
+	if len(messages) != 3 {
+		t.Errorf("Peek() = %d messages, want the 3 distinct ones", len(messages))
+	}
+	// Two rounds without new messages end the peek
+	if len(client.inputs) != 4 {
+		t.Errorf("receive calls = %d, want 4", len(client.inputs))
+	}
+	for _, in := range client.inputs {
+		if in.VisibilityTimeout != peekVisibilityTimeout || in.MaxNumberOfMessages != receiveBatch {
+			t.Errorf("input = %+v, want visibility timeout %d", in, peekVisibilityTimeout)
+		}
+	}
+	// Every received handle is made visible again, duplicates included
+	want := []string{"rh-a", "rh-b", "rh-b", "rh-c", "rh-a"}
+	if !slices.Equal(client.released, want) {
+		t.Errorf("released = %v, want %v", client.released, want)
+	}
+}
+
+func TestPeek_Limit(t *testing.T) {
+	client := &fakeClient{}
+	for round := range peekRounds {
+		var ids []string
+		for i := range receiveBatch {
+			ids = append(ids, fmt.Sprintf("m%d-%d", round, i))
+		}
+		client.batches = append(client.batches, ids)
+	}
+	messages, err := Peek(context.Background(), client, "orders")
+	if err != nil {
+		t.Fatalf("Peek() error = %v", err)
+	}
+	if len(messages) != MaxPeekMessages || len(client.inputs) != MaxPeekMessages/receiveBatch {
+		t.Errorf("Peek() = %d messages in %d calls, want %d", len(messages), len(client.inputs), MaxPeekMessages)
+	}
+	if len(client.released) != MaxPeekMessages {
+		t.Errorf("released %d messages, want %d", len(client.released), MaxPeekMessages)
+	}
+}
+
+func TestMessageHelpers(t *testing.T) {
+	m := types.Message{
+		MessageId: aws.String("id-1"),
+		Body:      aws.String(`{"order":1,"items":[]}`),
+		Attributes: map[string]string{
+			"ApproximateReceiveCount": "3",
+			"SentTimestamp":           "1700000000000",
+		},
+	}
+	if got, want := PrettyBody(m), "{\n  \"order\": 1,\n  \"items\": []\n}"; got != want {
+		t.Errorf("PrettyBody() = %q, want %q", got, want)
+	}
+	if ReceiveCount(m) != "3" {
+		t.Errorf("ReceiveCount() = %q", ReceiveCount(m))
+	}
+	if got := SentTime(m).UnixMilli(); got != 1700000000000 {
+		t.Errorf("SentTime() = %d", got)
+	}
+
+	plain := types.Message{MessageId: aws.String("id-2"), Body: aws.String("{not json")}
+	if PrettyBody(plain) != "{not json" || !FirstReceiveTime(plain).IsZero() {
+		t.Errorf("PrettyBody() = %q, want the body unchanged", PrettyBody(plain))
+	}
+}
@@ -0,0 +1,250 @@
+// Package ssmparam reads SSM Parameter Store hierarchies for the parameter
+// browser, viewer and compare: it lists one level of a path as folders and
+// parameters, lists subtrees and compares two subtrees by relative name.
+package ssmparam
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Mask replaces SecureString values until they are decrypted and revealed
+const Mask = "••••••••"
+
+const (
+	// pageSize is the GetParametersByPath and GetParameterHistory maximum
+	pageSize = 10
+	// describePageSize is the DescribeParameters maximum
+	describePageSize = 50
+)
+
+// Client is the part of the SSM client the parameter views use
+type Client interface {
+	GetParametersByPath(ctx context.Context, in *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error)
+	GetParameter(ctx context.Context, in *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	GetParameterHistory(ctx context.Context, in *ssm.GetParameterHistoryInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error)
+}
+
+// LevelClient is the part of the SSM client the path browser uses
+type LevelClient interface {
+	Client
+	DescribeParameters(ctx context.Context, in *ssm.DescribeParametersInput, optFns ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
+}
+
+// CleanPath returns a path with a leading slash and no trailing one, or
+// "/" for the root
+func CleanPath(path string) string {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	return "/" + path
+}
+
+// Parent returns the path a parameter or folder is in
+func Parent(name string) string {
+	name = strings.TrimSuffix(name, "/")
+	i := strings.LastIndex(name, "/")
+	if i <= 0 {
+		return "/"
+	}
+	return name[:i]
+}
+
+// Relative returns a name relative to a path, e.g. "db/host" for
+// /app/prod/db/host under /app/prod
+func Relative(path, name string) string {
+	path = CleanPath(path)
+	if path == "/" {
+		return strings.TrimPrefix(name, "/")
+	}
+	return strings.TrimPrefix(name, path+"/")
+}
+
+// ListPath returns all parameters under a path, recursively, sorted by
+// name. SecureString values are ciphertext unless decrypt is set.
+func ListPath(ctx context.Context, client Client, path string, decrypt bool) ([]types.Parameter, error) {
+	return byPath(ctx, client, CleanPath(path), true, decrypt)
+}
+
+// byPath reads the parameters under a path, sorted by name
+func byPath(ctx context.Context, client Client, path string, recursive, decrypt bool) ([]types.Parameter, error) {
+	in := &ssm.GetParametersByPathInput{
+		Path:           &path,
+		Recursive:      &recursive,
+		WithDecryption: &decrypt,
+		MaxResults:     appaws.Int32Ptr(pageSize),
+	}
+	var params []types.Parameter
+	for {
+		out, err := client.GetParametersByPath(ctx, in)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "get parameters by path %s", path)
+		}
+		params = append(params, out.Parameters...)
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	slices.SortFunc(params, func(a, b types.Parameter) int {
+		return strings.Compare(appaws.Str(a.Name), appaws.Str(b.Name))
+	})
+	return params, nil
+}
+
+// Entry is a direct child of a path: a parameter, or a folder holding
+// deeper parameters
+type Entry struct {
+	Name      string // full name, e.g. /app/prod/db
+	Folder    bool
+	Parameter types.Parameter
+}
+
+// ListLevel returns the direct children of a path, folders first, each
+// sorted by name. Parameters are read without recursion; SecureString
+// values are ciphertext. SSM cannot list folders, so they are found from
+// the names of deeper parameters, without reading their values.
+func ListLevel(ctx context.Context, client LevelClient, path string) ([]Entry, error) {
+	path = CleanPath(path)
+	folders, err := childFolders(ctx, client, path)
+	if err != nil {
+		return nil, err
+	}
+	params, err := byPath(ctx, client, path, false, false)
+	if err != nil {
+		return nil, err
+	}
+	entries := folders
+	for _, p := range params {
+		entries = append(entries, Entry{Name: appaws.Str(p.Name), Parameter: p})
+	}
+	return entries, nil
+}
+
+// childFolders returns the folders directly under a path, sorted by name
+func childFolders(ctx context.Context, client LevelClient, path string) ([]Entry, error) {
+	in := &ssm.DescribeParametersInput{
+		ParameterFilters: []types.ParameterStringFilter{{
+			Key:    appaws.StringPtr("Path"),
+			Option: appaws.StringPtr("Recursive"),
+			Values: []string{path},
+		}},
+		MaxResults: appaws.Int32Ptr(describePageSize),
+	}
+	seen := make(map[string]bool)
+	var folders []Entry
+	for {
+		out, err := client.DescribeParameters(ctx, in)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe parameters under %s", path)
+		}
+		for _, p := range out.Parameters {
+			first, rest, nested := strings.Cut(Relative(path, appaws.Str(p.Name)), "/")
+			if !nested || rest == "" {
+				continue
+			}
+			name := strings.TrimSuffix(path, "/") + "/" + first
+			if !seen[name] {
+				seen[name] = true
+				folders = append(folders, Entry{Name: name, Folder: true})
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	slices.SortFunc(folders, func(a, b Entry) int { return strings.Compare(a.Name, b.Name) })
+	return folders, nil
+}
+
+// Get reads a parameter, decrypted if asked
+func Get(ctx context.Context, client Client, name string, decrypt bool) (types.Parameter, error) {
+	out, err := client.GetParameter(ctx, &ssm.GetParameterInput{Name: &name, WithDecryption: &decrypt})
+	if err != nil {
+		return types.Parameter{}, apperrors.Wrapf(err, "get parameter %s", name)
+	}
+	if out.Parameter == nil {
+		return types.Parameter{}, fmt.Errorf("parameter not found: %s", name)
+	}
+	return *out.Parameter, nil
+}
+
+// History lists the versions of a parameter, newest first
+func History(ctx context.Context, client Client, name string, decrypt bool) ([]types.ParameterHistory, error) {
+	in := &ssm.GetParameterHistoryInput{
+		Name:           &name,
+		WithDecryption: &decrypt,
+		MaxResults:     appaws.Int32Ptr(pageSize),
+	}
+	var history []types.ParameterHistory
+	for {
+		out, err := client.GetParameterHistory(ctx, in)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "get history of parameter %s", name)
+		}
+		history = append(history, out.Parameters...)
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	slices.SortFunc(history, func(a, b types.ParameterHistory) int {
+		return int(b.Version - a.Version)
+	})
+	return history, nil
+}
+
+// Status says how a parameter compares between two subtrees
+type Status string
+
+const (
+	Same      Status = "same"
+	Differs   Status = "differs"
+	OnlyLeft  Status = "only left"
+	OnlyRight Status = "only right"
+)
+
+// Compared is a parameter name, relative to its subtree, on both sides
+type Compared struct {
+	Key         string
+	Status      Status
+	Left, Right *types.Parameter
+}
+
+// Compare matches two subtrees by name relative to their paths, so
+// /app/prod/db/host compares with /app/staging/db/host. Parameters differ
+// if their value or type does. The result is sorted by key.
+func Compare(leftPath string, left []types.Parameter, rightPath string, right []types.Parameter) []Compared {
+	byKey := make(map[string]*Compared)
+	for i := range left {
+		key := Relative(leftPath, appaws.Str(left[i].Name))
+		byKey[key] = &Compared{Key: key, Status: OnlyLeft, Left: &left[i]}
+	}
+	for i := range right {
+		key := Relative(rightPath, appaws.Str(right[i].Name))
+		c, ok := byKey[key]
+		if !ok {
+			byKey[key] = &Compared{Key: key, Status: OnlyRight, Right: &right[i]}
+			continue
+		}
+		c.Right = &right[i]
+		c.Status = Same
+		if appaws.Str(c.Left.Value) != appaws.Str(c.Right.Value) || c.Left.Type != c.Right.Type {
+			c.Status = Differs
+		}
+	}
+
+	compared := make([]Compared, 0, len(byKey))
+	for _, c := range byKey {
+		compared = append(compared, *c)
+	}
+	slices.SortFunc(compared, func(a, b Compared) int { return strings.Compare(a.Key, b.Key) })
+	return compared
+}
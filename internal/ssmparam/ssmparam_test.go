@@ -0,0 +1,224 @@
+package ssmparam
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// fakeStore holds parameters by name. SecureString values read without
+// decryption come back as "enc:<value>".
+type fakeStore struct {
+	params    map[string]types.Parameter
+	history   map[string][]types.ParameterHistory
+	paths     []*ssm.GetParametersByPathInput
+	described int
+}
+
+func (f *fakeStore) value(p types.Parameter, decrypt *bool) *string {
+	if p.Type == types.ParameterTypeSecureString && !aws.ToBool(decrypt) {
+		return aws.String("enc:" + aws.ToString(p.Value))
+	}
+	return p.Value
+}
+
+func (f *fakeStore) GetParametersByPath(_ context.Context, in *ssm.GetParametersByPathInput, _ ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error) {
+	f.paths = append(f.paths, in)
+	prefix := strings.TrimSuffix(aws.ToString(in.Path), "/") + "/"
+	var matched []types.Parameter
+	for name, p := range f.params {
+		if strings.HasPrefix(name, prefix) && (aws.ToBool(in.Recursive) || !strings.Contains(name[len(prefix):], "/")) {
+			p.Value = f.value(p, in.WithDecryption)
+			matched = append(matched, p)
+		}
+	}
+	// Two pages, in no particular order
+	if in.NextToken == nil && len(matched) > 1 {
+		return &ssm.GetParametersByPathOutput{Parameters: matched[:1], NextToken: aws.String("next")}, nil
+	}
+	if in.NextToken != nil {
+		matched = matched[1:]
+	}
+	return &ssm.GetParametersByPathOutput{Parameters: matched}, nil
+}
+
+func (f *fakeStore) DescribeParameters(_ context.Context, in *ssm.DescribeParametersInput, _ ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error) {
+	f.described++
+	filter := in.ParameterFilters[0]
+	if aws.ToString(filter.Key) != "Path" || aws.ToString(filter.Option) != "Recursive" {
+		return nil, fmt.Errorf("unexpected filter %+v", filter)
+	}
+	prefix := strings.TrimSuffix(filter.Values[0], "/") + "/"
+	var out ssm.DescribeParametersOutput
+	for name, p := range f.params {
+		if strings.HasPrefix(name, prefix) {
+			out.Parameters = append(out.Parameters, types.ParameterMetadata{Name: aws.String(name), Type: p.Type})
+		}
+	}
+	return &out, nil
+}
+
+func (f *fakeStore) GetParameter(_ context.Context, in *ssm.GetParameterInput, _ ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	p, ok := f.params[aws.ToString(in.Name)]
+	if !ok {
+		return &ssm.GetParameterOutput{}, nil
+	}
+	p.Value = f.value(p, in.WithDecryption)
+	return &ssm.GetParameterOutput{Parameter: &p}, nil
+}
+
+func (f *fakeStore) GetParameterHistory(_ context.Context, in *ssm.GetParameterHistoryInput, _ ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	return &ssm.GetParameterHistoryOutput{Parameters: f.history[aws.ToString(in.Name)]}, nil
+}
+
+func param(name, value string, typ types.ParameterType) types.Parameter {
+	return types.Parameter{Name: aws.String(name), Value: aws.String(value), Type: typ}
+}
+
+func names(params []types.Parameter) []string {
+	var out []string
+	for _, p := range params {
+		out = append(out, aws.ToString(p.Name))
+	}
+	return out
+}
+
+func TestPaths(t *testing.T) {
+	for _, tt := range []struct{ in, clean, parent string }{
+		{"", "/", "/"},
+		{"/", "/", "/"},
+		{"app/prod/", "/app/prod", "/app"},
+		{" /app ", "/app", "/"},
+	} {
+		if got := CleanPath(tt.in); got != tt.clean {
+			t.Errorf("CleanPath(%q) = %q, want %q", tt.in, got, tt.clean)
+		}
+		if got := Parent(tt.clean); got != tt.parent {
+			t.Errorf("Parent(%q) = %q, want %q", tt.clean, got, tt.parent)
+		}
+	}
+	if got := Relative("/app/prod/", "/app/prod/db/host"); got != "db/host" {
+		t.Errorf("Relative() = %q", got)
+	}
+	if got := Relative("/", "/app/prod"); got != "app/prod" {
+		t.Errorf("Relative(/) = %q", got)
+	}
+}
+
+func testStore() *fakeStore {
+	return &fakeStore{params: map[string]types.Parameter{
+		"/app/prod/db/host":     param("/app/prod/db/host", "db.prod", types.ParameterTypeString),
+		"/app/prod/db/password": param("/app/prod/db/password", "s3cret", types.ParameterTypeSecureString),
+		"/app/prod/log/level":   param("/app/prod/log/level", "info", types.ParameterTypeString),
+		"/app/prod/name":        param("/app/prod/name", "api", types.ParameterTypeString),
+		"/app/staging/name":     param("/app/staging/name", "api", types.ParameterTypeString),
+	}}
+}
+
+func TestListPath(t *testing.T) {
+	f := testStore()
+	params, err := ListPath(context.Background(), f, "/app/prod/", false)
+	if err != nil {
+		t.Fatalf("ListPath() error = %v", err)
+	}
+	want := []string{"/app/prod/db/host", "/app/prod/db/password", "/app/prod/log/level", "/app/prod/name"}
+	if got := names(params); !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPath() = %v, want %v", got, want)
+	}
+	in := f.paths[0]
+	if aws.ToString(in.Path) != "/app/prod" || !aws.ToBool(in.Recursive) || aws.ToBool(in.WithDecryption) {
+		t.Errorf("GetParametersByPath input = %+v", in)
+	}
+	if aws.ToString(params[1].Value) != "enc:s3cret" {
+		t.Errorf("SecureString value without decryption = %q", aws.ToString(params[1].Value))
+	}
+}
+
+func TestListLevel(t *testing.T) {
+	f := testStore()
+	entries, err := ListLevel(context.Background(), f, "/app/prod/")
+	if err != nil {
+		t.Fatalf("ListLevel() error = %v", err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name)
+	}
+	if want := []string{"/app/prod/db", "/app/prod/log", "/app/prod/name"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListLevel() = %v, want %v", got, want)
+	}
+	if !entries[0].Folder || entries[2].Folder || aws.ToString(entries[2].Parameter.Value) != "api" {
+		t.Errorf("ListLevel() entries = %+v", entries)
+	}
+	// Values are only read for the level itself
+	for _, in := range f.paths {
+		if aws.ToBool(in.Recursive) {
+			t.Errorf("GetParametersByPath input = %+v, want it not recursive", in)
+		}
+	}
+	if f.described != 1 {
+		t.Errorf("DescribeParameters calls = %d, want 1", f.described)
+	}
+}
+
+func TestGetAndHistory(t *testing.T) {
+	f := &fakeStore{
+		params: map[string]types.Parameter{"/app/token": param("/app/token", "t2", types.ParameterTypeSecureString)},
+		history: map[string][]types.ParameterHistory{"/app/token": {
+			{Name: aws.String("/app/token"), Version: 1},
+			{Name: aws.String("/app/token"), Version: 2},
+		}},
+	}
+	p, err := Get(context.Background(), f, "/app/token", true)
+	if err != nil || aws.ToString(p.Value) != "t2" {
+		t.Errorf("Get() = %q, %v", aws.ToString(p.Value), err)
+	}
+	if _, err := Get(context.Background(), f, "/app/missing", true); err == nil {
+		t.Error("Get() of a missing parameter should fail")
+	}
+	history, err := History(context.Background(), f, "/app/token", false)
+	if err != nil || len(history) != 2 || history[0].Version != 2 {
+		t.Errorf("History() = %+v, %v; want newest first", history, err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	left := []types.Parameter{
+		param("/app/staging/db/host", "db.staging", types.ParameterTypeString),
+		param("/app/staging/name", "api", types.ParameterTypeString),
+		param("/app/staging/debug", "true", types.ParameterTypeString),
+		param("/app/staging/token", "x", types.ParameterTypeString),
+	}
+	right := []types.Parameter{
+		param("/app/prod/db/host", "db.prod", types.ParameterTypeString),
+		param("/app/prod/name", "api", types.ParameterTypeString),
+		param("/app/prod/token", "x", types.ParameterTypeSecureString),
+		param("/app/prod/replicas", "3", types.ParameterTypeString),
+	}
+
+	got := make(map[string]Status)
+	var keys []string
+	for _, c := range Compare("/app/staging", left, "/app/prod", right) {
+		got[c.Key] = c.Status
+		keys = append(keys, c.Key)
+	}
+	want := map[string]Status{
+		"db/host":  Differs,
+		"debug":    OnlyLeft,
+		"name":     Same,
+		"replicas": OnlyRight,
+		"token":    Differs, // same value, different type
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() = %v, want %v", got, want)
+	}
+	if want := []string{"db/host", "debug", "name", "replicas", "token"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Compare() keys = %v, want sorted %v", keys, want)
+	}
+}
@@ -0,0 +1,127 @@
+// Package testevents stores named test payloads, such as Lambda test
+// events, per resource under the config dir.
+package testevents
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+const (
+	eventsDir = "test-events"
+	fileExt   = ".json"
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// unsafeChars are replaced in the path segments of a store key
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// IsValidName reports whether name can be used as an event name.
+// Valid characters: alphanumeric, hyphen, underscore, period
+func IsValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// Store holds the events of one resource
+type Store struct {
+	dir string
+}
+
+// Open returns the store for a resource, keyed by path segments such as
+// "lambda", "my-function". Nothing is created until an event is saved.
+func Open(key ...string) (*Store, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("test events: empty store key")
+	}
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	parts := []string{dir, eventsDir}
+	for _, k := range key {
+		k = unsafeChars.ReplaceAllString(k, "_")
+		if k == "" || strings.Trim(k, ".") == "" {
+			return nil, fmt.Errorf("test events: invalid store key %q", strings.Join(key, "/"))
+		}
+		parts = append(parts, k)
+	}
+	return &Store{dir: filepath.Join(parts...)}, nil
+}
+
+func (s *Store) path(name string) (string, error) {
+	if !IsValidName(name) {
+		return "", fmt.Errorf("invalid event name %q: use letters, digits, '.', '_' and '-'", name)
+	}
+	return filepath.Join(s.dir, name+fileExt), nil
+}
+
+// List returns the event names, sorted
+func (s *Store) List() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list test events: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), fileExt)
+		if e.IsDir() || !ok || !IsValidName(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// Load reads the named event
+func (s *Store) Load(name string) (string, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("test event not found: %s", name)
+		}
+		return "", fmt.Errorf("read test event: %w", err)
+	}
+	return string(data), nil
+}
+
+// Save writes the named event, replacing any event with the same name
+func (s *Store) Save(name, body string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("create test event dir: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+		return fmt.Errorf("write test event: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the named event
+func (s *Store) Delete(name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete test event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package testevents
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	store, err := Open("lambda", "orders-api")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if names, err := store.List(); err != nil || len(names) != 0 {
+		t.Errorf("List() on a new store = %v, %v", names, err)
+	}
+
+	for name, body := range map[string]string{"paid": `{"status": "paid"}`, "api-gw.get": `{"httpMethod": "GET"}`} {
+		if err := store.Save(name, body); err != nil {
+			t.Fatalf("Save(%s) error = %v", name, err)
+		}
+	}
+	if err := store.Save("paid", `{"status": "refunded"}`); err != nil {
+		t.Fatalf("Save() over an existing event error = %v", err)
+	}
+
+	names, err := store.List()
+	if err != nil || !slices.Equal(names, []string{"api-gw.get", "paid"}) {
+		t.Errorf("List() = %v, %v", names, err)
+	}
+	if body, err := store.Load("paid"); err != nil || body != `{"status": "refunded"}` {
+		t.Errorf("Load() = %q, %v", body, err)
+	}
+	if !strings.HasPrefix(store.dir, filepath.Join(home, ".config", "claws", "test-events", "lambda")) {
+		t.Errorf("store dir = %s", store.dir)
+	}
+
+	if err := store.Delete("paid"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Load("paid"); err == nil {
+		t.Error("Load() of a deleted event should fail")
+	}
+	for _, name := range []string{"", "../escape", "has space"} {
+		if err := store.Save(name, "{}"); err == nil {
+			t.Errorf("Save(%q) should fail", name)
+		}
+	}
+}
+
+func TestOpen_SanitizesKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := Open("lambda", "arn:aws:lambda:us-east-1:123:function:fn")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if base := filepath.Base(store.dir); base != "arn_aws_lambda_us-east-1_123_function_fn" {
+		t.Errorf("store dir = %s", base)
+	}
+	for _, key := range [][]string{nil, {".."}, {"lambda", ""}} {
+		if _, err := Open(key...); err == nil {
+			t.Errorf("Open(%q) should fail", key)
+		}
+	}
+}
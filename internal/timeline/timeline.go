@@ -0,0 +1,78 @@
+// Package timeline defines the events merged into the incident timeline.
+//
+// Resources from event-like sources (alarm history, stack events, scaling
+// activities, ...) implement Provider, so the timeline view can collect
+// them through the registry without knowing the service packages.
+package timeline
+
+import (
+	"strings"
+	"time"
+)
+
+// Source identifies where a timeline event comes from
+type Source string
+
+const (
+	SourceAlarm       Source = "alarm"
+	SourceStack       Source = "cfn"
+	SourceECS         Source = "ecs"
+	SourceAutoScaling Source = "asg"
+	SourceHealth      Source = "health"
+	SourceCloudTrail  Source = "cloudtrail"
+)
+
+// Sources lists all sources in display order
+var Sources = []Source{SourceAlarm, SourceStack, SourceECS, SourceAutoScaling, SourceHealth, SourceCloudTrail}
+
+// Severity classifies an event for highlighting
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityOK
+	SeverityWarning
+	SeverityError
+)
+
+// Event is a single entry on the timeline
+type Event struct {
+	Time     time.Time
+	Source   Source
+	Subject  string // resource the event is about (alarm, stack, service, ...)
+	Summary  string
+	Severity Severity
+}
+
+// Provider is implemented by resources that appear on the timeline
+type Provider interface {
+	TimelineEvent() Event
+}
+
+// Compare orders events newest first; events at the same time are ordered
+// by source and subject. Use it with slices.SortStableFunc.
+func Compare(a, b Event) int {
+	if c := b.Time.Compare(a.Time); c != 0 {
+		return c
+	}
+	if c := strings.Compare(string(a.Source), string(b.Source)); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Subject, b.Subject)
+}
+
+// SeverityFromStatus derives a severity from a status string such as
+// CREATE_FAILED, UPDATE_ROLLBACK_COMPLETE or Successful.
+func SeverityFromStatus(status string) Severity {
+	s := strings.ToUpper(status)
+	switch {
+	case strings.Contains(s, "FAIL"), strings.Contains(s, "ALARM"):
+		return SeverityError
+	case strings.Contains(s, "ROLLBACK"), strings.Contains(s, "CANCEL"), strings.Contains(s, "INSUFFICIENT"):
+		return SeverityWarning
+	case strings.HasSuffix(s, "COMPLETE"), s == "SUCCESSFUL", s == "OK":
+		return SeverityOK
+	default:
+		return SeverityInfo
+	}
+}
@@ -0,0 +1,47 @@
+package timeline
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []Event{
+		{Time: base, Source: SourceStack, Subject: "web"},
+		{Time: base.Add(time.Minute), Source: SourceAlarm, Subject: "cpu"},
+		{Time: base, Source: SourceAlarm, Subject: "latency"},
+	}
+	slices.SortStableFunc(events, Compare)
+
+	want := []string{"cpu", "latency", "web"}
+	for i, e := range events {
+		if e.Subject != want[i] {
+			t.Errorf("events[%d] = %s, want %s", i, e.Subject, want[i])
+		}
+	}
+}
+
+func TestSeverityFromStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   Severity
+	}{
+		{"CREATE_FAILED", SeverityError},
+		{"ALARM", SeverityError},
+		{"UPDATE_ROLLBACK_COMPLETE", SeverityWarning},
+		{"Cancelled", SeverityWarning},
+		{"INSUFFICIENT_DATA", SeverityWarning},
+		{"CREATE_COMPLETE", SeverityOK},
+		{"Successful", SeverityOK},
+		{"OK", SeverityOK},
+		{"UPDATE_IN_PROGRESS", SeverityInfo},
+		{"", SeverityInfo},
+	}
+	for _, tt := range tests {
+		if got := SeverityFromStatus(tt.status); got != tt.want {
+			t.Errorf("SeverityFromStatus(%q) = %d, want %d", tt.status, got, tt.want)
+		}
+	}
+}
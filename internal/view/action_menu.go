@@ -224,6 +224,24 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model, tea.Cmd) {
+	// Transfers ask for their inputs, confirm and show progress in their own view
+	if act.Transfer != nil {
+		transferView := NewTransferView(m.ctx, m.resource, act)
+		return m, func() tea.Msg { return NavigateMsg{View: transferView} }
+	}
+
+	// Compose actions edit and send their body in their own view
+	if act.Compose != nil {
+		composeView := NewComposeView(m.ctx, m.resource, act)
+		return m, func() tea.Msg { return NavigateMsg{View: composeView} }
+	}
+
+	// Deletes with dependencies are confirmed in the plan view
+	if action.NeedsPlan(act, m.resource) {
+		planView := NewDeletePlanView(m.ctx, m.resource, m.service, m.resType, act)
+		return m, func() tea.Msg { return NavigateMsg{View: planView} }
+	}
+
 	switch act.Confirm {
 	case action.ConfirmDangerous:
 		m.dangerous.active = true
@@ -12,8 +12,10 @@ import (
 
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/iac"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/snapshot"
 	"github.com/clawscli/claws/internal/ui"
 )
 
@@ -334,7 +336,8 @@ func (c *CommandInput) resolveDestination(input string) string {
 	if strings.HasPrefix(input, "tag ") || strings.HasPrefix(input, "tags ") ||
 		strings.HasPrefix(input, "diff ") || strings.HasPrefix(input, "sort ") ||
 		strings.HasPrefix(input, "theme ") || strings.HasPrefix(input, "autosave ") ||
-		strings.HasPrefix(input, "login ") {
+		strings.HasPrefix(input, "login ") || strings.HasPrefix(input, "snapshot ") ||
+		strings.HasPrefix(input, "timeline ") || strings.HasPrefix(input, "iac ") {
 		return ""
 	}
 
@@ -551,6 +554,37 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		}
 	}
 
+	if suffix, ok := strings.CutPrefix(input, "snapshot "); ok {
+		return c.executeSnapshot(strings.Fields(suffix))
+	}
+
+	// Handle timeline command: :timeline (selected resources) or :timeline <tag filter>
+	if input == "timeline" {
+		return func() tea.Msg {
+			return TimelineMsg{}
+		}, nil
+	}
+	if tagFilter, ok := strings.CutPrefix(input, "timeline "); ok {
+		timelineView := NewTimelineViewForTag(c.ctx, c.registry, strings.TrimSpace(tagFilter))
+		return nil, &NavigateMsg{View: timelineView}
+	}
+
+	// Handle iac command: :iac [tf|cfn] (selected resources)
+	if input == "iac" || strings.HasPrefix(input, "iac ") {
+		var format iac.Format
+		switch strings.TrimSpace(strings.TrimPrefix(input, "iac")) {
+		case "", "tf", "terraform":
+			format = iac.Terraform
+		case "cfn", "cloudformation":
+			format = iac.CloudFormation
+		default:
+			return func() tea.Msg { return ErrorMsg{Err: fmt.Errorf("usage: :iac [tf|cfn]")} }, nil
+		}
+		return func() tea.Msg {
+			return IaCMsg{Format: format}
+		}, nil
+	}
+
 	if suffix, ok := strings.CutPrefix(input, "theme "); ok {
 		themeName := strings.TrimSpace(suffix)
 		if themeName != "" {
@@ -610,6 +644,34 @@ func (c *CommandInput) parseSortArgs(args string) tea.Cmd {
 	}
 }
 
+// executeSnapshot handles :snapshot save <name> [service[/resource] ...]
+// and :snapshot diff <name> [<name>] (against live state if omitted)
+func (c *CommandInput) executeSnapshot(args []string) (tea.Cmd, *NavigateMsg) {
+	usage := ErrorMsg{Err: fmt.Errorf("usage: :snapshot save <name> [service/resource ...] | :snapshot diff <name> [<name>]")}
+	if len(args) < 2 {
+		return func() tea.Msg { return usage }, nil
+	}
+	name := args[1]
+	if !snapshot.IsValidName(name) {
+		return func() tea.Msg { return ErrorMsg{Err: fmt.Errorf("invalid snapshot name: %q", name)} }, nil
+	}
+
+	switch args[0] {
+	case "save":
+		targets := args[2:]
+		return func() tea.Msg {
+			return SnapshotSaveMsg{Name: name, Targets: targets}
+		}, nil
+	case "diff":
+		target := ""
+		if len(args) > 2 {
+			target = args[2]
+		}
+		return nil, &NavigateMsg{View: NewSnapshotDiffView(c.ctx, c.registry, name, target)}
+	}
+	return func() tea.Msg { return usage }, nil
+}
+
 func (c *CommandInput) executeLogin(profileName string) tea.Cmd {
 	exec := &action.SimpleExec{
 		Command:    fmt.Sprintf("aws login --remote --profile %s", profileName),
@@ -636,6 +698,20 @@ func (c *CommandInput) GetSuggestions() []string {
 		return c.getTagSuggestions("tag ", suffix)
 	}
 
+	// Handle :timeline command completion (tag filter)
+	if suffix, ok := strings.CutPrefix(input, "timeline "); ok {
+		return c.getTagSuggestions("timeline ", suffix)
+	}
+
+	if suffix, ok := strings.CutPrefix(input, "iac "); ok {
+		for _, f := range []string{"tf", "cfn"} {
+			if strings.HasPrefix(f, suffix) && f != suffix {
+				suggestions = append(suggestions, "iac "+f)
+			}
+		}
+		return suggestions
+	}
+
 	// Handle :tags command completion (same as :tag)
 	if suffix, ok := strings.CutPrefix(input, "tags "); ok {
 		return c.getTagSuggestions("tags ", suffix)
@@ -646,6 +722,10 @@ func (c *CommandInput) GetSuggestions() []string {
 		return c.getDiffSuggestions(suffix)
 	}
 
+	if suffix, ok := strings.CutPrefix(input, "snapshot "); ok {
+		return c.getSnapshotSuggestions(suffix)
+	}
+
 	if suffix, ok := strings.CutPrefix(input, "theme "); ok {
 		return c.getThemeSuggestions(suffix)
 	}
@@ -718,6 +798,18 @@ func (c *CommandInput) GetSuggestions() []string {
 			suggestions = append(suggestions, "autosave")
 		}
 
+		if strings.HasPrefix("snapshot", input) {
+			suggestions = append(suggestions, "snapshot")
+		}
+
+		if strings.HasPrefix("timeline", input) {
+			suggestions = append(suggestions, "timeline")
+		}
+
+		if strings.HasPrefix("iac", input) {
+			suggestions = append(suggestions, "iac")
+		}
+
 		if strings.HasPrefix("settings", input) {
 			suggestions = append(suggestions, "settings")
 		}
@@ -768,6 +860,43 @@ func (c *CommandInput) getAutosaveSuggestions(prefix string) []string {
 	return suggestions
 }
 
+// getSnapshotSuggestions completes subcommands and, for diff, saved snapshot names
+func (c *CommandInput) getSnapshotSuggestions(args string) []string {
+	var suggestions []string
+	sub, rest, hasArgs := strings.Cut(args, " ")
+	if !hasArgs {
+		for _, s := range []string{"save", "diff"} {
+			if strings.HasPrefix(s, sub) {
+				suggestions = append(suggestions, "snapshot "+s)
+			}
+		}
+		return suggestions
+	}
+	if sub != "diff" || strings.Count(rest, " ") > 1 {
+		return nil
+	}
+
+	infos, err := snapshot.List()
+	if err != nil {
+		return nil
+	}
+	first, prefix, hasSecond := strings.Cut(rest, " ")
+	if !hasSecond {
+		first, prefix = "", rest
+	}
+	for _, info := range infos {
+		if info.Name == first || !strings.HasPrefix(info.Name, prefix) {
+			continue
+		}
+		if hasSecond {
+			suggestions = append(suggestions, "snapshot diff "+first+" "+info.Name)
+		} else {
+			suggestions = append(suggestions, "snapshot diff "+info.Name)
+		}
+	}
+	return suggestions
+}
+
 func (c *CommandInput) getDiffSuggestions(args string) []string {
 	if c.diffProvider == nil {
 		return nil
@@ -6,6 +6,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/iac"
 	"github.com/clawscli/claws/internal/registry"
 )
 
@@ -835,3 +836,108 @@ func TestCommandInput_SuggestionTruncation(t *testing.T) {
 	ci.updateSuggestions()
 	// No assertion needed - just ensure no panic
 }
+
+func TestCommandInput_SnapshotCommand(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	tests := []struct {
+		input    string
+		wantMsg  any
+		wantView bool
+	}{
+		{input: "snapshot save friday ec2 lambda/functions", wantMsg: SnapshotSaveMsg{}},
+		{input: "snapshot save friday", wantMsg: SnapshotSaveMsg{}},
+		{input: "snapshot diff friday", wantView: true},
+		{input: "snapshot diff friday monday", wantView: true},
+		{input: "snapshot diff ../etc", wantMsg: ErrorMsg{}},
+		{input: "snapshot frobnicate friday", wantMsg: ErrorMsg{}},
+		{input: "snapshot save", wantMsg: ErrorMsg{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci := NewCommandInput(ctx, reg)
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+			if tt.wantView {
+				if nav == nil {
+					t.Fatal("expected NavigateMsg")
+				}
+				if _, ok := nav.View.(*SnapshotDiffView); !ok {
+					t.Errorf("View = %T, want *SnapshotDiffView", nav.View)
+				}
+				return
+			}
+			if cmd == nil {
+				t.Fatal("expected command")
+			}
+			msg := cmd()
+			switch tt.wantMsg.(type) {
+			case SnapshotSaveMsg:
+				save, ok := msg.(SnapshotSaveMsg)
+				if !ok {
+					t.Fatalf("got %T, want SnapshotSaveMsg", msg)
+				}
+				if save.Name != "friday" {
+					t.Errorf("Name = %q, want friday", save.Name)
+				}
+			case ErrorMsg:
+				if _, ok := msg.(ErrorMsg); !ok {
+					t.Errorf("got %T, want ErrorMsg", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestCommandInput_IaCCommand(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	tests := []struct {
+		input   string
+		want    iac.Format
+		wantErr bool
+	}{
+		{input: "iac", want: iac.Terraform},
+		{input: "iac tf", want: iac.Terraform},
+		{input: "iac cfn", want: iac.CloudFormation},
+		{input: "iac cloudformation", want: iac.CloudFormation},
+		{input: "iac pulumi", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci := NewCommandInput(ctx, reg)
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if nav != nil {
+				t.Fatalf("unexpected navigation to %T", nav.View)
+			}
+			if cmd == nil {
+				t.Fatal("expected command")
+			}
+			switch msg := cmd().(type) {
+			case IaCMsg:
+				if tt.wantErr {
+					t.Fatal("expected ErrorMsg")
+				}
+				if msg.Format != tt.want {
+					t.Errorf("Format = %v, want %v", msg.Format, tt.want)
+				}
+			case ErrorMsg:
+				if !tt.wantErr {
+					t.Fatalf("unexpected error: %v", msg.Err)
+				}
+			default:
+				t.Fatalf("got %T, want IaCMsg", msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	compareHeaderHeight  = 3 // title(1) + column headers(1) + separator(1)
+	compareMinColWidth   = 14
+	compareMaxFieldWidth = 40
+	compareMissing       = "—"
+)
+
+// compareRow is one field of the comparison matrix
+type compareRow struct {
+	path   string
+	values []string // one per resource; compareMissing if absent
+	drift  []bool   // true if the value differs from the majority
+	differ bool     // true if not all values are equal
+}
+
+// buildCompareRows flattens each resource's Raw() value and builds one row per
+// field path, sorted by path. Cells that differ from the most common value
+// are flagged; when there is no single most common value every cell is.
+func buildCompareRows(resources []dao.Resource, opts jsondiff.Options) ([]compareRow, error) {
+	flat := make([]map[string]any, len(resources))
+	paths := make(map[string]struct{})
+	for i, res := range resources {
+		f, err := jsondiff.Flatten(dao.UnwrapResource(res).Raw(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", res.GetName(), err)
+		}
+		flat[i] = f
+		for p := range f {
+			paths[p] = struct{}{}
+		}
+	}
+
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	slices.Sort(sorted)
+
+	rows := make([]compareRow, 0, len(sorted))
+	for _, p := range sorted {
+		row := compareRow{path: p, values: make([]string, len(resources)), drift: make([]bool, len(resources))}
+		counts := make(map[string]int, len(resources))
+		for i, f := range flat {
+			v, ok := f[p]
+			row.values[i] = compareMissing
+			if ok {
+				row.values[i] = jsondiff.FormatValue(v)
+			}
+			counts[row.values[i]]++
+		}
+		row.differ = len(counts) > 1
+
+		if row.differ {
+			majority, best, tie := "", 0, false
+			for _, v := range row.values {
+				switch n := counts[v]; {
+				case n > best:
+					majority, best, tie = v, n, false
+				case n == best && v != majority:
+					tie = true
+				}
+			}
+			for i, v := range row.values {
+				row.drift[i] = tie || v != majority
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// compareViewStyles holds cached lipgloss styles for performance
+type compareViewStyles struct {
+	title  lipgloss.Style
+	header lipgloss.Style
+	field  lipgloss.Style
+	drift  lipgloss.Style
+	dim    lipgloss.Style
+	error  lipgloss.Style
+}
+
+func newCompareViewStyles() compareViewStyles {
+	return compareViewStyles{
+		title:  ui.TitleStyle(),
+		header: ui.SectionStyle(),
+		field:  ui.AccentStyle(),
+		drift:  ui.BoldWarningStyle(),
+		dim:    ui.DimStyle(),
+		error:  ui.DangerStyle(),
+	}
+}
+
+// CompareView shows many resources side by side as a matrix of fields (rows)
+// and resources (columns), highlighting values that differ from the majority.
+type CompareView struct {
+	ctx          context.Context
+	resources    []dao.Resource
+	service      string
+	resourceType string
+	vp           ViewportState
+	width        int
+	styles       compareViewStyles
+
+	rows         []compareRow
+	err          error
+	showAll      bool // show fields where all values are equal
+	showVolatile bool
+	colOffset    int // first visible resource column
+}
+
+// NewCompareView creates a CompareView for the given (possibly wrapped) resources
+func NewCompareView(ctx context.Context, resources []dao.Resource, service, resourceType string) *CompareView {
+	v := &CompareView{
+		ctx:          ctx,
+		resources:    resources,
+		service:      service,
+		resourceType: resourceType,
+		styles:       newCompareViewStyles(),
+	}
+	v.rebuild()
+	return v
+}
+
+func (v *CompareView) rebuild() {
+	v.rows, v.err = buildCompareRows(v.resources, jsondiff.Options{IgnoreVolatile: !v.showVolatile})
+}
+
+// Init implements tea.Model
+func (v *CompareView) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (v *CompareView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "a":
+			v.showAll = !v.showAll
+			v.updateContent()
+			return v, nil
+		case "v":
+			v.showVolatile = !v.showVolatile
+			v.rebuild()
+			v.updateContent()
+			return v, nil
+		case "h", "left":
+			if v.colOffset > 0 {
+				v.colOffset--
+				v.updateContent()
+			}
+			return v, nil
+		case "l", "right":
+			if v.colOffset+v.visibleColumns() < len(v.resources) {
+				v.colOffset++
+				v.updateContent()
+			}
+			return v, nil
+		}
+	case ThemeChangedMsg:
+		v.styles = newCompareViewStyles()
+		v.updateContent()
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// layout returns the field column width and the resource column width
+func (v *CompareView) layout() (fieldWidth, colWidth int) {
+	fieldWidth = min(max(v.width*3/10, 16), compareMaxFieldWidth)
+	return fieldWidth, max((v.width-fieldWidth)/v.visibleColumns()-1, compareMinColWidth)
+}
+
+// visibleColumns returns how many resource columns fit on screen
+func (v *CompareView) visibleColumns() int {
+	fieldWidth := min(max(v.width*3/10, 16), compareMaxFieldWidth)
+	n := (v.width - fieldWidth) / (compareMinColWidth + 1)
+	return max(min(n, len(v.resources)-v.colOffset), 1)
+}
+
+// visibleRows returns the rows shown in the current mode
+func (v *CompareView) visibleRows() []compareRow {
+	if v.showAll {
+		return v.rows
+	}
+	var rows []compareRow
+	for _, row := range v.rows {
+		if row.differ {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (v *CompareView) updateContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.renderContent())
+	}
+}
+
+func (v *CompareView) renderContent() string {
+	s := v.styles
+	if v.err != nil {
+		return s.error.Render("Cannot compare: " + v.err.Error())
+	}
+	rows := v.visibleRows()
+	if len(rows) == 0 {
+		return s.dim.Render("No differences")
+	}
+
+	fieldWidth, colWidth := v.layout()
+	end := min(v.colOffset+v.visibleColumns(), len(v.resources))
+
+	var out strings.Builder
+	for _, row := range rows {
+		out.WriteString(s.field.Render(TruncateOrPadString(row.path, fieldWidth)))
+		for i := v.colOffset; i < end; i++ {
+			cell := " " + TruncateOrPadString(row.values[i], colWidth)
+			if row.drift[i] {
+				cell = s.drift.Render(cell)
+			}
+			out.WriteString(cell)
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+func (v *CompareView) renderHeader() string {
+	s := v.styles
+	fieldWidth, colWidth := v.layout()
+	end := min(v.colOffset+v.visibleColumns(), len(v.resources))
+
+	title := fmt.Sprintf("Compare: %s (%d resources)", v.resourceType, len(v.resources))
+	if v.colOffset > 0 || end < len(v.resources) {
+		title += fmt.Sprintf(" • columns %d-%d", v.colOffset+1, end)
+	}
+
+	var header strings.Builder
+	header.WriteString(TruncateOrPadString("FIELD", fieldWidth))
+	for i := v.colOffset; i < end; i++ {
+		header.WriteString(" " + TruncateOrPadString(diffLabel(v.resources[i]), colWidth))
+	}
+
+	return s.title.Render(title) + "\n" +
+		s.header.Render(header.String()) + "\n" +
+		strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *CompareView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *CompareView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *CompareView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height-compareHeaderHeight, 5))
+	v.colOffset = min(v.colOffset, max(len(v.resources)-v.visibleColumns(), 0))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *CompareView) StatusLine() string {
+	differing := 0
+	for _, row := range v.rows {
+		if row.differ {
+			differing++
+		}
+	}
+	fields := "a:all fields"
+	if v.showAll {
+		fields = "a:differing only"
+	}
+	volatile := "v:show volatile"
+	if v.showVolatile {
+		volatile = "v:hide volatile"
+	}
+	return fmt.Sprintf("%d resources • %d differing field(s) • ↑/↓:scroll ←/→:columns %s %s • q/esc:back",
+		len(v.resources), differing, fields, volatile)
+}
+
+func (v *CompareView) Resources() []dao.Resource { return v.resources }
+func (v *CompareView) Service() string           { return v.service }
+func (v *CompareView) ResourceType() string      { return v.resourceType }
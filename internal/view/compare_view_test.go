@@ -0,0 +1,164 @@
+package view
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func asgResource(name string, maxSize int, dlq bool, created string) dao.Resource {
+	data := map[string]any{
+		"MaxSize":     maxSize,
+		"MinSize":     1,
+		"CreatedTime": created,
+	}
+	if dlq {
+		data["RedrivePolicy"] = "dlq"
+	}
+	return &dao.BaseResource{ID: name, Name: name, Data: data}
+}
+
+func TestBuildCompareRows(t *testing.T) {
+	resources := []dao.Resource{
+		asgResource("a", 4, true, "2024-01-01"),
+		asgResource("b", 4, true, "2024-01-02"),
+		asgResource("c", 8, false, "2024-01-03"),
+	}
+
+	rows, err := buildCompareRows(resources, jsondiff.Options{IgnoreVolatile: true})
+	if err != nil {
+		t.Fatalf("buildCompareRows() error = %v", err)
+	}
+
+	byPath := make(map[string]compareRow)
+	for _, row := range rows {
+		byPath[row.path] = row
+	}
+	if _, ok := byPath["CreatedTime"]; ok {
+		t.Error("volatile CreatedTime should be excluded")
+	}
+	if byPath["MinSize"].differ {
+		t.Error("MinSize should not differ")
+	}
+
+	maxSize := byPath["MaxSize"]
+	if !maxSize.differ {
+		t.Fatal("MaxSize should differ")
+	}
+	if want := []bool{false, false, true}; !slices.Equal(maxSize.drift, want) {
+		t.Errorf("MaxSize drift = %v, want %v", maxSize.drift, want)
+	}
+
+	dlq := byPath["RedrivePolicy"]
+	if dlq.values[2] != compareMissing {
+		t.Errorf("missing value = %q, want %q", dlq.values[2], compareMissing)
+	}
+	if want := []bool{false, false, true}; !slices.Equal(dlq.drift, want) {
+		t.Errorf("RedrivePolicy drift = %v, want %v", dlq.drift, want)
+	}
+}
+
+func TestBuildCompareRows_Tie(t *testing.T) {
+	resources := []dao.Resource{
+		asgResource("a", 4, true, ""),
+		asgResource("b", 8, true, ""),
+	}
+	rows, err := buildCompareRows(resources, jsondiff.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if row.path == "MaxSize" && !slices.Equal(row.drift, []bool{true, true}) {
+			t.Errorf("tied drift = %v, want all highlighted", row.drift)
+		}
+	}
+}
+
+func TestCompareView_Toggles(t *testing.T) {
+	resources := []dao.Resource{
+		asgResource("a", 4, true, "2024-01-01"),
+		asgResource("b", 4, true, "2024-01-02"),
+		asgResource("c", 8, true, "2024-01-03"),
+	}
+	cv := NewCompareView(context.Background(), resources, "autoscaling", "groups")
+	cv.SetSize(120, 40)
+
+	content := cv.renderContent()
+	if !strings.Contains(content, "MaxSize") || strings.Contains(content, "MinSize") {
+		t.Errorf("default view should only list differing fields:\n%s", content)
+	}
+
+	cv.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if !strings.Contains(cv.renderContent(), "MinSize") {
+		t.Error("'a' should show all fields")
+	}
+
+	cv.Update(tea.KeyPressMsg{Code: 'v', Text: "v"})
+	if !strings.Contains(cv.renderContent(), "CreatedTime") {
+		t.Error("'v' should show volatile fields")
+	}
+
+	if !strings.Contains(cv.StatusLine(), "3 resources") {
+		t.Errorf("StatusLine() = %q", cv.StatusLine())
+	}
+	if !strings.Contains(cv.ViewString(), "Compare: groups") {
+		t.Error("ViewString() should contain the title")
+	}
+}
+
+func TestResourceBrowser_SelectAndCompare(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "ec2")
+	browser.SetSize(100, 50)
+	browser.renderer = &mockRenderer{detail: "test"}
+	browser.resources = []dao.Resource{
+		&mockResource{id: "i-1", name: "instance-1"},
+		&mockResource{id: "i-2", name: "instance-2"},
+		&mockResource{id: "i-3", name: "instance-3"},
+	}
+	browser.applyFilter()
+	browser.buildTable()
+
+	space := tea.KeyPressMsg{Code: tea.KeySpace}
+	browser.SetCursor(0)
+	browser.Update(space) // selects i-1, moves to i-2
+	browser.Update(space) // selects i-2
+
+	if len(browser.selected) != 2 {
+		t.Fatalf("selected = %d, want 2", len(browser.selected))
+	}
+	if !strings.Contains(browser.StatusLine(), "d:compare") {
+		t.Errorf("StatusLine() = %q, want d:compare hint", browser.StatusLine())
+	}
+
+	_, cmd := browser.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if cmd == nil {
+		t.Fatal("expected navigate cmd")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatalf("cmd() = %T, want NavigateMsg", cmd())
+	}
+	if cv, ok := nav.View.(*CompareView); !ok || len(cv.Resources()) != 2 {
+		t.Errorf("NavigateMsg.View = %T, want CompareView with 2 resources", nav.View)
+	}
+
+	// Toggling a selected row deselects it
+	browser.SetCursor(1)
+	browser.Update(space)
+	if len(browser.selected) != 1 || browser.selected[0].GetID() != "i-1" {
+		t.Errorf("selected after deselect = %v", browser.selected)
+	}
+
+	// Esc clears the selection
+	browser.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if len(browser.selected) != 0 {
+		t.Error("esc should clear the selection")
+	}
+}
@@ -0,0 +1,715 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/editor"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/testevents"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	composeHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	composeMinBody      = 5
+)
+
+type composeSentMsg struct {
+	result action.ComposeResult
+	err    error
+}
+
+type composeEditedMsg struct {
+	content string
+	err     error
+}
+
+type composeBodyMsg struct {
+	body string
+	err  error
+}
+
+type composeOptionsMsg struct {
+	options map[string][]string
+	err     error
+}
+
+// composeStyles holds cached lipgloss styles for performance
+type composeStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	label   lipgloss.Style
+	focused lipgloss.Style
+	option  lipgloss.Style
+	ok      lipgloss.Style
+	danger  lipgloss.Style
+	warning lipgloss.Style
+}
+
+func newComposeStyles() composeStyles {
+	return composeStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		label:   ui.DimStyle().Width(14),
+		focused: ui.AccentStyle().Bold(true).Width(14),
+		option:  ui.SelectedStyle(),
+		ok:      ui.SuccessStyle(),
+		danger:  ui.DangerStyle(),
+		warning: ui.WarningStyle(),
+	}
+}
+
+// ComposeView runs a compose action: it edits the action's fields and a
+// body, in place or in $EDITOR, sends them and shows the result. It stays
+// open after a send so the body can be tweaked and sent again.
+type ComposeView struct {
+	ctx      context.Context
+	resource dao.Resource
+	act      action.Action
+
+	fields  []action.ComposeField
+	inputs  []textinput.Model
+	choice  []int // selected option per field with Options
+	body    textarea.Model
+	ext     string
+	initial string // body before any edit, replaced by a loaded body
+	focus   int    // field index; len(fields) is the body
+	editing bool
+
+	confirming bool
+	sending    bool
+	result     *action.ComposeResult
+	err        error
+	notice     string
+	sent       int
+
+	// Saved test events, if the action has them
+	events     *testevents.Store
+	eventName  string // event loaded or saved last
+	eventNames []string
+	picking    bool
+	pickIdx    int
+	naming     bool
+	nameInput  textinput.Model
+
+	output  ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  composeStyles
+}
+
+// NewComposeView creates a view for an action with a Compose
+func NewComposeView(ctx context.Context, resource dao.Resource, act action.Action) *ComposeView {
+	v := &ComposeView{
+		ctx:      ctx,
+		resource: resource,
+		act:      act,
+		ext:      ".txt",
+		spinner:  ui.NewSpinner(),
+		styles:   newComposeStyles(),
+		editing:  true,
+	}
+	if act.Compose != nil && act.Compose.Fields != nil {
+		v.fields = act.Compose.Fields(resource)
+	}
+	for _, f := range v.fields {
+		ti := textinput.New()
+		ti.Prompt = ""
+		ti.Placeholder = f.Placeholder
+		ti.CharLimit = 1024
+		ti.SetValue(f.Value)
+		v.inputs = append(v.inputs, ti)
+		v.choice = append(v.choice, max(indexOf(f.Options, f.Value), 0))
+	}
+
+	v.body = textarea.New()
+	v.body.Prompt = ""
+	if act.Compose != nil && act.Compose.Body != nil {
+		content, ext := act.Compose.Body(resource)
+		v.body.SetValue(content)
+		v.initial = content
+		if ext != "" {
+			v.ext = ext
+		}
+	}
+	v.focus = len(v.fields)
+	v.body.Focus()
+
+	if act.Compose != nil && act.Compose.Events != nil {
+		store, err := testevents.Open(act.Compose.Events(resource)...)
+		if err != nil {
+			v.err = err
+		}
+		v.events = store
+	}
+	v.nameInput = textinput.New()
+	v.nameInput.Prompt = ""
+	v.nameInput.Placeholder = "event name"
+	v.nameInput.CharLimit = 128
+	return v
+}
+
+func indexOf(list []string, s string) int {
+	for i, item := range list {
+		if item == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// Init implements tea.Model
+func (v *ComposeView) Init() tea.Cmd {
+	cmds := []tea.Cmd{textarea.Blink}
+	if v.act.Compose == nil {
+		return tea.Batch(cmds...)
+	}
+	ctx, resource := v.ctx, v.resource
+	if load := v.act.Compose.Options; load != nil {
+		cmds = append(cmds, func() tea.Msg {
+			options, err := load(ctx, resource)
+			return composeOptionsMsg{options: options, err: err}
+		})
+	}
+	if load := v.act.Compose.LoadBody; load != nil {
+		cmds = append(cmds, func() tea.Msg {
+			body, err := load(ctx, resource)
+			return composeBodyMsg{body: body, err: err}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// setOptions replaces field options with loaded ones, keeping the selection
+func (v *ComposeView) setOptions(options map[string][]string) {
+	for i, f := range v.fields {
+		opts := options[f.Key]
+		if len(opts) == 0 {
+			continue
+		}
+		current := f.Value
+		if len(f.Options) > 0 {
+			current = f.Options[v.choice[i]]
+		}
+		v.fields[i].Options = opts
+		v.choice[i] = max(indexOf(opts, current), 0)
+	}
+}
+
+// values returns the trimmed inputs, or the picked options, by field key
+func (v *ComposeView) values() map[string]string {
+	values := make(map[string]string, len(v.fields))
+	for i, f := range v.fields {
+		if len(f.Options) > 0 {
+			values[f.Key] = f.Options[v.choice[i]]
+			continue
+		}
+		values[f.Key] = strings.TrimSpace(v.inputs[i].Value())
+	}
+	return values
+}
+
+// requestSend sends the body, after a y/n prompt if the action asks for one
+func (v *ComposeView) requestSend() tea.Cmd {
+	if v.sending {
+		return nil
+	}
+	if v.act.Confirm != action.ConfirmNone {
+		v.confirming = true
+		return nil
+	}
+	return v.send()
+}
+
+func (v *ComposeView) send() tea.Cmd {
+	v.sending = true
+	v.err = nil
+	v.notice = ""
+	ctx, act, resource := v.ctx, v.act, v.resource
+	body, values := v.body.Value(), v.values()
+	return tea.Batch(func() tea.Msg {
+		result, err := action.SendCompose(ctx, act, resource, body, values)
+		return composeSentMsg{result: result, err: err}
+	}, v.spinner.Tick)
+}
+
+// Update implements tea.Model
+func (v *ComposeView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case composeSentMsg:
+		v.sending = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.result = &msg.result
+			v.sent++
+		}
+		v.layout()
+		return v, nil
+
+	case composeBodyMsg:
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		if v.body.Value() == v.initial {
+			v.body.SetValue(msg.body)
+		}
+		return v, nil
+
+	case composeOptionsMsg:
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.setOptions(msg.options)
+		return v, nil
+
+	case composeEditedMsg:
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.body.SetValue(strings.TrimRight(msg.content, "\n"))
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.sending {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newComposeStyles()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch {
+		case v.confirming:
+			return v, v.handleConfirmKey(msg)
+		case v.sending:
+			return v, nil
+		case v.picking:
+			v.handlePickKey(msg)
+			return v, nil
+		case v.naming:
+			return v, v.handleNameKey(msg)
+		}
+		if msg.String() == "ctrl+s" {
+			return v, v.requestSend()
+		}
+		if v.editing {
+			return v, v.handleEditKey(msg)
+		}
+		switch msg.String() {
+		case "enter":
+			return v, v.requestSend()
+		case "E":
+			return v, v.openEditor()
+		case "e", "i":
+			v.editing = true
+			return v, v.focusCurrent()
+		case "y":
+			if v.result != nil && v.result.ID != "" {
+				return v, clipboard.Copy("ID", v.result.ID)
+			}
+			return v, nil
+		case "l":
+			return v, v.openLogs()
+		case "o":
+			v.openPicker()
+			return v, nil
+		case "w":
+			if v.events != nil {
+				v.naming = true
+				v.nameInput.SetValue(v.eventName)
+				v.nameInput.CursorEnd()
+				return v, v.nameInput.Focus()
+			}
+			return v, nil
+		}
+		var cmd tea.Cmd
+		v.output.Model, cmd = v.output.Model.Update(msg)
+		return v, cmd
+	}
+
+	if v.editing && v.focus == len(v.fields) {
+		var cmd tea.Cmd
+		v.body, cmd = v.body.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *ComposeView) handleEditKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.blurCurrent()
+		return nil
+	case "tab":
+		return v.moveFocus(1)
+	case "shift+tab":
+		return v.moveFocus(-1)
+	case "ctrl+e":
+		return v.openEditor()
+	}
+
+	if v.focus == len(v.fields) {
+		var cmd tea.Cmd
+		v.body, cmd = v.body.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "enter", "down":
+		return v.moveFocus(1)
+	case "up":
+		return v.moveFocus(-1)
+	}
+	if options := v.fields[v.focus].Options; len(options) > 0 {
+		switch msg.String() {
+		case "left", "h":
+			v.choice[v.focus] = (v.choice[v.focus] + len(options) - 1) % len(options)
+		case "right", "l", "space":
+			v.choice[v.focus] = (v.choice[v.focus] + 1) % len(options)
+		}
+		return nil
+	}
+	var cmd tea.Cmd
+	v.inputs[v.focus], cmd = v.inputs[v.focus].Update(msg)
+	return cmd
+}
+
+// openEditor edits the body in $EDITOR. ctrl+e only reaches the view while
+// editing; otherwise it toggles the compact header, so E is used instead.
+func (v *ComposeView) openEditor() tea.Cmd {
+	return editor.Edit(v.body.Value(), v.ext, func(edited string, err error) tea.Msg {
+		return composeEditedMsg{content: edited, err: err}
+	})
+}
+
+func (v *ComposeView) moveFocus(delta int) tea.Cmd {
+	v.blurCurrent()
+	v.focus = (v.focus + delta + len(v.fields) + 1) % (len(v.fields) + 1)
+	return v.focusCurrent()
+}
+
+func (v *ComposeView) focusCurrent() tea.Cmd {
+	if v.focus == len(v.fields) {
+		return v.body.Focus()
+	}
+	if len(v.fields[v.focus].Options) > 0 {
+		return nil
+	}
+	cmd := v.inputs[v.focus].Focus()
+	v.inputs[v.focus].CursorEnd()
+	return cmd
+}
+
+func (v *ComposeView) blurCurrent() {
+	if v.focus == len(v.fields) {
+		v.body.Blur()
+		return
+	}
+	v.inputs[v.focus].Blur()
+}
+
+// openLogs opens the logs of the last send, filtered to its lines
+func (v *ComposeView) openLogs() tea.Cmd {
+	if v.result == nil || v.result.LogGroup == "" {
+		return nil
+	}
+	logView := NewLogView(v.ctx, v.result.LogGroup)
+	logView.SetFilter(v.result.LogFilter)
+	return func() tea.Msg { return NavigateMsg{View: logView} }
+}
+
+func (v *ComposeView) openPicker() {
+	if v.events == nil {
+		return
+	}
+	names, err := v.events.List()
+	if err != nil {
+		v.err = err
+		return
+	}
+	if len(names) == 0 {
+		v.notice = "No saved events yet: w saves the body as one"
+		return
+	}
+	v.eventNames = names
+	v.pickIdx = max(indexOf(names, v.eventName), 0)
+	v.picking = true
+}
+
+func (v *ComposeView) handlePickKey(msg tea.KeyPressMsg) {
+	switch msg.String() {
+	case "esc", "q":
+		v.picking = false
+	case "up", "k":
+		v.pickIdx = max(v.pickIdx-1, 0)
+	case "down", "j":
+		v.pickIdx = min(v.pickIdx+1, len(v.eventNames)-1)
+	case "enter":
+		name := v.eventNames[v.pickIdx]
+		body, err := v.events.Load(name)
+		if err != nil {
+			v.err = err
+			return
+		}
+		v.body.SetValue(strings.TrimRight(body, "\n"))
+		v.eventName = name
+		v.notice = "Loaded event " + name
+		v.err = nil
+		v.picking = false
+	case "D":
+		name := v.eventNames[v.pickIdx]
+		if err := v.events.Delete(name); err != nil {
+			v.err = err
+			return
+		}
+		if v.eventName == name {
+			v.eventName = ""
+		}
+		v.notice = "Deleted event " + name
+		v.eventNames = append(v.eventNames[:v.pickIdx], v.eventNames[v.pickIdx+1:]...)
+		v.pickIdx = min(v.pickIdx, len(v.eventNames)-1)
+		v.picking = len(v.eventNames) > 0
+	}
+}
+
+func (v *ComposeView) handleNameKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.naming = false
+		v.nameInput.Blur()
+		return nil
+	case "enter":
+		name := strings.TrimSpace(v.nameInput.Value())
+		if err := v.events.Save(name, v.body.Value()); err != nil {
+			v.err = err
+			return nil
+		}
+		v.eventName = name
+		v.notice = "Saved event " + name
+		v.err = nil
+		v.naming = false
+		v.nameInput.Blur()
+		return nil
+	}
+	var cmd tea.Cmd
+	v.nameInput, cmd = v.nameInput.Update(msg)
+	return cmd
+}
+
+func (v *ComposeView) handleConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "y", "Y":
+		v.confirming = false
+		return v.send()
+	case "n", "N", "esc":
+		v.confirming = false
+	}
+	return nil
+}
+
+// layout sizes the body editor and the output below it
+func (v *ComposeView) layout() {
+	if v.width == 0 {
+		return
+	}
+	avail := v.height - composeHeaderHeight - len(v.fields) - 4 // blank, body label, blank, result line
+	bodyHeight := avail
+	if v.result != nil && v.result.Output != "" {
+		bodyHeight = avail / 2
+	}
+	bodyHeight = max(bodyHeight, composeMinBody)
+	v.body.SetWidth(max(v.width-2, 20))
+	v.body.SetHeight(bodyHeight)
+	v.output.SetSize(v.width, max(avail-bodyHeight, 3))
+	if v.result != nil {
+		v.output.Model.SetContent(v.result.Output)
+	}
+	for i := range v.inputs {
+		v.inputs[i].SetWidth(max(v.width-20, 10))
+	}
+}
+
+func (v *ComposeView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("%s: %s", v.act.Name, v.resource.GetName()))
+
+	var summary string
+	switch {
+	case v.sending:
+		summary = "Sending..."
+	case v.sent > 0:
+		summary = fmt.Sprintf("Sent %d time(s)", v.sent)
+	default:
+		summary = "Write the body and fields, then send with Ctrl+s"
+	}
+	if v.eventName != "" {
+		summary += " • event: " + v.eventName
+	}
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+func (v *ComposeView) renderField(i int) string {
+	s := v.styles
+	f := v.fields[i]
+	label := s.label.Render(f.Label)
+	if v.editing && v.focus == i {
+		label = s.focused.Render(f.Label)
+	}
+
+	if len(f.Options) > 0 {
+		var opts []string
+		for j, opt := range f.Options {
+			if j == v.choice[i] {
+				opts = append(opts, s.option.Render(" "+opt+" "))
+			} else {
+				opts = append(opts, s.dim.Render(" "+opt+" "))
+			}
+		}
+		line := strings.Join(opts, " ")
+		// Too many options for one line: show the selected one and its position
+		if lipgloss.Width(line) > v.width-16 {
+			line = s.option.Render("‹ "+f.Options[v.choice[i]]+" ›") + s.dim.Render(fmt.Sprintf(" %d/%d", v.choice[i]+1, len(f.Options)))
+		}
+		return label + " " + line
+	}
+
+	value := v.inputs[i].View()
+	if !(v.editing && v.focus == i) && v.inputs[i].Value() == "" {
+		value = s.dim.Render(f.Placeholder)
+	}
+	return label + " " + value
+}
+
+func (v *ComposeView) renderStatus() string {
+	s := v.styles
+	wrap := lipgloss.NewStyle().Width(max(v.width-4, 20))
+	switch {
+	case v.confirming:
+		return s.warning.Render(fmt.Sprintf("%s %s? (y/n)", v.act.Name, v.resource.GetName()))
+	case v.sending:
+		return v.spinner.View() + " Sending..."
+	case v.err != nil:
+		msg := v.err.Error()
+		if kind := apperrors.Classify(v.err); kind != apperrors.Unknown {
+			msg = fmt.Sprintf("[%s] %s", kind, msg)
+		}
+		return s.danger.Render(wrap.Render(msg))
+	case v.naming:
+		return s.label.Render("Save as") + " " + v.nameInput.View()
+	case v.notice != "":
+		return s.dim.Render(wrap.Render(v.notice))
+	case v.result != nil && v.result.Failed:
+		return s.danger.Render(wrap.Render("✗ " + v.result.Message))
+	case v.result != nil:
+		return s.ok.Render(wrap.Render("✓ " + v.result.Message))
+	}
+	return ""
+}
+
+func (v *ComposeView) renderPicker() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(s.focused.Render("Saved events") + "\n")
+	for i, name := range v.eventNames {
+		if i == v.pickIdx {
+			out.WriteString(s.option.Render("▸ "+name) + "\n")
+		} else {
+			out.WriteString("  " + name + "\n")
+		}
+	}
+	return out.String()
+}
+
+// ViewString returns the view content as a string
+func (v *ComposeView) ViewString() string {
+	if v.width == 0 {
+		return LoadingMessage
+	}
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(v.renderHeader() + "\n")
+	for i := range v.fields {
+		out.WriteString(v.renderField(i) + "\n")
+	}
+	bodyLabel := s.label.Render("Body")
+	if v.editing && v.focus == len(v.fields) {
+		bodyLabel = s.focused.Render("Body")
+	}
+	out.WriteString("\n")
+	if v.picking {
+		out.WriteString(v.renderPicker() + "\n")
+	} else {
+		out.WriteString(bodyLabel + s.dim.Render(" Ctrl+e (E when not editing): open in $EDITOR") + "\n")
+		out.WriteString(v.body.View() + "\n\n")
+	}
+	out.WriteString(v.renderStatus())
+	if v.result != nil && v.result.Output != "" && v.output.Ready {
+		out.WriteString("\n" + v.output.Model.View())
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *ComposeView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ComposeView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.layout()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ComposeView) StatusLine() string {
+	switch {
+	case v.confirming:
+		return "y:send n:cancel"
+	case v.sending:
+		return "Sending..."
+	case v.picking:
+		return "↑/↓:select Enter:load D:delete • Esc:close"
+	case v.naming:
+		return "Enter:save • Esc:cancel"
+	case v.editing:
+		return "Tab:next field ^s:send ^e:$EDITOR • Esc:done editing"
+	}
+	keys := "Enter/^s:send e:edit E:$EDITOR"
+	if v.events != nil {
+		keys += " o:open event w:save event"
+	}
+	if v.result != nil && v.result.ID != "" {
+		keys += " y:copy ID"
+	}
+	if v.result != nil && v.result.LogGroup != "" {
+		keys += " l:logs"
+	}
+	return keys + " • q/esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *ComposeView) HasActiveInput() bool {
+	return v.editing || v.confirming || v.sending || v.picking || v.naming
+}
@@ -0,0 +1,216 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// runComposeCmd runs a command, feeding send results back into the view
+func runComposeCmd(v *ComposeView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runComposeCmd(v, c)
+		}
+	case composeSentMsg:
+		v.Update(msg)
+	}
+}
+
+type composeCall struct {
+	body   string
+	values map[string]string
+}
+
+func newTestComposeView(t *testing.T, calls *[]composeCall, confirm action.ConfirmLevel, sendErr error) *ComposeView {
+	t.Helper()
+	act := action.Action{
+		Name:      "Publish",
+		Type:      action.ActionTypeAPI,
+		Operation: "PublishThings",
+		Confirm:   confirm,
+		Compose: &action.Compose{
+			Fields: func(dao.Resource) []action.ComposeField {
+				return []action.ComposeField{
+					{Key: "subject", Label: "Subject"},
+					{Key: "structure", Label: "Structure", Options: []string{"raw", "json"}},
+				}
+			},
+			Body: func(dao.Resource) (string, string) { return "hello", ".json" },
+			Send: func(_ context.Context, _ dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+				*calls = append(*calls, composeCall{body: body, values: values})
+				if sendErr != nil {
+					return action.ComposeResult{}, sendErr
+				}
+				return action.ComposeResult{Message: "Published message m-1", ID: "m-1", Output: "MessageId: m-1"}, nil
+			},
+		},
+	}
+	v := NewComposeView(context.Background(), &dao.BaseResource{ID: "orders", Name: "orders"}, act)
+	v.SetSize(100, 30)
+	return v
+}
+
+func TestComposeView_Send(t *testing.T) {
+	var calls []composeCall
+	v := newTestComposeView(t, &calls, action.ConfirmNone, nil)
+
+	if !v.HasActiveInput() || v.focus != 2 {
+		t.Fatalf("view should start editing the body, focus = %d", v.focus)
+	}
+	// Tab wraps from the body to the first field
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	for _, r := range "greeting" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	runComposeCmd(v, cmd)
+	if len(calls) != 1 {
+		t.Fatalf("sends = %d, want 1", len(calls))
+	}
+	if calls[0].body != "hello" || calls[0].values["subject"] != "greeting" || calls[0].values["structure"] != "json" {
+		t.Errorf("send = %+v", calls[0])
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Published message m-1", "MessageId: m-1", "Sent 1 time(s)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+
+	// Leaving edit mode, enter sends again with the same body
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if v.HasActiveInput() {
+		t.Fatal("esc should stop editing")
+	}
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runComposeCmd(v, cmd)
+	if len(calls) != 2 || v.sent != 2 {
+		t.Errorf("sends = %d, want the body sent again", len(calls))
+	}
+}
+
+func TestComposeView_ConfirmAndError(t *testing.T) {
+	var calls []composeCall
+	v := newTestComposeView(t, &calls, action.ConfirmSimple, errors.New("topic not found"))
+
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if !v.confirming || len(calls) != 0 {
+		t.Fatal("send should wait for the confirmation")
+	}
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.confirming || len(calls) != 0 {
+		t.Fatal("n should cancel the send")
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runComposeCmd(v, cmd)
+	if len(calls) != 1 || v.result != nil || !strings.Contains(v.ViewString(), "topic not found") {
+		t.Errorf("send error should be shown:\n%s", v.ViewString())
+	}
+}
+
+func TestComposeView_Edited(t *testing.T) {
+	var calls []composeCall
+	v := newTestComposeView(t, &calls, action.ConfirmNone, nil)
+
+	// A loaded body replaces the initial one, but not an edited one
+	v.Update(composeBodyMsg{body: `{"id": 1}`})
+	if got := v.body.Value(); got != `{"id": 1}` {
+		t.Errorf("body = %q, want the loaded body", got)
+	}
+	v.Update(composeEditedMsg{content: "{\"id\": 2}\n"})
+	if got := v.body.Value(); got != `{"id": 2}` {
+		t.Errorf("body = %q, want the edited content", got)
+	}
+	v.Update(composeBodyMsg{body: `{"id": 3}`})
+	if got := v.body.Value(); got != `{"id": 2}` {
+		t.Errorf("body = %q, a late load should keep the edit", got)
+	}
+	v.Update(composeEditedMsg{err: errors.New("editor failed")})
+	if v.err == nil || v.body.Value() != `{"id": 2}` {
+		t.Errorf("failed edit should keep the body, err = %v", v.err)
+	}
+}
+
+func TestComposeView_OptionsAndEvents(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var calls []composeCall
+	act := action.Action{
+		Name:      "Invoke",
+		Type:      action.ActionTypeAPI,
+		Operation: "InvokeThings",
+		Compose: &action.Compose{
+			Fields: func(dao.Resource) []action.ComposeField {
+				return []action.ComposeField{{Key: "qualifier", Label: "Qualifier", Value: "$LATEST", Options: []string{"$LATEST"}}}
+			},
+			Body: func(dao.Resource) (string, string) { return "{}", ".json" },
+			Options: func(context.Context, dao.Resource) (map[string][]string, error) {
+				return map[string][]string{"qualifier": {"$LATEST", "live", "3"}}, nil
+			},
+			Events: func(dao.Resource) []string { return []string{"test", "fn"} },
+			Send: func(_ context.Context, _ dao.Resource, body string, values map[string]string) (action.ComposeResult, error) {
+				calls = append(calls, composeCall{body: body, values: values})
+				return action.ComposeResult{Message: "Function error", Failed: true, LogGroup: "/aws/lambda/fn", LogFilter: "req-1"}, nil
+			},
+		},
+	}
+	v := NewComposeView(context.Background(), &dao.BaseResource{ID: "fn", Name: "fn"}, act)
+	v.SetSize(100, 30)
+	v.Update(v.Init()().(tea.BatchMsg)[1]())
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyLeft})
+	if got := v.values()["qualifier"]; got != "3" {
+		t.Errorf("qualifier = %q, want the loaded options to be picked from", got)
+	}
+
+	// Save the body as an event, then load it back after changing the body
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	v.body.SetValue(`{"id": 1}`)
+	v.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	for _, r := range "paid" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.naming || v.eventName != "paid" || v.err != nil {
+		t.Fatalf("save: naming = %v, event = %q, err = %v", v.naming, v.eventName, v.err)
+	}
+	v.body.SetValue("{}")
+	v.Update(tea.KeyPressMsg{Code: 'o', Text: "o"})
+	if !v.picking || !strings.Contains(v.ViewString(), "Saved events") {
+		t.Fatalf("o should list the saved events:\n%s", v.ViewString())
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.picking || v.body.Value() != `{"id": 1}` {
+		t.Errorf("loading the event should restore the body, got %q", v.body.Value())
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runComposeCmd(v, cmd)
+	if len(calls) != 1 || !strings.Contains(v.ViewString(), "✗ Function error") {
+		t.Errorf("failed result should be shown:\n%s", v.ViewString())
+	}
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'l', Text: "l"})
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("l should open the logs")
+	}
+	if logView, ok := nav.View.(*LogView); !ok || logView.LogGroupName() != "/aws/lambda/fn" || logView.filterText != "req-1" {
+		t.Errorf("logs view = %+v", nav.View)
+	}
+}
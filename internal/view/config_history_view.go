@@ -0,0 +1,520 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	configHistoryHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	configHistoryMaxPages     = 5 // 100 configuration items each
+	configHistoryPageSize     = 100
+)
+
+// configItemProvider is implemented by configservice/history resources
+type configItemProvider interface {
+	CaptureTime() *time.Time
+	Status() string
+	Configuration() any
+}
+
+// configVersion is one recorded configuration of a resource
+type configVersion struct {
+	item    dao.Resource
+	time    time.Time
+	status  string
+	config  any               // keys in PascalCase, like SDK responses
+	changes []jsondiff.Change // compared with the next older version
+}
+
+// configHistoryLoadedMsg carries the configuration items of a resource
+type configHistoryLoadedMsg struct {
+	versions []*configVersion
+	err      error
+}
+
+// buildConfigVersions converts configuration items into versions, newest
+// first, each compared with the version recorded before it.
+func buildConfigVersions(items []dao.Resource) []*configVersion {
+	var versions []*configVersion
+	for _, res := range items {
+		item, ok := dao.UnwrapResource(res).(configItemProvider)
+		if !ok {
+			continue
+		}
+		v := &configVersion{item: res, status: item.Status(), config: pascalKeys(item.Configuration())}
+		if t := item.CaptureTime(); t != nil {
+			v.time = *t
+		}
+		versions = append(versions, v)
+	}
+	slices.SortStableFunc(versions, func(a, b *configVersion) int { return b.time.Compare(a.time) })
+
+	for i := 0; i+1 < len(versions); i++ {
+		changes, err := jsondiff.Diff(versions[i+1].config, versions[i].config, jsondiff.Options{})
+		if err != nil {
+			log.Debug("cannot diff configuration items", "error", err)
+			continue
+		}
+		versions[i].changes = changes
+	}
+	return versions
+}
+
+// pascalKeys upper-cases the first letter of every object key. AWS Config
+// records configurations in camelCase, while SDK responses (and so live
+// resources) use PascalCase.
+func pascalKeys(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			r, size := utf8.DecodeRuneInString(k)
+			out[string(unicode.ToUpper(r))+k[size:]] = pascalKeys(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = pascalKeys(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// configHistoryStyles holds cached lipgloss styles for performance
+type configHistoryStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	added    lipgloss.Style
+	removed  lipgloss.Style
+	changed  lipgloss.Style
+	marked   lipgloss.Style
+	selected lipgloss.Style
+	error    lipgloss.Style
+}
+
+func newConfigHistoryStyles() configHistoryStyles {
+	return configHistoryStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.SectionStyle(),
+		added:    ui.SuccessStyle(),
+		removed:  ui.DangerStyle(),
+		changed:  ui.WarningStyle(),
+		marked:   ui.AccentStyle(),
+		selected: ui.SelectedStyle(),
+		error:    ui.DangerStyle(),
+	}
+}
+
+// ConfigHistoryView steps through the configurations AWS Config recorded
+// for a resource and diffs them with each other or with the live resource.
+type ConfigHistoryView struct {
+	ctx          context.Context
+	registry     *registry.Registry
+	resource     dao.Resource // live resource
+	service      string
+	resourceType string
+	configType   string // AWS Config resource type
+
+	versions []*configVersion
+	cursor   int
+	marked   int // index of the version marked for comparison, or -1
+	loading  bool
+	loaded   bool
+	err      error
+
+	vp           ViewportState
+	width        int
+	detailHeight int
+	spinner      spinner.Model
+	styles       configHistoryStyles
+}
+
+// NewConfigHistoryView creates a configuration history view for resource,
+// recorded by AWS Config as configType. ctx should carry the resource's
+// profile and region.
+func NewConfigHistoryView(ctx context.Context, reg *registry.Registry, resource dao.Resource, service, resourceType, configType string) *ConfigHistoryView {
+	return &ConfigHistoryView{
+		ctx:          ctx,
+		registry:     reg,
+		resource:     resource,
+		service:      service,
+		resourceType: resourceType,
+		configType:   configType,
+		marked:       -1,
+		loading:      true,
+		spinner:      ui.NewSpinner(),
+		styles:       newConfigHistoryStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *ConfigHistoryView) Init() tea.Cmd {
+	// Keep the versions when returning from a diff
+	if v.loaded {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+func (v *ConfigHistoryView) loadCmd() tea.Cmd {
+	res := dao.UnwrapResource(v.resource)
+	ctx := dao.WithFilter(v.ctx, "ResourceType", v.configType)
+	ctx = dao.WithFilter(ctx, "ResourceId", res.GetID())
+	ctx = dao.WithFilter(ctx, "ResourceName", res.GetName())
+	reg := v.registry
+
+	return func() tea.Msg {
+		d, err := reg.GetDAO(ctx, "configservice", "history")
+		if err != nil {
+			return configHistoryLoadedMsg{err: err}
+		}
+		paginated, ok := d.(dao.PaginatedDAO)
+		if !ok {
+			return configHistoryLoadedMsg{err: fmt.Errorf("configservice/history does not support pagination")}
+		}
+
+		var items []dao.Resource
+		token := ""
+		for range configHistoryMaxPages {
+			page, next, err := paginated.ListPage(ctx, configHistoryPageSize, token)
+			if err != nil {
+				return configHistoryLoadedMsg{err: err}
+			}
+			items = append(items, page...)
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		return configHistoryLoadedMsg{versions: buildConfigVersions(items)}
+	}
+}
+
+func (v *ConfigHistoryView) selected() *configVersion {
+	if v.cursor < 0 || v.cursor >= len(v.versions) {
+		return nil
+	}
+	return v.versions[v.cursor]
+}
+
+// Update implements tea.Model
+func (v *ConfigHistoryView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case configHistoryLoadedMsg:
+		v.loading = false
+		v.loaded = msg.err == nil
+		v.err = msg.err
+		v.versions = msg.versions
+		v.cursor = 0
+		v.marked = -1
+		v.updateContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newConfigHistoryStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			v.moveCursor(1)
+			return v, nil
+		case "k", "up":
+			v.moveCursor(-1)
+			return v, nil
+		case "g", "home":
+			v.moveCursor(-len(v.versions))
+			return v, nil
+		case "G", "end":
+			v.moveCursor(len(v.versions))
+			return v, nil
+		case "m":
+			if v.selected() != nil {
+				if v.marked == v.cursor {
+					v.marked = -1
+				} else {
+					v.marked = v.cursor
+				}
+				v.updateContent()
+			}
+			return v, nil
+		case "enter", "d":
+			return v, v.openDiff()
+		case "l":
+			return v, v.openLiveDiff()
+		case "e":
+			return v, v.openItem()
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				v.err = nil
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *ConfigHistoryView) moveCursor(delta int) {
+	if len(v.versions) == 0 {
+		return
+	}
+	v.cursor = max(min(v.cursor+delta, len(v.versions)-1), 0)
+	v.updateContent()
+}
+
+// versionResource wraps a recorded configuration for DiffView
+func (v *ConfigHistoryView) versionResource(c *configVersion) dao.Resource {
+	return &dao.BaseResource{
+		ID:   dao.UnwrapResource(v.resource).GetID(),
+		Name: "Config " + c.time.Local().Format("2006-01-02 15:04:05"),
+		Data: c.config,
+	}
+}
+
+// openDiff diffs the selected version with the marked one, or with the
+// version recorded before it
+func (v *ConfigHistoryView) openDiff() tea.Cmd {
+	sel := v.selected()
+	if sel == nil {
+		return nil
+	}
+	older := v.cursor + 1
+	if v.marked >= 0 && v.marked != v.cursor {
+		older = v.marked
+	}
+	if older >= len(v.versions) {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("no older configuration recorded; mark a version with m or press l for live")}
+		}
+	}
+
+	left, right := v.versions[older], sel
+	if left.time.After(right.time) {
+		left, right = right, left
+	}
+	return v.navigateToDiff(v.versionResource(left), v.versionResource(right))
+}
+
+// openLiveDiff diffs the selected version with the live resource
+func (v *ConfigHistoryView) openLiveDiff() tea.Cmd {
+	sel := v.selected()
+	if sel == nil {
+		return nil
+	}
+	return v.navigateToDiff(v.versionResource(sel), v.resource)
+}
+
+func (v *ConfigHistoryView) navigateToDiff(left, right dao.Resource) tea.Cmd {
+	diffView := NewDiffView(v.ctx, left, right, nil, v.service, v.resourceType)
+	diffView.structural = true
+	return func() tea.Msg {
+		return NavigateMsg{View: diffView}
+	}
+}
+
+// openItem opens the selected configuration item in a DetailView
+func (v *ConfigHistoryView) openItem() tea.Cmd {
+	sel := v.selected()
+	if sel == nil {
+		return nil
+	}
+	renderer, err := v.registry.GetRenderer("configservice", "history")
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	detailView := NewDetailView(v.ctx, sel.item, renderer, "configservice", "history", v.registry, nil)
+	return func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *ConfigHistoryView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderList())
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if v.cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(v.cursor)
+		} else if v.cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(v.cursor - height + 1)
+		}
+	}
+}
+
+func (v *ConfigHistoryView) renderList() string {
+	s := v.styles
+	if v.err != nil {
+		return s.error.Render("Error: " + v.err.Error())
+	}
+	if v.loading {
+		return ""
+	}
+	if len(v.versions) == 0 {
+		return s.dim.Render("No configuration items recorded")
+	}
+
+	var out strings.Builder
+	for i, c := range v.versions {
+		mark := "  "
+		if i == v.marked {
+			mark = "◆ "
+		}
+		line := fmt.Sprintf("%s%s  %-28s", mark, c.time.Local().Format("2006-01-02 15:04:05"), c.status)
+		switch {
+		case i == len(v.versions)-1:
+			line += "  oldest recorded"
+		case len(c.changes) > 0:
+			line += fmt.Sprintf("  %d change(s)", len(c.changes))
+		default:
+			line += "  no configuration changes"
+		}
+		line = TruncateString(line, v.width)
+
+		switch {
+		case i == v.cursor:
+			line = s.selected.Render(TruncateOrPadString(line, v.width))
+		case i == v.marked:
+			line = s.marked.Render(line)
+		case c.status != "OK":
+			line = s.changed.Render(line)
+		}
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+// renderDetail renders the changes of the selected version
+func (v *ConfigHistoryView) renderDetail() string {
+	s := v.styles
+	c := v.selected()
+	if c == nil || v.detailHeight <= 0 {
+		return ""
+	}
+
+	var lines []string
+	if v.cursor+1 < len(v.versions) {
+		prev := v.versions[v.cursor+1]
+		lines = append(lines, s.label.Render("Changes since "+prev.time.Local().Format("2006-01-02 15:04:05")))
+	} else {
+		lines = append(lines, s.label.Render("Oldest recorded configuration"))
+	}
+	if len(c.changes) == 0 && v.cursor+1 < len(v.versions) {
+		lines = append(lines, s.dim.Render("  No configuration changes (status or relationships only)"))
+	}
+	for _, ch := range c.changes {
+		style := s.changed
+		switch ch.Kind {
+		case jsondiff.Added:
+			style = s.added
+		case jsondiff.Removed:
+			style = s.removed
+		}
+		lines = append(lines, style.Render(TruncateString("  "+formatChange(ch), v.width)))
+	}
+
+	if len(lines) > v.detailHeight {
+		hidden := len(lines) - v.detailHeight + 1
+		lines = append(lines[:v.detailHeight-1], s.dim.Render(fmt.Sprintf("  … %d more (enter for full diff)", hidden)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *ConfigHistoryView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("Config history: %s/%s %s", v.service, v.resourceType, diffLabel(v.resource)))
+
+	var summary string
+	switch {
+	case v.loading:
+		summary = v.spinner.View() + " Loading AWS Config history for " + v.configType + "..."
+	case v.err == nil:
+		summary = fmt.Sprintf("%s • %d version(s)", v.configType, len(v.versions))
+		if v.marked >= 0 {
+			summary += " • marked " + v.versions[v.marked].time.Local().Format("2006-01-02 15:04:05")
+		}
+	}
+
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *ConfigHistoryView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	out := v.renderHeader() + "\n" + v.vp.Model.View()
+	if v.detailHeight > 0 {
+		out += "\n" + strings.Repeat("─", v.width) + "\n" + v.renderDetail()
+	}
+	return out
+}
+
+// View implements tea.Model
+func (v *ConfigHistoryView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ConfigHistoryView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+
+	// The list gets about half of the body, the selected version's
+	// changes the rest (minus one separator line)
+	body := max(height-configHistoryHeaderHeight, 6)
+	listHeight := max(body/2, 3)
+	v.detailHeight = max(body-listHeight-1, 0)
+
+	v.vp.SetSize(width, listHeight)
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ConfigHistoryView) StatusLine() string {
+	diff := "enter:diff prev"
+	if v.marked >= 0 {
+		diff = "enter:diff marked"
+	}
+	return fmt.Sprintf("%s • j/k:move m:mark %s l:diff live e:item ^r:refresh • q/esc:back",
+		dao.UnwrapResource(v.resource).GetID(), diff)
+}
@@ -0,0 +1,184 @@
+package view
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// mockConfigItem implements configItemProvider
+type mockConfigItem struct {
+	dao.BaseResource
+	time   time.Time
+	status string
+	config any
+}
+
+func (r *mockConfigItem) CaptureTime() *time.Time { return &r.time }
+func (r *mockConfigItem) Status() string          { return r.status }
+func (r *mockConfigItem) Configuration() any      { return r.config }
+
+// mockConfigDAO returns configuration items for one resource
+type mockConfigDAO struct {
+	dao.BaseDAO
+	items   []dao.Resource
+	filters *[]string
+}
+
+func (d *mockConfigDAO) List(ctx context.Context) ([]dao.Resource, error) { return d.items, nil }
+func (d *mockConfigDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, nil
+}
+func (d *mockConfigDAO) Delete(ctx context.Context, id string) error { return nil }
+func (d *mockConfigDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	*d.filters = append(*d.filters,
+		dao.GetFilterFromContext(ctx, "ResourceType"),
+		dao.GetFilterFromContext(ctx, "ResourceId"),
+		dao.GetFilterFromContext(ctx, "ResourceName"))
+	return d.items, "", nil
+}
+
+func newMockConfigItem(id string, at time.Time, config any) *mockConfigItem {
+	return &mockConfigItem{BaseResource: dao.BaseResource{ID: id}, time: at, status: "OK", config: config}
+}
+
+func TestPascalKeys(t *testing.T) {
+	in := map[string]any{
+		"groupId": "sg-1",
+		"ipPermissions": []any{
+			map[string]any{"fromPort": float64(22), "ipRanges": []any{"10.0.0.0/8"}},
+		},
+		"Tags": []any{},
+	}
+	want := map[string]any{
+		"GroupId": "sg-1",
+		"IpPermissions": []any{
+			map[string]any{"FromPort": float64(22), "IpRanges": []any{"10.0.0.0/8"}},
+		},
+		"Tags": []any{},
+	}
+	if got := pascalKeys(in); !reflect.DeepEqual(got, want) {
+		t.Errorf("pascalKeys() = %#v, want %#v", got, want)
+	}
+}
+
+func TestBuildConfigVersions(t *testing.T) {
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	items := []dao.Resource{
+		newMockConfigItem("s1", base, map[string]any{"instanceType": "t3.micro"}),
+		newMockConfigItem("s3", base.Add(2*time.Hour), map[string]any{"instanceType": "m5.large"}),
+		newMockConfigItem("s2", base.Add(time.Hour), map[string]any{"instanceType": "t3.micro"}),
+	}
+
+	versions := buildConfigVersions(items)
+	if len(versions) != 3 {
+		t.Fatalf("versions = %d, want 3", len(versions))
+	}
+	if versions[0].item.GetID() != "s3" || versions[2].item.GetID() != "s1" {
+		t.Errorf("versions should be newest first")
+	}
+	if len(versions[0].changes) != 1 || versions[0].changes[0].Path != "InstanceType" {
+		t.Errorf("latest changes = %+v, want InstanceType", versions[0].changes)
+	}
+	if len(versions[1].changes) != 0 || len(versions[2].changes) != 0 {
+		t.Error("unchanged and oldest versions should have no changes")
+	}
+}
+
+func TestConfigHistoryView(t *testing.T) {
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var filters []string
+	reg := registry.New()
+	reg.RegisterCustom("configservice", "history", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockConfigDAO{
+				BaseDAO: dao.NewBaseDAO("configservice", "history"),
+				filters: &filters,
+				items: []dao.Resource{
+					newMockConfigItem("s1", base, map[string]any{"groupName": "web", "port": float64(22)}),
+					newMockConfigItem("s2", base.Add(time.Hour), map[string]any{"groupName": "web", "port": float64(443)}),
+					newMockConfigItem("s3", base.Add(2*time.Hour), map[string]any{"groupName": "web-prod", "port": float64(443)}),
+				},
+			}, nil
+		},
+	})
+
+	resource := &dao.BaseResource{ID: "sg-123", Name: "web-prod", Data: map[string]any{"GroupName": "web-prod", "Port": 8443}}
+	v := NewConfigHistoryView(context.Background(), reg, resource, "ec2", "security-groups", "AWS::EC2::SecurityGroup")
+	v.SetSize(120, 30)
+	v.Update(v.loadCmd()())
+
+	if v.err != nil {
+		t.Fatalf("unexpected error: %v", v.err)
+	}
+	if want := "AWS::EC2::SecurityGroup,sg-123,web-prod"; strings.Join(filters, ",") != want {
+		t.Errorf("filters = %v, want %s", filters, want)
+	}
+	if len(v.versions) != 3 {
+		t.Fatalf("versions = %d, want 3", len(v.versions))
+	}
+
+	out := v.ViewString()
+	for _, want := range []string{"3 version(s)", "oldest recorded", "~ GroupName: web → web-prod"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	// Enter diffs with the previous version
+	diff := navigatedDiff(t, v, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if diff.left.GetName() != "Config "+base.Add(time.Hour).Local().Format("2006-01-02 15:04:05") {
+		t.Errorf("left = %q, want previous version", diff.left.GetName())
+	}
+
+	// A marked version replaces the previous one
+	v.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+	v.Update(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	v.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	diff = navigatedDiff(t, v, tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if diff.left.GetName() != "Config "+base.Local().Format("2006-01-02 15:04:05") {
+		t.Errorf("left = %q, want marked version", diff.left.GetName())
+	}
+
+	// l diffs the selected version with the live resource
+	diff = navigatedDiff(t, v, tea.KeyPressMsg{Code: 'l', Text: "l"})
+	if diff.right != resource {
+		t.Error("right side should be the live resource")
+	}
+
+	// The oldest version has nothing to diff with unless another is marked
+	v.marked = -1
+	v.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if _, ok := cmd().(ErrorMsg); !ok {
+		t.Error("diffing the oldest version should report an error")
+	}
+
+	if cmd := v.Init(); cmd != nil {
+		t.Error("Init() should not reload once loaded")
+	}
+}
+
+func navigatedDiff(t *testing.T, v *ConfigHistoryView, key tea.KeyPressMsg) *DiffView {
+	t.Helper()
+	_, cmd := v.Update(key)
+	if cmd == nil {
+		t.Fatalf("%s should return a command", key.String())
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatalf("%s should navigate", key.String())
+	}
+	diff, ok := nav.View.(*DiffView)
+	if !ok || !diff.structural {
+		t.Fatalf("navigated to %T, want structural *DiffView", nav.View)
+	}
+	return diff
+}
@@ -0,0 +1,438 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const deletePlanHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+
+// planStepState is the progress of one step of the teardown
+type planStepState int
+
+const (
+	planStepPending planStepState = iota
+	planStepRunning
+	planStepDone
+	planStepFailed
+)
+
+type deletePlanLoadedMsg struct {
+	plan *action.Plan
+	err  error
+}
+
+// deletePlanStepMsg reports a finished teardown step; index len(steps) is
+// the delete action itself
+type deletePlanStepMsg struct {
+	index  int
+	err    error
+	result *action.ActionResult
+}
+
+// deletePlanStyles holds cached lipgloss styles for performance
+type deletePlanStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	section lipgloss.Style
+	ok      lipgloss.Style
+	warning lipgloss.Style
+	danger  lipgloss.Style
+	bold    lipgloss.Style
+	input   lipgloss.Style
+	box     lipgloss.Style
+}
+
+func newDeletePlanStyles() deletePlanStyles {
+	t := ui.Current()
+	return deletePlanStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		section: ui.SectionStyle(),
+		ok:      ui.SuccessStyle(),
+		warning: ui.WarningStyle(),
+		danger:  ui.DangerStyle(),
+		bold:    ui.TextStyle().Bold(true),
+		input:   ui.InputStyle(),
+		box:     ui.BoxStyle().BorderForeground(t.Danger).MarginTop(1),
+	}
+}
+
+// DeletePlanView shows what must be removed before a resource can be
+// deleted and runs the ordered teardown under one dangerous confirmation
+type DeletePlanView struct {
+	ctx      context.Context
+	resource dao.Resource
+	service  string
+	resType  string
+	act      action.Action
+
+	plan    *action.Plan
+	err     error
+	states  []planStepState // one per step, plus the delete itself
+	stepErr error
+	result  *action.ActionResult
+	loading bool
+	running bool
+
+	dangerous dangerousState
+
+	vp      ViewportState
+	width   int
+	spinner spinner.Model
+	styles  deletePlanStyles
+}
+
+// NewDeletePlanView creates a delete plan for a dangerous delete action
+func NewDeletePlanView(ctx context.Context, resource dao.Resource, service, resType string, act action.Action) *DeletePlanView {
+	return &DeletePlanView{
+		ctx:      ctx,
+		resource: resource,
+		service:  service,
+		resType:  resType,
+		act:      act,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newDeletePlanStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *DeletePlanView) Init() tea.Cmd {
+	if v.plan != nil {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+func (v *DeletePlanView) loadCmd() tea.Cmd {
+	ctx, act, resource := v.ctx, v.act, v.resource
+	return func() tea.Msg {
+		plan, err := action.BuildPlan(ctx, act, resource)
+		return deletePlanLoadedMsg{plan: plan, err: err}
+	}
+}
+
+// runStepCmd runs step i of the teardown; the last step is the action itself
+func (v *DeletePlanView) runStepCmd(i int) tea.Cmd {
+	ctx := v.ctx
+	if i < len(v.plan.Steps) {
+		step := v.plan.Steps[i]
+		return func() tea.Msg {
+			return deletePlanStepMsg{index: i, err: action.RunStep(ctx, step)}
+		}
+	}
+	act, resource, service, resType := v.act, v.resource, v.service, v.resType
+	return func() tea.Msg {
+		result := action.ExecuteWithDAO(ctx, act, resource, service, resType)
+		err := result.Error
+		if err == nil && !result.Success {
+			err = fmt.Errorf("%s failed", act.Name)
+		}
+		return deletePlanStepMsg{index: i, err: err, result: &result}
+	}
+}
+
+// Update implements tea.Model
+func (v *DeletePlanView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case deletePlanLoadedMsg:
+		v.loading = false
+		v.plan = msg.plan
+		v.err = msg.err
+		v.stepErr = nil
+		v.result = nil
+		if msg.plan != nil {
+			v.states = make([]planStepState, len(msg.plan.Steps)+1)
+		}
+		v.updateContent()
+		return v, nil
+
+	case deletePlanStepMsg:
+		return v, v.handleStep(msg)
+
+	case spinner.TickMsg:
+		if v.loading || v.running {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			v.updateContent()
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newDeletePlanStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.dangerous.active {
+			return v, v.handleConfirmKey(msg)
+		}
+		if IsEscKey(msg) || v.running {
+			return v, nil
+		}
+		switch msg.String() {
+		case "D":
+			v.startConfirm()
+			return v, nil
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				v.updateContent()
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// startConfirm asks for the dangerous confirmation of the whole teardown
+func (v *DeletePlanView) startConfirm() {
+	if v.plan == nil || v.loading || v.result != nil && v.result.Success {
+		return
+	}
+	if v.plan.Blocked() {
+		v.stepErr = fmt.Errorf("%d dependenc(ies) in use must be removed first", len(v.plan.InUse))
+		v.updateContent()
+		return
+	}
+	token := v.resource.GetID()
+	if v.act.ConfirmToken != nil {
+		token = v.act.ConfirmToken(v.resource)
+	}
+	v.dangerous = dangerousState{active: true, token: token}
+	v.updateContent()
+}
+
+func (v *DeletePlanView) handleConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch {
+	case IsEscKey(msg):
+		v.dangerous = dangerousState{}
+	case msg.String() == "enter":
+		if !action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+			return nil
+		}
+		v.dangerous = dangerousState{}
+		v.running = true
+		v.stepErr = nil
+		v.result = nil
+		for i := range v.states {
+			v.states[i] = planStepPending
+		}
+		v.states[0] = planStepRunning
+		v.updateContent()
+		return tea.Batch(v.runStepCmd(0), v.spinner.Tick)
+	case msg.Code == tea.KeyBackspace || msg.String() == "backspace":
+		if len(v.dangerous.input) > 0 {
+			v.dangerous.input = v.dangerous.input[:len(v.dangerous.input)-1]
+		}
+	case len(msg.String()) == 1:
+		v.dangerous.input += msg.String()
+	}
+	v.updateContent()
+	return nil
+}
+
+// handleStep records a finished step and starts the next one
+func (v *DeletePlanView) handleStep(msg deletePlanStepMsg) tea.Cmd {
+	if msg.result != nil {
+		v.result = msg.result
+	}
+	if msg.err != nil {
+		v.states[msg.index] = planStepFailed
+		v.stepErr = msg.err
+		v.running = false
+		v.updateContent()
+		return nil
+	}
+	v.states[msg.index] = planStepDone
+	next := msg.index + 1
+	if next == len(v.states) {
+		v.running = false
+		v.updateContent()
+		return nil
+	}
+	v.states[next] = planStepRunning
+	v.updateContent()
+	return v.runStepCmd(next)
+}
+
+func (v *DeletePlanView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderPlan())
+}
+
+func (v *DeletePlanView) renderPlan() string {
+	s := v.styles
+	if v.loading {
+		return v.spinner.View() + " Checking dependencies..."
+	}
+	if v.err != nil {
+		return s.danger.Render("Error: " + v.err.Error())
+	}
+
+	var out strings.Builder
+	width := max(v.width-4, 20)
+	wrap := lipgloss.NewStyle().Width(width)
+
+	for _, w := range v.plan.Warnings {
+		out.WriteString(s.warning.Render(wrap.Render("⚠ "+w)) + "\n\n")
+	}
+
+	if len(v.plan.InUse) > 0 {
+		out.WriteString(s.section.Render("In use (not removed by this plan)") + "\n")
+		for _, u := range v.plan.InUse {
+			out.WriteString("  " + s.danger.Render("✗ "+u) + "\n")
+		}
+		out.WriteString("\n")
+	}
+
+	out.WriteString(s.section.Render("Teardown order") + "\n")
+	n := len(v.plan.Steps)
+	for i, step := range v.plan.Steps {
+		out.WriteString(v.renderStep(i, fmt.Sprintf("%d. %s", i+1, step.Description)) + "\n")
+	}
+	target := fmt.Sprintf("%d. %s %s/%s %s", n+1, v.act.Name, v.service, v.resType, diffLabel(v.resource))
+	out.WriteString(v.renderStep(n, target) + "\n")
+	if n == 0 {
+		out.WriteString(s.dim.Render("  No dependencies found") + "\n")
+	}
+
+	if v.stepErr != nil {
+		msg := v.stepErr.Error()
+		if kind := apperrors.Classify(v.stepErr); kind != apperrors.Unknown {
+			msg = fmt.Sprintf("[%s] %s", kind, msg)
+		}
+		out.WriteString("\n" + s.danger.Render(wrap.Render(msg)) + "\n")
+	} else if v.result != nil && v.result.Success {
+		out.WriteString("\n" + s.ok.Render(v.result.Message) + "\n")
+	}
+
+	if v.dangerous.active {
+		out.WriteString(v.renderConfirm())
+	}
+	return out.String()
+}
+
+func (v *DeletePlanView) renderStep(i int, text string) string {
+	s := v.styles
+	state := planStepPending
+	if i < len(v.states) {
+		state = v.states[i]
+	}
+	switch state {
+	case planStepRunning:
+		return "  " + v.spinner.View() + " " + text
+	case planStepDone:
+		return "  " + s.ok.Render("✓ "+text)
+	case planStepFailed:
+		return "  " + s.danger.Render("✗ "+text)
+	default:
+		return "  · " + text
+	}
+}
+
+func (v *DeletePlanView) renderConfirm() string {
+	s := v.styles
+	t := ui.Current()
+
+	content := ui.BoldDangerStyle().Render("⚠ DANGER") + "\n\n"
+	content += fmt.Sprintf("You are about to run %d step(s), ending with %s:\n",
+		len(v.plan.Steps)+1, s.danger.Render(v.act.Name))
+	content += s.bold.Render(v.dangerous.token) + "\n\n"
+
+	suffix := action.ConfirmSuffix(v.dangerous.token)
+	if len(suffix) < len(v.dangerous.token) {
+		content += fmt.Sprintf("Type last %d chars: ...%s\n", len(suffix), suffix)
+	} else {
+		content += "Type to confirm:\n"
+	}
+
+	inputStyle := s.input
+	if action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Success)
+	} else if len(v.dangerous.input) > 0 && strings.HasPrefix(suffix, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Warning)
+	}
+	content += inputStyle.Render(v.dangerous.input+"▌") + "\n\n"
+	content += s.dim.Render("Press Enter to confirm, Esc to cancel")
+
+	return "\n" + s.box.Render(content)
+}
+
+func (v *DeletePlanView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("Delete plan: %s/%s %s", v.service, v.resType, diffLabel(v.resource)))
+
+	var summary string
+	switch {
+	case v.loading:
+		summary = "Checking dependencies..."
+	case v.plan == nil:
+		summary = "No plan"
+	case v.running:
+		summary = "Tearing down..."
+	default:
+		summary = fmt.Sprintf("%d dependenc(ies) to remove", len(v.plan.Steps))
+		if len(v.plan.InUse) > 0 {
+			summary += fmt.Sprintf(" • %d in use", len(v.plan.InUse))
+		}
+	}
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *DeletePlanView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *DeletePlanView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *DeletePlanView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height-deletePlanHeaderHeight, 3))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *DeletePlanView) StatusLine() string {
+	switch {
+	case v.dangerous.active:
+		return "Type to confirm • Enter:run • Esc:cancel"
+	case v.running:
+		return "Tearing down..."
+	default:
+		return "D:run teardown ^r:recheck • q/esc:back"
+	}
+}
+
+// HasActiveInput implements InputCapture. The view cannot be left while
+// the teardown runs.
+func (v *DeletePlanView) HasActiveInput() bool {
+	return v.dangerous.active || v.running
+}
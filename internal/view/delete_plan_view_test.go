@@ -0,0 +1,155 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// runPlanCmd runs a command, feeding plan results back into the view
+func runPlanCmd(v *DeletePlanView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runPlanCmd(v, c)
+		}
+	case deletePlanLoadedMsg:
+		v.Update(msg)
+	case deletePlanStepMsg:
+		_, next := v.Update(msg)
+		runPlanCmd(v, next)
+	}
+}
+
+func typeKeys(v *DeletePlanView, s string) {
+	for _, r := range s {
+		v.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+}
+
+func newTestPlanAction(ran *[]string, inUse bool) action.Action {
+	return action.Action{
+		Name:      "Delete",
+		Type:      action.ActionTypeAPI,
+		Operation: "DeleteThing",
+		Confirm:   action.ConfirmDangerous,
+		Plan: func(_ context.Context, r dao.Resource) (*action.Plan, error) {
+			p := &action.Plan{}
+			p.AddStep("Delete child-1", func(context.Context) error {
+				*ran = append(*ran, "child-1")
+				return nil
+			})
+			p.AddStep("Delete child-2", func(context.Context) error {
+				*ran = append(*ran, "child-2")
+				return nil
+			})
+			if inUse {
+				p.AddInUse("instance %s", "i-1")
+			}
+			return p, nil
+		},
+	}
+}
+
+func TestDeletePlanView_Teardown(t *testing.T) {
+	var ran []string
+	action.RegisterExecutor("test-plan", "things", func(_ context.Context, _ action.Action, r dao.Resource) action.ActionResult {
+		ran = append(ran, r.GetID())
+		return action.ActionResult{Success: true, Message: "Deleted " + r.GetID()}
+	})
+
+	resource := &dao.BaseResource{ID: "thing-1", Name: "thing-1"}
+	v := NewDeletePlanView(context.Background(), resource, "test-plan", "things", newTestPlanAction(&ran, false))
+	v.SetSize(100, 30)
+	runPlanCmd(v, v.Init())
+
+	out := v.ViewString()
+	for _, want := range []string{"Teardown order", "1. Delete child-1", "2. Delete child-2", "3. Delete test-plan/things thing-1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plan should contain %q, got:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Text: "D", Code: 'D'})
+	if !v.HasActiveInput() {
+		t.Fatal("D should open the confirmation")
+	}
+	typeKeys(v, "wrong")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd != nil || len(ran) != 0 {
+		t.Fatal("wrong confirmation should not run the teardown")
+	}
+
+	v.dangerous.input = ""
+	typeKeys(v, action.ConfirmSuffix("thing-1"))
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runPlanCmd(v, cmd)
+
+	if got := strings.Join(ran, ","); got != "child-1,child-2,thing-1" {
+		t.Errorf("teardown order = %s, want child-1,child-2,thing-1", got)
+	}
+	if v.running || v.result == nil || !v.result.Success {
+		t.Errorf("teardown should finish successfully, result = %+v", v.result)
+	}
+	if !strings.Contains(v.ViewString(), "Deleted thing-1") {
+		t.Error("view should show the delete result")
+	}
+}
+
+func TestDeletePlanView_Blocked(t *testing.T) {
+	var ran []string
+	resource := &dao.BaseResource{ID: "thing-1"}
+	v := NewDeletePlanView(context.Background(), resource, "test-plan", "things", newTestPlanAction(&ran, true))
+	v.SetSize(100, 30)
+	runPlanCmd(v, v.Init())
+
+	if !strings.Contains(v.ViewString(), "instance i-1") {
+		t.Error("view should list the dependency in use")
+	}
+	v.Update(tea.KeyPressMsg{Text: "D", Code: 'D'})
+	if v.dangerous.active {
+		t.Error("blocked plan should not open the confirmation")
+	}
+	if v.stepErr == nil {
+		t.Error("blocked plan should explain why it cannot run")
+	}
+}
+
+func TestDeletePlanView_StepFailure(t *testing.T) {
+	failed := errors.New("step failed")
+	act := action.Action{
+		Name:      "Delete",
+		Type:      action.ActionTypeAPI,
+		Operation: "DeleteThing",
+		Confirm:   action.ConfirmDangerous,
+		Plan: func(context.Context, dao.Resource) (*action.Plan, error) {
+			p := &action.Plan{}
+			p.AddStep("Delete child", func(context.Context) error { return failed })
+			return p, nil
+		},
+	}
+	v := NewDeletePlanView(context.Background(), &dao.BaseResource{ID: "thing-2"}, "test-plan", "things", act)
+	v.SetSize(100, 30)
+	runPlanCmd(v, v.Init())
+
+	v.Update(tea.KeyPressMsg{Text: "D", Code: 'D'})
+	typeKeys(v, action.ConfirmSuffix("thing-2"))
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runPlanCmd(v, cmd)
+
+	if !errors.Is(v.stepErr, failed) || v.running {
+		t.Errorf("stepErr = %v, want %v", v.stepErr, failed)
+	}
+	if v.states[0] != planStepFailed || v.states[1] != planStepPending {
+		t.Errorf("states = %v, want failed step and pending delete", v.states)
+	}
+}
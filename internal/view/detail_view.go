@@ -2,15 +2,19 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/awsconfig"
 	"github.com/clawscli/claws/internal/clipboard"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
@@ -25,6 +29,8 @@ type detailViewStyles struct {
 	title lipgloss.Style
 	label lipgloss.Style
 	value lipgloss.Style
+	path  lipgloss.Style
+	raw   rawTreeStyles
 }
 
 func newDetailViewStyles() detailViewStyles {
@@ -32,6 +38,8 @@ func newDetailViewStyles() detailViewStyles {
 		title: ui.TitleStyle(),
 		label: ui.DimStyle().Width(15),
 		value: ui.TextStyle(),
+		path:  ui.DimStyle(),
+		raw:   newRawTreeStyles(),
 	}
 }
 
@@ -51,6 +59,12 @@ type DetailView struct {
 	styles      detailViewStyles
 	width       int
 	height      int
+
+	// Raw mode shows Resource.Raw() as a foldable JSON or YAML tree
+	raw         *rawTree
+	rawErr      error
+	searchInput textinput.Model
+	searching   bool
 }
 
 // NewDetailView creates a new DetailView
@@ -58,6 +72,11 @@ func NewDetailView(ctx context.Context, resource dao.Resource, renderer render.R
 	hp := NewHeaderPanel()
 	hp.SetWidth(120) // Default width until SetSize is called
 
+	ti := textinput.New()
+	ti.Placeholder = "Search keys and values..."
+	ti.Prompt = "/"
+	ti.CharLimit = 200
+
 	return &DetailView{
 		ctx:         ctx,
 		resource:    resource,
@@ -69,6 +88,7 @@ func NewDetailView(ctx context.Context, resource dao.Resource, renderer render.R
 		headerPanel: hp,
 		spinner:     ui.NewSpinner(),
 		styles:      newDetailViewStyles(),
+		searchInput: ti,
 	}
 }
 
@@ -111,10 +131,10 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			d.refreshErr = nil
 			d.resource = mergeResources(d.resource, msg.resource)
-			if d.vp.Ready {
-				content := d.renderContent()
-				d.vp.Model.SetContent(content)
+			if d.raw != nil {
+				d.rebuildRaw()
 			}
+			d.updateContent()
 		}
 		return d, nil
 
@@ -128,9 +148,7 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ThemeChangedMsg:
 		d.styles = newDetailViewStyles()
 		d.headerPanel.ReloadStyles()
-		if d.vp.Ready {
-			d.vp.Model.SetContent(d.renderContent())
-		}
+		d.updateContent()
 		return d, nil
 	case CompactHeaderChangedMsg:
 		d.recalcViewport()
@@ -138,10 +156,45 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyPressMsg:
 		// Let app handle back navigation (esc/backspace/q handled by app.go)
+		if d.searching {
+			return d, d.handleSearchInput(msg)
+		}
 		if IsEscKey(msg) {
 			return d, nil
 		}
 
+		switch msg.String() {
+		case "J":
+			d.cycleRawMode()
+			return d, nil
+		case "H":
+			if d.registry != nil {
+				historyView := NewResourceHistoryView(d.ctx, d.registry, d.resource, d.service, d.resType)
+				return d, func() tea.Msg { return NavigateMsg{View: historyView} }
+			}
+		case "X":
+			// R is the global region selector
+			if d.registry != nil {
+				graphView := NewGraphView(d.ctx, d.registry, d.resource, d.service, d.resType)
+				return d, func() tea.Msg { return NavigateMsg{View: graphView} }
+			}
+		case "I":
+			if iac.Supported(d.service, d.resType) && d.registry != nil {
+				iacView := NewIaCView(d.ctx, d.registry, []dao.Resource{d.resource}, d.service, d.resType, iac.Terraform)
+				return d, func() tea.Msg { return NavigateMsg{View: iacView} }
+			}
+		case "C":
+			if configType, ok := awsconfig.ResourceType(d.service, d.resType); ok && d.registry != nil {
+				configView := NewConfigHistoryView(d.ctx, d.registry, d.resource, d.service, d.resType, configType)
+				return d, func() tea.Msg { return NavigateMsg{View: configView} }
+			}
+		}
+		if d.raw != nil {
+			if handled, cmd := d.handleRawKey(msg); handled {
+				return d, cmd
+			}
+		}
+
 		// Check navigation shortcuts
 		if model, cmd := d.handleNavigation(msg.String()); model != nil {
 			return model, cmd
@@ -202,6 +255,9 @@ func (d *DetailView) ViewString() string {
 
 	header := d.headerPanel.Render(d.service, d.resType, summaryFields)
 
+	if d.raw != nil || d.rawErr != nil {
+		return header + "\n" + d.vp.Model.View() + "\n" + d.rawStatusBar()
+	}
 	return header + "\n" + d.vp.Model.View()
 }
 
@@ -231,15 +287,153 @@ func (d *DetailView) recalcViewport() {
 	headerHeight := d.headerPanel.Height(headerStr)
 
 	// +1 compensates for border overlap
-	viewportHeight := max(d.height-headerHeight+1, minViewportHeight)
+	viewportHeight := d.height - headerHeight + 1
+	if d.raw != nil || d.rawErr != nil {
+		viewportHeight-- // search/path bar
+	}
 
-	d.vp.SetSize(d.width, viewportHeight)
+	d.vp.SetSize(d.width, max(viewportHeight, minViewportHeight))
+	d.searchInput.SetWidth(d.width - 4)
+	d.updateContent()
+}
 
+// updateContent re-renders the viewport, keeping the raw cursor visible
+func (d *DetailView) updateContent() {
 	if !d.vp.Ready {
 		return
 	}
-	content := d.renderContent()
-	d.vp.Model.SetContent(content)
+	d.vp.Model.SetContent(d.renderContent())
+
+	if d.raw == nil {
+		return
+	}
+	if height := d.vp.Model.Height(); height > 0 {
+		if d.raw.cursor < d.vp.Model.YOffset() {
+			d.vp.Model.SetYOffset(d.raw.cursor)
+		} else if d.raw.cursor >= d.vp.Model.YOffset()+height {
+			d.vp.Model.SetYOffset(d.raw.cursor - height + 1)
+		}
+	}
+}
+
+// cycleRawMode switches between the curated detail, raw JSON and raw YAML
+func (d *DetailView) cycleRawMode() {
+	switch {
+	case d.raw == nil && d.rawErr == nil:
+		d.rebuildRaw()
+	case d.raw != nil && !d.raw.yaml:
+		d.raw.setYAML(true)
+	default:
+		d.raw = nil
+		d.rawErr = nil
+		d.searchInput.SetValue("")
+	}
+	d.vp.Model.SetYOffset(0)
+	d.recalcViewport()
+}
+
+// rebuildRaw builds the raw tree from the current resource, keeping
+// folding, cursor and search of the previous tree
+func (d *DetailView) rebuildRaw() {
+	tree, err := newRawTree(dao.UnwrapResource(d.resource).Raw())
+	if err != nil {
+		log.Warn("failed to build raw view", "error", err)
+		d.raw, d.rawErr = nil, err
+		return
+	}
+	if d.raw != nil {
+		tree.restore(d.raw)
+	}
+	d.raw, d.rawErr = tree, nil
+}
+
+// handleRawKey handles cursor, folding, search and copy keys in raw mode
+func (d *DetailView) handleRawKey(msg tea.KeyPressMsg) (bool, tea.Cmd) {
+	t := d.raw
+	switch msg.String() {
+	case "j", "down":
+		t.moveCursor(1)
+	case "k", "up":
+		t.moveCursor(-1)
+	case "pgdown", "ctrl+d":
+		t.moveCursor(d.vp.Model.Height())
+	case "pgup", "ctrl+u":
+		t.moveCursor(-d.vp.Model.Height())
+	case "g", "home":
+		t.moveCursor(-len(t.lines))
+	case "G", "end":
+		t.moveCursor(len(t.lines))
+	case "enter", "space", "tab":
+		t.toggle()
+	case "l", "right":
+		t.expand()
+	case "h", "left":
+		t.collapse()
+	case "-":
+		t.setAllCollapsed(true)
+	case "+", "=":
+		t.setAllCollapsed(false)
+	case "/":
+		d.searching = true
+		d.searchInput.SetValue(t.query)
+		return true, d.searchInput.Focus()
+	case "n":
+		t.nextMatch(1)
+	case "N":
+		t.nextMatch(-1)
+	case "y":
+		return true, clipboard.Copy("path", t.copyPath())
+	case "Y":
+		return true, clipboard.Copy("value", t.copyValue())
+	default:
+		return false, nil
+	}
+	d.updateContent()
+	return true, nil
+}
+
+func (d *DetailView) handleSearchInput(msg tea.KeyPressMsg) tea.Cmd {
+	if IsEscKey(msg) {
+		d.searching = false
+		d.searchInput.Blur()
+		return nil
+	}
+	if msg.String() == "enter" {
+		d.searching = false
+		d.searchInput.Blur()
+		d.raw.search(d.searchInput.Value())
+		d.updateContent()
+		return nil
+	}
+	var cmd tea.Cmd
+	d.searchInput, cmd = d.searchInput.Update(msg)
+	return cmd
+}
+
+// rawStatusBar shows the search input, or the path of the node under the
+// cursor and the current match
+func (d *DetailView) rawStatusBar() string {
+	if d.searching {
+		return ui.InputFieldStyle().Render(d.searchInput.View())
+	}
+	if d.rawErr != nil {
+		return ui.DangerStyle().Render(TruncateString("Cannot render raw data: "+d.rawErr.Error(), d.width))
+	}
+
+	bar := d.raw.copyPath()
+	if d.raw.query != "" {
+		if len(d.raw.matches) == 0 {
+			bar += fmt.Sprintf(" • /%s: no matches", d.raw.query)
+		} else {
+			bar += fmt.Sprintf(" • /%s: %d/%d", d.raw.query, d.raw.match+1, len(d.raw.matches))
+		}
+	}
+	return d.styles.path.Render(TruncateString(bar, d.width))
+}
+
+// HasActiveInput implements InputCapture
+func (d *DetailView) HasActiveInput() bool {
+	return d.searching
 }
 
 func (d *DetailView) StatusLine() string {
@@ -251,13 +445,31 @@ func (d *DetailView) StatusLine() string {
 		parts = append(parts, "⚠ refresh failed")
 	}
 
+	if d.raw != nil {
+		format := "JSON"
+		if d.raw.yaml {
+			format = "YAML"
+		}
+		parts = append(parts, format, "enter:fold -/+:all /:search n/N:next y:path Y:value J:mode", "q/esc:back")
+		return strings.Join(parts, " • ")
+	}
+
 	parts = append(parts, "↑/↓:scroll")
 
 	if actions := action.Global.Get(d.service, d.resType); len(actions) > 0 {
 		parts = append(parts, "a:actions")
 	}
 
-	parts = append(parts, "y:copy")
+	parts = append(parts, "y:copy", "J:raw")
+	if d.registry != nil {
+		parts = append(parts, "H:history", "X:relations")
+		if _, ok := awsconfig.ResourceType(d.service, d.resType); ok {
+			parts = append(parts, "C:config history")
+		}
+		if iac.Supported(d.service, d.resType) {
+			parts = append(parts, "I:iac")
+		}
+	}
 
 	if navInfo := d.getNavigationShortcuts(); navInfo != "" {
 		parts = append(parts, navInfo)
@@ -290,6 +502,10 @@ func (d *DetailView) getNavigationShortcuts() string {
 }
 
 func (d *DetailView) renderContent() string {
+	if d.raw != nil {
+		return d.raw.render(d.styles.raw, d.width)
+	}
+
 	var detail string
 
 	// Try to use renderer's RenderDetail if available
@@ -333,7 +549,7 @@ func (d *DetailView) renderGenericDetail() string {
 		out += s.label.Render("ARN:") + s.value.Render(arn) + "\n"
 	}
 
-	out += "\n" + ui.DimStyle().Render("(Raw data view not implemented)")
+	out += "\n" + ui.DimStyle().Render("Press J for the raw JSON/YAML data")
 
 	return out
 }
@@ -8,6 +8,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/clipboard"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -319,3 +320,67 @@ func TestDetailViewCopyARNNoARN(t *testing.T) {
 		t.Fatal("Expected cmd from 'Y' key press for NoARN")
 	}
 }
+
+func TestDetailViewRawMode(t *testing.T) {
+	resource := &dao.BaseResource{
+		ID:   "i-123",
+		Name: "web",
+		Data: map[string]any{"State": map[string]any{"Name": "running"}, "Tags": []any{"a", "b"}},
+	}
+	dv := NewDetailView(context.Background(), resource, nil, "ec2", "instances", nil, nil)
+	dv.SetSize(100, 40)
+
+	press := func(key string) tea.Cmd {
+		r := []rune(key)[0]
+		_, cmd := dv.Update(tea.KeyPressMsg{Code: r, Text: key})
+		return cmd
+	}
+
+	press("J")
+	if dv.raw == nil || dv.raw.yaml {
+		t.Fatal("J should switch to raw JSON")
+	}
+	if out := dv.ViewString(); !strings.Contains(out, `"running"`) {
+		t.Errorf("raw JSON view missing value, got:\n%s", out)
+	}
+
+	// Search for a value and copy its path
+	press("/")
+	if !dv.HasActiveInput() {
+		t.Fatal("/ should start search input")
+	}
+	for _, r := range "runn" {
+		dv.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	dv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if dv.HasActiveInput() {
+		t.Error("enter should end search input")
+	}
+	if got := dv.raw.copyPath(); got != "State.Name" {
+		t.Errorf("cursor path = %q, want State.Name", got)
+	}
+	if !strings.Contains(dv.rawStatusBar(), "1/1") {
+		t.Errorf("status bar should show match position, got %q", dv.rawStatusBar())
+	}
+
+	cmd := press("y")
+	if cmd == nil {
+		t.Fatal("y should copy the path")
+	}
+	if msg, ok := cmd().(clipboard.CopiedMsg); !ok || msg.Label != "path" {
+		t.Errorf("y copied %v, want path", msg)
+	}
+
+	press("J")
+	if dv.raw == nil || !dv.raw.yaml {
+		t.Fatal("second J should switch to raw YAML")
+	}
+	if out := dv.ViewString(); !strings.Contains(out, "Name: running") {
+		t.Errorf("raw YAML view missing value, got:\n%s", out)
+	}
+
+	press("J")
+	if dv.raw != nil {
+		t.Error("third J should return to the curated detail")
+	}
+}
@@ -0,0 +1,122 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// DiffTarget identifies a resource in an explicit profile and region,
+// written as "profile:region:id" in the :diff command.
+// An empty Profile or Region means the current one (e.g. "prod::my-fn").
+type DiffTarget struct {
+	Profile string
+	Region  string
+	ID      string
+}
+
+// ParseDiffTarget parses a "profile:region:id" argument.
+// It returns false for plain IDs, including ARNs and IDs with a single colon.
+func ParseDiffTarget(s string) (DiffTarget, bool) {
+	if strings.HasPrefix(s, "arn:") {
+		return DiffTarget{}, false
+	}
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || parts[2] == "" || (parts[0] == "" && parts[1] == "") {
+		return DiffTarget{}, false
+	}
+	if profile := parts[0]; profile != "" && profile != config.ProfileIDSDKDefault &&
+		profile != config.ProfileIDEnvOnly && !config.IsValidProfileName(profile) {
+		return DiffTarget{}, false
+	}
+	if parts[1] != "" && !config.IsValidRegion(parts[1]) {
+		return DiffTarget{}, false
+	}
+	return DiffTarget{Profile: parts[0], Region: parts[1], ID: parts[2]}, true
+}
+
+// String formats the target back into its command form.
+func (t DiffTarget) String() string {
+	return t.Profile + ":" + t.Region + ":" + t.ID
+}
+
+// diffFetchedMsg carries both sides of a :diff that needed remote fetches
+type diffFetchedMsg struct {
+	left, right dao.Resource
+}
+
+// fetchDiffTarget fetches the target through the registry DAO, using the
+// target's profile and region instead of the current selection.
+func fetchDiffTarget(ctx context.Context, reg *registry.Registry, service, resourceType string, t DiffTarget) (dao.Resource, error) {
+	if t.Profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(t.Profile))
+	}
+	if t.Region != "" {
+		ctx = aws.WithRegionOverride(ctx, t.Region)
+	}
+
+	d, err := reg.GetDAO(ctx, service, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.Get(ctx, t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", t, err)
+	}
+
+	// Carry the target's scope, so labels and navigation use it
+	switch {
+	case t.Profile != "":
+		region := t.Region
+		if region == "" {
+			region = config.Global().Region()
+		}
+		res = dao.WrapWithProfile(res, t.Profile, "", region)
+	case t.Region != "":
+		res = dao.WrapWithRegion(res, t.Region)
+	}
+	return res, nil
+}
+
+// fetchDiffCmd resolves each side of a :diff either from the loaded list
+// (when given) or by fetching its target, then opens the DiffView.
+func (r *ResourceBrowser) fetchDiffCmd(left, right dao.Resource, leftTarget, rightTarget DiffTarget) tea.Cmd {
+	ctx, reg, service, resourceType := r.ctx, r.registry, r.service, r.resourceType
+	return func() tea.Msg {
+		var err error
+		if left == nil {
+			if left, err = fetchDiffTarget(ctx, reg, service, resourceType, leftTarget); err != nil {
+				return ErrorMsg{Err: err}
+			}
+		}
+		if right == nil {
+			if right, err = fetchDiffTarget(ctx, reg, service, resourceType, rightTarget); err != nil {
+				return ErrorMsg{Err: err}
+			}
+		}
+		return diffFetchedMsg{left: left, right: right}
+	}
+}
+
+// diffLabel returns the resource name with its profile and region, if any
+func diffLabel(res dao.Resource) string {
+	name := dao.UnwrapResource(res).GetName()
+	var scope []string
+	if profile := dao.GetResourceProfile(res); profile != "" {
+		scope = append(scope, config.ProfileSelectionFromID(profile).DisplayName())
+	}
+	if region := dao.GetResourceRegion(res); region != "" {
+		scope = append(scope, region)
+	}
+	if len(scope) == 0 {
+		return name
+	}
+	return name + " (" + strings.Join(scope, "/") + ")"
+}
@@ -0,0 +1,110 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestParseDiffTarget(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   DiffTarget
+		wantOK bool
+	}{
+		{"prod:us-east-1:my-fn", DiffTarget{Profile: "prod", Region: "us-east-1", ID: "my-fn"}, true},
+		{"prod::my-fn", DiffTarget{Profile: "prod", ID: "my-fn"}, true},
+		{":eu-west-1:my-fn", DiffTarget{Region: "eu-west-1", ID: "my-fn"}, true},
+		{"prod:us-east-1:arn:aws:sns:us-east-1:123456789012:topic", DiffTarget{Profile: "prod", Region: "us-east-1", ID: "arn:aws:sns:us-east-1:123456789012:topic"}, true},
+		{"my-fn", DiffTarget{}, false},
+		{"family:3", DiffTarget{}, false},
+		{"arn:aws:sns:us-east-1:123456789012:topic", DiffTarget{}, false},
+		{"::my-fn", DiffTarget{}, false},
+		{"prod:not a region:my-fn", DiffTarget{}, false},
+		{"prod:us-east-1:", DiffTarget{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseDiffTarget(tt.input)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseDiffTarget(%q) = %+v, %v; want %+v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDiffLabel(t *testing.T) {
+	base := &mockResource{id: "my-fn", name: "my-fn"}
+
+	if got := diffLabel(base); got != "my-fn" {
+		t.Errorf("diffLabel(plain) = %q, want %q", got, "my-fn")
+	}
+	if got := diffLabel(dao.WrapWithRegion(base, "us-west-2")); got != "my-fn (us-west-2)" {
+		t.Errorf("diffLabel(regional) = %q", got)
+	}
+	if got := diffLabel(dao.WrapWithProfile(base, "prod", "", "us-east-1")); got != "my-fn (prod/us-east-1)" {
+		t.Errorf("diffLabel(profiled) = %q", got)
+	}
+}
+
+func TestFetchDiffTarget_Scope(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("lambda", "functions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockDAO{BaseDAO: dao.NewBaseDAO("lambda", "functions"), supportsGet: true}, nil
+		},
+	})
+
+	tests := []struct {
+		target      DiffTarget
+		wantProfile string
+		wantRegion  string
+	}{
+		{DiffTarget{Region: "us-west-2", ID: "my-fn"}, "", "us-west-2"},
+		{DiffTarget{Profile: "prod", Region: "eu-west-1", ID: "my-fn"}, "prod", "eu-west-1"},
+	}
+	for _, tt := range tests {
+		res, err := fetchDiffTarget(context.Background(), reg, "lambda", "functions", tt.target)
+		if err != nil {
+			t.Fatalf("fetchDiffTarget(%s) error = %v", tt.target, err)
+		}
+		if got := dao.GetResourceProfile(res); got != tt.wantProfile {
+			t.Errorf("fetchDiffTarget(%s) profile = %q, want %q", tt.target, got, tt.wantProfile)
+		}
+		if got := dao.GetResourceRegion(res); got != tt.wantRegion {
+			t.Errorf("fetchDiffTarget(%s) region = %q, want %q", tt.target, got, tt.wantRegion)
+		}
+	}
+}
+
+func TestResourceBrowser_HandleDiffMsg_RemoteTarget(t *testing.T) {
+	rb := NewResourceBrowserWithType(context.Background(), registry.New(), "lambda", "functions")
+	rb.filtered = []dao.Resource{&mockResource{id: "my-fn", name: "my-fn"}}
+
+	// Unknown local ID: nothing to compare
+	if _, cmd := rb.handleDiffMsg(DiffMsg{RightID: "other-fn"}); cmd != nil {
+		t.Error("expected nil cmd for unknown local ID")
+	}
+
+	// Remote target is fetched asynchronously
+	if _, cmd := rb.handleDiffMsg(DiffMsg{RightID: "staging:us-east-1:my-fn"}); cmd == nil {
+		t.Error("expected fetch cmd for remote target")
+	}
+
+	// Fetch failures are surfaced as errors
+	_, cmd := rb.handleDiffMsg(DiffMsg{LeftID: "prod:us-east-1:my-fn", RightID: "my-fn"})
+	if cmd == nil {
+		t.Fatal("expected fetch cmd for remote left target")
+	}
+	msg, ok := cmd().(ErrorMsg)
+	if !ok {
+		t.Fatalf("cmd() = %T, want ErrorMsg (no DAO registered)", cmd())
+	}
+	if !strings.Contains(msg.Err.Error(), "lambda/functions") {
+		t.Errorf("error = %v, want mention of lambda/functions", msg.Err)
+	}
+}
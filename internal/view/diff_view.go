@@ -2,12 +2,14 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
 )
@@ -24,12 +26,20 @@ type DiffView struct {
 	vp           ViewportState
 	width        int
 	styles       diffViewStyles
+
+	// Structural mode compares Raw() values as JSON trees
+	structural   bool
+	showVolatile bool
 }
 
 type diffViewStyles struct {
 	title     lipgloss.Style
 	header    lipgloss.Style
 	separator lipgloss.Style
+	added     lipgloss.Style
+	removed   lipgloss.Style
+	changed   lipgloss.Style
+	dim       lipgloss.Style
 }
 
 func newDiffViewStyles() diffViewStyles {
@@ -37,6 +47,10 @@ func newDiffViewStyles() diffViewStyles {
 		title:     ui.TitleStyle(),
 		header:    ui.SectionStyle(),
 		separator: ui.MutedStyle(),
+		added:     ui.SuccessStyle(),
+		removed:   ui.DangerStyle(),
+		changed:   ui.WarningStyle(),
+		dim:       ui.DimStyle(),
 	}
 }
 
@@ -69,11 +83,21 @@ func (d *DiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if IsEscKey(msg) {
 			return d, nil
 		}
+		switch msg.String() {
+		case "s":
+			d.structural = !d.structural
+			d.refreshContent()
+			return d, nil
+		case "v":
+			if d.structural {
+				d.showVolatile = !d.showVolatile
+				d.refreshContent()
+			}
+			return d, nil
+		}
 	case ThemeChangedMsg:
 		d.styles = newDiffViewStyles()
-		if d.vp.Ready {
-			d.vp.Model.SetContent(d.renderSideBySide())
-		}
+		d.refreshContent()
 		return d, nil
 	}
 
@@ -104,16 +128,86 @@ func (d *DiffView) SetSize(width, height int) tea.Cmd {
 	viewportHeight := max(height-headerHeight, 5)
 
 	d.vp.SetSize(width, viewportHeight)
-
-	content := d.renderSideBySide()
-	d.vp.Model.SetContent(content)
+	d.refreshContent()
 
 	return nil
 }
 
+// refreshContent re-renders the viewport content for the current mode
+func (d *DiffView) refreshContent() {
+	if !d.vp.Ready {
+		return
+	}
+	if d.structural {
+		d.vp.Model.SetContent(d.renderStructural())
+	} else {
+		d.vp.Model.SetContent(d.renderSideBySide())
+	}
+}
+
 // StatusLine implements View
 func (d *DiffView) StatusLine() string {
-	return d.leftUnwrap.GetName() + " vs " + d.rightUnwrap.GetName() + " • ↑/↓:scroll • q/esc:back"
+	names := diffLabel(d.left) + " vs " + diffLabel(d.right)
+	if !d.structural {
+		return names + " • ↑/↓:scroll s:structural • q/esc:back"
+	}
+	volatile := "v:show volatile"
+	if d.showVolatile {
+		volatile = "v:hide volatile"
+	}
+	return names + " • ↑/↓:scroll s:side-by-side " + volatile + " • q/esc:back"
+}
+
+// renderStructural lists the paths that differ between both Raw() values
+func (d *DiffView) renderStructural() string {
+	s := d.styles
+	var out strings.Builder
+
+	out.WriteString(s.title.Render("Compare: "+d.resourceType+" (structural)") + "\n")
+	out.WriteString(strings.Repeat("─", d.width) + "\n")
+	out.WriteString(s.removed.Render("- ◀ "+diffLabel(d.left)) + "   " + s.added.Render("+ "+diffLabel(d.right)+" ▶") + "\n")
+	out.WriteString(strings.Repeat("─", d.width) + "\n")
+
+	changes, err := jsondiff.Diff(d.leftUnwrap.Raw(), d.rightUnwrap.Raw(), jsondiff.Options{IgnoreVolatile: !d.showVolatile})
+	if err != nil {
+		out.WriteString(s.removed.Render("Cannot compare: "+err.Error()) + "\n")
+		return out.String()
+	}
+	if len(changes) == 0 {
+		out.WriteString(s.dim.Render("No differences") + "\n")
+		return out.String()
+	}
+
+	for _, c := range changes {
+		style := s.changed
+		switch c.Kind {
+		case jsondiff.Added:
+			style = s.added
+		case jsondiff.Removed:
+			style = s.removed
+		}
+		out.WriteString(style.Render(TruncateString(formatChange(c), d.width)) + "\n")
+	}
+	out.WriteString("\n" + s.dim.Render(fmt.Sprintf("%d difference(s)", len(changes))) + "\n")
+
+	return out.String()
+}
+
+// formatChange formats a structural change as "~ path: old → new",
+// "+ path: value" or "- path: value"
+func formatChange(c jsondiff.Change) string {
+	path := c.Path
+	if path == "" {
+		path = "(root)"
+	}
+	switch c.Kind {
+	case jsondiff.Added:
+		return fmt.Sprintf("%s %s: %s", c.Kind.Symbol(), path, jsondiff.FormatValue(c.Right))
+	case jsondiff.Removed:
+		return fmt.Sprintf("%s %s: %s", c.Kind.Symbol(), path, jsondiff.FormatValue(c.Left))
+	default:
+		return fmt.Sprintf("%s %s: %s → %s", c.Kind.Symbol(), path, jsondiff.FormatValue(c.Left), jsondiff.FormatValue(c.Right))
+	}
 }
 
 // renderSideBySide generates the side-by-side view
@@ -141,8 +235,8 @@ func (d *DiffView) renderSideBySide() string {
 	colWidth := (d.width - 3) / 2
 
 	// Column headers
-	leftHeader := TruncateOrPadString("◀ "+d.leftUnwrap.GetName(), colWidth)
-	rightHeader := TruncateOrPadString(d.rightUnwrap.GetName()+" ▶", colWidth)
+	leftHeader := TruncateOrPadString("◀ "+diffLabel(d.left), colWidth)
+	rightHeader := TruncateOrPadString(diffLabel(d.right)+" ▶", colWidth)
 	out.WriteString(s.header.Render(leftHeader))
 	out.WriteString(s.separator.Render(" │ "))
 	out.WriteString(s.header.Render(rightHeader))
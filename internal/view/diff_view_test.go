@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
 )
 
 func TestDiffView_New(t *testing.T) {
@@ -116,3 +118,44 @@ func TestDiffView_View_NotReady(t *testing.T) {
 		t.Errorf("ViewString() = %q, want %q", view, LoadingMessage)
 	}
 }
+
+func TestDiffView_Structural(t *testing.T) {
+	ctx := context.Background()
+	left := &dao.BaseResource{ID: "i-111", Name: "instance-a", Data: map[string]any{
+		"InstanceType": "t3.micro",
+		"LaunchTime":   "2024-01-01T00:00:00Z",
+		"Tags":         []map[string]string{{"Key": "env", "Value": "prod"}},
+	}}
+	right := &dao.BaseResource{ID: "i-222", Name: "instance-b", Data: map[string]any{
+		"InstanceType": "t3.large",
+		"LaunchTime":   "2024-02-01T00:00:00Z",
+		"Tags":         []map[string]string{{"Key": "env", "Value": "prod"}},
+	}}
+
+	dv := NewDiffView(ctx, left, right, nil, "ec2", "instances")
+	dv.SetSize(120, 50)
+
+	dv.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	if !dv.structural {
+		t.Fatal("expected structural mode after 's'")
+	}
+
+	content := dv.renderStructural()
+	if !strings.Contains(content, "InstanceType: t3.micro → t3.large") {
+		t.Errorf("structural diff missing InstanceType change:\n%s", content)
+	}
+	if strings.Contains(content, "LaunchTime") {
+		t.Errorf("volatile LaunchTime should be hidden by default:\n%s", content)
+	}
+	if strings.Contains(content, "Tags") {
+		t.Errorf("unchanged tags should not be listed:\n%s", content)
+	}
+
+	dv.Update(tea.KeyPressMsg{Code: 'v', Text: "v"})
+	if !strings.Contains(dv.renderStructural(), "LaunchTime") {
+		t.Error("volatile LaunchTime should be shown after 'v'")
+	}
+	if !strings.Contains(dv.StatusLine(), "v:hide volatile") {
+		t.Errorf("StatusLine() = %q, want volatile hint", dv.StatusLine())
+	}
+}
@@ -0,0 +1,479 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/graph"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const graphHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+
+// graphItem is a row of the relationship tree: a resource or a group of
+// related resources
+type graphItem struct {
+	node     *graph.Node // nil for group rows
+	group    *graph.Group
+	parent   *graphItem
+	children []*graphItem
+	depth    int
+	expanded bool
+	loading  bool
+	loaded   bool
+	cycle    bool // resource already shown on the path from the root
+	err      error
+}
+
+// graphNeighborsMsg carries the neighbors loaded for a tree item
+type graphNeighborsMsg struct {
+	item   *graphItem
+	node   *graph.Node // the item's node, fetched if it was partial
+	groups []graph.Group
+	err    error
+}
+
+// graphStyles holds cached lipgloss styles for performance
+type graphStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	group    lipgloss.Style
+	kind     lipgloss.Style
+	warning  lipgloss.Style
+	selected lipgloss.Style
+}
+
+func newGraphStyles() graphStyles {
+	return graphStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		group:    ui.SectionStyle(),
+		kind:     ui.AccentStyle(),
+		warning:  ui.WarningStyle(),
+		selected: ui.SelectedStyle(),
+	}
+}
+
+// GraphView shows the resources related to a resource as a tree that
+// expands lazily, one resource at a time
+type GraphView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	builder  *graph.Builder
+	root     *graphItem
+
+	rows    []*graphItem
+	cursor  int
+	pending int // neighbor loads in flight
+
+	vp      ViewportState
+	width   int
+	spinner spinner.Model
+	styles  graphStyles
+}
+
+// NewGraphView creates a relationship view rooted at a resource
+func NewGraphView(ctx context.Context, reg *registry.Registry, resource dao.Resource, service, resourceType string) *GraphView {
+	ctx = resourceContext(ctx, resource)
+
+	root := &graphItem{node: &graph.Node{
+		Ctx:          ctx,
+		Service:      service,
+		ResourceType: resourceType,
+		Resource:     dao.UnwrapResource(resource),
+	}}
+	v := &GraphView{
+		ctx:      ctx,
+		registry: reg,
+		builder:  graph.NewBuilder(reg),
+		root:     root,
+		spinner:  ui.NewSpinner(),
+		styles:   newGraphStyles(),
+	}
+	v.rebuildRows()
+	return v
+}
+
+// Init implements tea.Model
+func (v *GraphView) Init() tea.Cmd {
+	// Keep the expanded tree when returning from a related resource
+	if v.root.loaded || v.root.loading {
+		return nil
+	}
+	return v.expand(v.root)
+}
+
+// expand shows an item's children, loading a resource's neighbors first
+func (v *GraphView) expand(item *graphItem) tea.Cmd {
+	if item.cycle || item.loading {
+		return nil
+	}
+	item.expanded = true
+	if item.node == nil || item.loaded {
+		v.rebuildRows()
+		return nil
+	}
+
+	item.loading = true
+	item.err = nil
+	v.pending++
+	v.rebuildRows()
+
+	node := *item.node
+	builder := v.builder
+	load := func() tea.Msg {
+		groups, err := builder.Neighbors(&node)
+		return graphNeighborsMsg{item: item, node: &node, groups: groups, err: err}
+	}
+	return tea.Batch(load, v.spinner.Tick)
+}
+
+// setNeighbors replaces an item's children with loaded groups
+func (v *GraphView) setNeighbors(msg graphNeighborsMsg) {
+	item := msg.item
+	v.pending--
+	item.loading = false
+	item.loaded = true
+	item.err = msg.err
+	item.node = msg.node
+	item.children = nil
+
+	for i := range msg.groups {
+		g := &msg.groups[i]
+		// Hide navigations that lead nowhere
+		if len(g.Nodes) == 0 && g.Err == nil {
+			continue
+		}
+		groupItem := &graphItem{
+			group:    g,
+			parent:   item,
+			depth:    item.depth + 1,
+			expanded: true,
+			loaded:   true,
+			err:      g.Err,
+		}
+		for _, n := range g.Nodes {
+			groupItem.children = append(groupItem.children, &graphItem{
+				node:   n,
+				parent: groupItem,
+				depth:  item.depth + 2,
+				cycle:  onPath(groupItem, n),
+			})
+		}
+		item.children = append(item.children, groupItem)
+	}
+	v.rebuildRows()
+}
+
+// onPath reports whether a resource is already shown above item
+func onPath(item *graphItem, n *graph.Node) bool {
+	for p := item; p != nil; p = p.parent {
+		if p.node == nil {
+			continue
+		}
+		if p.node.Service == n.Service && p.node.ResourceType == n.ResourceType &&
+			(p.node.Resource.GetID() == n.Resource.GetID() ||
+				(n.Resource.GetARN() != "" && p.node.Resource.GetARN() == n.Resource.GetARN())) {
+			return true
+		}
+	}
+	return false
+}
+
+// rebuildRows flattens the expanded tree, keeping the cursor on the same item
+func (v *GraphView) rebuildRows() {
+	var current *graphItem
+	if v.cursor < len(v.rows) {
+		current = v.rows[v.cursor]
+	}
+
+	v.rows = v.rows[:0]
+	var add func(item *graphItem)
+	add = func(item *graphItem) {
+		v.rows = append(v.rows, item)
+		if !item.expanded {
+			return
+		}
+		for _, child := range item.children {
+			add(child)
+		}
+	}
+	add(v.root)
+
+	v.cursor = 0
+	for i, row := range v.rows {
+		if row == current {
+			v.cursor = i
+			break
+		}
+	}
+	v.updateContent()
+}
+
+// Update implements tea.Model
+func (v *GraphView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case graphNeighborsMsg:
+		v.setNeighbors(msg)
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.pending > 0 {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			v.updateContent()
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newGraphStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			v.moveCursor(1)
+			return v, nil
+		case "k", "up":
+			v.moveCursor(-1)
+			return v, nil
+		case "ctrl+d", "pgdown":
+			v.moveCursor(max(v.vp.Model.Height()/2, 1))
+			return v, nil
+		case "ctrl+u", "pgup":
+			v.moveCursor(-max(v.vp.Model.Height()/2, 1))
+			return v, nil
+		case "g", "home":
+			v.moveCursor(-len(v.rows))
+			return v, nil
+		case "G", "end":
+			v.moveCursor(len(v.rows))
+			return v, nil
+		case "l", "right":
+			if item := v.selected(); item != nil && !item.expanded {
+				return v, v.expand(item)
+			}
+			return v, nil
+		case "space":
+			if item := v.selected(); item != nil {
+				if item.expanded {
+					v.collapse(item)
+					return v, nil
+				}
+				return v, v.expand(item)
+			}
+			return v, nil
+		case "h", "left":
+			v.collapseOrParent()
+			return v, nil
+		case "enter":
+			return v, v.openSelected()
+		case "ctrl+r":
+			if item := v.selected(); item != nil && item.node != nil && !item.loading {
+				item.loaded = false
+				return v, v.expand(item)
+			}
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *GraphView) collapse(item *graphItem) {
+	item.expanded = false
+	v.rebuildRows()
+}
+
+// collapseOrParent collapses the selected item, or moves to its parent
+func (v *GraphView) collapseOrParent() {
+	item := v.selected()
+	if item == nil {
+		return
+	}
+	if item.expanded && len(item.children) > 0 {
+		v.collapse(item)
+		return
+	}
+	for i, row := range v.rows {
+		if row == item.parent {
+			v.cursor = i
+			v.updateContent()
+			return
+		}
+	}
+}
+
+func (v *GraphView) moveCursor(delta int) {
+	if len(v.rows) == 0 {
+		return
+	}
+	v.cursor = max(min(v.cursor+delta, len(v.rows)-1), 0)
+	v.updateContent()
+}
+
+func (v *GraphView) selected() *graphItem {
+	if v.cursor < 0 || v.cursor >= len(v.rows) {
+		return nil
+	}
+	return v.rows[v.cursor]
+}
+
+// openSelected opens the selected resource; on a group it toggles the group
+func (v *GraphView) openSelected() tea.Cmd {
+	item := v.selected()
+	if item == nil {
+		return nil
+	}
+	if item.node == nil {
+		if item.expanded {
+			v.collapse(item)
+			return nil
+		}
+		return v.expand(item)
+	}
+
+	n := item.node
+	renderer, err := v.registry.GetRenderer(n.Service, n.ResourceType)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	daoInst, err := v.registry.GetDAO(n.Ctx, n.Service, n.ResourceType)
+	if err != nil {
+		daoInst = nil
+	}
+	detailView := NewDetailView(n.Ctx, n.Resource, renderer, n.Service, n.ResourceType, v.registry, daoInst)
+	return func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *GraphView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderTree())
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if v.cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(v.cursor)
+		} else if v.cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(v.cursor - height + 1)
+		}
+	}
+}
+
+func (v *GraphView) renderTree() string {
+	var out strings.Builder
+	for i, item := range v.rows {
+		line := v.renderRow(item, i == v.cursor)
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+// renderRow renders one tree row: indentation, fold marker and label
+func (v *GraphView) renderRow(item *graphItem, selected bool) string {
+	s := v.styles
+
+	marker := "  "
+	switch {
+	case item.loading:
+		marker = v.spinner.View() + " "
+	case item.cycle:
+		marker = "↺ "
+	case item.expanded:
+		marker = "▾ "
+	case item.node != nil && !item.loaded, len(item.children) > 0:
+		marker = "▸ "
+	}
+	indent := strings.Repeat("  ", item.depth)
+
+	var text, plain string
+	if item.group != nil {
+		g := item.group
+		count := fmt.Sprintf("%d", len(g.Nodes))
+		if g.More {
+			count += "+"
+		}
+		plain = fmt.Sprintf("%s (%s)", g.Label, count)
+		text = s.group.Render(g.Label) + s.dim.Render(" ("+count+")")
+	} else {
+		n := item.node
+		name := diffLabel(n.Resource)
+		kind := n.Service + "/" + n.ResourceType
+		plain = name + "  " + kind
+		text = name + "  " + s.kind.Render(kind)
+	}
+	if item.err != nil {
+		errText := "  ⚠ " + item.err.Error()
+		plain += errText
+		text += s.warning.Render(errText)
+	}
+
+	if selected {
+		return s.selected.Render(TruncateOrPadString(indent+marker+plain, v.width))
+	}
+	if lipgloss.Width(indent+marker+plain) > v.width {
+		return TruncateString(indent+marker+plain, v.width)
+	}
+	return indent + marker + text
+}
+
+func (v *GraphView) renderHeader() string {
+	s := v.styles
+	n := v.root.node
+	title := s.title.Render(fmt.Sprintf("Relations: %s/%s %s", n.Service, n.ResourceType, diffLabel(n.Resource)))
+
+	var resources int
+	for _, row := range v.rows {
+		if row.node != nil && row != v.root {
+			resources++
+		}
+	}
+	summary := fmt.Sprintf("%d related resource(s) shown", resources)
+	if v.pending > 0 {
+		summary = v.spinner.View() + " Loading relations..."
+	}
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *GraphView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *GraphView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *GraphView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height-graphHeaderHeight, 3))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *GraphView) StatusLine() string {
+	return "j/k:move l:expand h:collapse space:toggle enter:open ^r:reload • q/esc:back"
+}
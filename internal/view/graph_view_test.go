@@ -0,0 +1,130 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// mockGraphRenderer navigates from every resource to its children
+type mockGraphRenderer struct {
+	mockRenderer
+}
+
+func (r *mockGraphRenderer) Navigations(res dao.Resource) []render.Navigation {
+	return []render.Navigation{
+		{Key: "c", Label: "Children", Service: "test", Resource: "items", FilterField: "Parent", FilterValue: res.GetID()},
+	}
+}
+
+type mockGraphData struct {
+	Parent string
+}
+
+// newGraphRegistry registers a tree: root -> a, b; a -> root (cycle)
+func newGraphRegistry() *registry.Registry {
+	items := []dao.Resource{
+		&dao.BaseResource{ID: "a", Name: "a", Data: mockGraphData{Parent: "root"}},
+		&dao.BaseResource{ID: "b", Name: "b", Data: mockGraphData{Parent: "root"}},
+		&dao.BaseResource{ID: "root", Name: "root", Data: mockGraphData{Parent: "a"}},
+	}
+	reg := registry.New()
+	reg.RegisterCustom("test", "items", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockTimelineDAO{
+				BaseDAO: dao.NewBaseDAO("test", "items"),
+				list:    func(ctx context.Context) ([]dao.Resource, error) { return items, nil },
+			}, nil
+		},
+		RendererFactory: func() render.Renderer { return &mockGraphRenderer{} },
+	})
+	return reg
+}
+
+// runGraphCmd runs a command, feeding neighbor results back into the view
+func runGraphCmd(v *GraphView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runGraphCmd(v, c)
+		}
+	case graphNeighborsMsg:
+		v.Update(msg)
+	}
+}
+
+func TestGraphView(t *testing.T) {
+	reg := newGraphRegistry()
+	root := &dao.BaseResource{ID: "root", Name: "root", Data: mockGraphData{}}
+	v := NewGraphView(context.Background(), reg, root, "test", "items")
+	v.SetSize(100, 20)
+
+	runGraphCmd(v, v.Init())
+	if v.pending != 0 || !v.root.loaded {
+		t.Fatal("root neighbors should be loaded")
+	}
+
+	// root, Children group, a, b
+	if len(v.rows) != 4 {
+		t.Fatalf("rows = %d, want 4", len(v.rows))
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Relations: test/items root", "2 related resource(s)", "Children", "(2)", "▸ a"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	// Expanding a lists its children; root is on the path and not expanded again
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'l', Text: "l"})
+	runGraphCmd(v, cmd)
+	if len(v.rows) != 6 {
+		t.Fatalf("rows after expanding a = %d, want 6", len(v.rows))
+	}
+	cycle := v.rows[4]
+	if cycle.node.Resource.GetID() != "root" || !cycle.cycle {
+		t.Errorf("root under a should be marked as a cycle")
+	}
+	if cmd := v.expand(cycle); cmd != nil {
+		t.Error("cycles should not expand")
+	}
+
+	// h collapses a, then moves to the group
+	v.Update(tea.KeyPressMsg{Code: 'h', Text: "h"})
+	if len(v.rows) != 4 || v.rows[v.cursor].node.Resource.GetID() != "a" {
+		t.Fatalf("h should collapse a, rows = %d", len(v.rows))
+	}
+	v.Update(tea.KeyPressMsg{Code: 'h', Text: "h"})
+	if v.rows[v.cursor].group == nil {
+		t.Error("h on a collapsed item should move to its parent")
+	}
+
+	// enter opens the selected resource
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return a command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("enter should navigate")
+	}
+	if detail, ok := nav.View.(*DetailView); !ok || detail.resource.GetID() != "a" {
+		t.Errorf("navigated to %T, want detail of a", nav.View)
+	}
+
+	if cmd := v.Init(); cmd != nil {
+		t.Error("Init() should not reload once loaded")
+	}
+}
@@ -88,6 +88,8 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("a") + s.desc.Render("Show actions menu") + "\n"
 	out += s.key.Render("y") + s.desc.Render("Copy resource ID to clipboard") + "\n"
 	out += s.key.Render("Y") + s.desc.Render("Copy resource ARN to clipboard") + "\n"
+	out += s.key.Render("M") + s.desc.Render("Toggle inline metrics") + "\n"
+	out += s.key.Render("Ctrl+g") + s.desc.Render("Open metrics charts for resource") + "\n"
 
 	// Filter Syntax
 	out += "\n" + s.section.Render("Filter Syntax") + "\n"
@@ -121,12 +123,31 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render(":tags") + s.desc.Render("Browse all tagged resources") + "\n"
 	out += s.key.Render(":tags Env=prod") + s.desc.Render("Browse with tag filter") + "\n"
 
+	// Detail View
+	out += "\n" + s.section.Render("Detail View") + "\n"
+	out += s.key.Render("J") + s.desc.Render("Cycle curated → raw JSON → raw YAML") + "\n"
+	out += s.key.Render("Enter (raw)") + s.desc.Render("Fold/unfold node (-/+ all)") + "\n"
+	out += s.key.Render("/ n N (raw)") + s.desc.Render("Search keys and values, next/prev match") + "\n"
+	out += s.key.Render("y / Y (raw)") + s.desc.Render("Copy node path (JMESPath) / value") + "\n"
+	out += s.key.Render("H") + s.desc.Render("CloudTrail change history (who changed what)") + "\n"
+	out += s.key.Render("C") + s.desc.Render("AWS Config configuration history (diff versions)") + "\n"
+	out += s.key.Render("X") + s.desc.Render("Related resources (navigations and ARN references)") + "\n"
+
 	// Diff Commands
 	out += "\n" + s.section.Render("Compare Resources") + "\n"
 	out += s.key.Render("m") + s.desc.Render("Mark resource for comparison") + "\n"
 	out += s.key.Render("d") + s.desc.Render("Compare with marked resource (or view detail)") + "\n"
+	out += s.key.Render("Space") + s.desc.Render("Select resource for N-way comparison") + "\n"
+	out += s.key.Render("d (selected)") + s.desc.Render("Compare 2+ selected resources as a field matrix") + "\n"
 	out += s.key.Render(":diff name") + s.desc.Render("Compare current row with named resource") + "\n"
 	out += s.key.Render(":diff a b") + s.desc.Render("Compare two named resources") + "\n"
+	out += s.key.Render(":diff p:r:name") + s.desc.Render("Compare with resource in profile p, region r") + "\n"
+	out += s.key.Render("s (in diff)") + s.desc.Render("Toggle structural (changed paths only) diff") + "\n"
+	out += s.key.Render("v (in diff)") + s.desc.Render("Show/hide volatile fields (timestamps)") + "\n"
+	out += s.key.Render(":snapshot save") + s.desc.Render("Save inventory snapshot: :snapshot save name [svc/res]") + "\n"
+	out += s.key.Render(":snapshot diff") + s.desc.Render("Compare snapshot with live, or two snapshots") + "\n"
+	out += s.key.Render(":timeline") + s.desc.Render("Incident timeline of selected resources (or :timeline Key=Value)") + "\n"
+	out += s.key.Render(":iac [tf|cfn]") + s.desc.Render("Terraform/CloudFormation code for selected resources") + "\n"
 
 	// Actions
 	out += "\n" + s.section.Render("Actions (EC2 Instances)") + "\n"
@@ -136,6 +157,11 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("R") + s.desc.Render("Start instance") + "\n"
 	out += s.key.Render("T") + s.desc.Render("Terminate instance (dangerous)") + "\n"
 
+	// Delete Plans
+	out += "\n" + s.section.Render("Delete Plans (VPC, SG, ECS cluster, S3 bucket)") + "\n"
+	out += s.key.Render("D (plan)") + s.desc.Render("Run ordered teardown of dependencies, then delete") + "\n"
+	out += s.key.Render("Ctrl+r (plan)") + s.desc.Render("Check dependencies again") + "\n"
+
 	// Navigation shortcuts
 	out += "\n" + s.section.Render("Resource Navigation") + "\n"
 	out += ui.DimStyle().Italic(true).
@@ -163,6 +189,7 @@ func (h *HelpView) renderContent() string {
 			"  :tag Env=prod    → Filter current view by tag\n" +
 			"  :tags Env=prod   → Browse all resources with tag\n" +
 			"  :diff my-func    → Compare current row with my-func\n" +
+			"  :diff prod:us-east-1:fn staging:us-east-1:fn → Compare across profiles\n" +
 			"  :login           → AWS Console login\n" +
 			"  :theme nord      → Switch to Nord theme",
 	)
@@ -0,0 +1,400 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/iac"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	iacHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	iacConcurrency  = 6
+)
+
+// iacItem is the code generated for one resource, or why it failed
+type iacItem struct {
+	name  string
+	block *iac.Block
+	err   error
+}
+
+type iacLoadedMsg struct {
+	items []iacItem
+}
+
+type iacSavedMsg struct {
+	path string
+	err  error
+}
+
+// iacStyles holds cached lipgloss styles for performance
+type iacStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	comment lipgloss.Style
+	ok      lipgloss.Style
+	danger  lipgloss.Style
+}
+
+func newIaCStyles() iacStyles {
+	return iacStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		comment: ui.DimStyle().Italic(true),
+		ok:      ui.SuccessStyle(),
+		danger:  ui.DangerStyle(),
+	}
+}
+
+// IaCView shows Terraform or CloudFormation code for resources, to copy
+// or save to a file
+type IaCView struct {
+	ctx          context.Context
+	registry     *registry.Registry
+	resources    []dao.Resource
+	service      string
+	resourceType string
+
+	items   []iacItem
+	format  iac.Format
+	code    string
+	codeErr error
+	loading bool
+
+	saving    bool
+	pathInput textinput.Model
+	status    string
+	statusErr error
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  iacStyles
+}
+
+// NewIaCView creates a code view for resources of one type
+func NewIaCView(ctx context.Context, reg *registry.Registry, resources []dao.Resource, service, resourceType string, format iac.Format) *IaCView {
+	ti := textinput.New()
+	ti.Prompt = "Save to: "
+	ti.CharLimit = 512
+	return &IaCView{
+		ctx:          ctx,
+		registry:     reg,
+		resources:    resources,
+		service:      service,
+		resourceType: resourceType,
+		format:       format,
+		loading:      true,
+		pathInput:    ti,
+		spinner:      ui.NewSpinner(),
+		styles:       newIaCStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *IaCView) Init() tea.Cmd {
+	if v.items != nil {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+// loadCmd fetches each resource with Get, since lists often lack the
+// details the code needs (policies, encryption, ...), then generates code
+func (v *IaCView) loadCmd() tea.Cmd {
+	ctx, reg, resources := v.ctx, v.registry, v.resources
+	service, resourceType := v.service, v.resourceType
+	return func() tea.Msg {
+		items := make([]iacItem, len(resources))
+		sem := make(chan struct{}, iacConcurrency)
+		var wg sync.WaitGroup
+		for i, res := range resources {
+			wg.Go(func() {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				full := fetchFullResource(ctx, reg, res, service, resourceType)
+				block, err := iac.Generate(service, resourceType, full)
+				items[i] = iacItem{name: res.GetName(), block: block, err: err}
+				if items[i].name == "" {
+					items[i].name = res.GetID()
+				}
+			})
+		}
+		wg.Wait()
+		return iacLoadedMsg{items: items}
+	}
+}
+
+// fetchFullResource returns the resource as loaded by Get, or res if Get fails
+func fetchFullResource(ctx context.Context, reg *registry.Registry, res dao.Resource, service, resourceType string) dao.Resource {
+	ctx = resourceContext(ctx, res)
+	d, err := reg.GetDAO(ctx, service, resourceType)
+	if err != nil {
+		return res
+	}
+	full, err := d.Get(ctx, res.GetID())
+	if err != nil {
+		return res
+	}
+	return dao.UnwrapResource(mergeResources(dao.UnwrapResource(res), full))
+}
+
+// Update implements tea.Model
+func (v *IaCView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case iacLoadedMsg:
+		v.loading = false
+		v.items = msg.items
+		v.render()
+		return v, nil
+
+	case iacSavedMsg:
+		v.statusErr = msg.err
+		v.status = ""
+		if msg.err == nil {
+			v.status = "Saved to " + msg.path
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			v.updateContent()
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newIaCStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.saving {
+			return v, v.handlePathInput(msg)
+		}
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "f", "tab":
+			if v.format == iac.Terraform {
+				v.format = iac.CloudFormation
+			} else {
+				v.format = iac.Terraform
+			}
+			v.status, v.statusErr = "", nil
+			v.render()
+			return v, nil
+		case "y":
+			if v.code != "" {
+				return v, clipboard.Copy(v.format.String(), v.code)
+			}
+			return v, nil
+		case "w":
+			if v.code != "" {
+				v.saving = true
+				v.pathInput.SetValue(v.defaultPath())
+				v.pathInput.CursorEnd()
+				v.pathInput.Focus()
+				return v, textinput.Blink
+			}
+			return v, nil
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				v.status, v.statusErr = "", nil
+				v.updateContent()
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *IaCView) handlePathInput(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.saving = false
+		v.pathInput.Blur()
+		return nil
+	case "enter":
+		value := strings.TrimSpace(v.pathInput.Value())
+		if value == "" {
+			v.statusErr = fmt.Errorf("empty file path")
+			return nil
+		}
+		path, err := config.ExpandPath(value)
+		if err != nil {
+			v.statusErr = err
+			return nil
+		}
+		v.saving = false
+		v.pathInput.Blur()
+		code := v.code
+		return func() tea.Msg {
+			return iacSavedMsg{path: path, err: writeCodeFile(path, code)}
+		}
+	}
+	var cmd tea.Cmd
+	v.pathInput, cmd = v.pathInput.Update(msg)
+	return cmd
+}
+
+// writeCodeFile writes generated code, creating the directory if needed
+func writeCodeFile(path, code string) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return apperrors.Wrap(err, "create directory")
+		}
+	}
+	if err := os.WriteFile(path, []byte(code), 0o644); err != nil {
+		return apperrors.Wrap(err, "write file")
+	}
+	return nil
+}
+
+// defaultPath is the prefilled file name, e.g. sqs-queues.tf
+func (v *IaCView) defaultPath() string {
+	return v.service + "-" + v.resourceType + v.format.Ext()
+}
+
+// render generates the code in the current format
+func (v *IaCView) render() {
+	var blocks []*iac.Block
+	var failed strings.Builder
+	for _, item := range v.items {
+		if item.err != nil {
+			fmt.Fprintf(&failed, "# %s: %v\n", item.name, item.err)
+			continue
+		}
+		blocks = append(blocks, item.block)
+	}
+	v.code, v.codeErr = "", nil
+	if len(blocks) > 0 {
+		v.code, v.codeErr = iac.Render(blocks, v.format)
+	}
+	if failed.Len() > 0 {
+		if v.code != "" {
+			failed.WriteString("\n")
+		}
+		v.code = failed.String() + v.code
+	}
+	v.updateContent()
+}
+
+func (v *IaCView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderContent())
+}
+
+func (v *IaCView) renderContent() string {
+	if v.loading {
+		return v.spinner.View() + " Loading resources..."
+	}
+	if v.codeErr != nil {
+		return v.styles.danger.Render("Error: " + v.codeErr.Error())
+	}
+	lines := strings.Split(strings.TrimRight(v.code, "\n"), "\n")
+	for i, l := range lines {
+		if strings.HasPrefix(strings.TrimSpace(l), "#") {
+			lines[i] = v.styles.comment.Render(l)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *IaCView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("%s: %s/%s", v.format, v.service, v.resourceType))
+
+	var summary string
+	switch {
+	case v.saving:
+		summary = v.pathInput.View()
+		if v.statusErr != nil {
+			summary += "  " + s.danger.Render(v.statusErr.Error())
+		}
+		return title + "\n" + summary + "\n" + strings.Repeat("─", v.width)
+	case v.loading:
+		summary = s.dim.Render(fmt.Sprintf("Loading %d resource(s)...", len(v.resources)))
+	case v.statusErr != nil:
+		summary = s.danger.Render(TruncateString(v.statusErr.Error(), v.width))
+	case v.status != "":
+		summary = s.ok.Render("✓ " + TruncateString(v.status, v.width))
+	default:
+		generated := 0
+		for _, item := range v.items {
+			if item.err == nil {
+				generated++
+			}
+		}
+		text := fmt.Sprintf("%d of %d resource(s)", generated, len(v.items))
+		if v.format == iac.Terraform {
+			text += " • resource and import blocks (Terraform 1.5+)"
+		} else {
+			text += " • Resources section with DeletionPolicy Retain for import"
+		}
+		summary = s.dim.Render(TruncateString(text, v.width))
+	}
+	return title + "\n" + summary + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *IaCView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *IaCView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *IaCView) SetSize(width, height int) tea.Cmd {
+	v.width, v.height = width, height
+	v.vp.SetSize(width, max(height-iacHeaderHeight, 3))
+	v.pathInput.SetWidth(max(width-len(v.pathInput.Prompt)-2, 10))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *IaCView) StatusLine() string {
+	if v.saving {
+		return "Enter:save • Esc:cancel"
+	}
+	return "f:Terraform/CloudFormation y:copy w:save ^r:reload • q/esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *IaCView) HasActiveInput() bool {
+	return v.saving
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	iac.Register("test", "iac", func(res dao.Resource) (*iac.Block, error) {
+		if res.GetID() == "bad" {
+			return nil, errors.New("unsupported thing")
+		}
+		return &iac.Block{
+			Name: res.GetName(),
+			Terraform: []iac.TerraformResource{{
+				Type:     "aws_thing",
+				Name:     iac.LocalName(res.GetID()),
+				ImportID: res.GetID(),
+				Body:     iac.NewBody().Set("name", res.GetName()),
+			}},
+			CloudFormation: &iac.CloudFormationResource{
+				Type:       "AWS::Test::Thing",
+				LogicalID:  iac.LogicalID(res.GetID()),
+				Properties: iac.NewBody().Set("Name", res.GetName()),
+			},
+		}, nil
+	})
+}
+
+func newIaCTestView(t *testing.T, ids ...string) *IaCView {
+	t.Helper()
+	reg := registry.New()
+	reg.RegisterCustom("test", "iac", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockDAO{BaseDAO: dao.NewBaseDAO("test", "iac"), supportsGet: true}, nil
+		},
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+	var resources []dao.Resource
+	for _, id := range ids {
+		resources = append(resources, &mockResource{id: id, name: "listed"})
+	}
+	v := NewIaCView(context.Background(), reg, resources, "test", "iac", iac.Terraform)
+	v.SetSize(100, 30)
+	for _, cmd := range v.Init()().(tea.BatchMsg) {
+		if msg, ok := cmd().(iacLoadedMsg); ok {
+			v.Update(msg)
+		}
+	}
+	if v.loading {
+		t.Fatal("resources should be loaded")
+	}
+	return v
+}
+
+func TestIaCView(t *testing.T) {
+	v := newIaCTestView(t, "one", "bad")
+
+	// Code is generated from the resource as fetched by Get
+	for _, want := range []string{`resource "aws_thing" "one"`, `name = "fetched"`, "# listed: unsupported thing"} {
+		if !strings.Contains(v.code, want) {
+			t.Errorf("Terraform code missing %q:\n%s", want, v.code)
+		}
+	}
+	if out := v.ViewString(); !strings.Contains(out, "1 of 2 resource(s)") {
+		t.Errorf("header should count generated resources:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if v.format != iac.CloudFormation {
+		t.Fatal("f should switch to CloudFormation")
+	}
+	if !strings.Contains(v.code, "Type: AWS::Test::Thing") {
+		t.Errorf("CloudFormation code missing resource:\n%s", v.code)
+	}
+	if v.defaultPath() != "test-iac.yaml" {
+		t.Errorf("defaultPath() = %q, want test-iac.yaml", v.defaultPath())
+	}
+}
+
+func TestIaCViewSave(t *testing.T) {
+	v := newIaCTestView(t, "one")
+	path := filepath.Join(t.TempDir(), "out", "main.tf")
+
+	v.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	if !v.HasActiveInput() {
+		t.Fatal("w should open the path prompt")
+	}
+	v.pathInput.SetValue(path)
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should save")
+	}
+	v.Update(cmd())
+
+	if v.statusErr != nil || v.status != "Saved to "+path {
+		t.Fatalf("status = %q, err = %v", v.status, v.statusErr)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(data) != v.code {
+		t.Errorf("saved file differs from code:\n%s", data)
+	}
+}
@@ -0,0 +1,885 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/ddbitem"
+	"github.com/clawscli/claws/internal/editor"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	itemPageSize       = 50
+	itemMaxColumnWidth = 32
+	itemHeaderHeight   = 3 // title(1) + summary(1) + separator(1)
+)
+
+// Query form inputs, after the index selector
+const (
+	itemFieldPartitionKey = iota
+	itemFieldSortKey
+	itemFieldFilter
+	itemFieldCount
+)
+
+// itemClient is the part of the DynamoDB client the explorer uses
+type itemClient interface {
+	Scan(ctx context.Context, in *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
+	Query(ctx context.Context, in *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error)
+	PutItem(ctx context.Context, in *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error)
+	DeleteItem(ctx context.Context, in *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
+}
+
+type itemsLoadedMsg struct {
+	gen     int
+	items   []ddbitem.Item
+	lastKey ddbitem.Item
+	scanned int32
+	next    bool
+	err     error
+}
+
+type itemEditedMsg struct {
+	content  string
+	original string
+	key      ddbitem.Item // key of the edited item, nil for a new item
+	err      error
+}
+
+type itemWriteDoneMsg struct {
+	write itemWrite
+	err   error
+}
+
+// itemWrite is a PutItem or DeleteItem waiting for confirmation
+type itemWrite struct {
+	put        ddbitem.Item // nil for a delete
+	key        ddbitem.Item // key of the edited or deleted item, nil for a new item
+	keyChanged bool
+}
+
+// itemExplorerStyles holds cached lipgloss styles for performance
+type itemExplorerStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	label   lipgloss.Style
+	focused lipgloss.Style
+	ok      lipgloss.Style
+	warning lipgloss.Style
+	danger  lipgloss.Style
+	bold    lipgloss.Style
+	input   lipgloss.Style
+	box     lipgloss.Style
+}
+
+func newItemExplorerStyles() itemExplorerStyles {
+	t := ui.Current()
+	return itemExplorerStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		label:   ui.DimStyle().Width(14),
+		focused: ui.AccentStyle().Width(14),
+		ok:      ui.SuccessStyle(),
+		warning: ui.WarningStyle(),
+		danger:  ui.DangerStyle(),
+		bold:    ui.TextStyle().Bold(true),
+		input:   ui.InputStyle(),
+		box:     ui.BoxStyle().BorderForeground(t.Danger).MarginTop(1),
+	}
+}
+
+// ItemExplorerView browses the items of a DynamoDB table with Scan or Query,
+// and edits, creates and deletes single items
+type ItemExplorerView struct {
+	ctx    context.Context
+	schema ddbitem.Schema
+	client itemClient
+
+	query   ddbitem.Query
+	items   []ddbitem.Item
+	columns []string
+	lastKey ddbitem.Item
+	scanned int
+	gen     int
+	loading bool
+	err     error
+	message string
+
+	form   bool
+	choice int // 0 is Scan, then schema.Indexes
+	inputs []textinput.Model
+	focus  int // 0 is the index selector, then the inputs
+
+	pending   *itemWrite
+	dangerous dangerousState
+	writing   bool
+
+	tc        TableCursor
+	colOffset int
+	width     int
+	height    int
+	spinner   spinner.Model
+	styles    itemExplorerStyles
+}
+
+// NewItemExplorerView creates an item explorer for a table. It starts with
+// a Scan of the first page.
+func NewItemExplorerView(ctx context.Context, table types.TableDescription) *ItemExplorerView {
+	v := &ItemExplorerView{
+		ctx:     ctx,
+		schema:  ddbitem.NewSchema(table),
+		query:   ddbitem.Query{Scan: true},
+		loading: true,
+		spinner: ui.NewSpinner(),
+		styles:  newItemExplorerStyles(),
+	}
+	placeholders := []string{"value", "e.g. begins_with ORDER# or between 1 9", "e.g. status = active AND age > 30"}
+	for i := 0; i < itemFieldCount; i++ {
+		ti := textinput.New()
+		ti.Prompt = ""
+		ti.Placeholder = placeholders[i]
+		ti.CharLimit = 1024
+		v.inputs = append(v.inputs, ti)
+	}
+	return v
+}
+
+// Init implements tea.Model
+func (v *ItemExplorerView) Init() tea.Cmd {
+	if v.client == nil {
+		cfg, err := appaws.NewConfig(v.ctx)
+		if err != nil {
+			v.loading = false
+			v.err = apperrors.Wrap(err, "item explorer")
+			return nil
+		}
+		v.client = dynamodb.NewFromConfig(cfg)
+	}
+	return v.load(false)
+}
+
+// load runs the current query; next continues after the last page
+func (v *ItemExplorerView) load(next bool) tea.Cmd {
+	req, err := ddbitem.Build(v.schema, v.query, itemPageSize)
+	if err != nil {
+		v.loading = false
+		v.err = err
+		return nil
+	}
+	var start ddbitem.Item
+	if next {
+		start = v.lastKey
+	} else {
+		v.gen++
+	}
+	v.loading = true
+	v.err = nil
+	v.message = ""
+	ctx, client, gen, table := v.ctx, v.client, v.gen, v.schema.Table
+	return tea.Batch(func() tea.Msg {
+		msg := fetchItems(ctx, client, req, start)
+		if msg.err != nil {
+			msg.err = apperrors.Wrapf(msg.err, "read items from %s", table)
+		}
+		msg.gen, msg.next = gen, next
+		return msg
+	}, v.spinner.Tick)
+}
+
+func fetchItems(ctx context.Context, client itemClient, req ddbitem.Request, start ddbitem.Item) itemsLoadedMsg {
+	if req.Scan != nil {
+		req.Scan.ExclusiveStartKey = start
+		out, err := client.Scan(ctx, req.Scan)
+		if err != nil {
+			return itemsLoadedMsg{err: err}
+		}
+		return itemsLoadedMsg{items: out.Items, lastKey: out.LastEvaluatedKey, scanned: out.ScannedCount}
+	}
+	req.Query.ExclusiveStartKey = start
+	out, err := client.Query(ctx, req.Query)
+	if err != nil {
+		return itemsLoadedMsg{err: err}
+	}
+	return itemsLoadedMsg{items: out.Items, lastKey: out.LastEvaluatedKey, scanned: out.ScannedCount}
+}
+
+// Update implements tea.Model
+func (v *ItemExplorerView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case itemsLoadedMsg:
+		if msg.gen != v.gen {
+			return v, nil
+		}
+		v.loading = false
+		v.err = msg.err
+		if msg.err != nil {
+			return v, nil
+		}
+		if msg.next {
+			v.items = append(v.items, msg.items...)
+			v.scanned += int(msg.scanned)
+		} else {
+			v.items = msg.items
+			v.scanned = int(msg.scanned)
+			v.tc.SetCursor(0, len(v.items))
+			v.colOffset = 0
+		}
+		v.lastKey = msg.lastKey
+		v.refreshColumns()
+		return v, nil
+
+	case itemEditedMsg:
+		v.handleEdited(msg)
+		return v, nil
+
+	case itemWriteDoneMsg:
+		v.writing = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.applyWrite(msg.write)
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading || v.writing {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newItemExplorerStyles()
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		delta := 0
+		switch msg.Button {
+		case tea.MouseWheelUp:
+			delta = -3
+		case tea.MouseWheelDown:
+			delta = 3
+		}
+		v.tc.AdjustScrollOffset(delta, len(v.items))
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch {
+		case v.form:
+			return v, v.handleFormKey(msg)
+		case v.dangerous.active:
+			return v, v.handleDeleteConfirmKey(msg)
+		case v.pending != nil:
+			return v, v.handlePutConfirmKey(msg)
+		}
+		return v, v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *ItemExplorerView) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	n := len(v.items)
+	switch msg.String() {
+	case "/":
+		return v.openForm()
+	case "N":
+		if v.lastKey != nil && !v.loading {
+			return v.load(true)
+		}
+	case "ctrl+r":
+		return v.load(false)
+	case "enter", "d":
+		if item := v.selected(); item != nil {
+			title := fmt.Sprintf("%s: %s", v.schema.Table, ddbitem.KeyString(item, v.schema.KeyNames()...))
+			text := NewTextView(title, ddbitem.JSON(item))
+			return func() tea.Msg { return NavigateMsg{View: text} }
+		}
+	case "y":
+		if item := v.selected(); item != nil {
+			return clipboard.Copy("item JSON", ddbitem.JSON(item))
+		}
+	case "e":
+		if item := v.selected(); item != nil && v.checkWritable() {
+			content := ddbitem.DynamoJSON(item)
+			key := ddbitem.Key(item, v.schema.KeyNames()...)
+			return editor.Edit(content, ".json", func(edited string, err error) tea.Msg {
+				return itemEditedMsg{content: edited, original: content, key: key, err: err}
+			})
+		}
+	case "n":
+		if v.checkWritable() {
+			content := ddbitem.DynamoJSON(v.newItemTemplate())
+			return editor.Edit(content, ".json", func(edited string, err error) tea.Msg {
+				return itemEditedMsg{content: edited, original: content, err: err}
+			})
+		}
+	case "D":
+		if item := v.selected(); item != nil && v.checkWritable() {
+			key := ddbitem.Key(item, v.schema.KeyNames()...)
+			v.pending = &itemWrite{key: key}
+			v.dangerous = dangerousState{active: true, token: ddbitem.KeyString(key, v.schema.KeyNames()...)}
+		}
+	case "h", "left":
+		v.colOffset = max(v.colOffset-1, 0)
+	case "l", "right":
+		v.colOffset = min(v.colOffset+1, max(len(v.columns)-1, 0))
+	case "j", "down":
+		v.moveCursor(v.tc.Cursor()+1, n)
+	case "k", "up":
+		v.moveCursor(v.tc.Cursor()-1, n)
+	case "ctrl+d", "pgdown":
+		v.moveCursor(v.tc.Cursor()+v.tc.TableHeight()/2, n)
+	case "ctrl+u", "pgup":
+		v.moveCursor(v.tc.Cursor()-v.tc.TableHeight()/2, n)
+	case "g", "home":
+		v.moveCursor(0, n)
+	case "G", "end":
+		v.moveCursor(n-1, n)
+	}
+	return nil
+}
+
+func (v *ItemExplorerView) moveCursor(to, n int) {
+	v.tc.SetCursor(to, n)
+	v.tc.UpdateScrollOffset(n)
+}
+
+func (v *ItemExplorerView) selected() ddbitem.Item {
+	if c := v.tc.Cursor(); c < len(v.items) {
+		return v.items[c]
+	}
+	return nil
+}
+
+// checkWritable reports whether items may be changed, showing why not
+func (v *ItemExplorerView) checkWritable() bool {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return false
+	}
+	return true
+}
+
+// newItemTemplate has the table's key attributes with empty values
+func (v *ItemExplorerView) newItemTemplate() ddbitem.Item {
+	item := make(ddbitem.Item)
+	for _, name := range v.schema.KeyNames() {
+		switch v.schema.AttributeTypes[name] {
+		case types.ScalarAttributeTypeN:
+			item[name] = &types.AttributeValueMemberN{Value: "0"}
+		case types.ScalarAttributeTypeB:
+			item[name] = &types.AttributeValueMemberB{Value: []byte{}}
+		default:
+			item[name] = &types.AttributeValueMemberS{Value: ""}
+		}
+	}
+	return item
+}
+
+// handleEdited checks the edited item and asks to confirm the PutItem
+func (v *ItemExplorerView) handleEdited(msg itemEditedMsg) {
+	v.err = nil
+	v.message = ""
+	if msg.err != nil {
+		v.err = msg.err
+		return
+	}
+	if strings.TrimSpace(msg.content) == strings.TrimSpace(msg.original) {
+		v.message = "No changes"
+		return
+	}
+	item, err := ddbitem.ParseDynamoJSON(msg.content)
+	if err != nil {
+		v.err = err
+		return
+	}
+	keyNames := v.schema.KeyNames()
+	for _, name := range keyNames {
+		if _, ok := item[name]; !ok {
+			v.err = fmt.Errorf("key attribute %s is missing", name)
+			return
+		}
+	}
+	write := &itemWrite{put: item, key: msg.key}
+	if msg.key != nil {
+		write.keyChanged = ddbitem.KeyString(item, keyNames...) != ddbitem.KeyString(msg.key, keyNames...)
+	}
+	v.pending = write
+}
+
+func (v *ItemExplorerView) handlePutConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "y", "Y":
+		write := *v.pending
+		v.pending = nil
+		return v.runWrite(write)
+	case "n", "N", "esc":
+		v.pending = nil
+		v.message = "Cancelled"
+	}
+	return nil
+}
+
+func (v *ItemExplorerView) handleDeleteConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch {
+	case IsEscKey(msg):
+		v.dangerous = dangerousState{}
+		v.pending = nil
+	case msg.String() == "enter":
+		if !action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+			return nil
+		}
+		v.dangerous = dangerousState{}
+		write := *v.pending
+		v.pending = nil
+		return v.runWrite(write)
+	case msg.Code == tea.KeyBackspace || msg.String() == "backspace":
+		if len(v.dangerous.input) > 0 {
+			v.dangerous.input = v.dangerous.input[:len(v.dangerous.input)-1]
+		}
+	case len(msg.String()) == 1:
+		v.dangerous.input += msg.String()
+	}
+	return nil
+}
+
+// runWrite sends a confirmed PutItem or DeleteItem. New items and items
+// whose key changed must not overwrite an existing item.
+func (v *ItemExplorerView) runWrite(write itemWrite) tea.Cmd {
+	v.writing = true
+	v.err = nil
+	v.message = ""
+	ctx, client, table := v.ctx, v.client, v.schema.Table
+	pk := v.schema.KeyNames()[0]
+	return tea.Batch(func() tea.Msg {
+		var err error
+		if write.put == nil {
+			log.Info("deleting item", "table", table)
+			_, err = client.DeleteItem(ctx, &dynamodb.DeleteItemInput{TableName: &table, Key: write.key})
+			err = apperrors.Wrapf(err, "delete item from %s", table)
+		} else {
+			log.Info("putting item", "table", table)
+			input := &dynamodb.PutItemInput{TableName: &table, Item: write.put}
+			if write.key == nil || write.keyChanged {
+				cond := "attribute_not_exists(#pk)"
+				input.ConditionExpression = &cond
+				input.ExpressionAttributeNames = map[string]string{"#pk": pk}
+			}
+			_, err = client.PutItem(ctx, input)
+			var condErr *types.ConditionalCheckFailedException
+			if errors.As(err, &condErr) {
+				err = errors.New("an item with this key already exists")
+			}
+			err = apperrors.Wrapf(err, "put item to %s", table)
+		}
+		return itemWriteDoneMsg{write: write, err: err}
+	}, v.spinner.Tick)
+}
+
+// applyWrite updates the loaded items after a successful write, so the
+// page does not have to be read again
+func (v *ItemExplorerView) applyWrite(write itemWrite) {
+	keyNames := v.schema.KeyNames()
+	index := -1
+	if write.key != nil {
+		want := ddbitem.KeyString(write.key, keyNames...)
+		for i, item := range v.items {
+			if ddbitem.KeyString(item, keyNames...) == want {
+				index = i
+				break
+			}
+		}
+	}
+
+	switch {
+	case write.put == nil:
+		v.message = "Deleted " + ddbitem.KeyString(write.key, keyNames...)
+		if index >= 0 {
+			v.items = append(v.items[:index], v.items[index+1:]...)
+		}
+	case index >= 0 && !write.keyChanged:
+		v.message = "Saved " + ddbitem.KeyString(write.put, keyNames...)
+		v.items[index] = write.put
+	default:
+		v.message = "Created " + ddbitem.KeyString(write.put, keyNames...)
+		v.items = append(v.items, write.put)
+		index = len(v.items) - 1
+	}
+	v.refreshColumns()
+	v.moveCursor(max(index, v.tc.Cursor()), len(v.items))
+}
+
+func (v *ItemExplorerView) refreshColumns() {
+	v.columns = ddbitem.Columns(v.items, v.schema.AllKeyNames())
+	v.colOffset = min(v.colOffset, max(len(v.columns)-1, 0))
+	v.tc.SetCursor(v.tc.Cursor(), len(v.items))
+}
+
+// openForm shows the query form with the current query
+func (v *ItemExplorerView) openForm() tea.Cmd {
+	v.form = true
+	v.focus = 0
+	v.choice = 0
+	if !v.query.Scan {
+		for i, idx := range v.schema.Indexes {
+			if idx == v.query.Index {
+				v.choice = i + 1
+			}
+		}
+	}
+	v.inputs[itemFieldPartitionKey].SetValue(v.query.PartitionKey)
+	v.inputs[itemFieldSortKey].SetValue(v.query.SortKey)
+	v.inputs[itemFieldFilter].SetValue(v.query.Filter)
+	return nil
+}
+
+func (v *ItemExplorerView) closeForm() {
+	v.form = false
+	for i := range v.inputs {
+		v.inputs[i].Blur()
+	}
+}
+
+// formQuery returns the query described by the form
+func (v *ItemExplorerView) formQuery() ddbitem.Query {
+	q := ddbitem.Query{
+		Scan:   v.choice == 0,
+		Filter: strings.TrimSpace(v.inputs[itemFieldFilter].Value()),
+	}
+	if !q.Scan {
+		q.Index = v.schema.Indexes[v.choice-1]
+		q.PartitionKey = strings.TrimSpace(v.inputs[itemFieldPartitionKey].Value())
+		q.SortKey = strings.TrimSpace(v.inputs[itemFieldSortKey].Value())
+	}
+	return q
+}
+
+func (v *ItemExplorerView) handleFormKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.closeForm()
+		return nil
+	case "enter":
+		q := v.formQuery()
+		if _, err := ddbitem.Build(v.schema, q, itemPageSize); err != nil {
+			v.err = err
+			return nil
+		}
+		v.closeForm()
+		v.query = q
+		v.items = nil
+		v.lastKey = nil
+		return v.load(false)
+	case "tab", "down":
+		return v.moveFormFocus(1)
+	case "shift+tab", "up":
+		return v.moveFormFocus(-1)
+	}
+	if v.focus == 0 {
+		options := len(v.schema.Indexes) + 1
+		switch msg.String() {
+		case "left", "h":
+			v.choice = (v.choice - 1 + options) % options
+		case "right", "l", "space":
+			v.choice = (v.choice + 1) % options
+		}
+		return nil
+	}
+	var cmd tea.Cmd
+	v.inputs[v.focus-1], cmd = v.inputs[v.focus-1].Update(msg)
+	return cmd
+}
+
+// moveFormFocus cycles through the selector and inputs; a Scan has no key
+// inputs and an index without a sort key has no sort key input
+func (v *ItemExplorerView) moveFormFocus(delta int) tea.Cmd {
+	if v.focus > 0 {
+		v.inputs[v.focus-1].Blur()
+	}
+	stops := len(v.inputs) + 1
+	for {
+		v.focus = (v.focus + delta + stops) % stops
+		if v.formFieldEnabled(v.focus) {
+			break
+		}
+	}
+	if v.focus == 0 {
+		return nil
+	}
+	cmd := v.inputs[v.focus-1].Focus()
+	v.inputs[v.focus-1].CursorEnd()
+	return cmd
+}
+
+func (v *ItemExplorerView) formFieldEnabled(focus int) bool {
+	switch focus - 1 {
+	case itemFieldPartitionKey:
+		return v.choice > 0
+	case itemFieldSortKey:
+		return v.choice > 0 && v.schema.Indexes[v.choice-1].SortKey != ""
+	}
+	return true
+}
+
+func (v *ItemExplorerView) queryLabel(q ddbitem.Query) string {
+	if q.Scan {
+		label := "Scan"
+		if q.Filter != "" {
+			label += " where " + q.Filter
+		}
+		return label
+	}
+	label := fmt.Sprintf("Query %s: %s = %s", q.Index.Label(), q.Index.PartitionKey, q.PartitionKey)
+	if q.SortKey != "" {
+		label += fmt.Sprintf(", %s %s", q.Index.SortKey, q.SortKey)
+	}
+	if q.Filter != "" {
+		label += " where " + q.Filter
+	}
+	return label
+}
+
+func (v *ItemExplorerView) renderForm() string {
+	s := v.styles
+	var out strings.Builder
+	label := func(focus int, text string) string {
+		if v.focus == focus {
+			return s.focused.Render(text)
+		}
+		return s.label.Render(text)
+	}
+
+	choice := "Scan"
+	if v.choice > 0 {
+		choice = v.schema.Indexes[v.choice-1].Label()
+	}
+	out.WriteString(label(0, "Index") + " ◀ " + choice + " ▶\n")
+
+	names := []string{"", "", "Filter"}
+	if v.choice > 0 {
+		idx := v.schema.Indexes[v.choice-1]
+		names[itemFieldPartitionKey] = idx.PartitionKey
+		names[itemFieldSortKey] = idx.SortKey
+	}
+	for i, name := range names {
+		if !v.formFieldEnabled(i + 1) {
+			continue
+		}
+		out.WriteString(label(i+1, TruncateString(name, 13)) + " " + v.inputs[i].View() + "\n")
+	}
+	return out.String()
+}
+
+// columnWidths sizes the visible columns from their content
+func (v *ItemExplorerView) columnWidths() ([]string, []int) {
+	var cols []string
+	var widths []int
+	used := 0
+	for _, name := range v.columns[min(v.colOffset, len(v.columns)):] {
+		w := lipgloss.Width(name)
+		for _, item := range v.items {
+			w = max(w, lipgloss.Width(ddbitem.Cell(item[name])))
+		}
+		w = min(w, itemMaxColumnWidth) + 2
+		if len(cols) > 0 && used+w > v.width {
+			break
+		}
+		cols = append(cols, name)
+		widths = append(widths, w)
+		used += w
+	}
+	return cols, widths
+}
+
+func (v *ItemExplorerView) renderTable(height int) string {
+	v.tc.SetTableHeight(max(height, 3))
+	v.tc.UpdateScrollOffset(len(v.items))
+	cols, widths := v.columnWidths()
+	if len(cols) == 0 {
+		return ""
+	}
+
+	t := table.New().
+		Headers(cols...).
+		Height(max(height, 3)).
+		Wrap(false).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderColumn(false).
+		BorderHeader(true).
+		BorderStyle(TableBorderStyle()).
+		StyleFunc(NewTableStyleFunc(widths, v.tc.Cursor()))
+	for _, item := range v.items {
+		row := make([]string, len(cols))
+		for i, name := range cols {
+			row[i] = TruncateString(ddbitem.Cell(item[name]), widths[i]-2)
+		}
+		t = t.Row(row...)
+	}
+	if v.tc.ScrollOffset() > 0 {
+		t = t.YOffset(v.tc.ScrollOffset())
+	}
+	return t.String()
+}
+
+func (v *ItemExplorerView) renderConfirm() string {
+	s := v.styles
+	t := ui.Current()
+	keyNames := v.schema.KeyNames()
+
+	if !v.dangerous.active {
+		write := v.pending
+		key := ddbitem.KeyString(write.put, keyNames...)
+		var content string
+		switch {
+		case write.key == nil:
+			content = fmt.Sprintf("Create item %s?\n", s.bold.Render(key))
+		case write.keyChanged:
+			content = fmt.Sprintf("Create item %s?\n", s.bold.Render(key))
+			content += s.warning.Render("⚠ The key changed: the original item "+ddbitem.KeyString(write.key, keyNames...)+" is kept.") + "\n"
+		default:
+			content = fmt.Sprintf("Replace item %s?\n", s.bold.Render(key))
+			content += s.warning.Render("⚠ PutItem replaces the whole item.") + "\n"
+		}
+		content += "\n" + s.dim.Render("y:put n/esc:cancel")
+		return s.box.BorderForeground(t.Warning).Render(content)
+	}
+
+	content := ui.BoldDangerStyle().Render("⚠ DANGER") + "\n\n"
+	content += fmt.Sprintf("You are about to %s from %s:\n", s.danger.Render("delete an item"), v.schema.Table)
+	content += s.bold.Render(v.dangerous.token) + "\n\n"
+
+	suffix := action.ConfirmSuffix(v.dangerous.token)
+	if len(suffix) < len(v.dangerous.token) {
+		content += fmt.Sprintf("Type last %d chars: ...%s\n", len(suffix), suffix)
+	} else {
+		content += "Type to confirm:\n"
+	}
+	inputStyle := s.input
+	if action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Success)
+	} else if len(v.dangerous.input) > 0 && strings.HasPrefix(suffix, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Warning)
+	}
+	content += inputStyle.Render(v.dangerous.input+"▌") + "\n\n"
+	content += s.dim.Render("Press Enter to confirm, Esc to cancel")
+	return s.box.Render(content)
+}
+
+func (v *ItemExplorerView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render("Items: " + v.schema.Table)
+
+	summary := fmt.Sprintf("%s • %d item(s)", v.queryLabel(v.query), len(v.items))
+	if v.scanned > len(v.items) {
+		summary += fmt.Sprintf(" of %d scanned", v.scanned)
+	}
+	if v.lastKey != nil {
+		summary += " • more (N)"
+	}
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *ItemExplorerView) ViewString() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(v.renderHeader() + "\n")
+	used := itemHeaderHeight
+
+	if v.form {
+		form := v.renderForm()
+		out.WriteString(form + "\n")
+		used += strings.Count(form, "\n") + 1
+	}
+
+	var status string
+	switch {
+	case v.loading:
+		status = v.spinner.View() + " Reading items..."
+	case v.writing:
+		status = v.spinner.View() + " Writing..."
+	case v.err != nil:
+		msg := v.err.Error()
+		if kind := apperrors.Classify(v.err); kind != apperrors.Unknown {
+			msg = fmt.Sprintf("[%s] %s", kind, msg)
+		}
+		status = s.danger.Render(TruncateString(msg, v.width))
+	case v.message != "":
+		status = s.ok.Render(TruncateString(v.message, v.width))
+	}
+	if status != "" {
+		out.WriteString(status + "\n")
+		used++
+	}
+
+	if v.pending != nil {
+		out.WriteString(v.renderConfirm())
+		return out.String()
+	}
+	if len(v.items) == 0 {
+		if !v.loading && v.err == nil {
+			msg := "No items"
+			if v.lastKey != nil {
+				msg += " in this page (N for the next)"
+			}
+			out.WriteString(s.dim.Render(msg))
+		}
+		return out.String()
+	}
+	out.WriteString(v.renderTable(v.height - used))
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *ItemExplorerView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ItemExplorerView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	for i := range v.inputs {
+		v.inputs[i].SetWidth(max(width-20, 10))
+	}
+	return nil
+}
+
+// StatusLine implements View
+func (v *ItemExplorerView) StatusLine() string {
+	switch {
+	case v.form:
+		return "Tab:next field ←/→:index Enter:run • Esc:cancel"
+	case v.dangerous.active:
+		return "Type to confirm • Enter:delete • Esc:cancel"
+	case v.pending != nil:
+		return "y:put n:cancel"
+	}
+	return "/:query N:more Enter:view y:copy e:edit n:new D:delete h/l:columns ^r:reload • q/esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *ItemExplorerView) HasActiveInput() bool {
+	return v.form || v.pending != nil
+}
@@ -0,0 +1,264 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/ddbitem"
+)
+
+type fakeItemClient struct {
+	items   []ddbitem.Item
+	lastKey ddbitem.Item
+	scans   []*dynamodb.ScanInput
+	queries []*dynamodb.QueryInput
+	puts    []*dynamodb.PutItemInput
+	deletes []*dynamodb.DeleteItemInput
+	putErr  error
+}
+
+func (f *fakeItemClient) Scan(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	f.scans = append(f.scans, in)
+	return &dynamodb.ScanOutput{Items: f.items, LastEvaluatedKey: f.lastKey, ScannedCount: int32(len(f.items))}, nil
+}
+
+func (f *fakeItemClient) Query(_ context.Context, in *dynamodb.QueryInput, _ ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	f.queries = append(f.queries, in)
+	return &dynamodb.QueryOutput{Items: f.items[:1]}, nil
+}
+
+func (f *fakeItemClient) PutItem(_ context.Context, in *dynamodb.PutItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	f.puts = append(f.puts, in)
+	return &dynamodb.PutItemOutput{}, f.putErr
+}
+
+func (f *fakeItemClient) DeleteItem(_ context.Context, in *dynamodb.DeleteItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error) {
+	f.deletes = append(f.deletes, in)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+// runItemCmd runs a command, feeding explorer results back into the view
+func runItemCmd(v *ItemExplorerView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runItemCmd(v, c)
+		}
+	case itemsLoadedMsg, itemWriteDoneMsg:
+		v.Update(msg)
+	}
+}
+
+func testItem(pk, sk, status string) ddbitem.Item {
+	return ddbitem.Item{
+		"pk":     &types.AttributeValueMemberS{Value: pk},
+		"sk":     &types.AttributeValueMemberS{Value: sk},
+		"status": &types.AttributeValueMemberS{Value: status},
+	}
+}
+
+func newTestItemExplorer(t *testing.T, client *fakeItemClient) *ItemExplorerView {
+	t.Helper()
+	v := NewItemExplorerView(context.Background(), types.TableDescription{
+		TableName: aws.String("orders"),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("sk"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+			{AttributeName: aws.String("sk"), KeyType: types.KeyTypeRange},
+		},
+	})
+	v.client = client
+	v.SetSize(120, 30)
+	runItemCmd(v, v.Init())
+	return v
+}
+
+func TestItemExplorerView_ScanAndPaging(t *testing.T) {
+	client := &fakeItemClient{
+		items:   []ddbitem.Item{testItem("u#1", "o#1", "open"), testItem("u#1", "o#2", "paid")},
+		lastKey: ddbitem.Item{"pk": &types.AttributeValueMemberS{Value: "u#1"}},
+	}
+	v := newTestItemExplorer(t, client)
+
+	if len(client.scans) != 1 || aws.ToInt32(client.scans[0].Limit) != itemPageSize {
+		t.Fatalf("scans = %v, want one page of %d", client.scans, itemPageSize)
+	}
+	if got := strings.Join(v.columns, ","); got != "pk,sk,status" {
+		t.Errorf("columns = %q, want key attributes first", got)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Items: orders", "2 item(s)", "more (N)", "paid"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+
+	client.lastKey = nil
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'N', Text: "N"})
+	runItemCmd(v, cmd)
+	if len(client.scans) != 2 || client.scans[1].ExclusiveStartKey == nil {
+		t.Fatalf("next page should start after the last key, scans = %v", client.scans)
+	}
+	if len(v.items) != 4 || v.lastKey != nil {
+		t.Errorf("items = %d, lastKey = %v; want 4 items and no more pages", len(v.items), v.lastKey)
+	}
+}
+
+func TestItemExplorerView_QueryForm(t *testing.T) {
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open")}}
+	v := newTestItemExplorer(t, client)
+
+	v.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !v.HasActiveInput() {
+		t.Fatal("/ should open the query form")
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	for _, r := range "u#1" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	for _, r := range "begins_with o#" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemCmd(v, cmd)
+
+	if v.HasActiveInput() {
+		t.Error("enter should close the form")
+	}
+	if len(client.queries) != 1 {
+		t.Fatalf("queries = %d, want 1", len(client.queries))
+	}
+	if got := aws.ToString(client.queries[0].KeyConditionExpression); got != "#n0 = :v0 AND begins_with(#n1, :v1)" {
+		t.Errorf("KeyConditionExpression = %q", got)
+	}
+	if !strings.Contains(v.ViewString(), "Query table (pk, sk): pk = u#1, sk begins_with o#") {
+		t.Errorf("header should describe the query:\n%s", v.ViewString())
+	}
+}
+
+func TestItemExplorerView_QueryFormRequiresPartitionKey(t *testing.T) {
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open")}}
+	v := newTestItemExplorer(t, client)
+
+	v.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !v.HasActiveInput() || v.err == nil || len(client.queries) != 0 {
+		t.Errorf("form should stay open with an error, err = %v", v.err)
+	}
+}
+
+func TestItemExplorerView_EditPut(t *testing.T) {
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open")}}
+	v := newTestItemExplorer(t, client)
+
+	original := ddbitem.DynamoJSON(v.items[0])
+	edited := strings.Replace(original, `"open"`, `"paid"`, 1)
+	v.Update(itemEditedMsg{content: edited, original: original, key: ddbitem.Key(v.items[0], "pk", "sk")})
+	if v.pending == nil || v.pending.keyChanged {
+		t.Fatalf("pending = %+v, want a put of the same key", v.pending)
+	}
+	if out := v.ViewString(); !strings.Contains(out, "Replace item") || !strings.Contains(out, "pk=u#1, sk=o#1") {
+		t.Errorf("confirm missing:\n%s", v.ViewString())
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runItemCmd(v, cmd)
+	if len(client.puts) != 1 || client.puts[0].ConditionExpression != nil {
+		t.Fatalf("puts = %+v, want one unconditional put", client.puts)
+	}
+	if got := ddbitem.Cell(v.items[0]["status"]); got != "paid" {
+		t.Errorf("status = %q, want the item updated in place", got)
+	}
+	if !strings.Contains(v.message, "Saved") {
+		t.Errorf("message = %q", v.message)
+	}
+}
+
+func TestItemExplorerView_NewItemConditional(t *testing.T) {
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open")}}
+	v := newTestItemExplorer(t, client)
+
+	template := ddbitem.DynamoJSON(v.newItemTemplate())
+	if !strings.Contains(template, `"pk"`) || !strings.Contains(template, `"sk"`) {
+		t.Errorf("template = %s, want the key attributes", template)
+	}
+	v.Update(itemEditedMsg{content: template, original: template})
+	if v.pending != nil || v.message != "No changes" {
+		t.Errorf("unchanged template should not put, message = %q", v.message)
+	}
+
+	v.Update(itemEditedMsg{content: `{"pk": {"S": "u#2"}}`, original: template})
+	if v.err == nil || !strings.Contains(v.err.Error(), "sk is missing") {
+		t.Errorf("err = %v, want missing sort key", v.err)
+	}
+
+	client.putErr = &types.ConditionalCheckFailedException{}
+	v.Update(itemEditedMsg{content: `{"pk": {"S": "u#1"}, "sk": {"S": "o#1"}}`, original: template})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runItemCmd(v, cmd)
+	if len(client.puts) != 1 || aws.ToString(client.puts[0].ConditionExpression) != "attribute_not_exists(#pk)" {
+		t.Fatalf("puts = %+v, want a conditional put", client.puts)
+	}
+	if v.err == nil || !strings.Contains(v.err.Error(), "already exists") {
+		t.Errorf("err = %v, want an existing-item error", v.err)
+	}
+}
+
+func TestItemExplorerView_Delete(t *testing.T) {
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open"), testItem("u#1", "o#2", "paid")}}
+	v := newTestItemExplorer(t, client)
+
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	if !v.dangerous.active || v.dangerous.token != "pk=u#1, sk=o#1" {
+		t.Fatalf("dangerous = %+v, want the key as the token", v.dangerous)
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(client.deletes) != 0 {
+		t.Fatal("delete must wait for the confirmation")
+	}
+	for _, r := range action.ConfirmSuffix(v.dangerous.token) {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemCmd(v, cmd)
+
+	if len(client.deletes) != 1 || len(client.deletes[0].Key) != 2 {
+		t.Fatalf("deletes = %+v, want one delete by key", client.deletes)
+	}
+	if len(v.items) != 1 || ddbitem.Cell(v.items[0]["sk"]) != "o#2" {
+		t.Errorf("items = %v, want the deleted item removed", v.items)
+	}
+}
+
+func TestItemExplorerView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	client := &fakeItemClient{items: []ddbitem.Item{testItem("u#1", "o#1", "open")}}
+	v := newTestItemExplorer(t, client)
+	for _, key := range []rune{'e', 'n', 'D'} {
+		v.err = nil
+		_, cmd := v.Update(tea.KeyPressMsg{Code: key, Text: string(key)})
+		if cmd != nil || !errors.Is(v.err, action.ErrReadOnlyDenied) || v.HasActiveInput() {
+			t.Errorf("%c in read-only mode: err = %v", key, v.err)
+		}
+	}
+}
@@ -62,11 +62,23 @@ type LogView struct {
 	filterInput  textinput.Model
 	filterActive bool
 	filterText   string // Filter text (client-side substring match)
+
+	// Export state
+	exportInput  textinput.Model
+	exportStep   exportStep
+	exportRange  bool // Export a time range instead of the buffer
+	exportStart  time.Time
+	exportEnd    time.Time
+	exportJob    *logExportJob
+	exportCount  int
+	exportStatus string
+	exportErr    error
 }
 
 type logEntry struct {
 	timestamp time.Time
 	message   string
+	stream    string
 }
 
 type logViewStyles struct {
@@ -95,6 +107,9 @@ func NewLogView(ctx context.Context, logGroupName string) *LogView {
 	ti.Prompt = "/"
 	ti.CharLimit = 200
 
+	ei := textinput.New()
+	ei.CharLimit = 1024
+
 	return &LogView{
 		ctx:          ctx,
 		logGroupName: logGroupName,
@@ -104,6 +119,7 @@ func NewLogView(ctx context.Context, logGroupName string) *LogView {
 		loading:      true,
 		pollInterval: defaultLogPollInterval,
 		filterInput:  ti,
+		exportInput:  ei,
 	}
 }
 
@@ -227,12 +243,7 @@ func (v *LogView) processLogEvents(events []types.FilteredLogEvent, older bool)
 	entries := make([]logEntry, 0, len(events))
 
 	for _, event := range events {
-		ts := time.UnixMilli(appaws.Int64(event.Timestamp))
-		msg := appaws.Str(event.Message)
-		entries = append(entries, logEntry{
-			timestamp: ts,
-			message:   strings.TrimSuffix(msg, "\n"),
-		})
+		entries = append(entries, newLogEntry(event))
 
 		eventTs := appaws.Int64(event.Timestamp)
 		if older {
@@ -249,6 +260,14 @@ func (v *LogView) processLogEvents(events []types.FilteredLogEvent, older bool)
 	return logsLoadedMsg{entries: entries, lastEventTime: boundaryTime, older: older}
 }
 
+func newLogEntry(event types.FilteredLogEvent) logEntry {
+	return logEntry{
+		timestamp: time.UnixMilli(appaws.Int64(event.Timestamp)),
+		message:   strings.TrimSuffix(appaws.Str(event.Message), "\n"),
+		stream:    appaws.Str(event.LogStreamName),
+	}
+}
+
 func (v *LogView) tickCmd() tea.Cmd {
 	return tea.Tick(v.pollInterval, func(t time.Time) tea.Msg {
 		return logTickMsg(t)
@@ -309,6 +328,9 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return v, nil
 
+	case logExportProgressMsg, logExportDoneMsg:
+		return v, v.handleExportMsg(msg)
+
 	case logTickMsg:
 		if v.paused {
 			return v, nil
@@ -320,6 +342,13 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if v.filterActive {
 			return v.handleFilterInput(msg)
 		}
+		if v.exportStep != exportStepNone {
+			return v.handleExportInput(msg)
+		}
+		if v.exportJob != nil && IsEscKey(msg) {
+			v.cancelExport()
+			return v, nil
+		}
 
 		switch msg.String() {
 		case "/":
@@ -359,6 +388,12 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				v.updateViewportContent()
 			}
 			return v, nil
+		case "s":
+			return v, v.startExport(false)
+		case "S":
+			return v, v.startExport(true)
+		case "|":
+			return v, v.pipeCmd()
 		case "p":
 			if v.oldestEventTime > 0 && !v.loading {
 				v.loading = true
@@ -389,6 +424,15 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return v, nil
 }
 
+// SetFilter shows only lines containing text, e.g. a request ID
+func (v *LogView) SetFilter(text string) {
+	v.filterText = text
+	v.filterInput.SetValue(text)
+	if v.vp.Ready {
+		v.updateViewportContent()
+	}
+}
+
 func (v *LogView) matchesFilter(entry logEntry) bool {
 	if v.filterText == "" {
 		return true
@@ -464,6 +508,11 @@ func (v *LogView) ViewString() string {
 		sb.WriteString("\n")
 	}
 
+	if line := v.exportStatusLine(); line != "" {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+
 	if v.paused {
 		sb.WriteString(v.styles.paused.Render("⏸ PAUSED"))
 		sb.WriteString(" ")
@@ -524,6 +573,9 @@ func (v *LogView) SetSize(width, height int) tea.Cmd {
 	if v.filterActive || v.filterText != "" {
 		headerOffset++ // Extra line for filter UI
 	}
+	if v.exportStatusLine() != "" {
+		headerOffset++ // Extra line for export prompt/progress
+	}
 	viewportHeight := height - headerOffset
 	v.vp.SetSize(width, viewportHeight)
 
@@ -533,6 +585,7 @@ func (v *LogView) SetSize(width, height int) tea.Cmd {
 		filterWidth = minFilterWidth
 	}
 	v.filterInput.SetWidth(filterWidth)
+	v.exportInput.SetWidth(filterWidth)
 
 	v.updateViewportContent()
 	return nil
@@ -542,8 +595,14 @@ func (v *LogView) StatusLine() string {
 	if v.filterActive {
 		return "Esc:cancel Enter:done"
 	}
+	if v.exportStep == exportStepRange {
+		return "Esc:cancel Enter:next • duration (6h) or start..end (RFC3339)"
+	}
+	if v.exportStep == exportStepPath {
+		return "Esc:cancel Enter:save • .jsonl for JSON Lines, .json for a JSON array"
+	}
 
-	status := "Space:pause/resume p:older g/G:top/bottom c:clear /:filter Esc:back"
+	status := "Space:pause/resume p:older g/G:top/bottom c:clear /:filter s/S:save/export |:pipe Esc:back"
 
 	if v.filterText != "" {
 		filterDisplay := v.filterText
@@ -563,8 +622,10 @@ func (v *LogView) StatusLine() string {
 	return "▶ STREAMING • " + status
 }
 
+// HasActiveInput implements InputCapture; Esc cancels a running export
+// before it leaves the view
 func (v *LogView) HasActiveInput() bool {
-	return v.filterActive
+	return v.filterActive || v.exportStep != exportStepNone || v.exportJob != nil
 }
 
 func (v *LogView) LogGroupName() string {
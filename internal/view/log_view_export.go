@@ -0,0 +1,510 @@
+package view
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// exportPageLimit is the page size used when exporting a time range.
+	exportPageLimit = 10000
+	// defaultExportRange is prefilled in the range prompt.
+	defaultExportRange = "1h"
+)
+
+// exportStep tracks which prompt of the export flow is active.
+type exportStep int
+
+const (
+	exportStepNone exportStep = iota
+	exportStepRange
+	exportStepPath
+)
+
+// logExportFormat is the output format of an exported log file.
+type logExportFormat int
+
+const (
+	logExportText logExportFormat = iota
+	logExportJSONL
+	logExportJSON
+)
+
+// logExportFormatForPath picks the format from the file extension:
+// .jsonl produces JSON Lines, .json a JSON array, anything else plain text.
+func logExportFormatForPath(path string) logExportFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl":
+		return logExportJSONL
+	case ".json":
+		return logExportJSON
+	default:
+		return logExportText
+	}
+}
+
+// jsonLogLine is a single line of a JSON Lines export.
+type jsonLogLine struct {
+	Timestamp string `json:"timestamp"`
+	Message   string `json:"message"`
+	LogStream string `json:"logStream,omitempty"`
+}
+
+// writeLogEntry writes one log entry as a line of text or JSON Lines.
+// JSON arrays are written by logExportWriter.
+func writeLogEntry(w io.Writer, entry logEntry, format logExportFormat) error {
+	ts := entry.timestamp.UTC().Format(time.RFC3339Nano)
+	if format == logExportJSONL {
+		b, err := json.Marshal(jsonLogLine{Timestamp: ts, Message: entry.message, LogStream: entry.stream})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s %s\n", ts, entry.message)
+	return err
+}
+
+// logExportWriter writes log entries to an export file, opening and
+// closing the array of a JSON export.
+type logExportWriter struct {
+	w      io.Writer
+	format logExportFormat
+	count  int
+}
+
+func (e *logExportWriter) write(entry logEntry) error {
+	if e.format != logExportJSON {
+		if err := writeLogEntry(e.w, entry, e.format); err != nil {
+			return err
+		}
+		e.count++
+		return nil
+	}
+	b, err := json.Marshal(jsonLogLine{
+		Timestamp: entry.timestamp.UTC().Format(time.RFC3339Nano),
+		Message:   entry.message,
+		LogStream: entry.stream,
+	})
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if e.count == 0 {
+		sep = "[\n  "
+	}
+	if _, err := fmt.Fprintf(e.w, "%s%s", sep, b); err != nil {
+		return err
+	}
+	e.count++
+	return nil
+}
+
+// close ends a JSON array; other formats need nothing
+func (e *logExportWriter) close() error {
+	if e.format != logExportJSON {
+		return nil
+	}
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// parseExportRange parses the time range prompt.
+// Accepted forms:
+//   - a duration such as "30m" or "6h" meaning [now-d, now]
+//   - "<start>..<end>" with RFC3339 timestamps; an empty end means now
+func parseExportRange(s string, now time.Time) (time.Time, time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("empty time range")
+	}
+
+	if startStr, endStr, ok := strings.Cut(s, ".."); ok {
+		start, err := time.Parse(time.RFC3339, strings.TrimSpace(startStr))
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start time %q (want RFC3339)", startStr)
+		}
+		end := now
+		if endStr = strings.TrimSpace(endStr); endStr != "" {
+			end, err = time.Parse(time.RFC3339, endStr)
+			if err != nil {
+				return time.Time{}, time.Time{}, fmt.Errorf("invalid end time %q (want RFC3339)", endStr)
+			}
+		}
+		if !start.Before(end) {
+			return time.Time{}, time.Time{}, fmt.Errorf("start time must be before end time")
+		}
+		return start, end, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid time range %q (e.g. 6h or 2024-01-02T15:04:05Z..)", s)
+	}
+	if d <= 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range must be positive")
+	}
+	return now.Add(-d), now, nil
+}
+
+// defaultExportPath builds the prefilled file path for an export.
+func (v *LogView) defaultExportPath(now time.Time) string {
+	name := strings.Trim(v.logGroupName, "/")
+	if v.logStreamName != "" {
+		name += "-" + v.logStreamName
+	}
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ', '[', ']', '$':
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" {
+		name = "logs"
+	}
+	return filepath.Join(config.File().LogExportDir(), fmt.Sprintf("%s-%s.log", name, now.Format("20060102-150405")))
+}
+
+// displayedEntries returns the buffered entries that pass the current filter.
+func (v *LogView) displayedEntries() []logEntry {
+	entries := make([]logEntry, 0, len(v.logs))
+	for _, entry := range v.logs {
+		if v.matchesFilter(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// logExportProgressMsg reports the number of events written so far.
+type logExportProgressMsg struct {
+	job   *logExportJob
+	count int
+}
+
+// logExportDoneMsg is sent when a save or export finishes.
+type logExportDoneMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+// logExportJob is a running time-range export.
+// The goroutine owns the file, so it is always closed even if the view
+// is no longer current and stops listening.
+type logExportJob struct {
+	progress chan int
+	done     chan logExportDoneMsg
+	cancel   context.CancelFunc
+}
+
+func (v *LogView) startExport(rangeExport bool) tea.Cmd {
+	if v.exportJob != nil {
+		return nil
+	}
+	v.exportRange = rangeExport
+	v.exportErr = nil
+	v.exportStatus = ""
+	if rangeExport {
+		v.exportStep = exportStepRange
+		v.exportInput.Prompt = "Range: "
+		v.exportInput.Placeholder = "6h or 2024-01-02T15:04:05Z..2024-01-02T16:00:00Z"
+		v.exportInput.SetValue(defaultExportRange)
+	} else {
+		v.exportStep = exportStepPath
+		v.exportInput.Prompt = "Save to: "
+		v.exportInput.Placeholder = "path (.jsonl for JSON Lines, .json for a JSON array)"
+		v.exportInput.SetValue(v.defaultExportPath(time.Now()))
+	}
+	v.exportInput.CursorEnd()
+	v.exportInput.Focus()
+	v.relayout()
+	return textinput.Blink
+}
+
+func (v *LogView) cancelExportPrompt() {
+	v.exportStep = exportStepNone
+	v.exportInput.Blur()
+	v.relayout()
+}
+
+// relayout recalculates the viewport height after the header changed.
+func (v *LogView) relayout() {
+	if v.vp.Ready {
+		v.SetSize(v.width, v.height)
+	}
+}
+
+func (v *LogView) handleExportInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.cancelExportPrompt()
+		return v, nil
+	case "enter":
+		value := strings.TrimSpace(v.exportInput.Value())
+		if v.exportStep == exportStepRange {
+			start, end, err := parseExportRange(value, time.Now())
+			if err != nil {
+				v.exportErr = err
+				return v, nil
+			}
+			v.exportErr = nil
+			v.exportStart, v.exportEnd = start, end
+			v.exportStep = exportStepPath
+			v.exportInput.Prompt = "Save to: "
+			v.exportInput.Placeholder = "path (.jsonl for JSON Lines, .json for a JSON array)"
+			v.exportInput.SetValue(v.defaultExportPath(time.Now()))
+			v.exportInput.CursorEnd()
+			return v, nil
+		}
+
+		if value == "" {
+			v.exportErr = fmt.Errorf("empty file path")
+			return v, nil
+		}
+		path, err := config.ExpandPath(value)
+		if err != nil {
+			v.exportErr = err
+			return v, nil
+		}
+		v.cancelExportPrompt()
+		if v.exportRange {
+			return v, v.runRangeExport(path)
+		}
+		return v, v.saveBufferCmd(path)
+	default:
+		var cmd tea.Cmd
+		v.exportInput, cmd = v.exportInput.Update(msg)
+		return v, cmd
+	}
+}
+
+// saveBufferCmd writes the currently displayed log lines to path.
+func (v *LogView) saveBufferCmd(path string) tea.Cmd {
+	entries := v.displayedEntries()
+	return func() tea.Msg {
+		count, err := writeLogFile(path, func(w *logExportWriter) error {
+			for _, entry := range entries {
+				if err := w.write(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return logExportDoneMsg{path: path, count: count, err: err}
+	}
+}
+
+// writeLogFile creates path and calls write with a buffered writer in the
+// format of the path, returning the number of entries written.
+func writeLogFile(path string, write func(*logExportWriter) error) (int, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return 0, apperrors.Wrap(err, "create export directory")
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, apperrors.Wrap(err, "create export file")
+	}
+	w := bufio.NewWriter(f)
+	ew := &logExportWriter{w: w, format: logExportFormatForPath(path)}
+	writeErr := write(ew)
+	if writeErr == nil {
+		writeErr = ew.close()
+	}
+	count := ew.count
+	if err := w.Flush(); err != nil && writeErr == nil {
+		writeErr = err
+	}
+	if err := f.Close(); err != nil && writeErr == nil {
+		writeErr = err
+	}
+	if writeErr != nil {
+		return count, apperrors.Wrap(writeErr, "write log export")
+	}
+	return count, nil
+}
+
+// runRangeExport pages through FilterLogEvents for the selected range.
+func (v *LogView) runRangeExport(path string) tea.Cmd {
+	if v.client == nil {
+		v.exportErr = fmt.Errorf("CloudWatch Logs client not initialized")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(v.ctx)
+	job := &logExportJob{
+		progress: make(chan int, 1),
+		done:     make(chan logExportDoneMsg, 1),
+		cancel:   cancel,
+	}
+	v.exportJob = job
+	v.exportCount = 0
+	v.relayout()
+
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: appaws.StringPtr(v.logGroupName),
+		StartTime:    appaws.Int64Ptr(v.exportStart.UnixMilli()),
+		EndTime:      appaws.Int64Ptr(v.exportEnd.UnixMilli()),
+		Limit:        appaws.Int32Ptr(exportPageLimit),
+	}
+	if v.logStreamName != "" {
+		input.LogStreamNames = []string{v.logStreamName}
+	}
+
+	client := v.client
+	filter := strings.ToLower(v.filterText)
+
+	go func() {
+		defer cancel()
+		count, err := writeLogFile(path, func(w *logExportWriter) error {
+			paginator := cloudwatchlogs.NewFilterLogEventsPaginator(client, input)
+			for paginator.HasMorePages() {
+				pageCtx, cancel := context.WithTimeout(ctx, config.File().LogFetchTimeout())
+				page, err := paginator.NextPage(pageCtx)
+				cancel()
+				if err != nil {
+					return err
+				}
+				for _, event := range page.Events {
+					entry := newLogEntry(event)
+					if filter != "" && !strings.Contains(strings.ToLower(entry.message), filter) {
+						continue
+					}
+					if err := w.write(entry); err != nil {
+						return err
+					}
+				}
+				// Non-blocking: the listener only needs the latest count
+				select {
+				case <-job.progress:
+				default:
+				}
+				job.progress <- w.count
+			}
+			return nil
+		})
+		switch {
+		case ctx.Err() != nil:
+			// Cancelled: drop the partial file
+			err = context.Canceled
+			_ = os.Remove(path)
+		case err != nil:
+			log.Warn("log export failed", "path", path, "error", err)
+		}
+		job.done <- logExportDoneMsg{path: path, count: count, err: err}
+	}()
+
+	return waitForExport(job)
+}
+
+// waitForExport waits for the next progress update or completion of job.
+func waitForExport(job *logExportJob) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case msg := <-job.done:
+			return msg
+		case count := <-job.progress:
+			return logExportProgressMsg{job: job, count: count}
+		}
+	}
+}
+
+// pipeCmd hands the displayed log lines to the configured pipe command.
+func (v *LogView) pipeCmd() tea.Cmd {
+	var sb strings.Builder
+	for _, entry := range v.displayedEntries() {
+		_ = writeLogEntry(&sb, entry, logExportText)
+	}
+	pipe := &action.PipeExec{
+		Command: config.File().LogPipeCommand(),
+		Input:   strings.NewReader(sb.String()),
+	}
+	return tea.Exec(pipe, func(err error) tea.Msg {
+		if err != nil {
+			return ErrorMsg{Err: apperrors.Wrap(err, "pipe logs")}
+		}
+		return nil
+	})
+}
+
+// cancelExport stops a running range export; its done message follows
+func (v *LogView) cancelExport() {
+	if v.exportJob != nil {
+		v.exportJob.cancel()
+	}
+}
+
+func (v *LogView) handleExportMsg(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case logExportProgressMsg:
+		if msg.job == v.exportJob {
+			v.exportCount = msg.count
+		}
+		return waitForExport(msg.job)
+	case logExportDoneMsg:
+		v.exportJob = nil
+		if errors.Is(msg.err, context.Canceled) {
+			v.exportErr = nil
+			v.exportStatus = fmt.Sprintf("Export cancelled after %d lines", msg.count)
+			v.relayout()
+			return nil
+		}
+		if msg.err != nil {
+			v.exportErr = msg.err
+			v.exportStatus = ""
+			v.relayout()
+			return nil
+		}
+		v.exportErr = nil
+		v.exportStatus = fmt.Sprintf("Saved %d lines to %s", msg.count, msg.path)
+		v.relayout()
+	}
+	return nil
+}
+
+// exportStatusLine returns the export progress/result line shown above the logs.
+func (v *LogView) exportStatusLine() string {
+	switch {
+	case v.exportStep != exportStepNone:
+		line := v.exportInput.View()
+		if v.exportErr != nil {
+			line += "  " + v.styles.error.Render(v.exportErr.Error())
+		}
+		return line
+	case v.exportJob != nil:
+		return v.styles.dim.Render(fmt.Sprintf("⬇ Exporting %s → %s... %d events (Esc to cancel)",
+			v.exportStart.Format(time.DateTime), v.exportEnd.Format(time.DateTime), v.exportCount))
+	case v.exportErr != nil:
+		return v.styles.error.Render(fmt.Sprintf("Export failed: %v", v.exportErr))
+	case v.exportStatus != "":
+		return v.styles.dim.Render("✓ " + v.exportStatus)
+	}
+	return ""
+}
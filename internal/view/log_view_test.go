@@ -2,7 +2,10 @@ package view
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -525,3 +528,222 @@ func TestLogViewFilterUnicode(t *testing.T) {
 		t.Error("Unicode truncation broke character encoding")
 	}
 }
+
+func TestParseExportRange(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{"duration", "6h", now.Add(-6 * time.Hour), now, false},
+		{"duration with spaces", " 30m ", now.Add(-30 * time.Minute), now, false},
+		{"absolute range", "2024-01-01T00:00:00Z..2024-01-01T12:00:00Z",
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), false},
+		{"open end", "2024-01-01T00:00:00Z..", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), now, false},
+		{"empty", "", time.Time{}, time.Time{}, true},
+		{"negative duration", "-1h", time.Time{}, time.Time{}, true},
+		{"invalid duration", "yesterday", time.Time{}, time.Time{}, true},
+		{"invalid start", "2024-01-01..", time.Time{}, time.Time{}, true},
+		{"start after end", "2024-01-02T00:00:00Z..2024-01-01T00:00:00Z", time.Time{}, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := parseExportRange(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseExportRange(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestWriteLogEntry(t *testing.T) {
+	entry := logEntry{
+		timestamp: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		message:   `hello "world"`,
+		stream:    "stream-1",
+	}
+
+	var text strings.Builder
+	if err := writeLogEntry(&text, entry, logExportText); err != nil {
+		t.Fatalf("writeLogEntry(text) error = %v", err)
+	}
+	if got, want := text.String(), "2024-01-02T15:04:05Z hello \"world\"\n"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+
+	var jsonl strings.Builder
+	if err := writeLogEntry(&jsonl, entry, logExportJSONL); err != nil {
+		t.Fatalf("writeLogEntry(jsonl) error = %v", err)
+	}
+	want := `{"timestamp":"2024-01-02T15:04:05Z","message":"hello \"world\"","logStream":"stream-1"}` + "\n"
+	if got := jsonl.String(); got != want {
+		t.Errorf("jsonl = %q, want %q", got, want)
+	}
+}
+
+func TestLogExportFormatForPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want logExportFormat
+	}{
+		{"out.log", logExportText},
+		{"out.txt", logExportText},
+		{"out", logExportText},
+		{"out.jsonl", logExportJSONL},
+		{"OUT.JSON", logExportJSON},
+	}
+	for _, tt := range tests {
+		if got := logExportFormatForPath(tt.path); got != tt.want {
+			t.Errorf("logExportFormatForPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestWriteLogFileJSON(t *testing.T) {
+	entries := []logEntry{
+		{timestamp: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), message: "one"},
+		{timestamp: time.Date(2024, 1, 2, 15, 4, 6, 0, time.UTC), message: "two"},
+	}
+	dir := t.TempDir()
+	for _, tt := range []struct {
+		entries []logEntry
+		want    int
+	}{{entries, 2}, {nil, 0}} {
+		path := filepath.Join(dir, "out.json")
+		count, err := writeLogFile(path, func(w *logExportWriter) error {
+			for _, entry := range tt.entries {
+				if err := w.write(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil || count != tt.want {
+			t.Fatalf("writeLogFile() = %d, %v; want %d", count, err, tt.want)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		var lines []jsonLogLine
+		if err := json.Unmarshal(data, &lines); err != nil {
+			t.Fatalf(".json export is not a JSON array: %v\n%s", err, data)
+		}
+		if len(lines) != tt.want {
+			t.Errorf("JSON array has %d entries, want %d", len(lines), tt.want)
+		}
+	}
+}
+
+func TestLogViewCancelExport(t *testing.T) {
+	lv := NewLogView(context.Background(), "/aws/test")
+	lv.SetSize(80, 24)
+	lv.loading = false
+
+	cancelled := false
+	lv.exportJob = &logExportJob{cancel: func() { cancelled = true }}
+	if !lv.HasActiveInput() {
+		t.Fatal("a running export should capture Esc")
+	}
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if !cancelled {
+		t.Fatal("Esc should cancel the running export")
+	}
+
+	lv.Update(logExportDoneMsg{path: "out.log", count: 3, err: context.Canceled})
+	if lv.exportJob != nil || lv.exportErr != nil || !strings.Contains(lv.exportStatus, "cancelled after 3") {
+		t.Errorf("after cancel: job = %v, err = %v, status = %q", lv.exportJob, lv.exportErr, lv.exportStatus)
+	}
+	if lv.HasActiveInput() {
+		t.Error("Esc should leave the view once the export stopped")
+	}
+}
+
+func TestLogViewSaveBuffer(t *testing.T) {
+	ctx := context.Background()
+	lv := NewLogView(ctx, "/aws/test")
+	lv.SetSize(80, 24)
+	lv.loading = false
+	lv.logs = []logEntry{
+		{timestamp: time.Now(), message: "ERROR: failed"},
+		{timestamp: time.Now(), message: "INFO: ok"},
+	}
+	lv.filterText = "error"
+
+	lv.Update(tea.KeyPressMsg{Code: 0, Text: "s"})
+	if !lv.HasActiveInput() {
+		t.Fatal("Expected HasActiveInput to be true after 's'")
+	}
+
+	path := filepath.Join(t.TempDir(), "out.log")
+	lv.exportInput.SetValue(path)
+	_, cmd := lv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if lv.HasActiveInput() {
+		t.Error("Expected HasActiveInput to be false after Enter")
+	}
+	if cmd == nil {
+		t.Fatal("Expected save command")
+	}
+
+	lv.Update(cmd())
+	if lv.exportErr != nil {
+		t.Fatalf("exportErr = %v", lv.exportErr)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(data), "ERROR: failed") || strings.Contains(string(data), "INFO: ok") {
+		t.Errorf("saved file should contain only filtered lines, got %q", string(data))
+	}
+	if !strings.Contains(lv.exportStatus, "Saved 1 lines") {
+		t.Errorf("exportStatus = %q, want saved count", lv.exportStatus)
+	}
+}
+
+func TestLogViewExportRangePrompt(t *testing.T) {
+	ctx := context.Background()
+	lv := NewLogView(ctx, "/aws/test")
+	lv.SetSize(80, 24)
+	lv.loading = false
+
+	lv.Update(tea.KeyPressMsg{Code: 0, Text: "S"})
+	if lv.exportStep != exportStepRange {
+		t.Fatalf("exportStep = %v, want exportStepRange", lv.exportStep)
+	}
+
+	lv.exportInput.SetValue("bogus")
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if lv.exportStep != exportStepRange || lv.exportErr == nil {
+		t.Error("Expected invalid range to keep the range prompt with an error")
+	}
+
+	lv.exportInput.SetValue("2h")
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if lv.exportStep != exportStepPath {
+		t.Errorf("exportStep = %v, want exportStepPath", lv.exportStep)
+	}
+	if got := lv.exportEnd.Sub(lv.exportStart); got != 2*time.Hour {
+		t.Errorf("export range = %v, want 2h", got)
+	}
+
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if lv.HasActiveInput() {
+		t.Error("Expected HasActiveInput to be false after Esc")
+	}
+}
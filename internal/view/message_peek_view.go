@@ -0,0 +1,453 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/sqsmsg"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const messagePeekHeaderHeight = 3 // title(1) + summary(1) + status(1)
+
+// errPeekReadOnly explains why peeking is denied in read-only mode
+var errPeekReadOnly = fmt.Errorf("%w: peeking receives messages, which raises their receive count", action.ErrReadOnlyDenied)
+
+type messagesPeekedMsg struct {
+	client   sqsmsg.Client // set when the client was created
+	messages []types.Message
+	err      error
+}
+
+type messageDeletedMsg struct {
+	id  string
+	err error
+}
+
+type messagePeekStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	selected lipgloss.Style
+	ok       lipgloss.Style
+	danger   lipgloss.Style
+	bold     lipgloss.Style
+	input    lipgloss.Style
+	box      lipgloss.Style
+}
+
+func newMessagePeekStyles() messagePeekStyles {
+	t := ui.Current()
+	return messagePeekStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.AccentStyle().Bold(true),
+		selected: ui.SelectedStyle(),
+		ok:       ui.SuccessStyle(),
+		danger:   ui.DangerStyle(),
+		bold:     ui.TextStyle().Bold(true),
+		input:    ui.InputStyle(),
+		box:      ui.BoxStyle().BorderForeground(t.Danger).MarginTop(1),
+	}
+}
+
+// MessagePeekView shows a sample of the messages in an SQS queue. Peeking
+// receives the messages, which raises their receive count, so it runs
+// once when the view opens: the view is not refreshed, and peeking is
+// denied in read-only mode.
+type MessagePeekView struct {
+	ctx      context.Context
+	client   sqsmsg.Client
+	queueURL string
+
+	messages []types.Message
+	idx      int
+	peekedAt time.Time
+
+	loading   bool
+	err       error
+	notice    string
+	dangerous dangerousState
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  messagePeekStyles
+}
+
+// NewMessagePeekView creates a view that peeks at a queue
+func NewMessagePeekView(ctx context.Context, queueURL string) *MessagePeekView {
+	return &MessagePeekView{
+		ctx:      ctx,
+		queueURL: queueURL,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newMessagePeekStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *MessagePeekView) Init() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.loading = false
+		v.err = errPeekReadOnly
+		return nil
+	}
+	return tea.Batch(v.peekCmd(), v.spinner.Tick)
+}
+
+// peekCmd receives a sample of messages and makes them visible again
+func (v *MessagePeekView) peekCmd() tea.Cmd {
+	ctx, client, queueURL := v.ctx, v.client, v.queueURL
+	return func() tea.Msg {
+		var created sqsmsg.Client
+		if client == nil {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return messagesPeekedMsg{err: apperrors.Wrap(err, "init AWS config")}
+			}
+			client = sqs.NewFromConfig(cfg)
+			created = client
+		}
+		messages, err := sqsmsg.Peek(ctx, client, queueURL)
+		return messagesPeekedMsg{client: created, messages: messages, err: err}
+	}
+}
+
+// deleteCmd deletes a message with its receipt handle from the peek
+func (v *MessagePeekView) deleteCmd(m types.Message) tea.Cmd {
+	ctx, client, queueURL := v.ctx, v.client, v.queueURL
+	return func() tea.Msg {
+		return messageDeletedMsg{id: appaws.Str(m.MessageId), err: sqsmsg.Delete(ctx, client, queueURL, m)}
+	}
+}
+
+// queueName returns the name of the queue
+func (v *MessagePeekView) queueName() string {
+	return appaws.ExtractResourceName(v.queueURL)
+}
+
+func (v *MessagePeekView) selected() (types.Message, bool) {
+	if v.idx < len(v.messages) {
+		return v.messages[v.idx], true
+	}
+	return types.Message{}, false
+}
+
+// Update implements tea.Model
+func (v *MessagePeekView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case messagesPeekedMsg:
+		v.loading = false
+		if msg.client != nil {
+			v.client = msg.client
+		}
+		v.err = msg.err
+		if msg.err == nil {
+			v.messages = msg.messages
+			v.peekedAt = time.Now()
+		}
+		v.updateContent()
+		return v, nil
+
+	case messageDeletedMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.messages = slices.DeleteFunc(v.messages, func(m types.Message) bool {
+				return appaws.Str(m.MessageId) == msg.id
+			})
+			v.idx = min(v.idx, max(len(v.messages)-1, 0))
+			v.notice = "Deleted message " + msg.id
+		}
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.dangerous.active {
+			return v, v.handleDeleteConfirmKey(msg)
+		}
+		return v, v.handleKey(msg)
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newMessagePeekStyles()
+		v.updateContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *MessagePeekView) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if v.loading {
+		return nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		v.idx = max(v.idx-1, 0)
+	case "down", "j":
+		v.idx = min(v.idx+1, max(len(v.messages)-1, 0))
+	case "g":
+		v.idx = 0
+	case "G":
+		v.idx = max(len(v.messages)-1, 0)
+	case "y":
+		m, ok := v.selected()
+		if !ok {
+			return nil
+		}
+		return clipboard.Copy("message body", appaws.Str(m.Body))
+	case "D":
+		m, ok := v.selected()
+		if !ok {
+			return nil
+		}
+		if config.Global().ReadOnly() {
+			v.err = action.ErrReadOnlyDenied
+			return nil
+		}
+		v.notice = ""
+		v.dangerous = dangerousState{active: true, token: appaws.Str(m.MessageId)}
+		return nil
+	default:
+		if v.vp.Ready {
+			var cmd tea.Cmd
+			v.vp.Model, cmd = v.vp.Model.Update(msg)
+			return cmd
+		}
+		return nil
+	}
+	v.updateContent()
+	return nil
+}
+
+func (v *MessagePeekView) handleDeleteConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch {
+	case IsEscKey(msg):
+		v.dangerous = dangerousState{}
+	case msg.String() == "enter":
+		if !action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+			return nil
+		}
+		v.dangerous = dangerousState{}
+		m, ok := v.selected()
+		if !ok {
+			return nil
+		}
+		v.loading = true
+		v.err = nil
+		return tea.Batch(v.deleteCmd(m), v.spinner.Tick)
+	case msg.Code == tea.KeyBackspace || msg.String() == "backspace":
+		if len(v.dangerous.input) > 0 {
+			v.dangerous.input = v.dangerous.input[:len(v.dangerous.input)-1]
+		}
+	case len(msg.String()) == 1:
+		v.dangerous.input += msg.String()
+	}
+	return nil
+}
+
+func (v *MessagePeekView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	content, cursor := v.renderContent()
+	v.vp.Model.SetContent(content)
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(cursor)
+		} else if cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(cursor - height + 1)
+		}
+	}
+}
+
+// renderContent renders the messages and the selected one in full, and
+// returns the line of the cursor
+func (v *MessagePeekView) renderContent() (string, int) {
+	s := v.styles
+	if len(v.messages) == 0 {
+		return s.dim.Render("No messages: the queue is empty, or short polling missed them"), 0
+	}
+
+	lines := []string{s.label.Render(fmt.Sprintf("  %-38s %-10s %-9s %-10s %s", "MESSAGE ID", "SENT", "RECEIVES", "SIZE", "BODY"))}
+	cursor := 0
+	for i, m := range v.messages {
+		sent := ""
+		if t := sqsmsg.SentTime(m); !t.IsZero() {
+			sent = render.FormatAge(t)
+		}
+		line := fmt.Sprintf("  %-38s %-10s %-9s %-10s %s", appaws.Str(m.MessageId), sent, sqsmsg.ReceiveCount(m),
+			render.FormatSize(int64(len(appaws.Str(m.Body)))), strings.Join(strings.Fields(appaws.Str(m.Body)), " "))
+		line = TruncateString(line, max(v.width, 20))
+		if i == v.idx {
+			cursor = len(lines)
+			line = s.selected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	m := v.messages[v.idx]
+	lines = append(lines, "", s.label.Render("Message "+appaws.Str(m.MessageId)))
+	for _, f := range messageFields(m) {
+		lines = append(lines, fmt.Sprintf("  %-22s %s", f[0], f[1]))
+	}
+	lines = append(lines, "", s.label.Render("Body"))
+	for _, l := range strings.Split(sqsmsg.PrettyBody(m), "\n") {
+		lines = append(lines, "  "+l)
+	}
+	return strings.Join(lines, "\n"), cursor
+}
+
+// messageFields lists the receive details and attributes of a message
+func messageFields(m types.Message) [][2]string {
+	var fields [][2]string
+	if t := sqsmsg.SentTime(m); !t.IsZero() {
+		fields = append(fields, [2]string{"Sent", t.Format("2006-01-02 15:04:05")})
+	}
+	if t := sqsmsg.FirstReceiveTime(m); !t.IsZero() {
+		fields = append(fields, [2]string{"First Received", t.Format("2006-01-02 15:04:05")})
+	}
+	fields = append(fields, [2]string{"Receive Count", sqsmsg.ReceiveCount(m)})
+	if group := sqsmsg.Attribute(m, types.MessageSystemAttributeNameMessageGroupId); group != "" {
+		fields = append(fields,
+			[2]string{"Message Group", group},
+			[2]string{"Deduplication ID", sqsmsg.Attribute(m, types.MessageSystemAttributeNameMessageDeduplicationId)},
+		)
+	}
+
+	names := make([]string, 0, len(m.MessageAttributes))
+	for name := range m.MessageAttributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fields = append(fields, [2]string{name, formatMessageAttribute(m.MessageAttributes[name])})
+	}
+	return fields
+}
+
+// formatMessageAttribute shows an attribute value with its data type
+func formatMessageAttribute(a types.MessageAttributeValue) string {
+	dataType := appaws.Str(a.DataType)
+	if a.StringValue != nil {
+		return fmt.Sprintf("%s (%s)", *a.StringValue, dataType)
+	}
+	if a.BinaryValue != nil {
+		return fmt.Sprintf("<%d bytes> (%s)", len(a.BinaryValue), dataType)
+	}
+	return "(" + dataType + ")"
+}
+
+func (v *MessagePeekView) renderConfirm() string {
+	s := v.styles
+	t := ui.Current()
+	content := ui.BoldDangerStyle().Render("⚠ DANGER") + "\n\n"
+	content += fmt.Sprintf("You are about to %s from %s:\n", s.danger.Render("delete a message"), v.queueName())
+	content += s.bold.Render(v.dangerous.token) + "\n\n"
+
+	suffix := action.ConfirmSuffix(v.dangerous.token)
+	if len(suffix) < len(v.dangerous.token) {
+		content += fmt.Sprintf("Type last %d chars: ...%s\n", len(suffix), suffix)
+	} else {
+		content += "Type to confirm:\n"
+	}
+	inputStyle := s.input
+	if action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Success)
+	} else if len(v.dangerous.input) > 0 && strings.HasPrefix(suffix, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Warning)
+	}
+	content += inputStyle.Render(v.dangerous.input+"▌") + "\n\n"
+	content += s.dim.Render("Press Enter to confirm, Esc to cancel")
+	return s.box.Render(content)
+}
+
+// ViewString renders the view
+func (v *MessagePeekView) ViewString() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(s.title.Render("📨 "+v.queueName()) + "\n")
+	if !v.peekedAt.IsZero() {
+		summary := fmt.Sprintf("%d message(s) • peeked at %s • each peek counts as a receive",
+			len(v.messages), v.peekedAt.Format("15:04:05"))
+		out.WriteString(s.dim.Render(TruncateString(summary, v.width)))
+	}
+	out.WriteString("\n")
+
+	switch {
+	case v.loading:
+		out.WriteString(v.spinner.View() + " Peeking...")
+	case v.err != nil:
+		out.WriteString(s.danger.Render(TruncateString(v.err.Error(), v.width)))
+	case v.notice != "":
+		out.WriteString(s.ok.Render(TruncateString(v.notice, v.width)))
+	}
+	out.WriteString("\n")
+
+	if v.dangerous.active {
+		out.WriteString(v.renderConfirm())
+		return out.String()
+	}
+	if v.vp.Ready && !v.peekedAt.IsZero() {
+		out.WriteString(v.vp.Model.View())
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *MessagePeekView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *MessagePeekView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.vp.SetSize(width, max(height-messagePeekHeaderHeight, 1))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *MessagePeekView) StatusLine() string {
+	if v.dangerous.active {
+		return "Type to confirm • Enter:delete • Esc:cancel"
+	}
+	return "j/k:message y:copy body D:delete • q/esc:back (p on the queue peeks again)"
+}
+
+// HasActiveInput implements InputCapture
+func (v *MessagePeekView) HasActiveInput() bool {
+	return v.dangerous.active
+}
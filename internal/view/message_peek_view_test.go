@@ -0,0 +1,136 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+const testQueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/orders"
+
+// fakeQueueClient returns the same messages on the first receive, then none
+type fakeQueueClient struct {
+	messages []types.Message
+	receives int
+	deleted  []string
+}
+
+func (f *fakeQueueClient) ReceiveMessage(_ context.Context, _ *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	f.receives++
+	if f.receives > 1 {
+		return &sqs.ReceiveMessageOutput{}, nil
+	}
+	return &sqs.ReceiveMessageOutput{Messages: f.messages}, nil
+}
+
+func (f *fakeQueueClient) ChangeMessageVisibilityBatch(_ context.Context, _ *sqs.ChangeMessageVisibilityBatchInput, _ ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityBatchOutput, error) {
+	return &sqs.ChangeMessageVisibilityBatchOutput{}, nil
+}
+
+func (f *fakeQueueClient) DeleteMessage(_ context.Context, in *sqs.DeleteMessageInput, _ ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+	f.deleted = append(f.deleted, aws.ToString(in.ReceiptHandle))
+	return &sqs.DeleteMessageOutput{}, nil
+}
+
+// runPeekCmd runs a command, feeding peeks and deletes back into the view
+func runPeekCmd(v *MessagePeekView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runPeekCmd(v, c)
+		}
+	case messagesPeekedMsg, messageDeletedMsg:
+		v.Update(msg)
+	}
+}
+
+func newTestPeekView(t *testing.T) (*MessagePeekView, *fakeQueueClient) {
+	t.Helper()
+	client := &fakeQueueClient{messages: []types.Message{
+		{MessageId: aws.String("msg-1"), ReceiptHandle: aws.String("rh-1"), Body: aws.String(`{"order":1}`),
+			Attributes: map[string]string{"ApproximateReceiveCount": "2"}},
+		{MessageId: aws.String("msg-2"), ReceiptHandle: aws.String("rh-2"), Body: aws.String("plain text")},
+	}}
+	v := NewMessagePeekView(context.Background(), testQueueURL)
+	v.client = client
+	v.SetSize(120, 30)
+	runPeekCmd(v, v.Init())
+	return v, client
+}
+
+func TestMessagePeekView_PeeksOnce(t *testing.T) {
+	v, client := newTestPeekView(t)
+
+	out := v.ViewString()
+	for _, want := range []string{"orders", "2 message(s)", "msg-1", "msg-2", `"order": 1`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	receives := client.receives
+
+	// Peeking changes the queue, so the view is never refreshed
+	if _, ok := any(v).(Refreshable); ok {
+		t.Error("MessagePeekView should not be refreshable")
+	}
+	v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	v.Update(RefreshMsg{})
+	if client.receives != receives {
+		t.Errorf("receives = %d after refresh, want %d", client.receives, receives)
+	}
+}
+
+func TestMessagePeekView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	client := &fakeQueueClient{}
+	v := NewMessagePeekView(context.Background(), testQueueURL)
+	v.client = client
+	v.SetSize(120, 30)
+	runPeekCmd(v, v.Init())
+
+	if client.receives != 0 {
+		t.Errorf("receives = %d, want no peek in read-only mode", client.receives)
+	}
+	if out := v.ViewString(); !strings.Contains(out, "read-only") {
+		t.Errorf("view should say peeking is denied:\n%s", out)
+	}
+}
+
+func TestMessagePeekView_Delete(t *testing.T) {
+	v, client := newTestPeekView(t)
+
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	if !v.HasActiveInput() {
+		t.Fatal("D should ask for typed confirmation")
+	}
+	// Enter without the token does nothing
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(client.deleted) != 0 {
+		t.Fatal("delete ran without confirmation")
+	}
+	for _, r := range "msg-2" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runPeekCmd(v, cmd)
+
+	if len(client.deleted) != 1 || client.deleted[0] != "rh-2" {
+		t.Errorf("deleted = %v, want the receipt handle of msg-2", client.deleted)
+	}
+	if out := v.ViewString(); strings.Contains(out, "plain text") || !strings.Contains(out, "Deleted message msg-2") {
+		t.Errorf("deleted message should be gone:\n%s", out)
+	}
+}
@@ -0,0 +1,425 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/metrics"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	chartHeight       = 8
+	chartHeaderOffset = 3 // title(1) + settings(1) + spacing(1)
+	maxChartPoints    = 360
+)
+
+var (
+	chartWindows = []time.Duration{time.Hour, 3 * time.Hour, 12 * time.Hour, 24 * time.Hour, 3 * 24 * time.Hour, 7 * 24 * time.Hour}
+	chartPeriods = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, time.Hour}
+	// chartStats are CloudWatch statistics; empty means each metric's default stat.
+	chartStats = []string{"", "Average", "Maximum", "p50", "p90", "p99"}
+)
+
+// metricChartSpecs returns the metrics to plot for a resource type, or nil if it has none.
+// Metric columns configured for the resource type are appended.
+func metricChartSpecs(renderer render.Renderer, service, resourceType string) []render.MetricSpec {
+	var specs []render.MetricSpec
+	if provider, ok := renderer.(render.MetricChartProvider); ok {
+		specs = provider.MetricCharts()
+	}
+	if provider, ok := renderer.(render.MetricSpecProvider); ok && len(specs) == 0 {
+		specs = provider.MetricSpecs()
+	}
+	return append(specs, customMetricSpecs(service, resourceType)...)
+}
+
+// autoChartPeriod returns the smallest period that keeps window within maxChartPoints.
+func autoChartPeriod(window time.Duration) time.Duration {
+	for _, p := range chartPeriods {
+		if window/p <= maxChartPoints {
+			return p
+		}
+	}
+	return chartPeriods[len(chartPeriods)-1]
+}
+
+// metricsChartStyles holds cached lipgloss styles for performance
+type metricsChartStyles struct {
+	title     lipgloss.Style
+	section   lipgloss.Style
+	chart     lipgloss.Style
+	dim       lipgloss.Style
+	error     lipgloss.Style
+	alarm     lipgloss.Style
+	threshold lipgloss.Style
+}
+
+func newMetricsChartStyles() metricsChartStyles {
+	return metricsChartStyles{
+		title:     ui.TitleStyle(),
+		section:   ui.SectionStyle(),
+		chart:     ui.AccentStyle(),
+		dim:       ui.DimStyle(),
+		error:     ui.DangerStyle(),
+		alarm:     ui.DangerStyle(),
+		threshold: ui.WarningStyle(),
+	}
+}
+
+// MetricsChartView plots several CloudWatch metrics of a single resource.
+type MetricsChartView struct {
+	ctx      context.Context
+	resource dao.Resource
+	service  string
+	resType  string
+	specs    []render.MetricSpec
+
+	windowIdx      int
+	period         time.Duration
+	statIdx        int
+	showThresholds bool
+
+	series     []metrics.Series
+	thresholds [][]metrics.AlarmThreshold
+	start      time.Time
+	end        time.Time
+	loading    bool
+	err        error
+
+	vp      ViewportState
+	spinner spinner.Model
+	styles  metricsChartStyles
+	width   int
+	height  int
+}
+
+// NewMetricsChartView creates a new MetricsChartView
+func NewMetricsChartView(ctx context.Context, resource dao.Resource, specs []render.MetricSpec, service, resType string) *MetricsChartView {
+	windowIdx := 1
+	window := config.File().MetricsWindow()
+	for i, w := range chartWindows {
+		if w >= window {
+			windowIdx = i
+			break
+		}
+	}
+	return &MetricsChartView{
+		ctx:            ctx,
+		resource:       resource,
+		service:        service,
+		resType:        resType,
+		specs:          specs,
+		windowIdx:      windowIdx,
+		period:         autoChartPeriod(chartWindows[windowIdx]),
+		showThresholds: true,
+		loading:        true,
+		spinner:        ui.NewSpinner(),
+		styles:         newMetricsChartStyles(),
+	}
+}
+
+// metricsChartLoadedMsg is sent when chart data has been fetched
+type metricsChartLoadedMsg struct {
+	series     []metrics.Series
+	thresholds [][]metrics.AlarmThreshold
+	start      time.Time
+	end        time.Time
+	err        error
+}
+
+// Init implements tea.Model
+func (v *MetricsChartView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.loadCmd())
+}
+
+func (v *MetricsChartView) loadCmd() tea.Cmd {
+	ctx := v.ctx
+	res := dao.UnwrapResource(v.resource)
+	specs := v.specs
+	window := chartWindows[v.windowIdx]
+	query := metrics.SeriesQuery{Period: v.period, Stat: chartStats[v.statIdx]}
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, config.File().MetricsLoadTimeout())
+		defer cancel()
+
+		query.End = time.Now().Truncate(time.Minute)
+		query.Start = query.End.Add(-window)
+
+		fetcher, err := metrics.NewFetcher(ctx)
+		if err != nil {
+			return metricsChartLoadedMsg{err: err}
+		}
+		series, err := fetcher.FetchSeries(ctx, res, specs, query)
+		if err != nil {
+			return metricsChartLoadedMsg{err: err}
+		}
+
+		thresholds := make([][]metrics.AlarmThreshold, len(specs))
+		for i, spec := range specs {
+			th, err := fetcher.FetchAlarmThresholds(ctx, res, spec)
+			if err != nil {
+				log.Debug("failed to fetch alarm thresholds", "metric", spec.MetricName, "error", err)
+				continue
+			}
+			thresholds[i] = th
+		}
+
+		return metricsChartLoadedMsg{series: series, thresholds: thresholds, start: query.Start, end: query.End}
+	}
+}
+
+func (v *MetricsChartView) reload() tea.Cmd {
+	v.loading = true
+	return tea.Batch(v.spinner.Tick, v.loadCmd())
+}
+
+// Update implements tea.Model
+func (v *MetricsChartView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case metricsChartLoadedMsg:
+		v.loading = false
+		if msg.err != nil {
+			log.Warn("failed to load metrics", "error", msg.err)
+			v.err = msg.err
+		} else {
+			v.err = nil
+			v.series = msg.series
+			v.thresholds = msg.thresholds
+			v.start, v.end = msg.start, msg.end
+		}
+		v.updateContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newMetricsChartStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "w":
+			v.windowIdx = (v.windowIdx + 1) % len(chartWindows)
+			v.period = autoChartPeriod(chartWindows[v.windowIdx])
+			return v, v.reload()
+		case "p":
+			v.period = v.nextPeriod()
+			return v, v.reload()
+		case "s":
+			v.statIdx = (v.statIdx + 1) % len(chartStats)
+			return v, v.reload()
+		case "t":
+			v.showThresholds = !v.showThresholds
+			v.updateContent()
+			return v, nil
+		case "ctrl+r":
+			return v, v.reload()
+		}
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+// nextPeriod cycles through periods that keep the window within maxChartPoints.
+func (v *MetricsChartView) nextPeriod() time.Duration {
+	window := chartWindows[v.windowIdx]
+	minPeriod := autoChartPeriod(window)
+	for i, p := range chartPeriods {
+		if p == v.period {
+			next := chartPeriods[(i+1)%len(chartPeriods)]
+			if next < minPeriod {
+				return minPeriod
+			}
+			return next
+		}
+	}
+	return minPeriod
+}
+
+func (v *MetricsChartView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderContent())
+}
+
+func (v *MetricsChartView) renderContent() string {
+	s := v.styles
+	var sb strings.Builder
+
+	for i, series := range v.series {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		title := fmt.Sprintf("%s (%s)", series.Spec.MetricName, v.statLabel(series.Spec))
+		if series.HasData() {
+			latest := series.Values[len(series.Values)-1]
+			title += "  " + s.dim.Render("latest "+metrics.FormatChartValue(latest, series.Spec.Unit))
+		}
+		sb.WriteString(s.section.Render(title) + "\n")
+
+		var thresholds []metrics.AlarmThreshold
+		if v.showThresholds && i < len(v.thresholds) {
+			thresholds = v.thresholds[i]
+		}
+		values := make([]float64, len(thresholds))
+		for j, th := range thresholds {
+			values[j] = th.Threshold
+		}
+
+		if !series.HasData() && len(values) == 0 {
+			sb.WriteString(s.dim.Render("  No data in this time window") + "\n")
+			continue
+		}
+
+		chart := metrics.RenderChart(&series, metrics.ChartOptions{
+			Width:      v.width - 2,
+			Height:     chartHeight,
+			Start:      v.start,
+			End:        v.end,
+			Unit:       series.Spec.Unit,
+			Thresholds: values,
+		})
+		sb.WriteString(s.chart.Render(chart) + "\n")
+
+		for _, th := range thresholds {
+			line := fmt.Sprintf("  ┄ %s: %s %s", th.AlarmName, comparisonSymbol(th.Comparison),
+				metrics.FormatChartValue(th.Threshold, series.Spec.Unit))
+			style := s.threshold
+			if th.State == "ALARM" {
+				style = s.alarm
+			}
+			sb.WriteString(style.Render(line) + s.dim.Render(" ["+th.State+"]") + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func (v *MetricsChartView) statLabel(spec render.MetricSpec) string {
+	if stat := chartStats[v.statIdx]; stat != "" {
+		return stat
+	}
+	return spec.Stat
+}
+
+// comparisonSymbol converts a CloudWatch comparison operator to a short symbol.
+func comparisonSymbol(op string) string {
+	switch op {
+	case "GreaterThanThreshold":
+		return ">"
+	case "GreaterThanOrEqualToThreshold":
+		return ">="
+	case "LessThanThreshold":
+		return "<"
+	case "LessThanOrEqualToThreshold":
+		return "<="
+	default:
+		return op
+	}
+}
+
+func (v *MetricsChartView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	s := v.styles
+
+	var sb strings.Builder
+	res := dao.UnwrapResource(v.resource)
+	name := res.GetName()
+	if name == "" {
+		name = res.GetID()
+	}
+	sb.WriteString(s.title.Render(fmt.Sprintf("📈 %s/%s: %s", v.service, v.resType, name)))
+	sb.WriteString("\n")
+
+	stat := chartStats[v.statIdx]
+	if stat == "" {
+		stat = "default"
+	}
+	thresholds := "off"
+	if v.showThresholds {
+		thresholds = "on"
+	}
+	sb.WriteString(s.dim.Render(fmt.Sprintf("window: %s • period: %s • stat: %s • thresholds: %s",
+		formatChartDuration(chartWindows[v.windowIdx]), formatChartDuration(v.period), stat, thresholds)))
+	sb.WriteString("\n\n")
+
+	if v.loading && v.series == nil {
+		sb.WriteString(v.spinner.View() + " Loading metrics...")
+		return sb.String()
+	}
+	if v.err != nil {
+		sb.WriteString(s.error.Render(fmt.Sprintf("Error: %v", v.err)))
+		return sb.String()
+	}
+
+	sb.WriteString(v.vp.Model.View())
+	return sb.String()
+}
+
+// formatChartDuration formats window/period values compactly (e.g., 5m, 3h, 7d).
+func formatChartDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	default:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+}
+
+// View implements tea.Model
+func (v *MetricsChartView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *MetricsChartView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.vp.SetSize(width, max(height-chartHeaderOffset, minViewportHeight))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *MetricsChartView) StatusLine() string {
+	status := "w:window p:period s:stat t:thresholds Ctrl+r:refresh ↑/↓:scroll Esc:back"
+	if v.loading {
+		return v.spinner.View() + " loading • " + status
+	}
+	return status
+}
+
+// Resource returns the resource whose metrics are shown.
+func (v *MetricsChartView) Resource() dao.Resource {
+	return v.resource
+}
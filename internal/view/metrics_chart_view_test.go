@@ -0,0 +1,116 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/metrics"
+	"github.com/clawscli/claws/internal/render"
+)
+
+type mockChartRenderer struct {
+	mockRenderer
+	specs []render.MetricSpec
+}
+
+func (m *mockChartRenderer) MetricCharts() []render.MetricSpec { return m.specs }
+
+type mockSpecRenderer struct {
+	mockRenderer
+}
+
+func (m *mockSpecRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{{Namespace: "AWS/Test", MetricName: "Inline", DimensionName: "Id", Stat: "Sum"}}
+}
+
+func TestMetricChartSpecs(t *testing.T) {
+	if specs := metricChartSpecs(&mockRenderer{}, "test", "items"); specs != nil {
+		t.Errorf("metricChartSpecs(no provider) = %v, want nil", specs)
+	}
+
+	inline := metricChartSpecs(&mockSpecRenderer{}, "test", "items")
+	if len(inline) != 1 || inline[0].MetricName != "Inline" {
+		t.Errorf("metricChartSpecs(MetricSpecProvider) = %v, want single inline spec", inline)
+	}
+
+	charts := metricChartSpecs(&mockChartRenderer{specs: []render.MetricSpec{{MetricName: "A"}, {MetricName: "B"}}}, "test", "items")
+	if len(charts) != 2 {
+		t.Errorf("metricChartSpecs(MetricChartProvider) len = %d, want 2", len(charts))
+	}
+}
+
+func TestAutoChartPeriod(t *testing.T) {
+	tests := []struct {
+		window time.Duration
+		want   time.Duration
+	}{
+		{time.Hour, time.Minute},
+		{6 * time.Hour, time.Minute},
+		{12 * time.Hour, 5 * time.Minute},
+		{3 * 24 * time.Hour, 15 * time.Minute},
+		{7 * 24 * time.Hour, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := autoChartPeriod(tt.window); got != tt.want {
+			t.Errorf("autoChartPeriod(%v) = %v, want %v", tt.window, got, tt.want)
+		}
+	}
+}
+
+func TestMetricsChartViewKeys(t *testing.T) {
+	res := &mockResource{id: "i-123", name: "web"}
+	specs := []render.MetricSpec{{Namespace: "AWS/EC2", MetricName: "CPUUtilization", DimensionName: "InstanceId", Stat: "Average", Unit: "%"}}
+	v := NewMetricsChartView(context.Background(), res, specs, "ec2", "instances")
+	v.SetSize(100, 40)
+
+	window := v.windowIdx
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'w', Text: "w"}); cmd == nil {
+		t.Error("expected reload command after changing window")
+	}
+	if v.windowIdx == window {
+		t.Error("expected window to change")
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	if chartStats[v.statIdx] != "Average" {
+		t.Errorf("stat = %q, want Average", chartStats[v.statIdx])
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if v.showThresholds {
+		t.Error("expected thresholds to be hidden after 't'")
+	}
+}
+
+func TestMetricsChartViewRendersSeries(t *testing.T) {
+	res := &mockResource{id: "i-123", name: "web"}
+	spec := render.MetricSpec{Namespace: "AWS/EC2", MetricName: "CPUUtilization", DimensionName: "InstanceId", Stat: "Average", Unit: "%"}
+	v := NewMetricsChartView(context.Background(), res, []render.MetricSpec{spec}, "ec2", "instances")
+	v.SetSize(100, 40)
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-time.Hour)
+	v.Update(metricsChartLoadedMsg{
+		series: []metrics.Series{{
+			Spec:       spec,
+			Timestamps: []time.Time{start, end},
+			Values:     []float64{20, 40},
+		}},
+		thresholds: [][]metrics.AlarmThreshold{{
+			{AlarmName: "high-cpu", Threshold: 80, Comparison: "GreaterThanThreshold", State: "OK"},
+		}},
+		start: start,
+		end:   end,
+	})
+
+	out := v.ViewString()
+	for _, want := range []string{"CPUUtilization (Average)", "latest 40%", "high-cpu: > 80%", "web"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ViewString() missing %q", want)
+		}
+	}
+}
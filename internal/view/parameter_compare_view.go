@@ -0,0 +1,501 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/ssmparam"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const parameterCompareHeaderHeight = 4 // title(1) + sides(1) + summary(1) + status(1)
+
+type parameterProfilesMsg struct {
+	ids []string
+}
+
+type parameterCompareMsg struct {
+	compared []ssmparam.Compared
+	err      error
+}
+
+type parameterCompareStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	selected lipgloss.Style
+	input    lipgloss.Style
+	ok       lipgloss.Style
+	danger   lipgloss.Style
+	warning  lipgloss.Style
+}
+
+func newParameterCompareStyles() parameterCompareStyles {
+	return parameterCompareStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.AccentStyle().Bold(true),
+		selected: ui.SelectedStyle(),
+		input:    ui.InputFieldStyle(),
+		ok:       ui.SuccessStyle(),
+		danger:   ui.DangerStyle(),
+		warning:  ui.WarningStyle(),
+	}
+}
+
+// ParameterCompareView compares an SSM path subtree with a subtree of
+// another profile, e.g. /app/staging here with /app/prod in the prod
+// account, by parameter name relative to each path. Values are decrypted
+// to compare them and masked until revealed.
+type ParameterCompareView struct {
+	ctx  context.Context
+	path string
+	// newClient creates the client for a side, whose context carries its
+	// profile selection
+	newClient func(ctx context.Context) (ssmparam.Client, error)
+
+	profiles   []string // profile IDs, as in the profile selector
+	profileIdx int
+	pathInput  textinput.Model
+	formActive bool
+	formField  int // 0: profile, 1: path
+
+	thereProfile string
+	therePath    string
+	compared     []ssmparam.Compared
+	diffOnly     bool
+	revealed     bool
+	idx          int
+
+	loading bool
+	err     error
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  parameterCompareStyles
+}
+
+// NewParameterCompareView creates a compare view for a path, opening with
+// the form to pick the other profile and path
+func NewParameterCompareView(ctx context.Context, path string) *ParameterCompareView {
+	path = ssmparam.CleanPath(path)
+	pi := textinput.New()
+	pi.Placeholder = path
+	pi.Prompt = ""
+	pi.CharLimit = 1011 // maximum parameter name length
+	pi.SetValue(path)
+
+	return &ParameterCompareView{
+		ctx:  ctx,
+		path: path,
+		newClient: func(ctx context.Context) (ssmparam.Client, error) {
+			cfg, err := aws.NewConfig(ctx)
+			if err != nil {
+				return nil, apperrors.Wrap(err, "init AWS config")
+			}
+			return ssm.NewFromConfig(cfg), nil
+		},
+		pathInput:  pi,
+		formActive: true,
+		spinner:    ui.NewSpinner(),
+		styles:     newParameterCompareStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *ParameterCompareView) Init() tea.Cmd {
+	return tea.Batch(loadProfileIDs, v.spinner.Tick)
+}
+
+// loadProfileIDs lists the SDK default and the configured profiles
+func loadProfileIDs() tea.Msg {
+	ids := []string{config.ProfileIDSDKDefault}
+	profiles, err := aws.LoadProfiles()
+	if err != nil {
+		log.Error("failed to load profiles", "error", err)
+	}
+	for _, p := range profiles {
+		ids = append(ids, p.Name)
+	}
+	return parameterProfilesMsg{ids: ids}
+}
+
+// currentProfile returns the ID of the profile this side uses
+func (v *ParameterCompareView) currentProfile() string {
+	if sel, ok := aws.GetSelectionFromContext(v.ctx); ok {
+		return sel.ID()
+	}
+	return config.Global().Selection().ID()
+}
+
+// profileName returns the display name of a profile ID
+func profileName(id string) string {
+	return config.ProfileSelectionFromID(id).DisplayName()
+}
+
+// compareCmd loads both subtrees, decrypted, and compares them
+func (v *ParameterCompareView) compareCmd() tea.Cmd {
+	here, herePath := v.ctx, v.path
+	there := aws.WithSelectionOverride(v.ctx, config.ProfileSelectionFromID(v.thereProfile))
+	therePath, thereProfile, newClient := v.therePath, v.thereProfile, v.newClient
+	return func() tea.Msg {
+		load := func(ctx context.Context, path string) ([]types.Parameter, error) {
+			client, err := newClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return ssmparam.ListPath(ctx, client, path, true)
+		}
+		left, err := load(here, herePath)
+		if err != nil {
+			return parameterCompareMsg{err: err}
+		}
+		right, err := load(there, therePath)
+		if err != nil {
+			return parameterCompareMsg{err: apperrors.Wrapf(err, "profile %s", profileName(thereProfile))}
+		}
+		return parameterCompareMsg{compared: ssmparam.Compare(herePath, left, therePath, right)}
+	}
+}
+
+// Update implements tea.Model
+func (v *ParameterCompareView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case parameterProfilesMsg:
+		v.profiles = msg.ids
+		// Default to the first other named profile
+		current := v.currentProfile()
+		for i, id := range v.profiles {
+			if id != current && id != config.ProfileIDSDKDefault {
+				v.profileIdx = i
+				break
+			}
+		}
+		return v, nil
+
+	case parameterCompareMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.compared = msg.compared
+			v.idx = 0
+			v.revealed = false
+		}
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.formActive {
+			return v, v.handleFormKey(msg)
+		}
+		return v, v.handleKey(msg)
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newParameterCompareStyles()
+		v.updateContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *ParameterCompareView) handleFormKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.formActive = false
+		v.pathInput.Blur()
+		return nil
+	case "tab", "shift+tab", "up", "down":
+		v.formField = 1 - v.formField
+		if v.formField == 1 {
+			v.pathInput.Focus()
+			return textinput.Blink
+		}
+		v.pathInput.Blur()
+		return nil
+	case "enter":
+		if len(v.profiles) == 0 {
+			return nil
+		}
+		v.formActive = false
+		v.pathInput.Blur()
+		v.thereProfile = v.profiles[v.profileIdx]
+		v.therePath = ssmparam.CleanPath(v.pathInput.Value())
+		v.loading = true
+		return tea.Batch(v.compareCmd(), v.spinner.Tick)
+	}
+
+	if v.formField == 0 {
+		switch msg.String() {
+		case "left", "h":
+			if n := len(v.profiles); n > 0 {
+				v.profileIdx = (v.profileIdx + n - 1) % n
+			}
+		case "right", "l", "space":
+			if n := len(v.profiles); n > 0 {
+				v.profileIdx = (v.profileIdx + 1) % n
+			}
+		}
+		return nil
+	}
+	var cmd tea.Cmd
+	v.pathInput, cmd = v.pathInput.Update(msg)
+	return cmd
+}
+
+func (v *ParameterCompareView) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if v.loading {
+		return nil
+	}
+
+	rows := v.rows()
+	switch msg.String() {
+	case "up", "k":
+		v.idx = max(v.idx-1, 0)
+	case "down", "j":
+		v.idx = min(v.idx+1, max(len(rows)-1, 0))
+	case "g":
+		v.idx = 0
+	case "G":
+		v.idx = max(len(rows)-1, 0)
+	case "d":
+		v.diffOnly = !v.diffOnly
+		v.idx = 0
+	case "r":
+		v.revealed = !v.revealed
+	case "e":
+		v.formActive = true
+		if v.formField == 1 {
+			v.pathInput.Focus()
+			return textinput.Blink
+		}
+		return nil
+	case "ctrl+r":
+		if v.thereProfile == "" {
+			return nil
+		}
+		v.loading = true
+		return tea.Batch(v.compareCmd(), v.spinner.Tick)
+	default:
+		if v.vp.Ready {
+			var cmd tea.Cmd
+			v.vp.Model, cmd = v.vp.Model.Update(msg)
+			return cmd
+		}
+		return nil
+	}
+	v.updateContent()
+	return nil
+}
+
+// rows returns the compared parameters shown, all or only those not the same
+func (v *ParameterCompareView) rows() []ssmparam.Compared {
+	if !v.diffOnly {
+		return v.compared
+	}
+	var rows []ssmparam.Compared
+	for _, c := range v.compared {
+		if c.Status != ssmparam.Same {
+			rows = append(rows, c)
+		}
+	}
+	return rows
+}
+
+// cell shows one side's value on one line, masked for SecureString values
+// until revealed
+func (v *ParameterCompareView) cell(p *types.Parameter) string {
+	switch {
+	case p == nil:
+		return "-"
+	case p.Type == types.ParameterTypeSecureString && !v.revealed:
+		return ssmparam.Mask
+	}
+	return strings.ReplaceAll(aws.Str(p.Value), "\n", `\n`)
+}
+
+func (v *ParameterCompareView) statusLabel(status ssmparam.Status) string {
+	s := v.styles
+	switch status {
+	case ssmparam.Differs:
+		return s.warning.Render("differs   ")
+	case ssmparam.OnlyLeft:
+		return s.danger.Render("only here ")
+	case ssmparam.OnlyRight:
+		return s.ok.Render("only there")
+	default:
+		return s.dim.Render("same      ")
+	}
+}
+
+func (v *ParameterCompareView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	content, cursor := v.renderTable()
+	v.vp.Model.SetContent(content)
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(cursor)
+		} else if cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(cursor - height + 1)
+		}
+	}
+}
+
+// renderTable renders the compared parameters and returns the line of the
+// cursor
+func (v *ParameterCompareView) renderTable() (string, int) {
+	s := v.styles
+	rows := v.rows()
+	if len(rows) == 0 {
+		if v.diffOnly && len(v.compared) > 0 {
+			return s.ok.Render("No differences"), 0
+		}
+		return s.dim.Render("No parameters on either side"), 0
+	}
+
+	keyWidth := 3
+	for _, c := range rows {
+		keyWidth = max(keyWidth, lipgloss.Width(c.Key))
+	}
+	keyWidth = min(keyWidth, 40)
+	valueWidth := max((v.width-keyWidth-16)/2, 10)
+
+	lines := []string{s.dim.Render(fmt.Sprintf("%-*s  %-10s  %-*s  %s", keyWidth, "KEY", "STATUS", valueWidth, "HERE", "THERE"))}
+	cursor := 0
+	for i, c := range rows {
+		key := fmt.Sprintf("%-*s", keyWidth, TruncateString(c.Key, keyWidth))
+		here := fmt.Sprintf("%-*s", valueWidth, TruncateString(v.cell(c.Left), valueWidth))
+		there := TruncateString(v.cell(c.Right), valueWidth)
+		if i == v.idx {
+			cursor = len(lines)
+			lines = append(lines, s.selected.Render(key+"  ")+v.statusLabel(c.Status)+s.selected.Render("  "+here+"  "+there))
+			continue
+		}
+		lines = append(lines, key+"  "+v.statusLabel(c.Status)+"  "+here+"  "+there)
+	}
+	return strings.Join(lines, "\n"), cursor
+}
+
+// summary counts the compared parameters by status
+func (v *ParameterCompareView) summary() string {
+	counts := make(map[ssmparam.Status]int)
+	for _, c := range v.compared {
+		counts[c.Status]++
+	}
+	return fmt.Sprintf("%d same • %d differ • %d only here • %d only there",
+		counts[ssmparam.Same], counts[ssmparam.Differs], counts[ssmparam.OnlyLeft], counts[ssmparam.OnlyRight])
+}
+
+func (v *ParameterCompareView) renderForm() string {
+	s := v.styles
+	profile := "(loading profiles)"
+	if v.profileIdx < len(v.profiles) {
+		profile = "◂ " + profileName(v.profiles[v.profileIdx]) + " ▸"
+	}
+	label := func(text string, field int) string {
+		if v.formField == field {
+			return s.label.Render("▸ " + text)
+		}
+		return s.dim.Render("  " + text)
+	}
+	lines := []string{
+		s.label.Render("Compare " + v.path + " with"),
+		"",
+		label("Profile  ", 0) + s.input.Render(profile),
+		label("Path     ", 1) + v.pathInput.View(),
+		"",
+		s.dim.Render("Tab: next field • ←/→: profile • Enter: compare • Esc: cancel"),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString renders the view
+func (v *ParameterCompareView) ViewString() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(s.title.Render("⇄ Compare "+v.path) + "\n")
+	if v.thereProfile != "" {
+		out.WriteString(s.dim.Render(fmt.Sprintf("here: %s %s • there: %s %s",
+			profileName(v.currentProfile()), v.path, profileName(v.thereProfile), v.therePath)))
+	}
+	out.WriteString("\n")
+	if v.compared != nil {
+		out.WriteString(s.dim.Render(v.summary()))
+	}
+	out.WriteString("\n")
+
+	switch {
+	case v.loading:
+		out.WriteString(v.spinner.View() + " Loading both sides...")
+	case v.err != nil:
+		out.WriteString(s.danger.Render(TruncateString(v.err.Error(), v.width)))
+	case v.compared != nil && !v.revealed:
+		out.WriteString(s.dim.Render("SecureString values are masked (r to reveal)"))
+	}
+	out.WriteString("\n")
+
+	switch {
+	case v.formActive:
+		out.WriteString(v.renderForm())
+	case v.vp.Ready && v.compared != nil:
+		out.WriteString(v.vp.Model.View())
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *ParameterCompareView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ParameterCompareView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.pathInput.SetWidth(max(width-16, 10))
+	v.vp.SetSize(width, max(height-parameterCompareHeaderHeight, 1))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ParameterCompareView) StatusLine() string {
+	if v.formActive {
+		return "Tab:field ←/→:profile Enter:compare • Esc:cancel"
+	}
+	return "d:differences only r:reveal/mask e:edit ^r:reload • q/esc:back"
+}
+
+// HasActiveInput implements InputCapture; Esc closes the form first
+func (v *ParameterCompareView) HasActiveInput() bool {
+	return v.formActive
+}
@@ -0,0 +1,109 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/ssmparam"
+)
+
+func newTestCompareView(t *testing.T) *ParameterCompareView {
+	t.Helper()
+	clients := map[string]*fakeParameterClient{
+		"staging": {
+			params: map[string]types.ParameterType{
+				"/app/staging/db/host":     types.ParameterTypeString,
+				"/app/staging/db/password": types.ParameterTypeSecureString,
+				"/app/staging/name":        types.ParameterTypeString,
+				"/app/staging/debug":       types.ParameterTypeString,
+			},
+			values: map[string][]string{
+				"/app/staging/db/host":     {"db.staging"},
+				"/app/staging/db/password": {"stag1ng"},
+				"/app/staging/name":        {"api"},
+				"/app/staging/debug":       {"true"},
+			},
+		},
+		"prod": {
+			params: map[string]types.ParameterType{
+				"/app/prod/db/host":     types.ParameterTypeString,
+				"/app/prod/db/password": types.ParameterTypeSecureString,
+				"/app/prod/name":        types.ParameterTypeString,
+				"/app/prod/replicas":    types.ParameterTypeString,
+			},
+			values: map[string][]string{
+				"/app/prod/db/host":     {"db.prod"},
+				"/app/prod/db/password": {"pr0d"},
+				"/app/prod/name":        {"api"},
+				"/app/prod/replicas":    {"3"},
+			},
+		},
+	}
+
+	ctx := aws.WithSelectionOverride(context.Background(), config.NamedProfile("staging"))
+	v := NewParameterCompareView(ctx, "/app/staging/")
+	v.newClient = func(ctx context.Context) (ssmparam.Client, error) {
+		sel, _ := aws.GetSelectionFromContext(ctx)
+		return clients[sel.ID()], nil
+	}
+	v.SetSize(120, 30)
+	v.Update(parameterProfilesMsg{ids: []string{config.ProfileIDSDKDefault, "staging", "prod"}})
+	return v
+}
+
+func TestParameterCompareView(t *testing.T) {
+	v := newTestCompareView(t)
+	if !v.HasActiveInput() || v.profiles[v.profileIdx] != "prod" {
+		t.Fatalf("the form should open on the first other profile, got %q", v.profiles[v.profileIdx])
+	}
+
+	// Compare with /app/prod in prod
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	v.pathInput.SetValue("/app/prod")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil || v.HasActiveInput() {
+		t.Fatal("enter should close the form and compare")
+	}
+	v.Update(v.compareCmd()())
+
+	out := v.ViewString()
+	for _, want := range []string{"staging /app/staging", "prod /app/prod", "1 same • 2 differ • 1 only here • 1 only there", "db/host", "db.staging", "db.prod", "replicas"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "stag1ng") || strings.Contains(out, "pr0d") {
+		t.Fatalf("SecureString values should be masked:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if out := v.ViewString(); !strings.Contains(out, "stag1ng") || !strings.Contains(out, "pr0d") {
+		t.Errorf("r should reveal SecureString values:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if rows := v.rows(); len(rows) != 4 {
+		t.Errorf("differences only = %d rows, want 4", len(rows))
+	}
+	for _, c := range v.rows() {
+		if c.Status == ssmparam.Same {
+			t.Errorf("d should hide %s, which is the same", c.Key)
+		}
+	}
+
+	// e reopens the form, Esc closes it again
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if !v.HasActiveInput() {
+		t.Error("e should open the form")
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if v.HasActiveInput() {
+		t.Error("esc should close the form")
+	}
+}
@@ -0,0 +1,377 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/ssmparam"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const parameterHeaderHeight = 3 // title(1) + metadata(1) + status(1)
+
+type parameterLoadedMsg struct {
+	client  ssmparam.Client // set when the client was created
+	param   types.Parameter
+	history []types.ParameterHistory
+	err     error
+}
+
+type parameterDecryptedMsg struct {
+	history []types.ParameterHistory
+	err     error
+}
+
+type parameterViewStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	selected lipgloss.Style
+	ok       lipgloss.Style
+	danger   lipgloss.Style
+}
+
+func newParameterViewStyles() parameterViewStyles {
+	return parameterViewStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.AccentStyle().Bold(true),
+		selected: ui.SelectedStyle(),
+		ok:       ui.SuccessStyle(),
+		danger:   ui.DangerStyle(),
+	}
+}
+
+// ParameterView shows the value of an SSM parameter and its history. The
+// value of the selected version is shown above the history. SecureString
+// values are masked, and only decrypted when first revealed or copied.
+type ParameterView struct {
+	ctx    context.Context
+	client ssmparam.Client
+	name   string
+
+	param     types.Parameter
+	history   []types.ParameterHistory
+	idx       int  // selected history entry, newest first
+	decrypted bool // history holds plaintext SecureString values
+	revealed  bool
+	copyNext  bool // copy the selected value once decrypted
+
+	loading bool
+	err     error
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  parameterViewStyles
+}
+
+// NewParameterView creates a view of a parameter
+func NewParameterView(ctx context.Context, name string) *ParameterView {
+	return &ParameterView{
+		ctx:     ctx,
+		name:    name,
+		loading: true,
+		spinner: ui.NewSpinner(),
+		styles:  newParameterViewStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *ParameterView) Init() tea.Cmd {
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+// loadCmd reads the parameter and its history, without decryption
+func (v *ParameterView) loadCmd() tea.Cmd {
+	ctx, client, name := v.ctx, v.client, v.name
+	return func() tea.Msg {
+		var created ssmparam.Client
+		if client == nil {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return parameterLoadedMsg{err: apperrors.Wrap(err, "init AWS config")}
+			}
+			client = ssm.NewFromConfig(cfg)
+			created = client
+		}
+		param, err := ssmparam.Get(ctx, client, name, false)
+		if err != nil {
+			return parameterLoadedMsg{client: created, err: err}
+		}
+		history, err := ssmparam.History(ctx, client, name, false)
+		return parameterLoadedMsg{client: created, param: param, history: history, err: err}
+	}
+}
+
+// decryptCmd reads the history again with SecureString values decrypted
+func (v *ParameterView) decryptCmd() tea.Cmd {
+	ctx, client, name := v.ctx, v.client, v.name
+	return func() tea.Msg {
+		history, err := ssmparam.History(ctx, client, name, true)
+		return parameterDecryptedMsg{history: history, err: err}
+	}
+}
+
+// secure returns whether the parameter's values are encrypted
+func (v *ParameterView) secure() bool {
+	return v.param.Type == types.ParameterTypeSecureString
+}
+
+// Update implements tea.Model
+func (v *ParameterView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case parameterLoadedMsg:
+		v.loading = false
+		if msg.client != nil {
+			v.client = msg.client
+		}
+		v.err = msg.err
+		if msg.err == nil {
+			v.param = msg.param
+			v.history = msg.history
+			v.idx = min(v.idx, max(len(v.history)-1, 0))
+			v.decrypted = !v.secure()
+			v.revealed = false
+		}
+		v.updateContent()
+		return v, nil
+
+	case parameterDecryptedMsg:
+		v.loading = false
+		v.err = msg.err
+		copyNext := v.copyNext
+		v.copyNext = false
+		if msg.err != nil {
+			v.updateContent()
+			return v, nil
+		}
+		v.history = msg.history
+		v.idx = min(v.idx, max(len(v.history)-1, 0))
+		v.decrypted = true
+		var cmd tea.Cmd
+		if copyNext {
+			cmd = v.copySelected()
+		} else {
+			v.revealed = true
+		}
+		v.updateContent()
+		return v, cmd
+
+	case tea.KeyPressMsg:
+		return v, v.handleKey(msg)
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newParameterViewStyles()
+		v.updateContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *ParameterView) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if v.loading {
+		return nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		v.idx = max(v.idx-1, 0)
+	case "down", "j":
+		v.idx = min(v.idx+1, max(len(v.history)-1, 0))
+	case "g":
+		v.idx = 0
+	case "G":
+		v.idx = max(len(v.history)-1, 0)
+	case "space", "enter":
+		if !v.secure() {
+			return nil
+		}
+		if v.revealed || v.decrypted {
+			v.revealed = !v.revealed
+			break
+		}
+		v.loading = true
+		return tea.Batch(v.decryptCmd(), v.spinner.Tick)
+	case "y":
+		if len(v.history) == 0 {
+			return nil
+		}
+		if !v.decrypted {
+			v.copyNext = true
+			v.loading = true
+			return tea.Batch(v.decryptCmd(), v.spinner.Tick)
+		}
+		return v.copySelected()
+	case "ctrl+r":
+		v.loading = true
+		return tea.Batch(v.loadCmd(), v.spinner.Tick)
+	default:
+		if v.vp.Ready {
+			var cmd tea.Cmd
+			v.vp.Model, cmd = v.vp.Model.Update(msg)
+			return cmd
+		}
+		return nil
+	}
+	v.updateContent()
+	return nil
+}
+
+func (v *ParameterView) copySelected() tea.Cmd {
+	if v.idx >= len(v.history) {
+		return nil
+	}
+	h := v.history[v.idx]
+	return clipboard.Copy(fmt.Sprintf("%s version %d", v.name, h.Version), appaws.Str(h.Value))
+}
+
+// shown returns a value as displayed: masked until revealed for
+// SecureString parameters
+func (v *ParameterView) shown(value string) string {
+	if v.secure() && !v.revealed {
+		return ssmparam.Mask
+	}
+	if value == "" {
+		return `""`
+	}
+	return value
+}
+
+func (v *ParameterView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	content, cursor := v.renderContent()
+	v.vp.Model.SetContent(content)
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(cursor)
+		} else if cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(cursor - height + 1)
+		}
+	}
+}
+
+// renderContent renders the selected value and the history, and returns
+// the line of the cursor
+func (v *ParameterView) renderContent() (string, int) {
+	s := v.styles
+	var lines []string
+	if len(v.history) == 0 {
+		return s.dim.Render("No history"), 0
+	}
+
+	sel := v.history[v.idx]
+	label := fmt.Sprintf("Value (version %d)", sel.Version)
+	if sel.Version == v.param.Version {
+		label = fmt.Sprintf("Value (version %d, current)", sel.Version)
+	}
+	lines = append(lines, s.label.Render(label))
+	for _, l := range strings.Split(v.shown(appaws.Str(sel.Value)), "\n") {
+		lines = append(lines, "  "+l)
+	}
+	if desc := appaws.Str(sel.Description); desc != "" {
+		lines = append(lines, s.dim.Render("  "+desc))
+	}
+	lines = append(lines, "", s.label.Render(fmt.Sprintf("History (%d)", len(v.history))))
+
+	cursor := 0
+	for i, h := range v.history {
+		modified := ""
+		if h.LastModifiedDate != nil {
+			modified = h.LastModifiedDate.Format("2006-01-02 15:04")
+		}
+		value := strings.ReplaceAll(v.shown(appaws.Str(h.Value)), "\n", `\n`)
+		line := fmt.Sprintf("  %-5d %-16s %-24s %-14s %s", h.Version, modified,
+			TruncateString(appaws.ExtractResourceName(appaws.Str(h.LastModifiedUser)), 24),
+			TruncateString(strings.Join(h.Labels, ","), 14), value)
+		line = TruncateString(line, max(v.width, 20))
+		if i == v.idx {
+			cursor = len(lines)
+			line = s.selected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), cursor
+}
+
+// ViewString renders the view
+func (v *ParameterView) ViewString() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(s.title.Render("🔧 "+v.name) + "\n")
+	if v.param.Name != nil {
+		meta := []string{string(v.param.Type), fmt.Sprintf("version %d", v.param.Version)}
+		if v.param.LastModifiedDate != nil {
+			meta = append(meta, "modified "+v.param.LastModifiedDate.Format("2006-01-02 15:04"))
+		}
+		if dt := appaws.Str(v.param.DataType); dt != "" && dt != "text" {
+			meta = append(meta, dt)
+		}
+		out.WriteString(s.dim.Render(strings.Join(meta, " • ")))
+	}
+	out.WriteString("\n")
+
+	switch {
+	case v.loading:
+		out.WriteString(v.spinner.View() + " Loading...")
+	case v.err != nil:
+		out.WriteString(s.danger.Render(TruncateString(v.err.Error(), v.width)))
+	case v.secure() && !v.revealed:
+		out.WriteString(s.dim.Render("SecureString values are masked (space to decrypt and reveal)"))
+	}
+	out.WriteString("\n")
+
+	if v.vp.Ready && v.param.Name != nil {
+		out.WriteString(v.vp.Model.View())
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *ParameterView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ParameterView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.vp.SetSize(width, max(height-parameterHeaderHeight, 1))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ParameterView) StatusLine() string {
+	if v.secure() {
+		return "j/k:version Space:reveal/mask y:copy ^r:reload • q/esc:back"
+	}
+	return "j/k:version y:copy ^r:reload • q/esc:back"
+}
@@ -0,0 +1,168 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// fakeParameterClient serves parameters by name, each with its history
+// oldest first. SecureString values read without decryption are "AQICAH...".
+type fakeParameterClient struct {
+	params   map[string]types.ParameterType
+	values   map[string][]string // name -> value of each version
+	decrypts int
+}
+
+func (f *fakeParameterClient) shown(name, value string, decrypt *bool) *string {
+	if f.params[name] == types.ParameterTypeSecureString && !aws.ToBool(decrypt) {
+		return aws.String("AQICAH" + strings.Repeat("x", len(value)))
+	}
+	return aws.String(value)
+}
+
+func (f *fakeParameterClient) GetParametersByPath(_ context.Context, in *ssm.GetParametersByPathInput, _ ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error) {
+	prefix := aws.ToString(in.Path) + "/"
+	var out []types.Parameter
+	for name, typ := range f.params {
+		if strings.HasPrefix(name, prefix) {
+			values := f.values[name]
+			out = append(out, types.Parameter{Name: aws.String(name), Type: typ, Value: f.shown(name, values[len(values)-1], in.WithDecryption)})
+		}
+	}
+	return &ssm.GetParametersByPathOutput{Parameters: out}, nil
+}
+
+func (f *fakeParameterClient) GetParameter(_ context.Context, in *ssm.GetParameterInput, _ ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	name := aws.ToString(in.Name)
+	values := f.values[name]
+	return &ssm.GetParameterOutput{Parameter: &types.Parameter{
+		Name:    aws.String(name),
+		Type:    f.params[name],
+		Version: int64(len(values)),
+		Value:   f.shown(name, values[len(values)-1], in.WithDecryption),
+	}}, nil
+}
+
+func (f *fakeParameterClient) GetParameterHistory(_ context.Context, in *ssm.GetParameterHistoryInput, _ ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	name := aws.ToString(in.Name)
+	if aws.ToBool(in.WithDecryption) {
+		f.decrypts++
+	}
+	var out []types.ParameterHistory
+	for i, value := range f.values[name] {
+		out = append(out, types.ParameterHistory{
+			Name:             aws.String(name),
+			Type:             f.params[name],
+			Version:          int64(i + 1),
+			Value:            f.shown(name, value, in.WithDecryption),
+			LastModifiedDate: aws.Time(time.Date(2026, 1, i+1, 9, 0, 0, 0, time.UTC)),
+			LastModifiedUser: aws.String("arn:aws:iam::123456789012:user/alice"),
+		})
+	}
+	return &ssm.GetParameterHistoryOutput{Parameters: out}, nil
+}
+
+// runParameterCmd runs a command, feeding loads back into the view
+func runParameterCmd(v *ParameterView, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		var last tea.Cmd
+		for _, c := range msg {
+			if next := runParameterCmd(v, c); next != nil {
+				last = next
+			}
+		}
+		return last
+	case parameterLoadedMsg, parameterDecryptedMsg:
+		_, next := v.Update(msg)
+		return next
+	}
+	return nil
+}
+
+func newTestParameterView(t *testing.T, name string) (*ParameterView, *fakeParameterClient) {
+	t.Helper()
+	client := &fakeParameterClient{
+		params: map[string]types.ParameterType{
+			"/app/prod/db/password": types.ParameterTypeSecureString,
+			"/app/prod/db/host":     types.ParameterTypeString,
+		},
+		values: map[string][]string{
+			"/app/prod/db/password": {"0ld-pass", "n3w-pass"},
+			"/app/prod/db/host":     {"db1.internal", "db2.internal"},
+		},
+	}
+	v := NewParameterView(context.Background(), name)
+	v.client = client
+	v.SetSize(120, 30)
+	runParameterCmd(v, v.Init())
+	return v, client
+}
+
+func TestParameterView_SecureStringMaskedUntilRevealed(t *testing.T) {
+	v, client := newTestParameterView(t, "/app/prod/db/password")
+
+	out := v.ViewString()
+	for _, want := range []string{"/app/prod/db/password", "SecureString", "Value (version 2, current)", "History (2)", "alice"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "n3w-pass") || strings.Contains(out, "AQICAH") {
+		t.Fatalf("values should be masked, ciphertext included:\n%s", out)
+	}
+	if client.decrypts != 0 {
+		t.Fatalf("nothing should be decrypted before it is revealed")
+	}
+
+	// Space decrypts once and reveals
+	_, cmd := v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	runParameterCmd(v, cmd)
+	if out := v.ViewString(); !strings.Contains(out, "n3w-pass") || !strings.Contains(out, "0ld-pass") {
+		t.Errorf("space should reveal the values:\n%s", out)
+	}
+	v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	if client.decrypts != 1 {
+		t.Errorf("decrypts = %d, want the values decrypted once", client.decrypts)
+	}
+
+	// j selects the previous version
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if out := v.ViewString(); !strings.Contains(out, "Value (version 1)") {
+		t.Errorf("j should show version 1:\n%s", out)
+	}
+}
+
+func TestParameterView_CopyDecryptsFirst(t *testing.T) {
+	v, client := newTestParameterView(t, "/app/prod/db/password")
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if copyCmd := runParameterCmd(v, cmd); copyCmd == nil {
+		t.Fatal("y should copy once the value is decrypted")
+	}
+	if client.decrypts != 1 || v.revealed {
+		t.Errorf("decrypts = %d, revealed = %v; copying should decrypt without revealing", client.decrypts, v.revealed)
+	}
+}
+
+func TestParameterView_String(t *testing.T) {
+	v, client := newTestParameterView(t, "/app/prod/db/host")
+
+	if out := v.ViewString(); !strings.Contains(out, "db2.internal") || strings.Contains(out, "masked") {
+		t.Errorf("String values should be shown:\n%s", out)
+	}
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"}); cmd == nil || client.decrypts != 0 {
+		t.Errorf("y should copy without decrypting, decrypts = %d", client.decrypts)
+	}
+}
@@ -0,0 +1,559 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type rawNodeKind int
+
+const (
+	rawScalar rawNodeKind = iota
+	rawObject
+	rawArray
+)
+
+// rawNode is one value of a resource's raw API data.
+// Object keys keep the order in which they were serialized.
+type rawNode struct {
+	key       string // object key; empty for array elements and the root
+	index     int    // position in the parent array, or -1
+	path      string // JMESPath expression, usable with aws --query
+	kind      rawNodeKind
+	value     any // scalar value: string, json.Number, bool or nil
+	children  []*rawNode
+	parent    *rawNode
+	depth     int
+	collapsed bool
+}
+
+// rawLine is one rendered line of the tree
+type rawLine struct {
+	node     *rawNode
+	closing  bool // closing bracket of an expanded JSON container
+	expanded bool // container whose children follow
+}
+
+// rawTreeStyles holds cached lipgloss styles for performance
+type rawTreeStyles struct {
+	key      lipgloss.Style
+	str      lipgloss.Style
+	number   lipgloss.Style
+	literal  lipgloss.Style
+	dim      lipgloss.Style
+	match    lipgloss.Style
+	selected lipgloss.Style
+}
+
+func newRawTreeStyles() rawTreeStyles {
+	return rawTreeStyles{
+		key:      ui.AccentStyle(),
+		str:      ui.SuccessStyle(),
+		number:   ui.InfoStyle(),
+		literal:  ui.WarningStyle(),
+		dim:      ui.DimStyle(),
+		match:    ui.HighlightStyle(),
+		selected: ui.SelectedStyle(),
+	}
+}
+
+// rawTree is a foldable, searchable JSON or YAML rendering of raw data
+type rawTree struct {
+	root   *rawNode
+	yaml   bool
+	lines  []rawLine
+	cursor int
+
+	query   string
+	matches []*rawNode // in document order
+	match   int        // index into matches, or -1
+}
+
+var jmesIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// newRawTree builds a fully expanded tree from the JSON form of v
+func newRawTree(v any) (*rawTree, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	root := &rawNode{index: -1}
+	if err := root.parse(dec); err != nil {
+		return nil, err
+	}
+	t := &rawTree{root: root, match: -1}
+	t.reflow()
+	return t, nil
+}
+
+func (n *rawNode) parse(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		n.value = tok
+		return nil
+	}
+
+	n.kind = rawArray
+	if delim == '{' {
+		n.kind = rawObject
+	}
+	for dec.More() {
+		child := &rawNode{parent: n, depth: n.depth + 1, index: -1}
+		if n.kind == rawObject {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			child.key, _ = keyTok.(string)
+		} else {
+			child.index = len(n.children)
+		}
+		child.path = childPath(n.path, child.key, child.index)
+		if err := child.parse(dec); err != nil {
+			return err
+		}
+		n.children = append(n.children, child)
+	}
+	_, err = dec.Token() // closing delimiter
+	return err
+}
+
+// childPath returns the JMESPath of a child of the node at parent
+func childPath(parent, key string, index int) string {
+	if index >= 0 {
+		return fmt.Sprintf("%s[%d]", parent, index)
+	}
+	if !jmesIdentifier.MatchString(key) {
+		key = strconv.Quote(key)
+	}
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func (n *rawNode) isContainer() bool {
+	return n.kind != rawScalar && len(n.children) > 0
+}
+
+func (n *rawNode) walk(fn func(*rawNode)) {
+	fn(n)
+	for _, c := range n.children {
+		c.walk(fn)
+	}
+}
+
+// appendLines appends the lines of n's subtree as rendered with root at
+// the top. In YAML the root container has no line of its own; with all
+// set, collapsed nodes are rendered expanded.
+func (n *rawNode) appendLines(lines []rawLine, root *rawNode, yaml, all bool) []rawLine {
+	expanded := n.isContainer() && (all || !n.collapsed)
+	if !yaml || n != root || !n.isContainer() {
+		lines = append(lines, rawLine{node: n, expanded: expanded})
+	}
+	if !expanded {
+		return lines
+	}
+	for _, c := range n.children {
+		lines = c.appendLines(lines, root, yaml, all)
+	}
+	if !yaml {
+		lines = append(lines, rawLine{node: n, closing: true})
+	}
+	return lines
+}
+
+// reflow rebuilds the visible lines, keeping the cursor on the same node
+func (t *rawTree) reflow() {
+	current := t.current()
+	t.lines = t.root.appendLines(t.lines[:0], t.root, t.yaml, false)
+	t.cursor = t.lineOf(current)
+}
+
+// setYAML switches between JSON and YAML rendering
+func (t *rawTree) setYAML(yaml bool) {
+	t.yaml = yaml
+	t.reflow()
+}
+
+func (t *rawTree) current() *rawNode {
+	if t.cursor < 0 || t.cursor >= len(t.lines) {
+		return nil
+	}
+	return t.lines[t.cursor].node
+}
+
+// lineOf returns the line of n's key or opening bracket, or 0 if hidden
+func (t *rawTree) lineOf(n *rawNode) int {
+	for i, l := range t.lines {
+		if l.node == n && !l.closing {
+			return i
+		}
+	}
+	return 0
+}
+
+func (t *rawTree) moveCursor(delta int) {
+	t.cursor = max(min(t.cursor+delta, len(t.lines)-1), 0)
+}
+
+// toggle folds or unfolds the container under the cursor
+func (t *rawTree) toggle() {
+	n := t.current()
+	if n == nil || !n.isContainer() || (t.yaml && n == t.root) {
+		return
+	}
+	n.collapsed = !n.collapsed
+	t.reflow()
+}
+
+// expand unfolds the container under the cursor
+func (t *rawTree) expand() {
+	if n := t.current(); n != nil && n.isContainer() && n.collapsed {
+		n.collapsed = false
+		t.reflow()
+	}
+}
+
+// collapse folds the container under the cursor, or moves to its parent
+func (t *rawTree) collapse() {
+	n := t.current()
+	if n == nil {
+		return
+	}
+	if n.isContainer() && !n.collapsed && n != t.root {
+		n.collapsed = true
+		t.reflow()
+		return
+	}
+	if n.parent != nil && (!t.yaml || n.parent != t.root) {
+		t.cursor = t.lineOf(n.parent)
+	}
+}
+
+// setAllCollapsed folds or unfolds every container below the root
+func (t *rawTree) setAllCollapsed(collapsed bool) {
+	t.root.walk(func(n *rawNode) {
+		if n != t.root && n.isContainer() {
+			n.collapsed = collapsed
+		}
+	})
+	// Keep the cursor on a visible node
+	n := t.current()
+	for collapsed && n != nil && n.parent != nil && n.parent != t.root {
+		n = n.parent
+	}
+	t.lines = t.root.appendLines(t.lines[:0], t.root, t.yaml, false)
+	t.cursor = t.lineOf(n)
+}
+
+// search finds keys and scalar values containing query (case-insensitive)
+// and jumps to the first match at or after the cursor.
+func (t *rawTree) search(query string) {
+	t.query = query
+	t.findMatches()
+	if len(t.matches) == 0 {
+		return
+	}
+
+	// Nodes are walked in document order, as are fully expanded lines
+	start := 0
+	if current := t.current(); current != nil {
+		order := make(map[*rawNode]int)
+		i := 0
+		t.root.walk(func(n *rawNode) { order[n] = i; i++ })
+		for start < len(t.matches) && order[t.matches[start]] < order[current] {
+			start++
+		}
+	}
+	t.jumpTo(start % len(t.matches))
+}
+
+// nextMatch moves to the next (delta 1) or previous (delta -1) match
+func (t *rawTree) nextMatch(delta int) {
+	if len(t.matches) == 0 {
+		return
+	}
+	t.jumpTo((t.match + delta + len(t.matches)) % len(t.matches))
+}
+
+func (t *rawTree) jumpTo(i int) {
+	t.match = i
+	n := t.matches[i]
+	for p := n.parent; p != nil; p = p.parent {
+		p.collapsed = false
+	}
+	t.lines = t.root.appendLines(t.lines[:0], t.root, t.yaml, false)
+	t.cursor = t.lineOf(n)
+}
+
+// restore carries folding, format, cursor and search over from a tree
+// built from an earlier version of the same data
+func (t *rawTree) restore(prev *rawTree) {
+	collapsed := make(map[string]bool)
+	prev.root.walk(func(n *rawNode) {
+		if n.collapsed {
+			collapsed[n.path] = true
+		}
+	})
+	var cursorPath string
+	if n := prev.current(); n != nil {
+		cursorPath = n.path
+	}
+
+	var cursorNode *rawNode
+	t.root.walk(func(n *rawNode) {
+		n.collapsed = collapsed[n.path]
+		if cursorNode == nil && n.path == cursorPath {
+			cursorNode = n
+		}
+	})
+	t.yaml = prev.yaml
+	t.lines = t.root.appendLines(t.lines[:0], t.root, t.yaml, false)
+	t.cursor = t.lineOf(cursorNode)
+
+	t.query = prev.query
+	t.findMatches()
+}
+
+// findMatches collects the nodes whose key or scalar value contains the query
+func (t *rawTree) findMatches() {
+	t.matches = nil
+	t.match = -1
+	if t.query == "" {
+		return
+	}
+	t.root.walk(func(n *rawNode) {
+		if matchesQuery(n.key, t.query) || (n.kind == rawScalar && matchesQuery(scalarText(n.value), t.query)) {
+			t.matches = append(t.matches, n)
+		}
+	})
+}
+
+// copyPath returns the JMESPath of the node under the cursor ("@" for the root)
+func (t *rawTree) copyPath() string {
+	n := t.current()
+	if n == nil || n.path == "" {
+		return "@"
+	}
+	return n.path
+}
+
+// copyValue returns the node under the cursor as plain text: scalars
+// unquoted, containers fully expanded in the current format
+func (t *rawTree) copyValue() string {
+	n := t.current()
+	if n == nil {
+		return ""
+	}
+	if n.kind == rawScalar {
+		return scalarText(n.value)
+	}
+	lines := n.appendLines(nil, n, t.yaml, true)
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		out[i] = t.formatLine(l, n, rawTreeStyles{})
+	}
+	return strings.Join(out, "\n")
+}
+
+// render returns the visible lines with the cursor line highlighted
+func (t *rawTree) render(st rawTreeStyles, width int) string {
+	var out strings.Builder
+	for i, l := range t.lines {
+		if i == t.cursor {
+			out.WriteString(st.selected.Render(TruncateOrPadString(t.formatLine(l, t.root, rawTreeStyles{}), width)))
+		} else {
+			out.WriteString(TruncateString(t.formatLine(l, t.root, st), width))
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+// formatLine renders one line relative to root. Zero-value styles give
+// plain text.
+func (t *rawTree) formatLine(l rawLine, root *rawNode, st rawTreeStyles) string {
+	if t.yaml {
+		return t.formatYAMLLine(l, root, st)
+	}
+
+	n := l.node
+	comma := ""
+	if n != root && n.parent != nil && n != n.parent.children[len(n.parent.children)-1] && (l.closing || !l.expanded) {
+		comma = ","
+	}
+	indent := strings.Repeat("  ", n.depth-root.depth)
+	if l.closing {
+		return indent + closer(n) + comma
+	}
+
+	var b strings.Builder
+	b.WriteString(indent)
+	if n != root && n.index < 0 {
+		b.WriteString(t.paintKey(st, n.key, jsonString(n.key)) + ": ")
+	}
+	switch {
+	case n.kind == rawScalar:
+		b.WriteString(t.paintScalar(st, n.value, jsonScalar(n.value)) + comma)
+	case l.expanded:
+		b.WriteString(opener(n))
+	case len(n.children) == 0:
+		b.WriteString(opener(n) + closer(n) + comma)
+	default:
+		b.WriteString(opener(n) + "…" + closer(n) + comma + st.dim.Render(" "+childCount(n)))
+	}
+	return b.String()
+}
+
+func (t *rawTree) formatYAMLLine(l rawLine, root *rawNode, st rawTreeStyles) string {
+	n := l.node
+	var b strings.Builder
+	b.WriteString(strings.Repeat("  ", max(n.depth-root.depth-1, 0)))
+
+	switch {
+	case n == root:
+	case n.index >= 0:
+		b.WriteString("-")
+	default:
+		b.WriteString(t.paintKey(st, n.key, yamlString(n.key)) + ":")
+	}
+	if l.expanded {
+		return b.String()
+	}
+	if n != root {
+		b.WriteString(" ")
+	}
+
+	switch {
+	case n.kind == rawScalar:
+		text := scalarText(n.value)
+		if s, ok := n.value.(string); ok {
+			text = yamlString(s)
+		}
+		b.WriteString(t.paintScalar(st, n.value, text))
+	case len(n.children) == 0:
+		b.WriteString(opener(n) + closer(n))
+	default:
+		b.WriteString(opener(n) + "…" + closer(n) + st.dim.Render(" "+childCount(n)))
+	}
+	return b.String()
+}
+
+func (t *rawTree) paintKey(st rawTreeStyles, key, text string) string {
+	if matchesQuery(key, t.query) {
+		return st.match.Render(text)
+	}
+	return st.key.Render(text)
+}
+
+func (t *rawTree) paintScalar(st rawTreeStyles, v any, text string) string {
+	if matchesQuery(scalarText(v), t.query) {
+		return st.match.Render(text)
+	}
+	switch v.(type) {
+	case string:
+		return st.str.Render(text)
+	case json.Number:
+		return st.number.Render(text)
+	default:
+		return st.literal.Render(text)
+	}
+}
+
+func matchesQuery(s, query string) bool {
+	return query != "" && strings.Contains(strings.ToLower(s), strings.ToLower(query))
+}
+
+func opener(n *rawNode) string {
+	if n.kind == rawArray {
+		return "["
+	}
+	return "{"
+}
+
+func closer(n *rawNode) string {
+	if n.kind == rawArray {
+		return "]"
+	}
+	return "}"
+}
+
+func childCount(n *rawNode) string {
+	if n.kind == rawArray {
+		return fmt.Sprintf("%d items", len(n.children))
+	}
+	return fmt.Sprintf("%d keys", len(n.children))
+}
+
+// scalarText returns a scalar as unquoted text
+func scalarText(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func jsonScalar(v any) string {
+	if s, ok := v.(string); ok {
+		return jsonString(s)
+	}
+	return scalarText(v)
+}
+
+// jsonString quotes s as a JSON string without HTML escaping
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return strconv.Quote(s)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// yamlString returns s as a plain YAML scalar, or double-quoted when a
+// plain scalar would be read as something else
+func yamlString(s string) string {
+	if yamlNeedsQuote(s) {
+		return jsonString(s)
+	}
+	return s
+}
+
+func yamlNeedsQuote(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return true
+	}
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.ContainsAny(s, "\n\t\r") {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "~":
+		return true
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
@@ -0,0 +1,244 @@
+package view
+
+import (
+	"strings"
+	"testing"
+)
+
+type rawTestTag struct {
+	Key   string
+	Value string
+}
+
+type rawTestResource struct {
+	Name     string
+	Count    int
+	Enabled  bool
+	Missing  *string
+	Tags     []rawTestTag
+	Empty    []string
+	Metadata map[string]string `json:"meta-data"`
+}
+
+func newTestRawTree(t *testing.T) *rawTree {
+	t.Helper()
+	tree, err := newRawTree(rawTestResource{
+		Name:     "web",
+		Count:    2,
+		Enabled:  true,
+		Tags:     []rawTestTag{{Key: "env", Value: "prod"}, {Key: "team", Value: "core"}},
+		Empty:    []string{},
+		Metadata: map[string]string{"owner": "ops"},
+	})
+	if err != nil {
+		t.Fatalf("newRawTree() error: %v", err)
+	}
+	return tree
+}
+
+func plainLines(tree *rawTree) string {
+	lines := make([]string, len(tree.lines))
+	for i, l := range tree.lines {
+		lines[i] = tree.formatLine(l, tree.root, rawTreeStyles{})
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRawTree_JSON(t *testing.T) {
+	tree := newTestRawTree(t)
+
+	want := `{
+  "Name": "web",
+  "Count": 2,
+  "Enabled": true,
+  "Missing": null,
+  "Tags": [
+    {
+      "Key": "env",
+      "Value": "prod"
+    },
+    {
+      "Key": "team",
+      "Value": "core"
+    }
+  ],
+  "Empty": [],
+  "meta-data": {
+    "owner": "ops"
+  }
+}`
+	if got := plainLines(tree); got != want {
+		t.Errorf("JSON =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRawTree_YAML(t *testing.T) {
+	tree := newTestRawTree(t)
+	tree.setYAML(true)
+
+	want := `Name: web
+Count: 2
+Enabled: true
+Missing: null
+Tags:
+  -
+    Key: env
+    Value: prod
+  -
+    Key: team
+    Value: core
+Empty: []
+meta-data:
+  owner: ops`
+	if got := plainLines(tree); got != want {
+		t.Errorf("YAML =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRawTree_Fold(t *testing.T) {
+	tree := newTestRawTree(t)
+	tree.cursor = tree.lineOf(tree.root.children[4]) // Tags
+
+	tree.toggle()
+	if got := tree.formatLine(tree.lines[tree.cursor], tree.root, rawTreeStyles{}); got != `  "Tags": […], 2 items` {
+		t.Errorf("collapsed line = %q", got)
+	}
+	if len(tree.lines) != 11 {
+		t.Errorf("lines = %d, want 11", len(tree.lines))
+	}
+
+	tree.expand()
+	if len(tree.lines) != 20 {
+		t.Errorf("lines after expand = %d, want 20", len(tree.lines))
+	}
+
+	// h on a leaf moves to its parent
+	tree.moveCursor(2) // Tags[0].Key
+	tree.collapse()
+	if got := tree.copyPath(); got != "Tags[0]" {
+		t.Errorf("path after collapse on leaf = %q, want Tags[0]", got)
+	}
+
+	tree.setAllCollapsed(true)
+	if len(tree.lines) != 9 {
+		t.Errorf("lines after collapse all = %d, want 9", len(tree.lines))
+	}
+	if got := tree.copyPath(); got != "Tags" {
+		t.Errorf("cursor after collapse all = %q, want Tags", got)
+	}
+	tree.setAllCollapsed(false)
+	if len(tree.lines) != 20 {
+		t.Errorf("lines after expand all = %d, want 20", len(tree.lines))
+	}
+}
+
+func TestRawTree_Search(t *testing.T) {
+	tree := newTestRawTree(t)
+	tree.setAllCollapsed(true)
+
+	tree.search("VALUE")
+	if len(tree.matches) != 2 {
+		t.Fatalf("matches = %d, want 2", len(tree.matches))
+	}
+	if got := tree.copyPath(); got != "Tags[0].Value" {
+		t.Errorf("first match = %q, want Tags[0].Value", got)
+	}
+
+	tree.nextMatch(1)
+	if got := tree.copyPath(); got != "Tags[1].Value" {
+		t.Errorf("next match = %q, want Tags[1].Value", got)
+	}
+	tree.nextMatch(1)
+	if got := tree.copyPath(); got != "Tags[0].Value" {
+		t.Errorf("wrapped match = %q, want Tags[0].Value", got)
+	}
+	tree.nextMatch(-1)
+	if got := tree.copyPath(); got != "Tags[1].Value" {
+		t.Errorf("previous match = %q, want Tags[1].Value", got)
+	}
+
+	tree.search("nothing-matches")
+	if len(tree.matches) != 0 || tree.match != -1 {
+		t.Errorf("expected no matches, got %d", len(tree.matches))
+	}
+}
+
+func TestRawTree_Copy(t *testing.T) {
+	tree := newTestRawTree(t)
+
+	tests := []struct {
+		node      *rawNode
+		yaml      bool
+		wantPath  string
+		wantValue string
+	}{
+		{node: tree.root, wantPath: "@"},
+		{node: tree.root.children[0], wantPath: "Name", wantValue: "web"},
+		{node: tree.root.children[3], wantPath: "Missing", wantValue: "null"},
+		{node: tree.root.children[6].children[0], wantPath: `"meta-data".owner`, wantValue: "ops"},
+		{node: tree.root.children[4].children[1], wantPath: "Tags[1]", wantValue: "{\n  \"Key\": \"team\",\n  \"Value\": \"core\"\n}"},
+		{node: tree.root.children[4].children[1], yaml: true, wantPath: "Tags[1]", wantValue: "Key: team\nValue: core"},
+	}
+	for _, tt := range tests {
+		tree.setYAML(tt.yaml)
+		tree.cursor = tree.lineOf(tt.node)
+		if got := tree.copyPath(); got != tt.wantPath {
+			t.Errorf("copyPath() = %q, want %q", got, tt.wantPath)
+		}
+		if tt.wantValue == "" {
+			continue
+		}
+		if got := tree.copyValue(); got != tt.wantValue {
+			t.Errorf("copyValue(%s) = %q, want %q", tt.wantPath, got, tt.wantValue)
+		}
+	}
+}
+
+func TestRawTree_Restore(t *testing.T) {
+	prev := newTestRawTree(t)
+	prev.setYAML(true)
+	prev.cursor = prev.lineOf(prev.root.children[4]) // Tags
+	prev.toggle()
+	prev.search("owner")
+
+	tree := newTestRawTree(t)
+	tree.restore(prev)
+
+	if !tree.yaml {
+		t.Error("format not restored")
+	}
+	if !tree.root.children[4].collapsed {
+		t.Error("folding not restored")
+	}
+	if got := tree.copyPath(); got != `"meta-data".owner` {
+		t.Errorf("cursor = %q, want meta-data owner", got)
+	}
+	if len(tree.matches) != 1 {
+		t.Errorf("matches = %d, want 1", len(tree.matches))
+	}
+}
+
+func TestYAMLString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{"", `""`},
+		{"true", `"true"`},
+		{"No", `"No"`},
+		{"42", `"42"`},
+		{"1.5e3", `"1.5e3"`},
+		{"key: value", `"key: value"`},
+		{"-dash", `"-dash"`},
+		{" padded", `" padded"`},
+		{"line\nbreak", `"line\nbreak"`},
+		{"arn:aws:s3:::bucket", "arn:aws:s3:::bucket"},
+		{"<html>", "<html>"},
+	}
+	for _, tt := range tests {
+		if got := yamlString(tt.in); got != tt.want {
+			t.Errorf("yamlString(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,570 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/kinesisrec"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	defaultRecordPollInterval = time.Second
+	// catchUpPollInterval is used while shards are behind the tip, e.g.
+	// when reading from TRIM_HORIZON
+	catchUpPollInterval   = 200 * time.Millisecond
+	maxRecordPollInterval = 10 * time.Second
+	maxRecordBufferSize   = 1000
+	recordHeaderOffset    = 4 // header(1) + status(1) + spacing(2)
+)
+
+type recordsLoadedMsg struct {
+	gen       int
+	client    kinesisrec.Client  // set when the client was created
+	reader    *kinesisrec.Reader // set when reading (re)started
+	shards    int
+	poll      kinesisrec.Poll
+	err       error
+	throttled bool
+}
+
+type recordTickMsg struct {
+	gen int
+}
+
+type recordViewStyles struct {
+	header    lipgloss.Style
+	timestamp lipgloss.Style
+	meta      lipgloss.Style
+	message   lipgloss.Style
+	paused    lipgloss.Style
+	error     lipgloss.Style
+	dim       lipgloss.Style
+}
+
+func newRecordViewStyles() recordViewStyles {
+	return recordViewStyles{
+		header:    ui.TitleStyle(),
+		timestamp: ui.SecondaryStyle(),
+		meta:      ui.AccentStyle(),
+		message:   ui.TextStyle(),
+		paused:    ui.BoldWarningStyle(),
+		error:     ui.DangerStyle(),
+		dim:       ui.DimStyle(),
+	}
+}
+
+// RecordView follows the records of a Kinesis stream, on one shard or all
+// of them, like LogView follows a log group
+type RecordView struct {
+	ctx        context.Context
+	client     kinesisrec.Client
+	streamName string
+	streamARN  string
+	shardID    string // empty for all shards
+
+	reader   *kinesisrec.Reader
+	gen      int
+	position kinesisrec.Position
+	format   kinesisrec.Format
+	records  []kinesisrec.Record
+	read     int // records read since the start position
+	shards   int
+	open     int
+	behind   time.Duration
+
+	loading      bool
+	paused       bool
+	pending      bool // a poll or tick is outstanding, so polls never overlap
+	err          error
+	pollInterval time.Duration
+
+	filterInput  textinput.Model
+	filterActive bool
+	filterText   string
+
+	positionInput  textinput.Model
+	positionActive bool
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  recordViewStyles
+}
+
+// NewRecordView creates a record view reading from LATEST. An empty
+// shardID reads all shards.
+func NewRecordView(ctx context.Context, streamName, streamARN, shardID string) *RecordView {
+	fi := textinput.New()
+	fi.Placeholder = "Filter records..."
+	fi.Prompt = "/"
+	fi.CharLimit = 200
+
+	pi := textinput.New()
+	pi.Placeholder = "latest, trim_horizon, 2006-01-02 15:04 or 15m (ago)"
+	pi.Prompt = "from: "
+	pi.CharLimit = 64
+
+	return &RecordView{
+		ctx:           ctx,
+		streamName:    streamName,
+		streamARN:     streamARN,
+		shardID:       shardID,
+		position:      kinesisrec.Latest,
+		loading:       true,
+		pollInterval:  defaultRecordPollInterval,
+		filterInput:   fi,
+		positionInput: pi,
+		spinner:       ui.NewSpinner(),
+		styles:        newRecordViewStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *RecordView) Init() tea.Cmd {
+	return tea.Batch(v.start(), v.spinner.Tick)
+}
+
+// start reads from the current position again, dropping the buffer
+func (v *RecordView) start() tea.Cmd {
+	v.gen++
+	v.reader = nil
+	v.records = v.records[:0]
+	v.read = 0
+	v.loading = true
+	v.pending = true
+	v.err = nil
+	v.pollInterval = defaultRecordPollInterval
+	if v.vp.Ready {
+		v.updateViewportContent()
+	}
+
+	ctx, client, gen, pos := v.ctx, v.client, v.gen, v.position
+	streamARN, shardID := v.streamARN, v.shardID
+	return func() tea.Msg {
+		var created kinesisrec.Client
+		if client == nil {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return recordsLoadedMsg{gen: gen, err: apperrors.Wrap(err, "init AWS config")}
+			}
+			client = kinesis.NewFromConfig(cfg)
+			created = client
+		}
+
+		shardIDs := []string{shardID}
+		if shardID == "" {
+			shards, err := kinesisrec.ListShards(ctx, client, streamARN)
+			if err != nil {
+				return recordsLoadedMsg{gen: gen, client: created, err: err}
+			}
+			shardIDs = shardIDs[:0]
+			for _, s := range shards {
+				// Closed shards get no new records
+				if pos == kinesisrec.Latest && !kinesisrec.IsOpen(s) {
+					continue
+				}
+				shardIDs = append(shardIDs, appaws.Str(s.ShardId))
+			}
+		}
+		reader := kinesisrec.NewReader(client, streamARN, shardIDs)
+		if err := reader.Start(ctx, pos); err != nil {
+			return recordsLoadedMsg{gen: gen, client: created, err: err, throttled: apperrors.IsThrottling(err)}
+		}
+		msg := pollRecords(ctx, reader, gen)
+		msg.client, msg.reader, msg.shards = created, reader, len(shardIDs)
+		return msg
+	}
+}
+
+func pollRecords(ctx context.Context, reader *kinesisrec.Reader, gen int) recordsLoadedMsg {
+	poll, err := reader.Poll(ctx)
+	return recordsLoadedMsg{gen: gen, poll: poll, err: err, throttled: apperrors.IsThrottling(err)}
+}
+
+func (v *RecordView) pollCmd() tea.Cmd {
+	ctx, reader, gen := v.ctx, v.reader, v.gen
+	if reader == nil {
+		return nil
+	}
+	v.pending = true
+	return func() tea.Msg {
+		return pollRecords(ctx, reader, gen)
+	}
+}
+
+// tickCmd waits for the next poll, polling faster while catching up
+func (v *RecordView) tickCmd() tea.Cmd {
+	interval := v.pollInterval
+	if v.behind > 0 && interval == defaultRecordPollInterval {
+		interval = catchUpPollInterval
+	}
+	gen := v.gen
+	v.pending = true
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return recordTickMsg{gen: gen}
+	})
+}
+
+// Update implements tea.Model
+func (v *RecordView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case recordsLoadedMsg:
+		return v, v.handleLoaded(msg)
+
+	case recordTickMsg:
+		if msg.gen != v.gen {
+			return v, nil
+		}
+		v.pending = false
+		if v.paused {
+			return v, nil
+		}
+		return v, v.pollCmd()
+
+	case tea.KeyPressMsg:
+		if v.filterActive {
+			return v, v.handleFilterInput(msg)
+		}
+		if v.positionActive {
+			return v, v.handlePositionInput(msg)
+		}
+
+		switch msg.String() {
+		case "/":
+			v.filterActive = true
+			v.filterInput.Focus()
+			v.SetSize(v.width, v.height)
+			return v, textinput.Blink
+		case "t":
+			v.positionActive = true
+			v.positionInput.SetValue("")
+			v.positionInput.Focus()
+			v.SetSize(v.width, v.height)
+			return v, textinput.Blink
+		case "d":
+			v.format = v.format.Next()
+			if v.vp.Ready {
+				v.updateViewportContent()
+			}
+			return v, nil
+		case "space":
+			v.paused = !v.paused
+			if !v.paused && !v.pending && v.open > 0 {
+				return v, v.pollCmd()
+			}
+			return v, nil
+		case "g":
+			if v.vp.Ready {
+				v.vp.Model.GotoTop()
+			}
+			return v, nil
+		case "G":
+			if v.vp.Ready {
+				v.vp.Model.GotoBottom()
+			}
+			return v, nil
+		case "c":
+			if v.filterText != "" {
+				v.filterText = ""
+				v.filterInput.SetValue("")
+				v.SetSize(v.width, v.height)
+				return v, tea.ClearScreen
+			}
+			v.records = v.records[:0]
+			if v.vp.Ready {
+				v.updateViewportContent()
+			}
+			return v, nil
+		}
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newRecordViewStyles()
+		if v.vp.Ready {
+			v.updateViewportContent()
+		}
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *RecordView) handleLoaded(msg recordsLoadedMsg) tea.Cmd {
+	if msg.client != nil {
+		v.client = msg.client
+	}
+	if msg.gen != v.gen {
+		return nil
+	}
+	v.loading = false
+	v.pending = false
+	if msg.reader != nil {
+		v.reader, v.shards = msg.reader, msg.shards
+	}
+	if v.reader != nil {
+		v.open, v.behind = msg.poll.Open, msg.poll.Behind
+	}
+	v.addRecords(msg.poll.Records)
+
+	if msg.err != nil {
+		log.Warn("failed to read records", "stream", v.streamName, "error", msg.err)
+		v.err = msg.err
+		if msg.throttled && v.reader != nil {
+			v.pollInterval = min(v.pollInterval*2, maxRecordPollInterval)
+			if !v.paused {
+				return v.tickCmd()
+			}
+		}
+		return nil
+	}
+	v.err = nil
+	v.pollInterval = defaultRecordPollInterval
+	if v.paused || v.open == 0 {
+		return nil
+	}
+	return v.tickCmd()
+}
+
+func (v *RecordView) addRecords(records []kinesisrec.Record) {
+	if len(records) == 0 {
+		return
+	}
+	v.read += len(records)
+	v.records = append(v.records, records...)
+	if len(v.records) > maxRecordBufferSize {
+		v.records = v.records[len(v.records)-maxRecordBufferSize:]
+	}
+	if v.vp.Ready {
+		v.updateViewportContent()
+		v.vp.Model.GotoBottom()
+	}
+}
+
+func (v *RecordView) handleFilterInput(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "enter":
+		v.filterActive = false
+		v.filterInput.Blur()
+		v.filterText = v.filterInput.Value()
+		v.SetSize(v.width, v.height)
+		return nil
+	}
+	var cmd tea.Cmd
+	v.filterInput, cmd = v.filterInput.Update(msg)
+	v.filterText = v.filterInput.Value()
+	if v.vp.Ready {
+		v.updateViewportContent()
+	}
+	return cmd
+}
+
+func (v *RecordView) handlePositionInput(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.positionActive = false
+		v.positionInput.Blur()
+		v.SetSize(v.width, v.height)
+		return nil
+	case "enter":
+		pos, err := kinesisrec.ParsePosition(v.positionInput.Value(), time.Now())
+		if err != nil {
+			v.err = err
+			return nil
+		}
+		v.positionActive = false
+		v.positionInput.Blur()
+		v.position = pos
+		v.SetSize(v.width, v.height)
+		return tea.Batch(v.start(), v.spinner.Tick)
+	}
+	var cmd tea.Cmd
+	v.positionInput, cmd = v.positionInput.Update(msg)
+	return cmd
+}
+
+func (v *RecordView) matchesFilter(r kinesisrec.Record, data string) bool {
+	if v.filterText == "" {
+		return true
+	}
+	filter := strings.ToLower(v.filterText)
+	return strings.Contains(strings.ToLower(r.PartitionKey), filter) ||
+		strings.Contains(strings.ToLower(data), filter)
+}
+
+func (v *RecordView) updateViewportContent() {
+	var sb strings.Builder
+	for _, r := range v.records {
+		data := kinesisrec.Decode(r.Data, v.format)
+		if !v.matchesFilter(r, data) {
+			continue
+		}
+		sb.WriteString(v.styles.timestamp.Render(r.Arrival.Format("15:04:05.000")))
+		meta := "key=" + r.PartitionKey
+		if v.shardID == "" {
+			meta = r.ShardID + " " + meta
+		}
+		sb.WriteString(" " + v.styles.meta.Render(meta))
+		sb.WriteString(" " + v.styles.dim.Render(render.FormatSize(int64(len(r.Data)))))
+		if strings.Contains(data, "\n") {
+			sb.WriteString("\n")
+			for _, line := range strings.Split(data, "\n") {
+				sb.WriteString("  " + v.styles.message.Render(line) + "\n")
+			}
+			continue
+		}
+		sb.WriteString(" " + v.styles.message.Render(data) + "\n")
+	}
+	v.vp.Model.SetContent(sb.String())
+}
+
+func (v *RecordView) displayedCount() int {
+	if v.filterText == "" {
+		return len(v.records)
+	}
+	count := 0
+	for _, r := range v.records {
+		if v.matchesFilter(r, kinesisrec.Decode(r.Data, v.format)) {
+			count++
+		}
+	}
+	return count
+}
+
+// ViewString renders the view
+func (v *RecordView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+
+	var sb strings.Builder
+	title := v.streamName
+	if v.shardID != "" {
+		title += " / " + v.shardID
+	}
+	sb.WriteString(v.styles.header.Render("🌊 " + title))
+	sb.WriteString("\n")
+
+	if v.filterActive {
+		sb.WriteString(ui.InputFieldStyle().Render(v.filterInput.View()) + "\n")
+	} else if v.filterText != "" {
+		sb.WriteString(ui.AccentStyle().Render("🔍 filter: "+v.filterText) + "\n")
+	}
+	if v.positionActive {
+		sb.WriteString(ui.InputFieldStyle().Render(v.positionInput.View()) + "\n")
+	}
+
+	if v.paused {
+		sb.WriteString(v.styles.paused.Render("⏸ PAUSED") + " ")
+	}
+	info := []string{"from " + v.position.String(), v.format.String()}
+	if v.shardID == "" && v.reader != nil {
+		info = append(info, fmt.Sprintf("%d/%d shards open", v.open, v.shards))
+	}
+	count := fmt.Sprintf("%d records", len(v.records))
+	if shown := v.displayedCount(); v.filterText != "" && shown < len(v.records) {
+		count = fmt.Sprintf("%d/%d records", shown, len(v.records))
+	}
+	info = append(info, count)
+	if v.behind > 0 {
+		info = append(info, "behind "+render.FormatDuration(v.behind))
+	}
+	sb.WriteString(v.styles.dim.Render(strings.Join(info, " • ")))
+	sb.WriteString("\n\n")
+
+	if v.loading {
+		sb.WriteString(v.spinner.View() + " Reading records...")
+		return sb.String()
+	}
+	if v.err != nil {
+		sb.WriteString(v.styles.error.Render(fmt.Sprintf("Error: %v", v.err)) + "\n")
+	}
+	if len(v.records) == 0 {
+		switch {
+		case v.reader != nil && v.open == 0:
+			sb.WriteString(v.styles.dim.Render("No records: all shards are closed and read to their end"))
+		case v.err == nil:
+			sb.WriteString(v.styles.dim.Render("Waiting for records from " + v.position.String() + "..."))
+		}
+		return sb.String()
+	}
+
+	sb.WriteString(v.vp.Model.View())
+	return sb.String()
+}
+
+// View implements tea.Model
+func (v *RecordView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *RecordView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+
+	offset := recordHeaderOffset
+	if v.filterActive || v.filterText != "" {
+		offset++
+	}
+	if v.positionActive {
+		offset++
+	}
+	v.vp.SetSize(width, height-offset)
+
+	inputWidth := max(width-filterInputPadding, minFilterWidth)
+	v.filterInput.SetWidth(inputWidth)
+	v.positionInput.SetWidth(inputWidth)
+
+	v.updateViewportContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *RecordView) StatusLine() string {
+	if v.filterActive {
+		return "Esc/Enter:done"
+	}
+	if v.positionActive {
+		return "Esc:cancel Enter:read from here"
+	}
+	status := "Space:pause/resume t:start position d:decode g/G:top/bottom c:clear /:filter Esc:back"
+	switch {
+	case v.paused:
+		return "⏸ PAUSED • " + status
+	case v.pollInterval > defaultRecordPollInterval:
+		return fmt.Sprintf("⏳ THROTTLED (%ds) • %s", int(v.pollInterval.Seconds()), status)
+	case v.reader != nil && v.open == 0:
+		return "■ DONE • " + status
+	}
+	return "▶ FOLLOWING • " + status
+}
+
+// HasActiveInput implements InputCapture
+func (v *RecordView) HasActiveInput() bool {
+	return v.filterActive || v.positionActive
+}
@@ -0,0 +1,209 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+)
+
+// fakeKinesis serves open shards whose iterator is the shard ID
+type fakeKinesis struct {
+	shards    []string
+	closed    []string
+	records   map[string][]types.Record
+	iterators []*kinesis.GetShardIteratorInput
+	gets      int
+}
+
+func (f *fakeKinesis) ListShards(_ context.Context, _ *kinesis.ListShardsInput, _ ...func(*kinesis.Options)) (*kinesis.ListShardsOutput, error) {
+	out := &kinesis.ListShardsOutput{}
+	for _, id := range f.shards {
+		out.Shards = append(out.Shards, types.Shard{ShardId: aws.String(id)})
+	}
+	for _, id := range f.closed {
+		out.Shards = append(out.Shards, types.Shard{
+			ShardId:             aws.String(id),
+			SequenceNumberRange: &types.SequenceNumberRange{EndingSequenceNumber: aws.String("9")},
+		})
+	}
+	return out, nil
+}
+
+func (f *fakeKinesis) GetShardIterator(_ context.Context, in *kinesis.GetShardIteratorInput, _ ...func(*kinesis.Options)) (*kinesis.GetShardIteratorOutput, error) {
+	f.iterators = append(f.iterators, in)
+	return &kinesis.GetShardIteratorOutput{ShardIterator: in.ShardId}, nil
+}
+
+func (f *fakeKinesis) GetRecords(_ context.Context, in *kinesis.GetRecordsInput, _ ...func(*kinesis.Options)) (*kinesis.GetRecordsOutput, error) {
+	f.gets++
+	id := aws.ToString(in.ShardIterator)
+	out := &kinesis.GetRecordsOutput{Records: f.records[id], NextShardIterator: in.ShardIterator, MillisBehindLatest: aws.Int64(0)}
+	delete(f.records, id)
+	return out, nil
+}
+
+func testKinesisRecord(seq, key, data string) types.Record {
+	return types.Record{
+		SequenceNumber:              aws.String(seq),
+		PartitionKey:                aws.String(key),
+		Data:                        []byte(data),
+		ApproximateArrivalTimestamp: aws.Time(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}
+}
+
+// runRecordCmd runs a command, feeding reads back into the view. Ticks
+// are not waited for.
+func runRecordCmd(v *RecordView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runRecordCmd(v, c)
+		}
+	case recordsLoadedMsg:
+		v.Update(msg)
+	}
+}
+
+func newTestRecordView(client *fakeKinesis, shardID string) *RecordView {
+	v := NewRecordView(context.Background(), "orders", "arn:aws:kinesis:us-east-1:123456789012:stream/orders", shardID)
+	v.client = client
+	v.SetSize(120, 30)
+	runRecordCmd(v, v.Init())
+	return v
+}
+
+func TestRecordView_FollowAllShards(t *testing.T) {
+	client := &fakeKinesis{
+		shards: []string{"shardId-0", "shardId-1"},
+		closed: []string{"shardId-old"},
+		records: map[string][]types.Record{
+			"shardId-0": {testKinesisRecord("1", "user-1", `{"order":1}`)},
+		},
+	}
+	v := newTestRecordView(client, "")
+
+	if len(client.iterators) != 2 || client.iterators[0].ShardIteratorType != types.ShardIteratorTypeLatest {
+		t.Fatalf("LATEST should only read open shards, iterators = %+v", client.iterators)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"orders", "from LATEST", "2/2 shards open", "1 records", "shardId-0 key=user-1", `{"order":1}`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+
+	// A tick polls again and appends
+	client.records["shardId-1"] = []types.Record{testKinesisRecord("2", "user-2", "second")}
+	_, cmd := v.Update(recordTickMsg{gen: v.gen})
+	runRecordCmd(v, cmd)
+	if len(v.records) != 2 {
+		t.Errorf("records = %d, want 2 after a tick", len(v.records))
+	}
+
+	// Decoding as JSON indents the record under its header
+	v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if v.format.String() != "json" || !strings.Contains(v.vp.Model.View(), `"order": 1`) {
+		t.Errorf("d should decode as JSON:\n%s", v.vp.Model.View())
+	}
+}
+
+func TestRecordView_PauseAndStale(t *testing.T) {
+	client := &fakeKinesis{shards: []string{"shardId-0"}, records: map[string][]types.Record{}}
+	v := newTestRecordView(client, "shardId-0")
+	gets := client.gets
+
+	v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	if !v.paused || !strings.HasPrefix(v.StatusLine(), "⏸ PAUSED") {
+		t.Fatalf("space should pause, status = %q", v.StatusLine())
+	}
+	if _, cmd := v.Update(recordTickMsg{gen: v.gen}); cmd != nil {
+		t.Error("a tick while paused should not poll")
+	}
+
+	// Results of an earlier start are dropped
+	v.Update(recordsLoadedMsg{gen: v.gen - 1})
+	if v.gen != 1 || client.gets != gets {
+		t.Errorf("gen = %d, gets = %d", v.gen, client.gets)
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	runRecordCmd(v, cmd)
+	if v.paused || client.gets != gets+1 {
+		t.Errorf("resuming should poll at once, gets = %d", client.gets)
+	}
+}
+
+func TestRecordView_StartPosition(t *testing.T) {
+	client := &fakeKinesis{
+		shards:  []string{"shardId-0"},
+		records: map[string][]types.Record{"shardId-0": {testKinesisRecord("1", "k", "old")}},
+	}
+	v := newTestRecordView(client, "shardId-0")
+	if len(v.records) != 1 {
+		t.Fatalf("records = %d, want 1", len(v.records))
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	if !v.HasActiveInput() {
+		t.Fatal("t should open the position input")
+	}
+	for _, r := range "soon" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.err == nil || !v.HasActiveInput() {
+		t.Fatal("an invalid position should keep the input open with an error")
+	}
+
+	v.positionInput.SetValue("trim")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(v.records) != 0 || v.gen != 2 {
+		t.Errorf("a new position should clear the buffer, records = %d", len(v.records))
+	}
+	runRecordCmd(v, cmd)
+	last := client.iterators[len(client.iterators)-1]
+	if last.ShardIteratorType != types.ShardIteratorTypeTrimHorizon || aws.ToString(last.ShardId) != "shardId-0" {
+		t.Errorf("iterator = %+v, want TRIM_HORIZON on the shard", last)
+	}
+	if !strings.Contains(v.ViewString(), "from TRIM_HORIZON") {
+		t.Errorf("view should show the position:\n%s", v.ViewString())
+	}
+}
+
+func TestRecordView_Filter(t *testing.T) {
+	client := &fakeKinesis{
+		shards: []string{"shardId-0"},
+		records: map[string][]types.Record{"shardId-0": {
+			testKinesisRecord("1", "user-1", "created"),
+			testKinesisRecord("2", "user-2", "paid"),
+		}},
+	}
+	v := newTestRecordView(client, "shardId-0")
+
+	v.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	for _, r := range "user-2" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	content := v.vp.Model.View()
+	if strings.Contains(content, "created") || !strings.Contains(content, "paid") {
+		t.Errorf("filter should match partition keys:\n%s", content)
+	}
+	if !strings.Contains(v.ViewString(), "1/2 records") {
+		t.Errorf("view should count filtered records:\n%s", v.ViewString())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if v.filterText != "" || len(v.records) != 2 {
+		t.Error("c should clear the filter before the buffer")
+	}
+}
@@ -13,8 +13,6 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
-	"github.com/clawscli/claws/internal/aws"
-	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/metrics"
 	"github.com/clawscli/claws/internal/registry"
@@ -115,8 +113,12 @@ type ResourceBrowser struct {
 	// Diff mark (for comparing two resources)
 	markedResource dao.Resource
 
+	// Compare selection (for comparing many resources)
+	selected []dao.Resource
+
 	// Inline metrics
 	metricsEnabled bool
+	metricsColumn  int // 0 shows all metric columns, n shows only the nth
 	metricsLoading bool
 	metricsData    *metrics.MetricData
 
@@ -233,6 +235,12 @@ func (r *ResourceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return r.handleTagFilterMsg(msg)
 	case DiffMsg:
 		return r.handleDiffMsg(msg)
+	case TimelineMsg:
+		return r.handleTimelineMsg()
+	case IaCMsg:
+		return r.handleIaCMsg(msg)
+	case diffFetchedMsg:
+		return r.handleDiffFetched(msg)
 	case tea.KeyPressMsg:
 		if model, cmd := r.handleKeyPress(msg); model != nil || cmd != nil {
 			if model == nil {
@@ -349,15 +357,7 @@ func (r *ResourceBrowser) HasActiveInput() bool {
 }
 
 func (r *ResourceBrowser) contextForResource(res dao.Resource) (context.Context, dao.Resource) {
-	ctx := r.ctx
-	if profile := dao.GetResourceProfile(res); profile != "" {
-		sel := config.ProfileSelectionFromID(profile)
-		ctx = aws.WithSelectionOverride(ctx, sel)
-	}
-	if region := dao.GetResourceRegion(res); region != "" {
-		ctx = aws.WithRegionOverride(ctx, region)
-	}
-	return ctx, res
+	return resourceContext(r.ctx, res), res
 }
 
 func (r *ResourceBrowser) renderTabs() string {
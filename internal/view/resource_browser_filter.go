@@ -1,11 +1,8 @@
 package view
 
 import (
-	"fmt"
-	"reflect"
 	"strings"
 
-	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/filter"
 	"github.com/clawscli/claws/internal/render"
@@ -78,6 +75,20 @@ func (r *ResourceBrowser) applyFilter() {
 			r.markedResource = nil
 		}
 	}
+
+	// Keep selected resources that are still listed, using their current data
+	if len(r.selected) > 0 {
+		selected := r.selected[:0]
+		for _, sel := range r.selected {
+			for _, res := range r.filtered {
+				if res.GetID() == sel.GetID() {
+					selected = append(selected, res)
+					break
+				}
+			}
+		}
+		r.selected = selected
+	}
 }
 
 // matchesTagFilter checks if a resource matches the tag filter.
@@ -87,42 +98,7 @@ func (r *ResourceBrowser) matchesTagFilter(res dao.Resource, tagFilter string) b
 
 // matchesFieldFilter checks if a resource matches the field-based filter
 func (r *ResourceBrowser) matchesFieldFilter(res dao.Resource) bool {
-	filterValue := r.fieldFilterValue
-
-	// First, try matching by ID or Name with the original filter value
-	// This handles cases where ID is the full ARN (e.g., LoadBalancer, StateMachine)
-	if res.GetID() == filterValue || res.GetName() == filterValue {
-		return true
-	}
-
-	// Extract resource name from ARN if the filter value is an ARN
-	// e.g., "arn:aws:iam::123456789012:role/MyRole" -> "MyRole"
-	// This handles cases where ID is the resource name (e.g., IAM Role)
-	if strings.HasPrefix(filterValue, "arn:aws:") {
-		extractedName := appaws.ExtractResourceName(filterValue)
-		if res.GetID() == extractedName || res.GetName() == extractedName {
-			return true
-		}
-	}
-
-	// Then try field-based matching using reflection
-	data := res.Raw()
-	if data == nil {
-		// No raw data - assume DAO already filtered
-		return true
-	}
-
-	// Try to get the field value using the getter interface
-	fieldValue := getFieldValue(data, r.fieldFilter)
-
-	// If field not found (empty string), assume DAO already filtered correctly
-	// This handles cases like ECS where DAO uses "ClusterName" context filter
-	// but the actual struct has "ClusterArn" field
-	if fieldValue == "" {
-		return true
-	}
-
-	return fieldValue == filterValue
+	return filter.MatchesField(res, r.fieldFilter, r.fieldFilterValue)
 }
 
 // matchesFilter checks if a resource matches the text filter
@@ -145,51 +121,3 @@ func (r *ResourceBrowser) matchesFilter(res dao.Resource, cols []render.Column,
 
 	return false
 }
-
-// getFieldValue extracts a field value from an AWS resource using reflection
-func getFieldValue(data any, fieldName string) string {
-	if data == nil {
-		return ""
-	}
-
-	v := reflect.ValueOf(data)
-
-	// Handle pointer
-	if v.Kind() == reflect.Ptr {
-		if v.IsNil() {
-			return ""
-		}
-		v = v.Elem()
-	}
-
-	// Must be a struct
-	if v.Kind() != reflect.Struct {
-		return ""
-	}
-
-	// Get the field
-	field := v.FieldByName(fieldName)
-	if !field.IsValid() {
-		return ""
-	}
-
-	// Handle pointer fields (common in AWS SDK)
-	if field.Kind() == reflect.Ptr {
-		if field.IsNil() {
-			return ""
-		}
-		field = field.Elem()
-	}
-
-	// Return string representation
-	switch field.Kind() {
-	case reflect.String:
-		return field.String()
-	case reflect.Int, reflect.Int32, reflect.Int64:
-		return fmt.Sprintf("%d", field.Int())
-	case reflect.Bool:
-		return fmt.Sprintf("%v", field.Bool())
-	default:
-		return fmt.Sprintf("%v", field.Interface())
-	}
-}
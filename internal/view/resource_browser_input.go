@@ -1,6 +1,8 @@
 package view
 
 import (
+	"slices"
+
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 
@@ -38,8 +40,12 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleEsc()
 	case "m":
 		return r.handleMark()
+	case "space":
+		return r.handleSelect()
 	case "M":
 		return r.handleMetricsToggle()
+	case "ctrl+g":
+		return r.handleMetricsChart()
 	case "d", "enter":
 		return r.handleEnter()
 	case "a":
@@ -133,14 +139,16 @@ func (r *ResourceBrowser) handleClearFilter() (tea.Model, tea.Cmd) {
 	r.fieldFilter = ""
 	r.fieldFilterValue = ""
 	r.markedResource = nil
+	r.selected = nil
 	r.loading = true
 	r.err = nil
 	return r, tea.Batch(r.loadResources, r.spinner.Tick)
 }
 
 func (r *ResourceBrowser) handleEsc() (tea.Model, tea.Cmd) {
-	if r.markedResource != nil {
+	if r.markedResource != nil || len(r.selected) > 0 {
 		r.markedResource = nil
+		r.selected = nil
 		r.buildTable()
 		return r, nil
 	}
@@ -161,9 +169,41 @@ func (r *ResourceBrowser) handleMark() (tea.Model, tea.Cmd) {
 	return r, nil
 }
 
+// handleSelect toggles the current row in the compare selection
+func (r *ResourceBrowser) handleSelect() (tea.Model, tea.Cmd) {
+	cursor := r.tc.Cursor()
+	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
+		resource := r.filtered[cursor]
+		if idx := r.selectedIndex(resource.GetID()); idx >= 0 {
+			r.selected = slices.Delete(r.selected, idx, idx+1)
+		} else {
+			r.selected = append(r.selected, resource)
+		}
+		r.tc.SetCursor(cursor+1, len(r.filtered))
+		r.tc.UpdateScrollOffset(len(r.filtered))
+		r.buildTable()
+	}
+	return r, nil
+}
+
+// selectedIndex returns the position of id in the compare selection, or -1
+func (r *ResourceBrowser) selectedIndex(id string) int {
+	return slices.IndexFunc(r.selected, func(res dao.Resource) bool { return res.GetID() == id })
+}
+
+// handleMetricsToggle cycles inline metrics: off → all columns → each column alone → off.
 func (r *ResourceBrowser) handleMetricsToggle() (tea.Model, tea.Cmd) {
-	if r.getMetricSpec() != nil {
-		r.metricsEnabled = !r.metricsEnabled
+	if specs := r.getMetricSpecs(); len(specs) > 0 {
+		switch {
+		case !r.metricsEnabled:
+			r.metricsEnabled = true
+			r.metricsColumn = 0
+		case len(specs) > 1 && r.metricsColumn < len(specs):
+			r.metricsColumn++
+		default:
+			r.metricsEnabled = false
+			r.metricsColumn = 0
+		}
 		if r.metricsEnabled && r.metricsData == nil {
 			r.metricsLoading = true
 			return r, r.loadMetricsCmd()
@@ -173,7 +213,27 @@ func (r *ResourceBrowser) handleMetricsToggle() (tea.Model, tea.Cmd) {
 	return r, nil
 }
 
+func (r *ResourceBrowser) handleMetricsChart() (tea.Model, tea.Cmd) {
+	specs := metricChartSpecs(r.renderer, r.service, r.resourceType)
+	cursor := r.tc.Cursor()
+	if len(specs) == 0 || len(r.filtered) == 0 || cursor < 0 || cursor >= len(r.filtered) {
+		return r, nil
+	}
+	ctx, resource := r.contextForResource(r.filtered[cursor])
+	chartView := NewMetricsChartView(ctx, resource, specs, r.service, r.resourceType)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: chartView}
+	}
+}
+
 func (r *ResourceBrowser) handleEnter() (tea.Model, tea.Cmd) {
+	if len(r.selected) >= 2 {
+		compareView := NewCompareView(r.ctx, slices.Clone(r.selected), r.service, r.resourceType)
+		return r, func() tea.Msg {
+			return NavigateMsg{View: compareView}
+		}
+	}
+
 	cursor := r.tc.Cursor()
 	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
 		ctx, resource := r.contextForResource(r.filtered[cursor])
@@ -213,7 +273,9 @@ func (r *ResourceBrowser) handleNumberKey(key string) (tea.Model, tea.Cmd) {
 		r.filterText = ""
 		r.filterInput.SetValue("")
 		r.markedResource = nil
+		r.selected = nil
 		r.metricsEnabled = false
+		r.metricsColumn = 0
 		r.metricsData = nil
 		return r, tea.Batch(r.loadResources, r.spinner.Tick)
 	}
@@ -311,7 +373,9 @@ func (r *ResourceBrowser) switchToTab(idx int) (tea.Model, tea.Cmd) {
 	}
 	r.resourceType = r.resourceTypes[idx]
 	r.markedResource = nil
+	r.selected = nil
 	r.metricsEnabled = false
+	r.metricsColumn = 0
 	r.metricsData = nil
 	return r, r.loadResources
 }
@@ -19,22 +19,22 @@ type metricsLoadedMsg struct {
 }
 
 func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
-	spec := r.getMetricSpec()
-	if spec == nil {
+	specs := r.getMetricSpecs()
+	if len(specs) == 0 {
 		return nil
 	}
 
 	type resourceInfo struct {
-		fullID      string
-		unwrappedID string
-		region      string
+		fullID    string
+		unwrapped dao.Resource
+		region    string
 	}
 	infos := make([]resourceInfo, len(r.resources))
 	for i, res := range r.resources {
 		infos[i] = resourceInfo{
-			fullID:      res.GetID(),
-			unwrappedID: dao.UnwrapResource(res).GetID(),
-			region:      dao.GetResourceRegion(res),
+			fullID:    res.GetID(),
+			unwrapped: dao.UnwrapResource(res),
+			region:    dao.GetResourceRegion(res),
 		}
 	}
 	resourceType := r.resourceType
@@ -53,7 +53,7 @@ func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
 			byRegion[info.region] = append(byRegion[info.region], info)
 		}
 
-		data := metrics.NewMetricData(spec)
+		data := metrics.NewMetricData(specs)
 
 		for region, regionInfos := range byRegion {
 			regionCtx := ctx
@@ -66,20 +66,22 @@ func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
 				continue
 			}
 
-			unwrappedIDs := make([]string, len(regionInfos))
+			unwrapped := make([]dao.Resource, len(regionInfos))
 			for i, info := range regionInfos {
-				unwrappedIDs[i] = info.unwrappedID
+				unwrapped[i] = info.unwrapped
 			}
 
-			regionData, err := fetcher.Fetch(regionCtx, unwrappedIDs, spec)
+			regionData, err := fetcher.Fetch(regionCtx, unwrapped, specs)
 			if err != nil {
 				continue
 			}
 
-			for i, info := range regionInfos {
-				if result := regionData.Get(unwrappedIDs[i]); result != nil {
-					result.ResourceID = info.fullID
-					data.Results[info.fullID] = result
+			for s := range specs {
+				for _, info := range regionInfos {
+					if result := regionData.Get(s, info.unwrapped.GetID()); result != nil {
+						result.ResourceID = info.fullID
+						data.Set(s, info.fullID, result)
+					}
 				}
 			}
 		}
@@ -88,12 +90,53 @@ func (r *ResourceBrowser) loadMetricsCmd() tea.Cmd {
 	}
 }
 
-func (r *ResourceBrowser) getMetricSpec() *render.MetricSpec {
+// getMetricSpecs returns the built-in inline metrics of the renderer followed
+// by the metric columns configured for the resource type.
+func (r *ResourceBrowser) getMetricSpecs() []render.MetricSpec {
 	if r.renderer == nil {
 		return nil
 	}
+	var specs []render.MetricSpec
 	if provider, ok := r.renderer.(render.MetricSpecProvider); ok {
-		return provider.MetricSpec()
+		specs = append(specs, provider.MetricSpecs()...)
+	}
+	return append(specs, customMetricSpecs(r.service, r.resourceType)...)
+}
+
+// customMetricSpecs converts the metric columns configured in config.yaml for a resource type.
+func customMetricSpecs(service, resourceType string) []render.MetricSpec {
+	columns := config.File().MetricColumns(service, resourceType)
+	if len(columns) == 0 {
+		return nil
+	}
+	specs := make([]render.MetricSpec, len(columns))
+	for i, col := range columns {
+		specs[i] = render.MetricSpec{
+			Namespace:      col.Namespace,
+			MetricName:     col.Metric,
+			DimensionName:  col.Dimension,
+			DimensionField: col.Field,
+			Stat:           col.Stat,
+			ColumnHeader:   col.Header,
+			Unit:           col.Unit,
+			Expression:     col.Expression,
+		}
+	}
+	return specs
+}
+
+// visibleMetricColumns returns the indexes of the metric specs shown as columns.
+func (r *ResourceBrowser) visibleMetricColumns() []int {
+	specs := r.getMetricSpecs()
+	if !r.metricsEnabled || len(specs) == 0 {
+		return nil
+	}
+	if r.metricsColumn > 0 && r.metricsColumn <= len(specs) {
+		return []int{r.metricsColumn - 1}
+	}
+	cols := make([]int, len(specs))
+	for i := range specs {
+		cols[i] = i
 	}
-	return nil
+	return cols
 }
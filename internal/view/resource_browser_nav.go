@@ -51,7 +51,9 @@ func (r *ResourceBrowser) cycleResourceType(delta int) {
 	r.filterText = ""
 	r.filterInput.SetValue("")
 	r.markedResource = nil
+	r.selected = nil
 	r.metricsEnabled = false
+	r.metricsColumn = 0
 	r.metricsData = nil
 }
 
@@ -92,23 +94,32 @@ func (r *ResourceBrowser) StatusLine() string {
 		}
 	}
 
+	if len(r.selected) > 0 {
+		markInfo += fmt.Sprintf(" [● %d selected]", len(r.selected))
+	}
+
 	navInfo := r.getNavigationShortcuts()
 	toggleInfo := r.getToggleInfo()
 
 	dHint := "d:describe"
-	if r.markedResource != nil && markInFiltered {
+	if len(r.selected) >= 2 {
+		dHint = "d:compare"
+	} else if r.markedResource != nil && markInFiltered {
 		dHint = "d:diff"
 	}
 
 	metricsHint := ""
-	if r.getMetricSpec() != nil {
+	if len(r.getMetricSpecs()) > 0 {
 		if r.metricsLoading {
 			metricsHint = " M:metrics(loading)"
+		} else if r.metricsEnabled && r.metricsColumn > 0 {
+			metricsHint = fmt.Sprintf(" M:metrics(%s)", r.getMetricSpecs()[r.metricsColumn-1].ColumnHeader)
 		} else if r.metricsEnabled {
 			metricsHint = " M:metrics(on)"
 		} else {
 			metricsHint = " M:metrics"
 		}
+		metricsHint += " ^g:chart"
 	}
 
 	partialWarn := ""
@@ -38,7 +38,8 @@ func (r *ResourceBrowser) buildTable() {
 		return
 	}
 
-	effectiveMetricsEnabled := r.metricsEnabled && r.getMetricSpec() != nil
+	metricCols := r.visibleMetricColumns()
+	specs := r.getMetricSpecs()
 	isMultiProfile := config.Global().IsMultiProfile()
 	isMultiRegion := config.Global().IsMultiRegion()
 
@@ -48,9 +49,7 @@ func (r *ResourceBrowser) buildTable() {
 	} else if isMultiRegion {
 		numCols++
 	}
-	if effectiveMetricsEnabled {
-		numCols++
-	}
+	numCols += len(metricCols)
 
 	headers := make([]string, numCols)
 	headers[0] = ""
@@ -72,13 +71,13 @@ func (r *ResourceBrowser) buildTable() {
 		colIdx++
 	}
 
-	if effectiveMetricsEnabled {
-		spec := r.getMetricSpec()
-		header := "METRICS"
-		if spec != nil {
-			header = spec.ColumnHeader
+	for _, specIdx := range metricCols {
+		header := specs[specIdx].ColumnHeader
+		if header == "" {
+			header = "METRICS"
 		}
 		headers[colIdx] = header
+		colIdx++
 	}
 
 	var summaryFields []render.SummaryField
@@ -95,7 +94,7 @@ func (r *ResourceBrowser) buildTable() {
 	}
 	r.tc.SetTableHeight(tableHeight)
 
-	widths := r.calculateColumnWidths(cols, isMultiProfile, isMultiRegion, effectiveMetricsEnabled, numCols)
+	widths := r.calculateColumnWidths(cols, isMultiProfile, isMultiRegion, len(metricCols), numCols)
 
 	t := table.New().
 		Headers(headers...).
@@ -116,6 +115,8 @@ func (r *ResourceBrowser) buildTable() {
 		mark := " "
 		if r.markedResource != nil && r.markedResource.GetID() == res.GetID() {
 			mark = "◆"
+		} else if r.selectedIndex(res.GetID()) >= 0 {
+			mark = "●"
 		}
 
 		fullRow := make([]string, numCols)
@@ -135,14 +136,9 @@ func (r *ResourceBrowser) buildTable() {
 			fullRow[rowIdx] = dao.GetResourceRegion(res)
 			rowIdx++
 		}
-		if effectiveMetricsEnabled && r.metricsData != nil {
-			unit := ""
-			if r.metricsData.Spec != nil {
-				unit = r.metricsData.Spec.Unit
-			}
-			fullRow[rowIdx] = metrics.RenderSparkline(r.metricsData.Get(res.GetID()), unit)
-		} else if effectiveMetricsEnabled {
-			fullRow[rowIdx] = metrics.RenderSparkline(nil, "")
+		for _, specIdx := range metricCols {
+			fullRow[rowIdx] = metrics.RenderSparkline(r.metricsData.Get(specIdx, res.GetID()), specs[specIdx].Unit)
+			rowIdx++
 		}
 
 		t = t.Row(fullRow...)
@@ -155,8 +151,9 @@ func (r *ResourceBrowser) buildTable() {
 	r.tableContent = t.String()
 }
 
-func (r *ResourceBrowser) calculateColumnWidths(cols []render.Column, isMultiProfile, isMultiRegion, hasMetrics bool, numCols int) []int {
+func (r *ResourceBrowser) calculateColumnWidths(cols []render.Column, isMultiProfile, isMultiRegion bool, numMetricCols, numCols int) []int {
 	metricsColWidth := metrics.ColumnWidth
+	hasMetrics := numMetricCols > 0
 
 	totalColWidth := markColWidth
 	for _, col := range cols {
@@ -167,9 +164,7 @@ func (r *ResourceBrowser) calculateColumnWidths(cols []render.Column, isMultiPro
 	} else if isMultiRegion {
 		totalColWidth += regionColWidth
 	}
-	if hasMetrics {
-		totalColWidth += metricsColWidth
-	}
+	totalColWidth += metricsColWidth * numMetricCols
 
 	extraWidth := r.width - totalColWidth
 	if extraWidth < 0 {
@@ -210,8 +205,13 @@ func (r *ResourceBrowser) calculateColumnWidths(cols []render.Column, isMultiPro
 		colIdx++
 	}
 
-	if hasMetrics {
-		widths[colIdx] = metricsColWidth + extraWidth
+	for i := range numMetricCols {
+		w := metricsColWidth
+		if i == numMetricCols-1 {
+			w += extraWidth
+		}
+		widths[colIdx] = w
+		colIdx++
 	}
 
 	return widths
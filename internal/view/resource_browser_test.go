@@ -8,7 +8,9 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/metrics"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
 )
 
 func TestResourceBrowserFilterEsc(t *testing.T) {
@@ -694,3 +696,55 @@ func TestResourceBrowserCopyEmptyList(t *testing.T) {
 		t.Error("Expected nil cmd for 'Y' on empty list")
 	}
 }
+
+type mockMetricsRenderer struct {
+	mockRenderer
+}
+
+func (m *mockMetricsRenderer) MetricSpecs() []render.MetricSpec {
+	return []render.MetricSpec{
+		{Namespace: "AWS/Lambda", MetricName: "Invocations", DimensionName: "FunctionName", Stat: "Sum", ColumnHeader: "INVOC(15m)"},
+		{Namespace: "AWS/Lambda", MetricName: "Errors", DimensionName: "FunctionName", Stat: "Sum", ColumnHeader: "ERRORS(15m)"},
+	}
+}
+
+func TestResourceBrowserMetricsToggleCycle(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "lambda")
+	browser.renderer = &mockMetricsRenderer{}
+	specs := browser.getMetricSpecs()
+	browser.metricsData = metrics.NewMetricData(specs)
+
+	wantSteps := [][]int{{0, 1}, {0}, {1}, nil}
+	for i, want := range wantSteps {
+		browser.handleMetricsToggle()
+		got := browser.visibleMetricColumns()
+		if len(got) != len(want) {
+			t.Fatalf("step %d: visibleMetricColumns() = %v, want %v", i, got, want)
+		}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Errorf("step %d: visibleMetricColumns() = %v, want %v", i, got, want)
+			}
+		}
+	}
+	if browser.metricsEnabled {
+		t.Error("Expected metrics to be disabled after full cycle")
+	}
+}
+
+func TestResourceBrowserMetricColumnWidths(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "lambda")
+	browser.width = 100
+	cols := []render.Column{{Name: "NAME", Width: 20}}
+
+	widths := browser.calculateColumnWidths(cols, false, false, 2, 4)
+	if widths[1] != 20 {
+		t.Errorf("name width = %d, want 20", widths[1])
+	}
+	if widths[2] != metrics.ColumnWidth {
+		t.Errorf("first metric width = %d, want %d", widths[2], metrics.ColumnWidth)
+	}
+	if want := 100 - markColWidth - 20 - metrics.ColumnWidth; widths[3] != want {
+		t.Errorf("last metric width = %d, want %d (absorbs extra width)", widths[3], want)
+	}
+}
@@ -1,9 +1,13 @@
 package view
 
 import (
+	"fmt"
+	"slices"
+
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
 	"github.com/clawscli/claws/internal/log"
 )
 
@@ -78,7 +82,7 @@ func (r *ResourceBrowser) handleMetricsLoaded(msg metricsLoadedMsg) (tea.Model,
 }
 
 func (r *ResourceBrowser) handleAutoReloadTick() (tea.Model, tea.Cmd) {
-	if r.metricsEnabled && r.getMetricSpec() != nil {
+	if r.metricsEnabled && len(r.getMetricSpecs()) > 0 {
 		return r, tea.Batch(r.reloadResources, r.loadMetricsCmd())
 	}
 	return r, r.reloadResources
@@ -119,30 +123,33 @@ func (r *ResourceBrowser) handleDiffMsg(msg DiffMsg) (tea.Model, tea.Cmd) {
 	var leftRes, rightRes dao.Resource
 
 	// Match by ID (from GetResourceIDs)
-	for _, res := range r.filtered {
-		if res.GetID() == msg.RightID {
-			rightRes = res
-			break
-		}
-	}
-	if rightRes == nil {
+	rightRes = r.findFilteredResource(msg.RightID)
+	rightTarget, rightRemote := ParseDiffTarget(msg.RightID)
+	if rightRes == nil && !rightRemote {
 		return r, nil
 	}
 
+	var leftTarget DiffTarget
+	leftRemote := false
 	if msg.LeftID == "" {
 		if len(r.filtered) > 0 && r.tc.Cursor() < len(r.filtered) {
 			leftRes = r.filtered[r.tc.Cursor()]
 		}
 	} else {
-		for _, res := range r.filtered {
-			if res.GetID() == msg.LeftID {
-				leftRes = res
-				break
-			}
-		}
+		leftRes = r.findFilteredResource(msg.LeftID)
+		leftTarget, leftRemote = ParseDiffTarget(msg.LeftID)
+	}
+
+	if leftRes == nil && !leftRemote {
+		return r, nil
+	}
+
+	// Targets in another profile/region are fetched through the DAO
+	if leftRes == nil || rightRes == nil {
+		return r, r.fetchDiffCmd(leftRes, rightRes, leftTarget, rightTarget)
 	}
 
-	if leftRes == nil || leftRes.GetID() == rightRes.GetID() {
+	if leftRes.GetID() == rightRes.GetID() {
 		return r, nil
 	}
 
@@ -151,3 +158,59 @@ func (r *ResourceBrowser) handleDiffMsg(msg DiffMsg) (tea.Model, tea.Cmd) {
 		return NavigateMsg{View: diffView}
 	}
 }
+
+// handleTimelineMsg opens the incident timeline for the selected resources,
+// or the current row if none are selected
+func (r *ResourceBrowser) handleTimelineMsg() (tea.Model, tea.Cmd) {
+	resources := slices.Clone(r.selected)
+	if len(resources) == 0 {
+		if len(r.filtered) == 0 || r.tc.Cursor() >= len(r.filtered) {
+			return r, nil
+		}
+		resources = []dao.Resource{r.filtered[r.tc.Cursor()]}
+	}
+
+	timelineView := NewTimelineView(r.ctx, r.registry, resources, r.service, r.resourceType)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: timelineView}
+	}
+}
+
+// handleIaCMsg opens generated code for the selected resources, or the
+// current row if none are selected
+func (r *ResourceBrowser) handleIaCMsg(msg IaCMsg) (tea.Model, tea.Cmd) {
+	if !iac.Supported(r.service, r.resourceType) {
+		return r, func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("code generation is not supported for %s/%s", r.service, r.resourceType)}
+		}
+	}
+	resources := slices.Clone(r.selected)
+	if len(resources) == 0 {
+		if len(r.filtered) == 0 || r.tc.Cursor() >= len(r.filtered) {
+			return r, nil
+		}
+		resources = []dao.Resource{r.filtered[r.tc.Cursor()]}
+	}
+
+	iacView := NewIaCView(r.ctx, r.registry, resources, r.service, r.resourceType, msg.Format)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: iacView}
+	}
+}
+
+func (r *ResourceBrowser) handleDiffFetched(msg diffFetchedMsg) (tea.Model, tea.Cmd) {
+	diffView := NewDiffView(r.ctx, msg.left, msg.right, r.renderer, r.service, r.resourceType)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: diffView}
+	}
+}
+
+// findFilteredResource returns the filtered resource with the given ID, or nil
+func (r *ResourceBrowser) findFilteredResource(id string) dao.Resource {
+	for _, res := range r.filtered {
+		if res.GetID() == id {
+			return res
+		}
+	}
+	return nil
+}
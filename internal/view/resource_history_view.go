@@ -0,0 +1,590 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	historyHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	historyMaxPages     = 4 // per lookup value, 50 events each
+	historyPageSize     = 50
+)
+
+// cloudTrailEventProvider is implemented by cloudtrail/events resources
+type cloudTrailEventProvider interface {
+	EventId() string
+	EventName() string
+	EventSource() string
+	EventTime() *time.Time
+	Username() string
+	ReadOnly() string
+	CloudTrailEvent() string
+}
+
+// cloudTrailRecord holds the fields of the raw CloudTrail event JSON used
+// by the history timeline
+type cloudTrailRecord struct {
+	SourceIPAddress   string `json:"sourceIPAddress"`
+	ErrorCode         string `json:"errorCode"`
+	ErrorMessage      string `json:"errorMessage"`
+	RequestParameters any    `json:"requestParameters"`
+	UserIdentity      struct {
+		ARN string `json:"arn"`
+	} `json:"userIdentity"`
+}
+
+// historyEntry is one CloudTrail event in a resource's timeline
+type historyEntry struct {
+	event        dao.Resource
+	id           string
+	name         string
+	source       string
+	user         string
+	sourceIP     string
+	errorCode    string
+	errorMessage string
+	time         time.Time
+	readOnly     bool
+	params       any
+	prev         *historyEntry     // previous call of the same API
+	changes      []jsondiff.Change // request parameters compared with prev
+}
+
+// historyLoadedMsg carries the CloudTrail events found for a resource
+type historyLoadedMsg struct {
+	entries []*historyEntry
+	err     error
+}
+
+// buildHistory converts CloudTrail event resources into timeline entries,
+// newest first. Each entry's request parameters are compared with the
+// previous call of the same API; the first call lists all parameters.
+func buildHistory(events []dao.Resource) []*historyEntry {
+	seen := make(map[string]bool)
+	var entries []*historyEntry
+	for _, res := range events {
+		ev, ok := dao.UnwrapResource(res).(cloudTrailEventProvider)
+		if !ok || seen[ev.EventId()] {
+			continue
+		}
+		seen[ev.EventId()] = true
+
+		e := &historyEntry{
+			event:    res,
+			id:       ev.EventId(),
+			name:     ev.EventName(),
+			source:   ev.EventSource(),
+			user:     ev.Username(),
+			readOnly: ev.ReadOnly() == "true",
+		}
+		if t := ev.EventTime(); t != nil {
+			e.time = *t
+		}
+		var rec cloudTrailRecord
+		if raw := ev.CloudTrailEvent(); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+				log.Debug("cannot parse cloudtrail event", "id", e.id, "error", err)
+			}
+		}
+		e.sourceIP = rec.SourceIPAddress
+		e.errorCode = rec.ErrorCode
+		e.errorMessage = rec.ErrorMessage
+		e.params = rec.RequestParameters
+		if e.user == "" {
+			e.user = rec.UserIdentity.ARN
+		}
+		entries = append(entries, e)
+	}
+
+	slices.SortStableFunc(entries, func(a, b *historyEntry) int { return a.time.Compare(b.time) })
+
+	last := make(map[string]*historyEntry)
+	for _, e := range entries {
+		key := e.source + "/" + e.name
+		e.prev = last[key]
+		last[key] = e
+		e.changes = paramChanges(e.prev, e)
+	}
+
+	slices.Reverse(entries)
+	return entries
+}
+
+// paramChanges compares e's request parameters with prev's, or lists them
+// all as added when there is no previous call
+func paramChanges(prev, e *historyEntry) []jsondiff.Change {
+	if prev != nil {
+		changes, err := jsondiff.Diff(prev.params, e.params, jsondiff.Options{})
+		if err == nil {
+			return changes
+		}
+		log.Debug("cannot diff request parameters", "id", e.id, "error", err)
+	}
+
+	flat, err := jsondiff.Flatten(e.params, jsondiff.Options{})
+	if err != nil {
+		return nil
+	}
+	changes := make([]jsondiff.Change, 0, len(flat))
+	for path, v := range flat {
+		changes = append(changes, jsondiff.Change{Path: path, Kind: jsondiff.Added, Right: v})
+	}
+	slices.SortFunc(changes, func(a, b jsondiff.Change) int { return strings.Compare(a.Path, b.Path) })
+	return changes
+}
+
+// resourceHistoryStyles holds cached lipgloss styles for performance
+type resourceHistoryStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	added    lipgloss.Style
+	removed  lipgloss.Style
+	changed  lipgloss.Style
+	selected lipgloss.Style
+	error    lipgloss.Style
+}
+
+func newResourceHistoryStyles() resourceHistoryStyles {
+	return resourceHistoryStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.SectionStyle(),
+		added:    ui.SuccessStyle(),
+		removed:  ui.DangerStyle(),
+		changed:  ui.WarningStyle(),
+		selected: ui.SelectedStyle(),
+		error:    ui.DangerStyle(),
+	}
+}
+
+// ResourceHistoryView shows who changed a resource and when, from CloudTrail
+// events that reference its ID, ARN or name. The request parameters of the
+// selected event are shown against the previous call of the same API.
+type ResourceHistoryView struct {
+	ctx          context.Context
+	registry     *registry.Registry
+	resource     dao.Resource
+	service      string
+	resourceType string
+
+	entries      []*historyEntry
+	cursor       int
+	showReadOnly bool
+	loading      bool
+	loaded       bool
+	err          error
+
+	vp           ViewportState
+	width        int
+	detailHeight int
+	spinner      spinner.Model
+	styles       resourceHistoryStyles
+}
+
+// NewResourceHistoryView creates a history view for resource. ctx should
+// carry the resource's profile and region.
+func NewResourceHistoryView(ctx context.Context, reg *registry.Registry, resource dao.Resource, service, resourceType string) *ResourceHistoryView {
+	return &ResourceHistoryView{
+		ctx:          ctx,
+		registry:     reg,
+		resource:     resource,
+		service:      service,
+		resourceType: resourceType,
+		loading:      true,
+		spinner:      ui.NewSpinner(),
+		styles:       newResourceHistoryStyles(),
+	}
+}
+
+// lookupNames returns the values to look up as CloudTrail ResourceName
+func (v *ResourceHistoryView) lookupNames() []string {
+	res := dao.UnwrapResource(v.resource)
+	var names []string
+	for _, n := range []string{res.GetID(), res.GetARN(), res.GetName()} {
+		if n != "" && !slices.Contains(names, n) {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// Init implements tea.Model
+func (v *ResourceHistoryView) Init() tea.Cmd {
+	// Keep the timeline when returning from a diff or event detail
+	if v.loaded {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+func (v *ResourceHistoryView) loadCmd() tea.Cmd {
+	ctx, reg, names := v.ctx, v.registry, v.lookupNames()
+	return func() tea.Msg {
+		var events []dao.Resource
+		for _, name := range names {
+			lookupCtx := dao.WithFilter(ctx, "ResourceName", name)
+			d, err := reg.GetDAO(lookupCtx, "cloudtrail", "events")
+			if err != nil {
+				return historyLoadedMsg{err: err}
+			}
+			paginated, ok := d.(dao.PaginatedDAO)
+			if !ok {
+				return historyLoadedMsg{err: fmt.Errorf("cloudtrail/events does not support pagination")}
+			}
+
+			token := ""
+			for range historyMaxPages {
+				page, next, err := paginated.ListPage(lookupCtx, historyPageSize, token)
+				if err != nil {
+					return historyLoadedMsg{err: err}
+				}
+				events = append(events, page...)
+				if next == "" {
+					break
+				}
+				token = next
+			}
+		}
+		return historyLoadedMsg{entries: buildHistory(events)}
+	}
+}
+
+// visibleEntries returns the entries shown, hiding read-only calls unless enabled
+func (v *ResourceHistoryView) visibleEntries() []*historyEntry {
+	if v.showReadOnly {
+		return v.entries
+	}
+	var entries []*historyEntry
+	for _, e := range v.entries {
+		if !e.readOnly {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func (v *ResourceHistoryView) selected() *historyEntry {
+	entries := v.visibleEntries()
+	if v.cursor < 0 || v.cursor >= len(entries) {
+		return nil
+	}
+	return entries[v.cursor]
+}
+
+// Update implements tea.Model
+func (v *ResourceHistoryView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case historyLoadedMsg:
+		v.loading = false
+		v.loaded = msg.err == nil
+		v.err = msg.err
+		v.entries = msg.entries
+		v.cursor = 0
+		v.updateContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newResourceHistoryStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			v.moveCursor(1)
+			return v, nil
+		case "k", "up":
+			v.moveCursor(-1)
+			return v, nil
+		case "g", "home":
+			v.moveCursor(-len(v.entries))
+			return v, nil
+		case "G", "end":
+			v.moveCursor(len(v.entries))
+			return v, nil
+		case "r":
+			v.showReadOnly = !v.showReadOnly
+			v.cursor = 0
+			v.updateContent()
+			return v, nil
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				v.err = nil
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		case "enter", "d":
+			return v, v.openDiff()
+		case "e":
+			return v, v.openEvent()
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *ResourceHistoryView) moveCursor(delta int) {
+	entries := v.visibleEntries()
+	if len(entries) == 0 {
+		return
+	}
+	v.cursor = max(min(v.cursor+delta, len(entries)-1), 0)
+	v.updateContent()
+}
+
+// openDiff opens a structural diff of the selected event's request
+// parameters against the previous call, or the event itself for a first call
+func (v *ResourceHistoryView) openDiff() tea.Cmd {
+	e := v.selected()
+	if e == nil {
+		return nil
+	}
+	if e.prev == nil {
+		return v.openEvent()
+	}
+	left := historyParamsResource(e.prev)
+	right := historyParamsResource(e)
+	diffView := NewDiffView(v.ctx, left, right, nil, "cloudtrail", "events")
+	diffView.structural = true
+	return func() tea.Msg {
+		return NavigateMsg{View: diffView}
+	}
+}
+
+// historyParamsResource wraps an event's request parameters for DiffView
+func historyParamsResource(e *historyEntry) dao.Resource {
+	return &dao.BaseResource{
+		ID:   e.id,
+		Name: fmt.Sprintf("%s %s", e.name, e.time.Local().Format("2006-01-02 15:04:05")),
+		Data: e.params,
+	}
+}
+
+// openEvent opens the CloudTrail event in a DetailView
+func (v *ResourceHistoryView) openEvent() tea.Cmd {
+	e := v.selected()
+	if e == nil {
+		return nil
+	}
+	renderer, err := v.registry.GetRenderer("cloudtrail", "events")
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	detailView := NewDetailView(v.ctx, e.event, renderer, "cloudtrail", "events", v.registry, nil)
+	return func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *ResourceHistoryView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderList())
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if v.cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(v.cursor)
+		} else if v.cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(v.cursor - height + 1)
+		}
+	}
+}
+
+func (v *ResourceHistoryView) renderList() string {
+	s := v.styles
+	if v.err != nil {
+		return s.error.Render("Error: " + v.err.Error())
+	}
+	if v.loading {
+		return ""
+	}
+
+	entries := v.visibleEntries()
+	if len(entries) == 0 {
+		msg := "No CloudTrail events found in the last 90 days"
+		if !v.showReadOnly && len(v.entries) > 0 {
+			msg += fmt.Sprintf(" (%d read-only hidden, r to show)", len(v.entries))
+		}
+		return s.dim.Render(msg)
+	}
+
+	var out strings.Builder
+	for i, e := range entries {
+		line := fmt.Sprintf("%s  %-24s %-36s", e.time.Local().Format("2006-01-02 15:04:05"),
+			TruncateString(shortPrincipal(e.user), 24), TruncateString(e.name, 36))
+		switch {
+		case e.errorCode != "":
+			line += "  ✗ " + e.errorCode
+		case e.prev != nil && len(e.changes) > 0:
+			line += fmt.Sprintf("  %d change(s)", len(e.changes))
+		case e.prev != nil:
+			line += "  same parameters"
+		}
+		line = TruncateString(line, v.width)
+
+		switch {
+		case i == v.cursor:
+			line = s.selected.Render(TruncateOrPadString(line, v.width))
+		case e.errorCode != "":
+			line = s.error.Render(line)
+		case e.readOnly:
+			line = s.dim.Render(line)
+		}
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+// shortPrincipal returns the last part of an IAM or STS ARN
+func shortPrincipal(user string) string {
+	if strings.HasPrefix(user, "arn:") {
+		if i := strings.Index(user, ":assumed-role/"); i >= 0 {
+			return user[i+len(":assumed-role/"):]
+		}
+		if i := strings.LastIndex(user, "/"); i >= 0 {
+			return user[i+1:]
+		}
+	}
+	return user
+}
+
+// renderDetail renders the selected event's caller and request parameters
+func (v *ResourceHistoryView) renderDetail() string {
+	s := v.styles
+	e := v.selected()
+	if e == nil || v.detailHeight <= 0 {
+		return ""
+	}
+
+	var lines []string
+	who := fmt.Sprintf("%s by %s", e.name, e.user)
+	if e.sourceIP != "" {
+		who += " from " + e.sourceIP
+	}
+	lines = append(lines, s.label.Render(TruncateString(who, v.width)))
+	if e.errorCode != "" {
+		lines = append(lines, s.error.Render(TruncateString("✗ "+e.errorCode+": "+e.errorMessage, v.width)))
+	}
+
+	switch {
+	case e.params == nil:
+		lines = append(lines, s.dim.Render("No request parameters recorded"))
+	case e.prev == nil:
+		lines = append(lines, s.dim.Render("First call in range • request parameters:"))
+	case len(e.changes) == 0:
+		lines = append(lines, s.dim.Render("Same request parameters as the call at "+e.prev.time.Local().Format("2006-01-02 15:04:05")))
+	default:
+		lines = append(lines, s.dim.Render("Request parameters vs the call at "+e.prev.time.Local().Format("2006-01-02 15:04:05")+":"))
+	}
+
+	for _, c := range e.changes {
+		style := s.changed
+		switch c.Kind {
+		case jsondiff.Added:
+			style = s.added
+		case jsondiff.Removed:
+			style = s.removed
+		}
+		lines = append(lines, style.Render(TruncateString("  "+formatChange(c), v.width)))
+	}
+
+	if len(lines) > v.detailHeight {
+		hidden := len(lines) - v.detailHeight + 1
+		lines = append(lines[:v.detailHeight-1], s.dim.Render(fmt.Sprintf("  … %d more (enter for full diff)", hidden)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *ResourceHistoryView) renderHeader() string {
+	s := v.styles
+	res := dao.UnwrapResource(v.resource)
+	title := s.title.Render(fmt.Sprintf("History: %s/%s %s", v.service, v.resourceType, diffLabel(v.resource)))
+
+	var summary string
+	switch {
+	case v.loading:
+		summary = v.spinner.View() + " Looking up CloudTrail events for " + strings.Join(v.lookupNames(), ", ") + "..."
+	case v.err == nil:
+		entries := v.visibleEntries()
+		summary = fmt.Sprintf("%d event(s) in the last 90 days", len(entries))
+		if hidden := len(v.entries) - len(entries); hidden > 0 {
+			summary += fmt.Sprintf(" • %d read-only hidden", hidden)
+		}
+		summary += " • " + res.GetID()
+	}
+
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *ResourceHistoryView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	out := v.renderHeader() + "\n" + v.vp.Model.View()
+	if v.detailHeight > 0 {
+		out += "\n" + strings.Repeat("─", v.width) + "\n" + v.renderDetail()
+	}
+	return out
+}
+
+// View implements tea.Model
+func (v *ResourceHistoryView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ResourceHistoryView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+
+	// The list gets about half of the body, the selected event's
+	// parameters the rest (minus one separator line)
+	body := max(height-historyHeaderHeight, 6)
+	listHeight := max(body/2, 3)
+	v.detailHeight = max(body-listHeight-1, 0)
+
+	v.vp.SetSize(width, listHeight)
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ResourceHistoryView) StatusLine() string {
+	reads := "r:show reads"
+	if v.showReadOnly {
+		reads = "r:hide reads"
+	}
+	return fmt.Sprintf("%s • j/k:move enter:diff e:event %s ^r:refresh • q/esc:back",
+		dao.UnwrapResource(v.resource).GetID(), reads)
+}
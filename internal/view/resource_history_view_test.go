@@ -0,0 +1,169 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// mockEventResource implements cloudTrailEventProvider
+type mockEventResource struct {
+	dao.BaseResource
+	name     string
+	user     string
+	time     time.Time
+	readOnly string
+	raw      string
+}
+
+func (r *mockEventResource) EventId() string         { return r.ID }
+func (r *mockEventResource) EventName() string       { return r.name }
+func (r *mockEventResource) EventSource() string     { return "ec2.amazonaws.com" }
+func (r *mockEventResource) EventTime() *time.Time   { return &r.time }
+func (r *mockEventResource) Username() string        { return r.user }
+func (r *mockEventResource) ReadOnly() string        { return r.readOnly }
+func (r *mockEventResource) CloudTrailEvent() string { return r.raw }
+
+// mockEventDAO returns events only for the resource name it knows
+type mockEventDAO struct {
+	dao.BaseDAO
+	events  map[string][]dao.Resource
+	lookups *[]string
+}
+
+func (d *mockEventDAO) List(ctx context.Context) ([]dao.Resource, error) { return nil, nil }
+func (d *mockEventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, nil
+}
+func (d *mockEventDAO) Delete(ctx context.Context, id string) error { return nil }
+func (d *mockEventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	name := dao.GetFilterFromContext(ctx, "ResourceName")
+	*d.lookups = append(*d.lookups, name)
+	return d.events[name], "", nil
+}
+
+func newMockEvent(id, name string, at time.Time, readOnly, raw string) *mockEventResource {
+	return &mockEventResource{
+		BaseResource: dao.BaseResource{ID: id},
+		name:         name,
+		user:         "alice",
+		time:         at,
+		readOnly:     readOnly,
+		raw:          raw,
+	}
+}
+
+func TestBuildHistory(t *testing.T) {
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []dao.Resource{
+		newMockEvent("e3", "ModifyInstanceAttribute", base.Add(2*time.Hour), "false",
+			`{"requestParameters":{"instanceType":{"value":"m5.large"}},"sourceIPAddress":"10.0.0.1"}`),
+		newMockEvent("e1", "ModifyInstanceAttribute", base, "false",
+			`{"requestParameters":{"instanceType":{"value":"t3.micro"}}}`),
+		newMockEvent("e2", "StopInstances", base.Add(time.Hour), "false",
+			`{"requestParameters":{"force":false},"errorCode":"UnauthorizedOperation"}`),
+		newMockEvent("e1", "ModifyInstanceAttribute", base, "false", ""), // duplicate from another lookup
+	}
+
+	entries := buildHistory(events)
+	if len(entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(entries))
+	}
+	if entries[0].id != "e3" || entries[2].id != "e1" {
+		t.Errorf("order = %s,%s,%s, want newest first", entries[0].id, entries[1].id, entries[2].id)
+	}
+
+	latest := entries[0]
+	if latest.prev == nil || latest.prev.id != "e1" {
+		t.Fatal("latest call should be compared with e1")
+	}
+	if len(latest.changes) != 1 || latest.changes[0].Path != "instanceType.value" {
+		t.Errorf("changes = %+v, want instanceType.value", latest.changes)
+	}
+	if latest.sourceIP != "10.0.0.1" {
+		t.Errorf("sourceIP = %q", latest.sourceIP)
+	}
+
+	stop := entries[1]
+	if stop.prev != nil {
+		t.Error("first StopInstances call should have no previous call")
+	}
+	if stop.errorCode != "UnauthorizedOperation" {
+		t.Errorf("errorCode = %q", stop.errorCode)
+	}
+	if len(stop.changes) != 1 || stop.changes[0].Path != "force" {
+		t.Errorf("first call should list its parameters, got %+v", stop.changes)
+	}
+}
+
+func TestResourceHistoryView(t *testing.T) {
+	base := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var lookups []string
+	reg := registry.New()
+	reg.RegisterCustom("cloudtrail", "events", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockEventDAO{
+				BaseDAO: dao.NewBaseDAO("cloudtrail", "events"),
+				lookups: &lookups,
+				events: map[string][]dao.Resource{
+					"sg-123": {
+						newMockEvent("e1", "AuthorizeSecurityGroupIngress", base, "false", `{"requestParameters":{"groupId":"sg-123","port":22}}`),
+						newMockEvent("e2", "AuthorizeSecurityGroupIngress", base.Add(time.Hour), "false", `{"requestParameters":{"groupId":"sg-123","port":443}}`),
+						newMockEvent("e3", "DescribeSecurityGroups", base.Add(2*time.Hour), "true", ""),
+					},
+				},
+			}, nil
+		},
+	})
+
+	resource := &dao.BaseResource{ID: "sg-123", Name: "web", ARN: "arn:aws:ec2:us-east-1:123456789012:security-group/sg-123"}
+	v := NewResourceHistoryView(context.Background(), reg, resource, "ec2", "security-groups")
+	v.SetSize(120, 30)
+	v.Update(v.loadCmd()())
+
+	if v.err != nil {
+		t.Fatalf("unexpected error: %v", v.err)
+	}
+	if want := []string{"sg-123", resource.ARN, "web"}; strings.Join(lookups, ",") != strings.Join(want, ",") {
+		t.Errorf("lookups = %v, want %v", lookups, want)
+	}
+	if got := len(v.visibleEntries()); got != 2 {
+		t.Errorf("visible entries = %d, want 2 (read-only hidden)", got)
+	}
+
+	out := v.ViewString()
+	for _, want := range []string{"AuthorizeSecurityGroupIngress", "1 read-only hidden", "~ port: 22 → 443"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if got := len(v.visibleEntries()); got != 3 {
+		t.Errorf("visible entries with reads = %d, want 3", got)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+
+	// Enter opens a structural diff against the previous call
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return a command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("enter should navigate")
+	}
+	if diff, ok := nav.View.(*DiffView); !ok || !diff.structural {
+		t.Errorf("navigated to %T, want structural *DiffView", nav.View)
+	}
+
+	if cmd := v.Init(); cmd != nil {
+		t.Error("Init() should not reload once loaded")
+	}
+}
@@ -0,0 +1,559 @@
+package view
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/secretval"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const secretHeaderHeight = 3 // title(1) + version(1) + status(1)
+
+type secretPane int
+
+const (
+	secretPaneFields secretPane = iota
+	secretPaneVersions
+)
+
+type secretLoadedMsg struct {
+	client   secretval.Client // set when the client was created
+	versions []secretval.Version
+	value    secretval.Value
+	err      error
+}
+
+type secretValueMsg struct {
+	value secretval.Value
+	err   error
+}
+
+type secretDiffMsg struct {
+	from secretval.Value
+	err  error
+}
+
+type secretViewStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	selected lipgloss.Style
+	ok       lipgloss.Style
+	danger   lipgloss.Style
+	warning  lipgloss.Style
+}
+
+func newSecretViewStyles() secretViewStyles {
+	return secretViewStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.AccentStyle().Bold(true),
+		selected: ui.SelectedStyle(),
+		ok:       ui.SuccessStyle(),
+		danger:   ui.DangerStyle(),
+		warning:  ui.WarningStyle(),
+	}
+}
+
+// SecretView shows the value of a Secrets Manager secret, masked until a
+// key is revealed, and its versions. Versions can be viewed and compared
+// key by key.
+type SecretView struct {
+	ctx      context.Context
+	client   secretval.Client
+	secretID string
+
+	versions []secretval.Version
+	value    secretval.Value
+	fields   []secretval.Field
+	revealed map[int]bool // field index -> shown
+
+	pane       secretPane
+	fieldIdx   int
+	versionIdx int
+
+	diffing    bool
+	diffFrom   secretval.Value
+	diffTo     secretval.Value
+	diff       []secretval.Change
+	diffReveal bool
+
+	loading bool
+	err     error
+	notice  string
+
+	vp      ViewportState
+	width   int
+	height  int
+	spinner spinner.Model
+	styles  secretViewStyles
+}
+
+// NewSecretView creates a view of the current value of a secret
+func NewSecretView(ctx context.Context, secretID string) *SecretView {
+	return &SecretView{
+		ctx:      ctx,
+		secretID: secretID,
+		revealed: make(map[int]bool),
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newSecretViewStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *SecretView) Init() tea.Cmd {
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+// loadCmd reads the versions and the current value
+func (v *SecretView) loadCmd() tea.Cmd {
+	ctx, client, id := v.ctx, v.client, v.secretID
+	return func() tea.Msg {
+		var created secretval.Client
+		if client == nil {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return secretLoadedMsg{err: apperrors.Wrap(err, "init AWS config")}
+			}
+			client = secretsmanager.NewFromConfig(cfg)
+			created = client
+		}
+		versions, err := secretval.Versions(ctx, client, id)
+		if err != nil {
+			return secretLoadedMsg{client: created, err: err}
+		}
+		value, err := secretval.Get(ctx, client, id, "")
+		return secretLoadedMsg{client: created, versions: versions, value: value, err: err}
+	}
+}
+
+func (v *SecretView) valueCmd(versionID string) tea.Cmd {
+	ctx, client, id := v.ctx, v.client, v.secretID
+	return func() tea.Msg {
+		value, err := secretval.Get(ctx, client, id, versionID)
+		return secretValueMsg{value: value, err: err}
+	}
+}
+
+func (v *SecretView) diffCmd(versionID string) tea.Cmd {
+	ctx, client, id := v.ctx, v.client, v.secretID
+	return func() tea.Msg {
+		from, err := secretval.Get(ctx, client, id, versionID)
+		return secretDiffMsg{from: from, err: err}
+	}
+}
+
+// setValue shows a value with all its keys masked again
+func (v *SecretView) setValue(value secretval.Value) {
+	v.value = value
+	if value.Binary != nil {
+		v.fields = []secretval.Field{{Value: base64.StdEncoding.EncodeToString(value.Binary)}}
+	} else {
+		v.fields = secretval.Fields(value.String)
+	}
+	clear(v.revealed)
+	v.fieldIdx = min(v.fieldIdx, max(len(v.fields)-1, 0))
+	for i, ver := range v.versions {
+		if ver.ID == value.VersionID {
+			v.versionIdx = i
+		}
+	}
+}
+
+// Update implements tea.Model
+func (v *SecretView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case secretLoadedMsg:
+		v.loading = false
+		if msg.client != nil {
+			v.client = msg.client
+		}
+		v.err = msg.err
+		if msg.err == nil {
+			v.versions = msg.versions
+			v.setValue(msg.value)
+		}
+		v.updateContent()
+		return v, nil
+
+	case secretValueMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.setValue(msg.value)
+			v.notice = "Viewing version " + shortVersion(msg.value.VersionID)
+		}
+		v.updateContent()
+		return v, nil
+
+	case secretDiffMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			// Compare the older version with the newer one
+			from, to := msg.from, v.value
+			if from.Created.After(to.Created) {
+				from, to = to, from
+			}
+			v.diffing = true
+			v.diffReveal = false
+			v.diffFrom, v.diffTo = from, to
+			v.diff = secretval.Diff(from.Text(), to.Text())
+		}
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v, v.handleKey(msg)
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+
+	case ThemeChangedMsg:
+		v.styles = newSecretViewStyles()
+		v.updateContent()
+		return v, nil
+	}
+
+	if v.vp.Ready {
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *SecretView) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if v.loading {
+		return nil
+	}
+	v.notice = ""
+
+	if v.diffing {
+		switch msg.String() {
+		case "esc":
+			v.diffing = false
+		case "r":
+			v.diffReveal = !v.diffReveal
+		default:
+			var cmd tea.Cmd
+			v.vp.Model, cmd = v.vp.Model.Update(msg)
+			return cmd
+		}
+		v.updateContent()
+		return nil
+	}
+
+	switch msg.String() {
+	case "tab", "shift+tab":
+		if v.pane == secretPaneFields && len(v.versions) > 0 {
+			v.pane = secretPaneVersions
+		} else {
+			v.pane = secretPaneFields
+		}
+	case "up", "k":
+		if v.pane == secretPaneVersions {
+			v.versionIdx = max(v.versionIdx-1, 0)
+		} else {
+			v.fieldIdx = max(v.fieldIdx-1, 0)
+		}
+	case "down", "j":
+		if v.pane == secretPaneVersions {
+			v.versionIdx = min(v.versionIdx+1, max(len(v.versions)-1, 0))
+		} else {
+			v.fieldIdx = min(v.fieldIdx+1, max(len(v.fields)-1, 0))
+		}
+	case "space", "enter":
+		if v.pane == secretPaneVersions {
+			return v.viewVersion()
+		}
+		if len(v.fields) > 0 {
+			v.revealed[v.fieldIdx] = !v.revealed[v.fieldIdx]
+		}
+	case "r":
+		// Reveal all, or hide all if everything is shown
+		all := len(v.revealed) > 0
+		for i := range v.fields {
+			all = all && v.revealed[i]
+		}
+		for i := range v.fields {
+			v.revealed[i] = !all
+		}
+	case "y":
+		if len(v.fields) == 0 {
+			return nil
+		}
+		f := v.fields[v.fieldIdx]
+		label := "secret value"
+		if f.Key != "" {
+			label = "secret key " + f.Key
+		}
+		return clipboard.Copy(label, f.Value)
+	case "d":
+		if len(v.versions) < 2 {
+			v.notice = "The secret has only one version"
+			break
+		}
+		from := v.versions[v.versionIdx]
+		if from.ID == v.value.VersionID {
+			// Compare the viewed version with the next one by default
+			from = v.versions[(v.versionIdx+1)%len(v.versions)]
+		}
+		v.loading = true
+		return tea.Batch(v.diffCmd(from.ID), v.spinner.Tick)
+	case "ctrl+r":
+		v.loading = true
+		return tea.Batch(v.loadCmd(), v.spinner.Tick)
+	default:
+		if v.vp.Ready {
+			var cmd tea.Cmd
+			v.vp.Model, cmd = v.vp.Model.Update(msg)
+			return cmd
+		}
+		return nil
+	}
+	v.updateContent()
+	return nil
+}
+
+func (v *SecretView) viewVersion() tea.Cmd {
+	if v.versionIdx >= len(v.versions) {
+		return nil
+	}
+	ver := v.versions[v.versionIdx]
+	if ver.ID == v.value.VersionID {
+		v.pane = secretPaneFields
+		v.updateContent()
+		return nil
+	}
+	v.loading = true
+	return tea.Batch(v.valueCmd(ver.ID), v.spinner.Tick)
+}
+
+// shortVersion shortens a version ID, which is usually a UUID
+func shortVersion(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+func stagesLabel(stages []string) string {
+	if len(stages) == 0 {
+		return "(no stage)"
+	}
+	return strings.Join(stages, ", ")
+}
+
+// versionLabel names a version by its stages, e.g. "AWSPREVIOUS (3f2a1b2c)"
+func versionLabel(value secretval.Value) string {
+	return fmt.Sprintf("%s (%s)", stagesLabel(value.Stages), shortVersion(value.VersionID))
+}
+
+func (v *SecretView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	var content string
+	cursor := 0
+	if v.diffing {
+		content = v.renderDiff()
+	} else {
+		content, cursor = v.renderValue()
+	}
+	v.vp.Model.SetContent(content)
+
+	if height := v.vp.Model.Height(); height > 0 && !v.diffing {
+		if cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(cursor)
+		} else if cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(cursor - height + 1)
+		}
+	}
+}
+
+// renderValue renders the versions and the keys, and returns the line of
+// the cursor
+func (v *SecretView) renderValue() (string, int) {
+	s := v.styles
+	var lines []string
+	cursor := 0
+
+	title := func(text string, pane secretPane) string {
+		if v.pane == pane {
+			return s.label.Render("▸ " + text)
+		}
+		return s.dim.Render("  " + text)
+	}
+
+	lines = append(lines, title(fmt.Sprintf("Versions (%d)", len(v.versions)), secretPaneVersions))
+	for i, ver := range v.versions {
+		mark := "  "
+		if ver.ID == v.value.VersionID {
+			mark = "● "
+		}
+		line := fmt.Sprintf("  %s%-10s %-28s %s", mark, shortVersion(ver.ID), stagesLabel(ver.Stages), ver.Created.Format("2006-01-02 15:04"))
+		if v.pane == secretPaneVersions && i == v.versionIdx {
+			cursor = len(lines)
+			line = s.selected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "")
+
+	label := "Value"
+	if v.value.Binary != nil {
+		label = "Value (binary, base64)"
+	} else if len(v.fields) > 1 || len(v.fields) == 1 && v.fields[0].Key != "" {
+		label = fmt.Sprintf("Keys (%d)", len(v.fields))
+	}
+	lines = append(lines, title(label, secretPaneFields))
+
+	keyWidth := 0
+	for _, f := range v.fields {
+		keyWidth = max(keyWidth, lipgloss.Width(f.Key))
+	}
+	for i, f := range v.fields {
+		selected := v.pane == secretPaneFields && i == v.fieldIdx
+		if selected {
+			cursor = len(lines)
+		}
+		value := secretval.Mask
+		if v.revealed[i] {
+			value = f.Value
+			if value == "" {
+				value = `""`
+			}
+		}
+		valueLines := strings.Split(value, "\n")
+		prefix := "  "
+		if f.Key != "" {
+			prefix += fmt.Sprintf("%-*s  ", keyWidth, f.Key)
+		}
+		first := prefix + valueLines[0]
+		if selected {
+			first = s.selected.Render(first)
+		}
+		lines = append(lines, first)
+		indent := strings.Repeat(" ", lipgloss.Width(prefix))
+		for _, l := range valueLines[1:] {
+			lines = append(lines, indent+l)
+		}
+	}
+	return strings.Join(lines, "\n"), cursor
+}
+
+func (v *SecretView) renderDiff() string {
+	s := v.styles
+	var lines []string
+	lines = append(lines, s.label.Render(fmt.Sprintf("%s → %s", versionLabel(v.diffFrom), versionLabel(v.diffTo))))
+	if !v.diffReveal {
+		lines = append(lines, s.dim.Render("Values are masked (r to reveal)"))
+	}
+	lines = append(lines, "")
+
+	keyWidth := 0
+	for _, c := range v.diff {
+		keyWidth = max(keyWidth, lipgloss.Width(c.Key))
+	}
+	show := func(val string) string {
+		if !v.diffReveal {
+			return secretval.Mask
+		}
+		return strings.ReplaceAll(val, "\n", `\n`)
+	}
+	changed := 0
+	for _, c := range v.diff {
+		key := c.Key
+		if key == "" {
+			key = "(value)"
+		}
+		key = fmt.Sprintf("%-*s", max(keyWidth, 7), key)
+		switch c.Kind {
+		case secretval.Added:
+			lines = append(lines, s.ok.Render("+ "+key+"  "+show(c.New)))
+		case secretval.Removed:
+			lines = append(lines, s.danger.Render("- "+key+"  "+show(c.Old)))
+		case secretval.Changed:
+			lines = append(lines, s.warning.Render("~ "+key+"  "+show(c.Old)+" → "+show(c.New)))
+		default:
+			lines = append(lines, s.dim.Render("  "+key+"  unchanged"))
+			continue
+		}
+		changed++
+	}
+	lines = append(lines, "", s.dim.Render(fmt.Sprintf("%d of %d key(s) differ", changed, len(v.diff))))
+	return strings.Join(lines, "\n")
+}
+
+// ViewString renders the view
+func (v *SecretView) ViewString() string {
+	s := v.styles
+	var out strings.Builder
+	out.WriteString(s.title.Render("🔐 "+v.secretID) + "\n")
+	if v.value.VersionID != "" {
+		out.WriteString(s.dim.Render(fmt.Sprintf("Viewing %s • created %s", versionLabel(v.value), v.value.Created.Format("2006-01-02 15:04"))))
+	}
+	out.WriteString("\n")
+
+	switch {
+	case v.loading:
+		out.WriteString(v.spinner.View() + " Loading...")
+	case v.err != nil:
+		out.WriteString(s.danger.Render(TruncateString(v.err.Error(), v.width)))
+	case v.notice != "":
+		out.WriteString(s.ok.Render(v.notice))
+	}
+	out.WriteString("\n")
+
+	if v.vp.Ready && v.value.VersionID != "" {
+		out.WriteString(v.vp.Model.View())
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *SecretView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *SecretView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.vp.SetSize(width, max(height-secretHeaderHeight, 1))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *SecretView) StatusLine() string {
+	if v.diffing {
+		return "r:reveal/mask • Esc:close diff"
+	}
+	if v.pane == secretPaneVersions {
+		return "Enter:view version d:diff with viewed Tab:keys ^r:reload • q/esc:back"
+	}
+	return "Space:reveal r:reveal all y:copy key Tab:versions d:diff ^r:reload • q/esc:back"
+}
+
+// HasActiveInput implements InputCapture; Esc closes a diff first
+func (v *SecretView) HasActiveInput() bool {
+	return v.diffing
+}
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+type fakeSecretClient struct {
+	values map[string]string // version ID -> value; "cur" is AWSCURRENT
+}
+
+func (f *fakeSecretClient) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	id, stage, created := aws.ToString(in.VersionId), "AWSPREVIOUS", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if id == "" || id == "cur" {
+		id, stage, created = "cur", "AWSCURRENT", created.Add(24*time.Hour)
+	}
+	return &secretsmanager.GetSecretValueOutput{
+		VersionId:     aws.String(id),
+		VersionStages: []string{stage},
+		CreatedDate:   aws.Time(created),
+		SecretString:  aws.String(f.values[id]),
+	}, nil
+}
+
+func (f *fakeSecretClient) ListSecretVersionIds(_ context.Context, _ *secretsmanager.ListSecretVersionIdsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	return &secretsmanager.ListSecretVersionIdsOutput{Versions: []types.SecretVersionsListEntry{
+		{VersionId: aws.String("cur"), VersionStages: []string{"AWSCURRENT"}, CreatedDate: aws.Time(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))},
+		{VersionId: aws.String("prev"), VersionStages: []string{"AWSPREVIOUS"}, CreatedDate: aws.Time(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))},
+	}}, nil
+}
+
+// runSecretCmd runs a command, feeding loads back into the view
+func runSecretCmd(v *SecretView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runSecretCmd(v, c)
+		}
+	case secretLoadedMsg, secretValueMsg, secretDiffMsg:
+		v.Update(msg)
+	}
+}
+
+func newTestSecretView(t *testing.T) *SecretView {
+	t.Helper()
+	v := NewSecretView(context.Background(), "prod/db")
+	v.client = &fakeSecretClient{values: map[string]string{
+		"cur":  `{"username":"admin","password":"n3w-pass"}`,
+		"prev": `{"username":"admin","password":"0ld-pass","legacy":"x"}`,
+	}}
+	v.SetSize(100, 30)
+	runSecretCmd(v, v.Init())
+	return v
+}
+
+func TestSecretView_MaskAndReveal(t *testing.T) {
+	v := newTestSecretView(t)
+
+	out := v.ViewString()
+	for _, want := range []string{"prod/db", "AWSCURRENT (cur)", "Versions (2)", "Keys (2)", "username", "password"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "admin") || strings.Contains(out, "n3w-pass") {
+		t.Fatalf("values should be masked by default:\n%s", out)
+	}
+
+	// Space reveals only the selected key
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	v.Update(tea.KeyPressMsg{Code: ' ', Text: " "})
+	out = v.ViewString()
+	if !strings.Contains(out, "n3w-pass") || strings.Contains(out, "admin") {
+		t.Errorf("space should reveal the password only:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if out := v.ViewString(); !strings.Contains(out, "admin") {
+		t.Errorf("r should reveal all keys:\n%s", out)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if out := v.ViewString(); strings.Contains(out, "admin") || strings.Contains(out, "n3w-pass") {
+		t.Errorf("r should hide all keys once all are shown:\n%s", out)
+	}
+
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"}); cmd == nil {
+		t.Error("y should copy the selected key")
+	}
+}
+
+func TestSecretView_VersionsAndDiff(t *testing.T) {
+	v := newTestSecretView(t)
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runSecretCmd(v, cmd)
+	if v.value.VersionID != "prev" || len(v.fields) != 3 {
+		t.Fatalf("enter should view the previous version, got %+v", v.value)
+	}
+
+	// The diff goes from the older version to the newer one, masked
+	v.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	runSecretCmd(v, cmd)
+	if !v.HasActiveInput() {
+		t.Fatal("d should open the diff")
+	}
+	out := v.ViewString()
+	for _, want := range []string{"AWSPREVIOUS (prev) → AWSCURRENT (cur)", "~ password", "- legacy", "username", "unchanged", "2 of 3 key(s) differ"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("diff missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "0ld-pass") {
+		t.Errorf("diff should be masked:\n%s", out)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if out := v.ViewString(); !strings.Contains(out, "0ld-pass → n3w-pass") {
+		t.Errorf("r should reveal the diff:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if v.HasActiveInput() {
+		t.Error("esc should close the diff")
+	}
+}
@@ -0,0 +1,359 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/jsondiff"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/snapshot"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	snapshotDiffHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	snapshotLiveName         = "live"
+)
+
+// snapshotDiffLoadedMsg carries both sides of a snapshot comparison
+type snapshotDiffLoadedMsg struct {
+	before *snapshot.Snapshot
+	after  *snapshot.Snapshot
+	err    error
+}
+
+// snapshotDiffStyles holds cached lipgloss styles for performance
+type snapshotDiffStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	added    lipgloss.Style
+	removed  lipgloss.Style
+	changed  lipgloss.Style
+	selected lipgloss.Style
+	error    lipgloss.Style
+}
+
+func newSnapshotDiffStyles() snapshotDiffStyles {
+	return snapshotDiffStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		added:    ui.SuccessStyle(),
+		removed:  ui.DangerStyle(),
+		changed:  ui.WarningStyle(),
+		selected: ui.SelectedStyle(),
+		error:    ui.DangerStyle(),
+	}
+}
+
+// SnapshotDiffView lists resources added, removed and changed between two
+// snapshots, or between a snapshot and live state. Enter opens a structural
+// diff of the selected resource.
+type SnapshotDiffView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	base     string // snapshot name
+	target   string // snapshot name, or empty for live state
+
+	before       *snapshot.Snapshot
+	after        *snapshot.Snapshot
+	changes      []snapshot.ResourceChange
+	cursor       int
+	showVolatile bool
+	loading      bool
+	err          error
+
+	vp      ViewportState
+	width   int
+	spinner spinner.Model
+	styles  snapshotDiffStyles
+}
+
+// NewSnapshotDiffView compares snapshot base with snapshot target,
+// or with live state captured over the same scope when target is empty.
+func NewSnapshotDiffView(ctx context.Context, reg *registry.Registry, base, target string) *SnapshotDiffView {
+	return &SnapshotDiffView{
+		ctx:      ctx,
+		registry: reg,
+		base:     base,
+		target:   target,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newSnapshotDiffStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *SnapshotDiffView) Init() tea.Cmd {
+	// Keep the comparison when returning from a resource diff
+	if v.before != nil && v.after != nil {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+func (v *SnapshotDiffView) loadCmd() tea.Cmd {
+	ctx, reg, base, target := v.ctx, v.registry, v.base, v.target
+	return func() tea.Msg {
+		before, err := snapshot.Load(base)
+		if err != nil {
+			return snapshotDiffLoadedMsg{err: err}
+		}
+		var after *snapshot.Snapshot
+		if target == "" {
+			after = snapshot.Capture(ctx, reg, snapshotLiveName, before.Scope)
+		} else if after, err = snapshot.Load(target); err != nil {
+			return snapshotDiffLoadedMsg{err: err}
+		}
+		return snapshotDiffLoadedMsg{before: before, after: after}
+	}
+}
+
+func (v *SnapshotDiffView) targetName() string {
+	if v.target == "" {
+		return snapshotLiveName
+	}
+	return v.target
+}
+
+func (v *SnapshotDiffView) compare() {
+	v.changes, v.err = snapshot.Compare(v.before, v.after, jsondiff.Options{IgnoreVolatile: !v.showVolatile})
+	v.cursor = min(v.cursor, max(len(v.changes)-1, 0))
+}
+
+// Update implements tea.Model
+func (v *SnapshotDiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case snapshotDiffLoadedMsg:
+		v.loading = false
+		if msg.err != nil {
+			v.err = msg.err
+		} else {
+			v.before, v.after = msg.before, msg.after
+			v.compare()
+		}
+		v.updateContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newSnapshotDiffStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			v.moveCursor(1)
+			return v, nil
+		case "k", "up":
+			v.moveCursor(-1)
+			return v, nil
+		case "g", "home":
+			v.moveCursor(-len(v.changes))
+			return v, nil
+		case "G", "end":
+			v.moveCursor(len(v.changes))
+			return v, nil
+		case "v":
+			if v.before != nil && v.after != nil {
+				v.showVolatile = !v.showVolatile
+				v.compare()
+				v.updateContent()
+			}
+			return v, nil
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				v.err = nil
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		case "enter", "d":
+			return v, v.openDiff()
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *SnapshotDiffView) moveCursor(delta int) {
+	if len(v.changes) == 0 {
+		return
+	}
+	v.cursor = max(min(v.cursor+delta, len(v.changes)-1), 0)
+	v.updateContent()
+}
+
+// openDiff opens a structural DiffView for the selected resource. The
+// missing side of an added or removed resource is shown as empty.
+func (v *SnapshotDiffView) openDiff() tea.Cmd {
+	if v.cursor < 0 || v.cursor >= len(v.changes) {
+		return nil
+	}
+	c := v.changes[v.cursor]
+	left := snapshotSide(c.Old, c.Resource(), v.base)
+	right := snapshotSide(c.New, c.Resource(), v.targetName())
+
+	res := c.Resource()
+	diffView := NewDiffView(v.ctx, left, right, nil, res.Service, res.ResourceType)
+	diffView.structural = true
+	diffView.showVolatile = v.showVolatile
+	return func() tea.Msg {
+		return NavigateMsg{View: diffView}
+	}
+}
+
+// snapshotSide returns res for a DiffView side, or an empty placeholder
+// named after the snapshot when the resource is absent on that side.
+func snapshotSide(res, other *snapshot.Resource, snapshotName string) dao.Resource {
+	if res != nil {
+		return res
+	}
+	return &dao.BaseResource{
+		ID:   other.ID,
+		Name: fmt.Sprintf("(not in %s)", snapshotName),
+		Data: map[string]any{},
+	}
+}
+
+func (v *SnapshotDiffView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderContent())
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if v.cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(v.cursor)
+		} else if v.cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(v.cursor - height + 1)
+		}
+	}
+}
+
+func (v *SnapshotDiffView) renderContent() string {
+	s := v.styles
+	if v.err != nil {
+		return s.error.Render("Error: " + v.err.Error())
+	}
+	if v.loading {
+		return ""
+	}
+
+	var out strings.Builder
+	if len(v.changes) == 0 {
+		out.WriteString(s.dim.Render("No differences") + "\n")
+	}
+	for i, c := range v.changes {
+		res := c.Resource()
+		line := fmt.Sprintf("%s %-28s %s", c.Kind.Symbol(), res.Service+"/"+res.ResourceType, diffLabel(res))
+		if id := res.GetID(); id != res.GetName() {
+			line += "  " + id
+		}
+		if c.Kind == jsondiff.Changed {
+			line += fmt.Sprintf("  (%d field(s))", len(c.Changes))
+		}
+		line = TruncateString(line, v.width)
+
+		switch {
+		case i == v.cursor:
+			line = s.selected.Render(TruncateOrPadString(line, v.width))
+		case c.Kind == jsondiff.Added:
+			line = s.added.Render(line)
+		case c.Kind == jsondiff.Removed:
+			line = s.removed.Render(line)
+		default:
+			line = s.changed.Render(line)
+		}
+		out.WriteString(line + "\n")
+	}
+	// Errors while capturing would otherwise look like removed resources
+	if v.after != nil {
+		for _, e := range v.after.Errors {
+			out.WriteString(s.error.Render(TruncateString("⚠ "+v.targetName()+": "+e, v.width)) + "\n")
+		}
+	}
+	return out.String()
+}
+
+func (v *SnapshotDiffView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("Snapshot diff: %s → %s", v.base, v.targetName()))
+
+	summary := ""
+	switch {
+	case v.loading && v.target == "":
+		summary = v.spinner.View() + " Capturing live state..."
+	case v.loading:
+		summary = v.spinner.View() + " Loading snapshots..."
+	case v.before != nil && v.after != nil:
+		var added, removed, changed int
+		for _, c := range v.changes {
+			switch c.Kind {
+			case jsondiff.Added:
+				added++
+			case jsondiff.Removed:
+				removed++
+			default:
+				changed++
+			}
+		}
+		summary = fmt.Sprintf("%s (%s) • %d added, %d removed, %d changed",
+			v.base, v.before.CreatedAt.Local().Format("2006-01-02 15:04"), added, removed, changed)
+	}
+
+	return title + "\n" + s.dim.Render(summary) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *SnapshotDiffView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *SnapshotDiffView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *SnapshotDiffView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height-snapshotDiffHeaderHeight, 5))
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *SnapshotDiffView) StatusLine() string {
+	volatile := "v:show volatile"
+	if v.showVolatile {
+		volatile = "v:hide volatile"
+	}
+	hint := " ^r:refresh"
+	if v.target != "" {
+		hint = ""
+	}
+	return fmt.Sprintf("%s → %s • %d change(s) • j/k:move enter:diff %s%s • q/esc:back",
+		v.base, v.targetName(), len(v.changes), volatile, hint)
+}
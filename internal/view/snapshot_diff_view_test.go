@@ -0,0 +1,101 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/snapshot"
+)
+
+func saveTestSnapshot(t *testing.T, name string, resources ...snapshot.Resource) {
+	t.Helper()
+	s := &snapshot.Snapshot{Name: name, CreatedAt: time.Now().UTC(), Resources: resources}
+	if _, err := snapshot.Save(s); err != nil {
+		t.Fatalf("Save(%s) error: %v", name, err)
+	}
+}
+
+func snapshotResource(id string, data map[string]any) snapshot.Resource {
+	raw, _ := json.Marshal(data)
+	return snapshot.Resource{Service: "ec2", ResourceType: "instances", ID: id, Name: id, Data: raw}
+}
+
+func TestSnapshotDiffView(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	saveTestSnapshot(t, "before",
+		snapshotResource("i-1", map[string]any{"State": "running"}),
+		snapshotResource("i-2", map[string]any{"State": "running"}),
+	)
+	saveTestSnapshot(t, "after",
+		snapshotResource("i-1", map[string]any{"State": "stopped"}),
+		snapshotResource("i-3", map[string]any{"State": "running"}),
+	)
+
+	v := NewSnapshotDiffView(context.Background(), registry.New(), "before", "after")
+	v.SetSize(120, 30)
+
+	msg := v.loadCmd()()
+	v.Update(msg)
+
+	if v.err != nil {
+		t.Fatalf("unexpected error: %v", v.err)
+	}
+	if len(v.changes) != 3 {
+		t.Fatalf("changes = %d, want 3", len(v.changes))
+	}
+
+	out := v.ViewString()
+	for _, want := range []string{"1 added, 1 removed, 1 changed", "i-1", "i-2", "i-3"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	// Init must not reload once data is present (navigating back re-runs Init)
+	if cmd := v.Init(); cmd != nil {
+		t.Error("Init() should return nil after loading")
+	}
+
+	// Enter on the removed resource opens a structural diff with a placeholder side
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return a command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("enter should navigate")
+	}
+	diff, ok := nav.View.(*DiffView)
+	if !ok {
+		t.Fatalf("navigated to %T, want *DiffView", nav.View)
+	}
+	if !diff.structural {
+		t.Error("DiffView should start in structural mode")
+	}
+	if got := diff.right.GetName(); got != "(not in after)" {
+		t.Errorf("right side name = %q, want placeholder", got)
+	}
+}
+
+func TestSnapshotDiffView_MissingSnapshot(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	v := NewSnapshotDiffView(context.Background(), registry.New(), "nope", "other")
+	v.SetSize(80, 20)
+	v.Update(v.loadCmd()())
+
+	if v.err == nil {
+		t.Fatal("expected error for missing snapshot")
+	}
+	if !strings.Contains(v.ViewString(), "snapshot not found: nope") {
+		t.Errorf("view should show the error, got %q", v.ViewString())
+	}
+}
@@ -141,6 +141,11 @@ type fetchResult struct {
 }
 
 func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens map[string]string) fetchResult {
+	return fetchTaggedARNs(v.ctx, regions, v.parseTagFilters(), existingTokens)
+}
+
+// fetchTaggedARNs queries the tagging API in each region concurrently
+func fetchTaggedARNs(ctx context.Context, regions []string, tagFilters []tagtypes.TagFilter, existingTokens map[string]string) fetchResult {
 	type regionResult struct {
 		region    string
 		resources []taggedARN
@@ -148,14 +153,12 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 		err       error
 	}
 
-	ctx, cancel := context.WithTimeout(v.ctx, config.File().TagSearchTimeout())
+	ctx, cancel := context.WithTimeout(ctx, config.File().TagSearchTimeout())
 	defer cancel()
 
 	results := make(chan regionResult, len(regions))
 	var wg sync.WaitGroup
 
-	tagFilters := v.parseTagFilters()
-
 	for _, region := range regions {
 		wg.Add(1)
 		go func(region string) {
@@ -247,11 +250,16 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 }
 
 func (v *TagSearchView) parseTagFilters() []tagtypes.TagFilter {
-	if v.tagFilter == "" {
+	return parseTagFilter(v.tagFilter)
+}
+
+// parseTagFilter converts "Key" or "Key=Value" into tagging API filters
+func parseTagFilter(tagFilter string) []tagtypes.TagFilter {
+	if tagFilter == "" {
 		return nil
 	}
 
-	parts := strings.SplitN(v.tagFilter, "=", 2)
+	parts := strings.SplitN(tagFilter, "=", 2)
 	key := parts[0]
 	filter := tagtypes.TagFilter{Key: aws.StringPtr(key)}
 
@@ -477,7 +485,7 @@ func (v *TagSearchView) navigateToResource() (tea.Model, tea.Cmd) {
 		daoInst = nil
 	}
 
-	resourceID := v.getResourceIDForGet(res.ARN)
+	resourceID := res.ARN.ResourceIDForGet()
 	minimalResource := &dao.BaseResource{
 		ID:   resourceID,
 		Name: res.ARN.ShortID(),
@@ -491,23 +499,6 @@ func (v *TagSearchView) navigateToResource() (tea.Model, tea.Cmd) {
 	}
 }
 
-func (v *TagSearchView) getResourceIDForGet(arn *aws.ARN) string {
-	switch arn.Service {
-	case "states":
-		return arn.Raw
-	case "bedrock-agentcore":
-		if idx := strings.Index(arn.ResourceID, "/"); idx > 0 {
-			return arn.ResourceID[:idx]
-		}
-		return arn.ResourceID
-	default:
-		if arn.ResourceID != "" {
-			return arn.ResourceID
-		}
-		return arn.Raw
-	}
-}
-
 func (v *TagSearchView) applyFilter() {
 	if v.filterText == "" {
 		v.filtered = v.resources
@@ -0,0 +1,114 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const textHeaderHeight = 2 // title(1) + separator(1)
+
+// textStyles holds cached lipgloss styles for performance
+type textStyles struct {
+	title lipgloss.Style
+	dim   lipgloss.Style
+}
+
+func newTextStyles() textStyles {
+	return textStyles{
+		title: ui.TitleStyle(),
+		dim:   ui.DimStyle(),
+	}
+}
+
+// TextView shows read-only text, such as an object body fetched by an action
+type TextView struct {
+	title   string
+	content string
+	wrap    bool
+
+	vp     ViewportState
+	width  int
+	height int
+	styles textStyles
+}
+
+// NewTextView creates a text view
+func NewTextView(title, content string) *TextView {
+	return &TextView{
+		title:   title,
+		content: strings.ReplaceAll(content, "\r\n", "\n"),
+		wrap:    true,
+		styles:  newTextStyles(),
+	}
+}
+
+// Init implements tea.Model
+func (v *TextView) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (v *TextView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ThemeChangedMsg:
+		v.styles = newTextStyles()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "y":
+			return v, clipboard.Copy("content", v.content)
+		case "w":
+			v.wrap = !v.wrap
+			v.vp.Model.SoftWrap = v.wrap
+			v.vp.Model.SetXOffset(0)
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// ViewString returns the view content as a string
+func (v *TextView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	lines := strings.Count(v.content, "\n") + 1
+	header := v.styles.title.Render(v.title) + " " + v.styles.dim.Render(fmt.Sprintf("(%d lines)", lines))
+	return TruncateString(header, v.width) + "\n" + strings.Repeat("─", v.width) + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *TextView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TextView) SetSize(width, height int) tea.Cmd {
+	v.width, v.height = width, height
+	ready := v.vp.Ready
+	v.vp.SetSize(width, max(height-textHeaderHeight, 3))
+	if !ready {
+		v.vp.Model.SoftWrap = v.wrap
+		v.vp.Model.SetContent(v.content)
+	}
+	return nil
+}
+
+// StatusLine implements View
+func (v *TextView) StatusLine() string {
+	wrap := "w:wrap"
+	if v.wrap {
+		wrap = "w:no wrap"
+	}
+	return "↑/↓:scroll y:copy " + wrap + " • q/esc:back"
+}
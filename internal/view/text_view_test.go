@@ -0,0 +1,54 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func TestTextView_Render(t *testing.T) {
+	v := NewTextView("s3://b/a.json", "{\r\n  \"a\": 1\r\n}")
+	if got := v.ViewString(); got != LoadingMessage {
+		t.Errorf("ViewString() before SetSize = %q, want loading", got)
+	}
+
+	v.SetSize(80, 20)
+	out := v.ViewString()
+	if !strings.Contains(out, "s3://b/a.json") {
+		t.Error("ViewString() should contain the title")
+	}
+	if !strings.Contains(out, "(3 lines)") {
+		t.Errorf("ViewString() should count 3 lines, got:\n%s", out)
+	}
+	if strings.Contains(out, "\r") {
+		t.Error("ViewString() should normalize CRLF line endings")
+	}
+}
+
+func TestTextView_WrapToggle(t *testing.T) {
+	v := NewTextView("t", strings.Repeat("x", 200))
+	v.SetSize(40, 10)
+	if !v.vp.Model.SoftWrap {
+		t.Fatal("wrap should be on by default")
+	}
+	if !strings.Contains(v.StatusLine(), "w:no wrap") {
+		t.Errorf("StatusLine() = %q, want w:no wrap", v.StatusLine())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	if v.wrap || v.vp.Model.SoftWrap {
+		t.Error("w should turn wrap off")
+	}
+	if !strings.Contains(v.StatusLine(), "w:wrap") {
+		t.Errorf("StatusLine() = %q, want w:wrap", v.StatusLine())
+	}
+}
+
+func TestTextView_Copy(t *testing.T) {
+	v := NewTextView("t", "hello")
+	v.SetSize(40, 10)
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"}); cmd == nil {
+		t.Error("y should return a copy command")
+	}
+}
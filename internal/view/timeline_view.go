@@ -0,0 +1,809 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/timeline"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	timelineHeaderHeight  = 3 // title(1) + summary(1) + separator(1)
+	timelineDetailHeight  = 5
+	timelineConcurrency   = 6
+	timelineMaxTrailNames = 10 // CloudTrail LookupEvents allows 2 calls per second
+)
+
+// timelineWindows are the time ranges cycled with w
+var timelineWindows = []time.Duration{time.Hour, 6 * time.Hour, 24 * time.Hour, 3 * 24 * time.Hour, 7 * 24 * time.Hour}
+
+// timelineTarget is a resource whose events go on the timeline
+type timelineTarget struct {
+	ctx          context.Context // profile and region of the resource
+	scope        string          // profile/region key, targets in one scope share alarm lookups
+	resource     dao.Resource    // unwrapped
+	service      string
+	resourceType string
+}
+
+// timelineRef points to the resource an event belongs to
+type timelineRef struct {
+	ctx          context.Context
+	service      string
+	resourceType string
+	id           string
+	resource     dao.Resource // already loaded, or nil to fetch by id
+}
+
+// timelineEntry is an event on the timeline
+type timelineEntry struct {
+	event     timeline.Event
+	item      dao.Resource // the event itself (stack event, activity, ...)
+	itemCtx   context.Context
+	itemSvc   string
+	itemType  string
+	source    timelineRef // zero if the event is its own source (Health)
+	hasSource bool
+}
+
+// timelineQuery lists one event source for one resource
+type timelineQuery struct {
+	ctx          context.Context
+	service      string
+	resourceType string
+	pages        int
+	pageSize     int
+	source       *timelineRef
+	keep         func(dao.Resource) bool
+}
+
+// alarmDimensionProvider is implemented by cloudwatch/alarms resources
+type alarmDimensionProvider interface {
+	DimensionValues() []string
+}
+
+// readOnlyProvider is implemented by cloudtrail/events resources
+type readOnlyProvider interface {
+	ReadOnly() string
+}
+
+// clusterArnProvider is implemented by ecs/services resources
+type clusterArnProvider interface {
+	ClusterArn() string
+}
+
+// timelineLoadedMsg carries the collected timeline
+type timelineLoadedMsg struct {
+	targets []timelineTarget
+	entries []*timelineEntry
+	errors  []string
+}
+
+// timelineStyles holds cached lipgloss styles for performance
+type timelineStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	label    lipgloss.Style
+	source   lipgloss.Style
+	ok       lipgloss.Style
+	warning  lipgloss.Style
+	error    lipgloss.Style
+	selected lipgloss.Style
+}
+
+func newTimelineStyles() timelineStyles {
+	return timelineStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		label:    ui.SectionStyle(),
+		source:   ui.AccentStyle(),
+		ok:       ui.SuccessStyle(),
+		warning:  ui.WarningStyle(),
+		error:    ui.DangerStyle(),
+		selected: ui.SelectedStyle(),
+	}
+}
+
+// TimelineView merges alarm state changes, stack events, ECS service
+// events, scaling activities, Health events and CloudTrail write events
+// of a set of resources into one timeline.
+type TimelineView struct {
+	ctx       context.Context
+	registry  *registry.Registry
+	targets   []timelineTarget
+	tagFilter string // resolve targets by tag instead
+
+	entries []*timelineEntry
+	visible []*timelineEntry
+	errors  []string
+	window  int              // index into timelineWindows
+	source  timeline.Source  // show only this source, or all if empty
+	now     func() time.Time // for tests
+	cursor  int
+	loading bool
+	loaded  bool
+
+	vp      ViewportState
+	width   int
+	spinner spinner.Model
+	styles  timelineStyles
+}
+
+// NewTimelineView creates a timeline for resources of one type. ctx is
+// used for resources that carry no profile or region of their own.
+func NewTimelineView(ctx context.Context, reg *registry.Registry, resources []dao.Resource, service, resourceType string) *TimelineView {
+	v := newTimelineView(ctx, reg)
+	for _, res := range resources {
+		v.targets = append(v.targets, newTimelineTarget(ctx, res, service, resourceType))
+	}
+	return v
+}
+
+// NewTimelineViewForTag creates a timeline for the resources matching a
+// tag filter ("Key" or "Key=Value") in the configured regions
+func NewTimelineViewForTag(ctx context.Context, reg *registry.Registry, tagFilter string) *TimelineView {
+	v := newTimelineView(ctx, reg)
+	v.tagFilter = tagFilter
+	return v
+}
+
+func newTimelineView(ctx context.Context, reg *registry.Registry) *TimelineView {
+	return &TimelineView{
+		ctx:      ctx,
+		registry: reg,
+		window:   2, // 24h
+		now:      time.Now,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newTimelineStyles(),
+	}
+}
+
+// newTimelineTarget applies the profile and region of a wrapped resource
+func newTimelineTarget(ctx context.Context, res dao.Resource, service, resourceType string) timelineTarget {
+	profile, region := dao.GetResourceProfile(res), dao.GetResourceRegion(res)
+	return timelineTarget{
+		ctx:          resourceContext(ctx, res),
+		scope:        profile + "/" + region,
+		resource:     dao.UnwrapResource(res),
+		service:      service,
+		resourceType: resourceType,
+	}
+}
+
+// Init implements tea.Model
+func (v *TimelineView) Init() tea.Cmd {
+	// Keep the timeline when returning from a source resource
+	if v.loaded {
+		return nil
+	}
+	return tea.Batch(v.loadCmd(), v.spinner.Tick)
+}
+
+func (v *TimelineView) loadCmd() tea.Cmd {
+	ctx, reg, targets, tagFilter := v.ctx, v.registry, v.targets, v.tagFilter
+	return func() tea.Msg {
+		var errs []string
+		if tagFilter != "" {
+			targets, errs = resolveTagTargets(ctx, reg, tagFilter)
+		}
+		entries, collectErrs := collectTimeline(ctx, reg, targets)
+		return timelineLoadedMsg{targets: targets, entries: entries, errors: append(errs, collectErrs...)}
+	}
+}
+
+// resolveTagTargets finds the resources matching a tag filter that claws
+// can open
+func resolveTagTargets(ctx context.Context, reg *registry.Registry, tagFilter string) ([]timelineTarget, []string) {
+	regions := config.Global().Regions()
+	if len(regions) == 0 {
+		regions = []string{config.Global().Region()}
+	}
+
+	result := fetchTaggedARNs(ctx, regions, parseTagFilter(tagFilter), nil)
+	var targets []timelineTarget
+	for _, tagged := range result.resources {
+		if tagged.ARN == nil || !tagged.ARN.CanNavigate() {
+			continue
+		}
+		service, resourceType := tagged.ARN.ServiceResourceType()
+		if _, ok := reg.Get(service, resourceType); !ok {
+			continue
+		}
+		res := &dao.BaseResource{
+			ID:   tagged.ARN.ResourceIDForGet(),
+			Name: tagged.ARN.ShortID(),
+			ARN:  tagged.RawARN,
+			Tags: tagged.Tags,
+		}
+		targets = append(targets, newTimelineTarget(ctx, dao.WrapWithRegion(res, tagged.Region), service, resourceType))
+	}
+	return targets, result.errors
+}
+
+// collectTimeline queries every event source relevant to the targets
+func collectTimeline(ctx context.Context, reg *registry.Registry, targets []timelineTarget) ([]*timelineEntry, []string) {
+	queries, errs := timelineQueries(ctx, reg, targets)
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		entries []*timelineEntry
+		seen    = make(map[string]bool)
+		sem     = make(chan struct{}, timelineConcurrency)
+	)
+	for _, q := range queries {
+		wg.Add(1)
+		go func(q timelineQuery) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			items, err := listTimelineItems(q.ctx, reg, q)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s/%s: %v", q.service, q.resourceType, err))
+				return
+			}
+			for _, item := range items {
+				provider, ok := dao.UnwrapResource(item).(timeline.Provider)
+				if !ok || (q.keep != nil && !q.keep(item)) {
+					continue
+				}
+				// The same CloudTrail event can be found by ID and by name
+				key := q.service + "/" + q.resourceType + "/" + item.GetID()
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				e := &timelineEntry{
+					event:    provider.TimelineEvent(),
+					item:     item,
+					itemCtx:  q.ctx,
+					itemSvc:  q.service,
+					itemType: q.resourceType,
+				}
+				if q.source != nil {
+					e.source, e.hasSource = *q.source, true
+				}
+				entries = append(entries, e)
+			}
+		}(q)
+	}
+	wg.Wait()
+
+	slices.SortStableFunc(entries, func(a, b *timelineEntry) int { return timeline.Compare(a.event, b.event) })
+	slices.Sort(errs)
+	return entries, errs
+}
+
+// timelineQueries decides which sources to list for the targets
+func timelineQueries(ctx context.Context, reg *registry.Registry, targets []timelineTarget) ([]timelineQuery, []string) {
+	var (
+		queries []timelineQuery
+		errs    []string
+		seen    = make(map[string]bool)
+	)
+	add := func(key string, q timelineQuery) {
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		queries = append(queries, q)
+	}
+	filtered := func(ctx context.Context, kv ...string) context.Context {
+		for i := 0; i+1 < len(kv); i += 2 {
+			ctx = dao.WithFilter(ctx, kv[i], kv[i+1])
+		}
+		return ctx
+	}
+
+	trailNames := 0
+	for i := range targets {
+		t := &targets[i]
+		res := t.resource
+		self := &timelineRef{ctx: t.ctx, service: t.service, resourceType: t.resourceType, id: res.GetID(), resource: res}
+
+		// CloudTrail write events, looked up by ID and by name
+		for _, name := range uniqueNonEmpty(res.GetID(), res.GetName()) {
+			if trailNames >= timelineMaxTrailNames {
+				break
+			}
+			trailNames++
+			add(t.scope+"|cloudtrail|"+name, timelineQuery{
+				ctx:          filtered(t.ctx, "ResourceName", name),
+				service:      "cloudtrail",
+				resourceType: "events",
+				pages:        1,
+				pageSize:     50,
+				source:       self,
+				keep: func(item dao.Resource) bool {
+					e, ok := dao.UnwrapResource(item).(readOnlyProvider)
+					return !ok || e.ReadOnly() != "true"
+				},
+			})
+		}
+
+		stack := res.GetTags()["aws:cloudformation:stack-name"]
+		asg := res.GetTags()["aws:autoscaling:groupName"]
+		switch t.service + "/" + t.resourceType {
+		case "cloudformation/stacks":
+			stack = res.GetName()
+		case "autoscaling/groups":
+			asg = res.GetName()
+		case "cloudwatch/alarms":
+			add(t.scope+"|alarm|"+res.GetName(), timelineQuery{
+				ctx:          filtered(t.ctx, "AlarmName", res.GetName()),
+				service:      "cloudwatch",
+				resourceType: "alarm-history",
+				pages:        1,
+				pageSize:     100,
+				source:       self,
+			})
+		case "ecs/services":
+			cluster := ""
+			if c, ok := res.(clusterArnProvider); ok {
+				cluster = aws.ExtractResourceName(c.ClusterArn())
+			}
+			add(t.scope+"|ecs|"+res.GetARN()+res.GetName(), timelineQuery{
+				ctx:          filtered(t.ctx, "ServiceArn", res.GetARN(), "ClusterName", cluster, "ServiceName", res.GetName()),
+				service:      "ecs",
+				resourceType: "service-events",
+				pages:        1,
+				source:       self,
+			})
+		}
+		if stack != "" {
+			ref := self
+			if t.service != "cloudformation" {
+				ref = &timelineRef{ctx: t.ctx, service: "cloudformation", resourceType: "stacks", id: stack}
+			}
+			add(t.scope+"|cfn|"+stack, timelineQuery{
+				ctx:          filtered(t.ctx, "StackName", stack),
+				service:      "cloudformation",
+				resourceType: "events",
+				pages:        2,
+				source:       ref,
+			})
+		}
+		if asg != "" {
+			ref := self
+			if t.service != "autoscaling" {
+				ref = &timelineRef{ctx: t.ctx, service: "autoscaling", resourceType: "groups", id: asg}
+			}
+			add(t.scope+"|asg|"+asg, timelineQuery{
+				ctx:          filtered(t.ctx, "AutoScalingGroupName", asg),
+				service:      "autoscaling",
+				resourceType: "activities",
+				pages:        1,
+				pageSize:     100,
+				source:       ref,
+			})
+		}
+	}
+
+	// Alarms on metrics of the targets (one alarm listing per profile/region)
+	byScope := make(map[string][]*timelineTarget)
+	var scopes []string
+	for i := range targets {
+		t := &targets[i]
+		if t.service == "cloudwatch" {
+			continue
+		}
+		if _, ok := byScope[t.scope]; !ok {
+			scopes = append(scopes, t.scope)
+		}
+		byScope[t.scope] = append(byScope[t.scope], t)
+	}
+	for _, scope := range scopes {
+		scoped := byScope[scope]
+		alarms, err := listTimelineItems(scoped[0].ctx, reg, timelineQuery{service: "cloudwatch", resourceType: "alarms"})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("cloudwatch/alarms: %v", err))
+			continue
+		}
+		for _, alarm := range alarms {
+			a, ok := dao.UnwrapResource(alarm).(alarmDimensionProvider)
+			if !ok {
+				continue
+			}
+			values := a.DimensionValues()
+			for _, t := range scoped {
+				if !slices.ContainsFunc(uniqueNonEmpty(t.resource.GetID(), t.resource.GetName()), func(s string) bool {
+					return slices.Contains(values, s)
+				}) {
+					continue
+				}
+				add(scope+"|alarm|"+alarm.GetName(), timelineQuery{
+					ctx:          filtered(t.ctx, "AlarmName", alarm.GetName()),
+					service:      "cloudwatch",
+					resourceType: "alarm-history",
+					pages:        1,
+					pageSize:     100,
+					source:       &timelineRef{ctx: t.ctx, service: "cloudwatch", resourceType: "alarms", id: alarm.GetID(), resource: dao.UnwrapResource(alarm)},
+				})
+			}
+		}
+	}
+
+	// Health events are account-wide
+	if len(targets) > 0 {
+		add("health", timelineQuery{ctx: ctx, service: "health", resourceType: "events", pages: 1, pageSize: 100})
+	}
+
+	return queries, errs
+}
+
+// listTimelineItems lists the first pages of a source
+func listTimelineItems(ctx context.Context, reg *registry.Registry, q timelineQuery) ([]dao.Resource, error) {
+	d, err := reg.GetDAO(ctx, q.service, q.resourceType)
+	if err != nil {
+		return nil, err
+	}
+	paginated, ok := d.(dao.PaginatedDAO)
+	if !ok || q.pages == 0 {
+		return d.List(ctx)
+	}
+
+	var items []dao.Resource
+	token := ""
+	for range q.pages {
+		page, next, err := paginated.ListPage(ctx, q.pageSize, token)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	return items, nil
+}
+
+// uniqueNonEmpty returns the distinct non-empty values in order
+func uniqueNonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Update implements tea.Model
+func (v *TimelineView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case timelineLoadedMsg:
+		v.loading = false
+		v.loaded = true
+		v.targets = msg.targets
+		v.entries = msg.entries
+		v.errors = msg.errors
+		v.cursor = 0
+		v.applyFilter()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newTimelineStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			v.moveCursor(1)
+			return v, nil
+		case "k", "up":
+			v.moveCursor(-1)
+			return v, nil
+		case "ctrl+d", "pgdown":
+			v.moveCursor(max(v.vp.Model.Height()/2, 1))
+			return v, nil
+		case "ctrl+u", "pgup":
+			v.moveCursor(-max(v.vp.Model.Height()/2, 1))
+			return v, nil
+		case "g", "home":
+			v.moveCursor(-len(v.visible))
+			return v, nil
+		case "G", "end":
+			v.moveCursor(len(v.visible))
+			return v, nil
+		case "w":
+			v.window = (v.window + 1) % len(timelineWindows)
+			v.applyFilter()
+			return v, nil
+		case "f":
+			v.cycleSource()
+			v.applyFilter()
+			return v, nil
+		case "enter":
+			return v, v.openSource()
+		case "e":
+			return v, v.openEvent()
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				return v, tea.Batch(v.loadCmd(), v.spinner.Tick)
+			}
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// cycleSource steps the source filter through all sources and back to all
+func (v *TimelineView) cycleSource() {
+	if v.source == "" {
+		v.source = timeline.Sources[0]
+		return
+	}
+	i := slices.Index(timeline.Sources, v.source)
+	if i < 0 || i+1 == len(timeline.Sources) {
+		v.source = ""
+		return
+	}
+	v.source = timeline.Sources[i+1]
+}
+
+// applyFilter selects the entries inside the window and source filter,
+// keeping the cursor on the same entry where possible
+func (v *TimelineView) applyFilter() {
+	var current *timelineEntry
+	if v.cursor < len(v.visible) {
+		current = v.visible[v.cursor]
+	}
+
+	since := v.now().Add(-timelineWindows[v.window])
+	v.visible = v.visible[:0]
+	for _, e := range v.entries {
+		if e.event.Time.Before(since) || (v.source != "" && e.event.Source != v.source) {
+			continue
+		}
+		v.visible = append(v.visible, e)
+	}
+
+	v.cursor = max(slices.Index(v.visible, current), 0)
+	v.updateContent()
+}
+
+func (v *TimelineView) moveCursor(delta int) {
+	if len(v.visible) == 0 {
+		return
+	}
+	v.cursor = max(min(v.cursor+delta, len(v.visible)-1), 0)
+	v.updateContent()
+}
+
+func (v *TimelineView) selected() *timelineEntry {
+	if v.cursor < 0 || v.cursor >= len(v.visible) {
+		return nil
+	}
+	return v.visible[v.cursor]
+}
+
+// openSource opens the resource the selected event belongs to
+func (v *TimelineView) openSource() tea.Cmd {
+	e := v.selected()
+	if e == nil {
+		return nil
+	}
+	if !e.hasSource {
+		return v.openEvent()
+	}
+
+	ref := e.source
+	renderer, err := v.registry.GetRenderer(ref.service, ref.resourceType)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	daoInst, err := v.registry.GetDAO(ref.ctx, ref.service, ref.resourceType)
+	if err != nil {
+		daoInst = nil
+	}
+	res := ref.resource
+	if res == nil {
+		res = &dao.BaseResource{ID: ref.id, Name: ref.id}
+	}
+	detailView := NewDetailView(ref.ctx, res, renderer, ref.service, ref.resourceType, v.registry, daoInst)
+	return func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+// openEvent opens the selected event itself
+func (v *TimelineView) openEvent() tea.Cmd {
+	e := v.selected()
+	if e == nil {
+		return nil
+	}
+	renderer, err := v.registry.GetRenderer(e.itemSvc, e.itemType)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	detailView := NewDetailView(e.itemCtx, e.item, renderer, e.itemSvc, e.itemType, v.registry, nil)
+	return func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *TimelineView) severityStyle(s timeline.Severity) (lipgloss.Style, bool) {
+	switch s {
+	case timeline.SeverityError:
+		return v.styles.error, true
+	case timeline.SeverityWarning:
+		return v.styles.warning, true
+	case timeline.SeverityOK:
+		return v.styles.ok, true
+	default:
+		return lipgloss.Style{}, false
+	}
+}
+
+func (v *TimelineView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderList())
+
+	if height := v.vp.Model.Height(); height > 0 {
+		if v.cursor < v.vp.Model.YOffset() {
+			v.vp.Model.SetYOffset(v.cursor)
+		} else if v.cursor >= v.vp.Model.YOffset()+height {
+			v.vp.Model.SetYOffset(v.cursor - height + 1)
+		}
+	}
+}
+
+func (v *TimelineView) renderList() string {
+	s := v.styles
+	if v.loading && !v.loaded {
+		return ""
+	}
+	if len(v.visible) == 0 {
+		return s.dim.Render("No events in the " + v.windowLabel())
+	}
+
+	var out strings.Builder
+	for i, e := range v.visible {
+		ts := e.event.Time.Local().Format("01-02 15:04:05")
+		line := fmt.Sprintf("%s  %-10s  %-24s  %s", ts, e.event.Source,
+			TruncateString(e.event.Subject, 24), e.event.Summary)
+		line = TruncateString(line, v.width)
+
+		if i == v.cursor {
+			line = s.selected.Render(TruncateOrPadString(line, v.width))
+		} else if style, ok := v.severityStyle(e.event.Severity); ok {
+			line = style.Render(line)
+		}
+		out.WriteString(line + "\n")
+	}
+	return out.String()
+}
+
+// renderDetail renders the selected event and where enter leads
+func (v *TimelineView) renderDetail() string {
+	s := v.styles
+	e := v.selected()
+	if e == nil {
+		return ""
+	}
+
+	lines := []string{
+		s.label.Render(e.event.Time.Local().Format("2006-01-02 15:04:05 MST")) + "  " +
+			s.source.Render(string(e.event.Source)) + "  " + e.event.Subject,
+	}
+	summary := lipgloss.NewStyle().Width(max(v.width-2, 10)).Render(e.event.Summary)
+	for _, l := range strings.Split(summary, "\n") {
+		lines = append(lines, "  "+l)
+	}
+
+	target := e.itemSvc + "/" + e.itemType
+	if e.hasSource {
+		target = e.source.service + "/" + e.source.resourceType + " " + e.source.id
+	}
+	footer := s.dim.Render(TruncateString("enter: "+target+"  e: "+e.itemSvc+"/"+e.itemType, v.width))
+
+	if len(lines) > timelineDetailHeight-1 {
+		lines = lines[:timelineDetailHeight-1]
+	}
+	return strings.Join(append(lines, footer), "\n")
+}
+
+func (v *TimelineView) windowLabel() string {
+	d := timelineWindows[v.window]
+	if d >= 24*time.Hour {
+		return fmt.Sprintf("last %dd", int(d.Hours()/24))
+	}
+	return fmt.Sprintf("last %dh", int(d.Hours()))
+}
+
+func (v *TimelineView) renderHeader() string {
+	s := v.styles
+	subject := fmt.Sprintf("%d resource(s)", len(v.targets))
+	if v.tagFilter != "" {
+		subject = "tag " + v.tagFilter
+	} else if len(v.targets) == 1 {
+		t := v.targets[0]
+		subject = fmt.Sprintf("%s/%s %s", t.service, t.resourceType, diffLabel(t.resource))
+	}
+	title := s.title.Render("Timeline: " + subject)
+
+	var summary string
+	if v.loading {
+		summary = v.spinner.View() + " Collecting events..."
+	} else {
+		summary = fmt.Sprintf("%d of %d event(s) • %s", len(v.visible), len(v.entries), v.windowLabel())
+		if v.tagFilter != "" {
+			summary += fmt.Sprintf(" • %d resource(s)", len(v.targets))
+		}
+		if v.source != "" {
+			summary += " • only " + string(v.source)
+		}
+	}
+	summaryLine := s.dim.Render(TruncateString(summary, v.width))
+	if !v.loading && len(v.errors) > 0 {
+		errText := fmt.Sprintf(" • %d source(s) failed: %s", len(v.errors), v.errors[0])
+		summaryLine += s.warning.Render(TruncateString(errText, max(v.width-lipgloss.Width(summaryLine), 0)))
+	}
+
+	return title + "\n" + summaryLine + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *TimelineView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View() + "\n" +
+		strings.Repeat("─", v.width) + "\n" + v.renderDetail()
+}
+
+// View implements tea.Model
+func (v *TimelineView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TimelineView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	listHeight := max(height-timelineHeaderHeight-timelineDetailHeight-1, 3)
+	v.vp.SetSize(width, listHeight)
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *TimelineView) StatusLine() string {
+	return "j/k:move enter:source e:event w:window f:source filter ^r:refresh • q/esc:back"
+}
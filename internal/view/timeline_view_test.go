@@ -0,0 +1,219 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/timeline"
+)
+
+// mockTimelineResource implements timeline.Provider
+type mockTimelineResource struct {
+	dao.BaseResource
+	event    timeline.Event
+	readOnly string
+}
+
+func (r *mockTimelineResource) TimelineEvent() timeline.Event { return r.event }
+func (r *mockTimelineResource) ReadOnly() string              { return r.readOnly }
+
+// mockAlarmResource implements alarmDimensionProvider
+type mockAlarmResource struct {
+	dao.BaseResource
+	dims []string
+}
+
+func (r *mockAlarmResource) DimensionValues() []string { return r.dims }
+
+// mockTimelineDAO lists resources depending on the filters in ctx
+type mockTimelineDAO struct {
+	dao.BaseDAO
+	list func(ctx context.Context) ([]dao.Resource, error)
+}
+
+func (d *mockTimelineDAO) List(ctx context.Context) ([]dao.Resource, error) { return d.list(ctx) }
+func (d *mockTimelineDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, errors.New("not found")
+}
+func (d *mockTimelineDAO) Delete(ctx context.Context, id string) error { return nil }
+func (d *mockTimelineDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	items, err := d.list(ctx)
+	return items, "", err
+}
+
+func registerTimelineSource(reg *registry.Registry, service, resourceType string, list func(ctx context.Context) ([]dao.Resource, error)) {
+	reg.RegisterCustom(service, resourceType, registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return &mockTimelineDAO{BaseDAO: dao.NewBaseDAO(service, resourceType), list: list}, nil
+		},
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+}
+
+func newTimelineItem(id string, source timeline.Source, subject, summary string, at time.Time, severity timeline.Severity) *mockTimelineResource {
+	return &mockTimelineResource{
+		BaseResource: dao.BaseResource{ID: id, Name: id},
+		event:        timeline.Event{Time: at, Source: source, Subject: subject, Summary: summary, Severity: severity},
+	}
+}
+
+func TestTimelineView(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	var filters []string
+	record := func(ctx context.Context, key string) string {
+		value := dao.GetFilterFromContext(ctx, key)
+		filters = append(filters, key+"="+value)
+		return value
+	}
+
+	reg := registry.New()
+	registerTimelineSource(reg, "cloudtrail", "events", func(ctx context.Context) ([]dao.Resource, error) {
+		if record(ctx, "ResourceName") != "web-asg" {
+			return nil, nil
+		}
+		read := newTimelineItem("t2", timeline.SourceCloudTrail, "web-asg", "DescribeAutoScalingGroups by alice", now.Add(-time.Minute), timeline.SeverityInfo)
+		read.readOnly = "true"
+		return []dao.Resource{
+			newTimelineItem("t1", timeline.SourceCloudTrail, "web-asg", "UpdateAutoScalingGroup by alice", now.Add(-50*time.Minute), timeline.SeverityInfo),
+			read,
+		}, nil
+	})
+	registerTimelineSource(reg, "cloudformation", "events", func(ctx context.Context) ([]dao.Resource, error) {
+		if record(ctx, "StackName") != "web-stack" {
+			return nil, nil
+		}
+		return []dao.Resource{
+			newTimelineItem("c1", timeline.SourceStack, "web-stack", "LaunchTemplate UPDATE_FAILED", now.Add(-40*time.Minute), timeline.SeverityError),
+			newTimelineItem("c0", timeline.SourceStack, "web-stack", "Stack CREATE_COMPLETE", now.Add(-30*24*time.Hour), timeline.SeverityOK),
+		}, nil
+	})
+	registerTimelineSource(reg, "autoscaling", "activities", func(ctx context.Context) ([]dao.Resource, error) {
+		record(ctx, "AutoScalingGroupName")
+		return []dao.Resource{
+			newTimelineItem("a1", timeline.SourceAutoScaling, "web-asg", "Terminating EC2 instance", now.Add(-20*time.Minute), timeline.SeverityInfo),
+		}, nil
+	})
+	registerTimelineSource(reg, "cloudwatch", "alarms", func(ctx context.Context) ([]dao.Resource, error) {
+		return []dao.Resource{
+			&mockAlarmResource{BaseResource: dao.BaseResource{ID: "cpu-high", Name: "cpu-high"}, dims: []string{"web-asg"}},
+			&mockAlarmResource{BaseResource: dao.BaseResource{ID: "other", Name: "other"}, dims: []string{"i-123"}},
+		}, nil
+	})
+	registerTimelineSource(reg, "cloudwatch", "alarm-history", func(ctx context.Context) ([]dao.Resource, error) {
+		record(ctx, "AlarmName")
+		return []dao.Resource{
+			newTimelineItem("h1", timeline.SourceAlarm, "cpu-high", "OK → ALARM", now.Add(-10*time.Minute), timeline.SeverityError),
+		}, nil
+	})
+	registerTimelineSource(reg, "health", "events", func(ctx context.Context) ([]dao.Resource, error) {
+		return nil, errors.New("subscription required")
+	})
+	registerTimelineSource(reg, "autoscaling", "groups", func(ctx context.Context) ([]dao.Resource, error) { return nil, nil })
+	registerTimelineSource(reg, "cloudformation", "stacks", func(ctx context.Context) ([]dao.Resource, error) { return nil, nil })
+
+	asg := &dao.BaseResource{ID: "web-asg", Name: "web-asg", Tags: map[string]string{"aws:cloudformation:stack-name": "web-stack"}}
+	v := NewTimelineView(context.Background(), reg, []dao.Resource{asg}, "autoscaling", "groups")
+	v.now = func() time.Time { return now }
+	v.SetSize(120, 30)
+	v.Update(v.loadCmd()())
+
+	got := strings.Join(filters, ",")
+	for _, want := range []string{"ResourceName=web-asg", "StackName=web-stack", "AutoScalingGroupName=web-asg", "AlarmName=cpu-high"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("queries %s missing %s", got, want)
+		}
+	}
+	if strings.Contains(got, "AlarmName=other") {
+		t.Error("alarm without matching dimension should not be queried")
+	}
+
+	// Newest first, read-only CloudTrail events and events outside the window hidden
+	var ids []string
+	for _, e := range v.visible {
+		ids = append(ids, e.item.GetID())
+	}
+	if strings.Join(ids, ",") != "h1,a1,c1,t1" {
+		t.Errorf("visible = %v, want h1,a1,c1,t1", ids)
+	}
+	if len(v.errors) != 1 || !strings.Contains(v.errors[0], "subscription required") {
+		t.Errorf("errors = %v, want the health failure", v.errors)
+	}
+
+	out := v.ViewString()
+	for _, want := range []string{"4 of 5 event(s)", "last 1d", "1 source(s) failed", "OK → ALARM", "enter: cloudwatch/alarms cpu-high"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+
+	// f narrows to a single source
+	v.Update(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	if v.source != timeline.SourceAlarm || len(v.visible) != 1 {
+		t.Errorf("source filter = %q with %d entries, want alarm with 1", v.source, len(v.visible))
+	}
+	v.source = ""
+
+	// w widens the window to include the month-old stack event
+	for v.windowLabel() != "last 7d" {
+		v.Update(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	}
+	v.source = timeline.SourceStack
+	v.applyFilter()
+	if len(v.visible) != 1 {
+		t.Errorf("stack events in 7d = %d, want 1", len(v.visible))
+	}
+
+	// Enter opens the stack the event belongs to
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return a command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("enter should navigate")
+	}
+	detail, ok := nav.View.(*DetailView)
+	if !ok || detail.service != "cloudformation" || detail.resType != "stacks" || detail.resource.GetID() != "web-stack" {
+		t.Errorf("enter navigated to %T, want the web-stack stack", nav.View)
+	}
+
+	// e opens the event itself
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if nav, ok := cmd().(NavigateMsg); !ok || nav.View.(*DetailView).resType != "events" {
+		t.Error("e should open the stack event")
+	}
+
+	if cmd := v.Init(); cmd != nil {
+		t.Error("Init() should not reload once loaded")
+	}
+}
+
+func TestCommandInput_TimelineCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+
+	ci.textInput.SetValue("timeline")
+	cmd, nav := ci.executeCommand()
+	if nav != nil || cmd == nil {
+		t.Fatal(":timeline should return a command")
+	}
+	if _, ok := cmd().(TimelineMsg); !ok {
+		t.Error(":timeline should send TimelineMsg")
+	}
+
+	ci.textInput.SetValue("timeline Env=prod")
+	_, nav = ci.executeCommand()
+	if nav == nil {
+		t.Fatal(":timeline Env=prod should navigate")
+	}
+	if v, ok := nav.View.(*TimelineView); !ok || v.tagFilter != "Env=prod" {
+		t.Errorf("navigated to %T, want tag timeline", nav.View)
+	}
+}
@@ -0,0 +1,512 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	transferHeaderHeight = 3 // title(1) + summary(1) + separator(1)
+	transferBarWidth     = 40
+)
+
+type transferPreparedMsg struct {
+	job *action.TransferJob
+	err error
+}
+
+type transferDoneMsg struct {
+	message string
+	err     error
+}
+
+// transferProgress is written by the running job and read on each redraw
+type transferProgress struct {
+	mu sync.Mutex
+	p  action.TransferProgress
+}
+
+func (p *transferProgress) set(tp action.TransferProgress) {
+	p.mu.Lock()
+	p.p = tp
+	p.mu.Unlock()
+}
+
+func (p *transferProgress) get() action.TransferProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.p
+}
+
+// transferStyles holds cached lipgloss styles for performance
+type transferStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	section lipgloss.Style
+	label   lipgloss.Style
+	ok      lipgloss.Style
+	warning lipgloss.Style
+	danger  lipgloss.Style
+	bold    lipgloss.Style
+	input   lipgloss.Style
+	box     lipgloss.Style
+}
+
+func newTransferStyles() transferStyles {
+	t := ui.Current()
+	return transferStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		section: ui.SectionStyle(),
+		label:   ui.DimStyle().Width(14),
+		ok:      ui.SuccessStyle(),
+		warning: ui.WarningStyle(),
+		danger:  ui.DangerStyle(),
+		bold:    ui.TextStyle().Bold(true),
+		input:   ui.InputStyle(),
+		box:     ui.BoxStyle().BorderForeground(t.Danger).MarginTop(1),
+	}
+}
+
+// TransferView runs a transfer action: it asks for the action's inputs,
+// counts what will be transferred, confirms with the count and size, and
+// shows progress while the job runs
+type TransferView struct {
+	ctx      context.Context
+	resource dao.Resource
+	act      action.Action
+
+	fields  []action.TransferField
+	inputs  []textinput.Model
+	focus   int
+	editing bool
+
+	job       *action.TransferJob
+	err       error
+	result    string
+	preparing bool
+	running   bool
+	cancelled bool
+	cancel    context.CancelFunc
+	progress  *transferProgress
+
+	dangerous dangerousState
+
+	vp      ViewportState
+	width   int
+	spinner spinner.Model
+	styles  transferStyles
+}
+
+// NewTransferView creates a view for an action with a Transfer
+func NewTransferView(ctx context.Context, resource dao.Resource, act action.Action) *TransferView {
+	v := &TransferView{
+		ctx:      ctx,
+		resource: resource,
+		act:      act,
+		progress: &transferProgress{},
+		spinner:  ui.NewSpinner(),
+		styles:   newTransferStyles(),
+	}
+	if act.Transfer != nil && act.Transfer.Fields != nil {
+		v.fields = act.Transfer.Fields(resource)
+	}
+	for _, f := range v.fields {
+		ti := textinput.New()
+		ti.Prompt = ""
+		ti.Placeholder = f.Placeholder
+		ti.CharLimit = 1024
+		ti.SetValue(f.Value)
+		v.inputs = append(v.inputs, ti)
+	}
+	v.editing = len(v.inputs) > 0
+	if v.editing {
+		v.inputs[0].Focus()
+		v.inputs[0].CursorEnd()
+	}
+	return v
+}
+
+// Init implements tea.Model
+func (v *TransferView) Init() tea.Cmd {
+	if v.editing {
+		return textinput.Blink
+	}
+	if v.job != nil {
+		return nil
+	}
+	return v.prepare()
+}
+
+// values returns the trimmed inputs by field key
+func (v *TransferView) values() map[string]string {
+	values := make(map[string]string, len(v.fields))
+	for i, f := range v.fields {
+		values[f.Key] = strings.TrimSpace(v.inputs[i].Value())
+	}
+	return values
+}
+
+func (v *TransferView) prepare() tea.Cmd {
+	values := v.values()
+	for _, f := range v.fields {
+		if !f.Optional && values[f.Key] == "" {
+			v.err = fmt.Errorf("%s is required", f.Label)
+			v.updateContent()
+			return nil
+		}
+	}
+	v.preparing = true
+	v.job = nil
+	v.err = nil
+	v.result = ""
+	v.updateContent()
+	ctx, act, resource := v.ctx, v.act, v.resource
+	return tea.Batch(func() tea.Msg {
+		job, err := action.PrepareTransfer(ctx, act, resource, values)
+		return transferPreparedMsg{job: job, err: err}
+	}, v.spinner.Tick)
+}
+
+func (v *TransferView) start() tea.Cmd {
+	ctx, cancel := context.WithCancel(v.ctx)
+	v.cancel = cancel
+	v.running = true
+	v.cancelled = false
+	v.err = nil
+	v.result = ""
+	v.progress = &transferProgress{}
+	v.updateContent()
+	job, progress := v.job, v.progress
+	return tea.Batch(func() tea.Msg {
+		defer cancel()
+		message, err := job.Run(ctx, progress.set)
+		return transferDoneMsg{message: message, err: err}
+	}, v.spinner.Tick)
+}
+
+// Update implements tea.Model
+func (v *TransferView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case transferPreparedMsg:
+		v.preparing = false
+		v.job = msg.job
+		v.err = msg.err
+		v.updateContent()
+		return v, nil
+
+	case transferDoneMsg:
+		v.running = false
+		v.cancel = nil
+		v.err = msg.err
+		v.result = msg.message
+		v.updateContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.preparing || v.running {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			v.updateContent()
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newTransferStyles()
+		v.updateContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch {
+		case v.editing:
+			return v, v.handleFormKey(msg)
+		case v.dangerous.active:
+			return v, v.handleConfirmKey(msg)
+		case v.running:
+			if IsEscKey(msg) && v.cancel != nil {
+				v.cancel()
+				v.cancelled = true
+				v.updateContent()
+			}
+			return v, nil
+		case IsEscKey(msg) || v.preparing:
+			return v, nil
+		}
+		switch msg.String() {
+		case "enter":
+			v.startConfirm()
+			return v, nil
+		case "e":
+			if len(v.inputs) > 0 {
+				v.editing = true
+				v.updateContent()
+				return v, v.inputs[v.focus].Focus()
+			}
+			return v, nil
+		case "ctrl+r":
+			return v, v.prepare()
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *TransferView) handleFormKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.inputs[v.focus].Blur()
+		v.updateContent()
+		return nil
+	case "enter":
+		v.editing = false
+		v.inputs[v.focus].Blur()
+		return v.prepare()
+	case "tab", "down":
+		return v.moveFocus(1)
+	case "shift+tab", "up":
+		return v.moveFocus(-1)
+	}
+	var cmd tea.Cmd
+	v.inputs[v.focus], cmd = v.inputs[v.focus].Update(msg)
+	v.updateContent()
+	return cmd
+}
+
+func (v *TransferView) moveFocus(delta int) tea.Cmd {
+	v.inputs[v.focus].Blur()
+	v.focus = (v.focus + delta + len(v.inputs)) % len(v.inputs)
+	cmd := v.inputs[v.focus].Focus()
+	v.inputs[v.focus].CursorEnd()
+	v.updateContent()
+	return cmd
+}
+
+// startConfirm asks for the dangerous confirmation of the prepared job
+func (v *TransferView) startConfirm() {
+	if v.job == nil || v.result != "" {
+		return
+	}
+	v.dangerous = dangerousState{active: true, token: v.job.Token}
+	v.updateContent()
+}
+
+func (v *TransferView) handleConfirmKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch {
+	case IsEscKey(msg):
+		v.dangerous = dangerousState{}
+	case msg.String() == "enter":
+		if !action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+			return nil
+		}
+		v.dangerous = dangerousState{}
+		return v.start()
+	case msg.Code == tea.KeyBackspace || msg.String() == "backspace":
+		if len(v.dangerous.input) > 0 {
+			v.dangerous.input = v.dangerous.input[:len(v.dangerous.input)-1]
+		}
+	case len(msg.String()) == 1:
+		v.dangerous.input += msg.String()
+	}
+	v.updateContent()
+	return nil
+}
+
+func (v *TransferView) updateContent() {
+	if !v.vp.Ready {
+		return
+	}
+	v.vp.Model.SetContent(v.renderBody())
+}
+
+func (v *TransferView) renderBody() string {
+	s := v.styles
+	var out strings.Builder
+	wrap := lipgloss.NewStyle().Width(max(v.width-4, 20))
+
+	if len(v.fields) > 0 {
+		for i, f := range v.fields {
+			value := v.inputs[i].View()
+			if !v.editing && v.inputs[i].Value() == "" {
+				value = s.dim.Render(f.Placeholder)
+			}
+			out.WriteString(s.label.Render(f.Label) + " " + value + "\n")
+		}
+		out.WriteString("\n")
+	}
+
+	switch {
+	case v.preparing:
+		out.WriteString(v.spinner.View() + " Counting objects...\n")
+	case v.job != nil:
+		out.WriteString(v.renderJob(wrap))
+	}
+
+	switch {
+	case v.err != nil && v.cancelled && errors.Is(v.err, context.Canceled):
+		out.WriteString("\n" + s.warning.Render("Cancelled") + "\n")
+	case v.err != nil:
+		msg := v.err.Error()
+		if kind := apperrors.Classify(v.err); kind != apperrors.Unknown {
+			msg = fmt.Sprintf("[%s] %s", kind, msg)
+		}
+		out.WriteString("\n" + s.danger.Render(wrap.Render(msg)) + "\n")
+	case v.result != "":
+		out.WriteString("\n" + s.ok.Render(wrap.Render("✓ "+v.result)) + "\n")
+	}
+
+	if v.dangerous.active {
+		out.WriteString(v.renderConfirm())
+	}
+	return out.String()
+}
+
+func (v *TransferView) renderJob(wrap lipgloss.Style) string {
+	s := v.styles
+	job := v.job
+	var out strings.Builder
+
+	out.WriteString(s.section.Render(job.Description) + "\n")
+	out.WriteString(fmt.Sprintf("  %d object(s), %s\n", job.Objects, render.FormatSize(job.Bytes)))
+	for _, w := range job.Warnings {
+		out.WriteString(s.warning.Render(wrap.Render("⚠ "+w)) + "\n")
+	}
+
+	if v.running || v.result != "" || v.err != nil {
+		p := v.progress.get()
+		out.WriteString("\n" + transferBar(p, job, min(transferBarWidth, max(v.width-30, 10))))
+		out.WriteString(fmt.Sprintf(" %d/%d", p.Objects, job.Objects))
+		if p.Bytes > 0 {
+			out.WriteString(fmt.Sprintf(" • %s / %s", render.FormatSize(p.Bytes), render.FormatSize(job.Bytes)))
+		}
+		out.WriteString("\n")
+		if v.running && p.Current != "" {
+			out.WriteString(s.dim.Render(TruncateString("  "+p.Current, v.width)) + "\n")
+		}
+	}
+	return out.String()
+}
+
+// transferBar shows bytes done, or objects done when the job does not
+// report bytes (deletes, empty files)
+func transferBar(p action.TransferProgress, job *action.TransferJob, width int) string {
+	if job.Bytes > 0 && p.Bytes > 0 {
+		return renderBar(float64(p.Bytes), float64(job.Bytes), width, ui.Current())
+	}
+	return renderBar(float64(p.Objects), float64(max(job.Objects, 1)), width, ui.Current())
+}
+
+func (v *TransferView) renderConfirm() string {
+	s := v.styles
+	t := ui.Current()
+
+	content := ui.BoldDangerStyle().Render("⚠ DANGER") + "\n\n"
+	content += fmt.Sprintf("You are about to %s:\n", s.danger.Render(v.act.Name))
+	content += fmt.Sprintf("%s (%d object(s), %s)\n", v.job.Description, v.job.Objects, render.FormatSize(v.job.Bytes))
+	content += s.bold.Render(v.dangerous.token) + "\n\n"
+
+	suffix := action.ConfirmSuffix(v.dangerous.token)
+	if len(suffix) < len(v.dangerous.token) {
+		content += fmt.Sprintf("Type last %d chars: ...%s\n", len(suffix), suffix)
+	} else {
+		content += "Type to confirm:\n"
+	}
+
+	inputStyle := s.input
+	if action.ConfirmMatches(v.dangerous.token, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Success)
+	} else if len(v.dangerous.input) > 0 && strings.HasPrefix(suffix, v.dangerous.input) {
+		inputStyle = inputStyle.BorderForeground(t.Warning)
+	}
+	content += inputStyle.Render(v.dangerous.input+"▌") + "\n\n"
+	content += s.dim.Render("Press Enter to confirm, Esc to cancel")
+
+	return "\n" + s.box.Render(content)
+}
+
+func (v *TransferView) renderHeader() string {
+	s := v.styles
+	title := s.title.Render(fmt.Sprintf("%s: %s", v.act.Name, v.resource.GetName()))
+
+	var summary string
+	switch {
+	case v.editing:
+		summary = "Enter the transfer details"
+	case v.preparing:
+		summary = "Counting objects..."
+	case v.running && v.cancelled:
+		summary = "Cancelling..."
+	case v.running:
+		summary = "Transferring..."
+	case v.result != "":
+		summary = "Done"
+	case v.job != nil:
+		summary = fmt.Sprintf("%d object(s), %s", v.job.Objects, render.FormatSize(v.job.Bytes))
+	}
+	return title + "\n" + s.dim.Render(TruncateString(summary, v.width)) + "\n" + strings.Repeat("─", v.width)
+}
+
+// ViewString returns the view content as a string
+func (v *TransferView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.renderHeader() + "\n" + v.vp.Model.View()
+}
+
+// View implements tea.Model
+func (v *TransferView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TransferView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height-transferHeaderHeight, 3))
+	for i := range v.inputs {
+		v.inputs[i].SetWidth(max(width-20, 10))
+	}
+	v.updateContent()
+	return nil
+}
+
+// StatusLine implements View
+func (v *TransferView) StatusLine() string {
+	switch {
+	case v.editing:
+		return "Tab:next field Enter:count • Esc:done editing"
+	case v.dangerous.active:
+		return "Type to confirm • Enter:run • Esc:cancel"
+	case v.running:
+		return "Transferring... • Esc:cancel"
+	case v.job != nil && v.result == "":
+		return "Enter:run e:edit ^r:recount • q/esc:back"
+	case len(v.inputs) > 0:
+		return "e:edit ^r:retry • q/esc:back"
+	default:
+		return "^r:retry • q/esc:back"
+	}
+}
+
+// HasActiveInput implements InputCapture. The view cannot be left while
+// the transfer runs.
+func (v *TransferView) HasActiveInput() bool {
+	return v.editing || v.dangerous.active || v.running
+}
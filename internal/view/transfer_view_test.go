@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// runTransferCmd runs a command, feeding transfer results back into the view
+func runTransferCmd(v *TransferView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runTransferCmd(v, c)
+		}
+	case transferPreparedMsg, transferDoneMsg:
+		v.Update(msg)
+	}
+}
+
+func newTestTransferAction(values *map[string]string, runErr error) action.Action {
+	return action.Action{
+		Name:      "Copy to...",
+		Type:      action.ActionTypeAPI,
+		Operation: "CopyThings",
+		Confirm:   action.ConfirmDangerous,
+		Transfer: &action.Transfer{
+			Fields: func(dao.Resource) []action.TransferField {
+				return []action.TransferField{
+					{Key: "dest", Label: "Destination", Value: "s3://b/"},
+					{Key: "profile", Label: "Profile", Optional: true},
+				}
+			},
+			Prepare: func(_ context.Context, _ dao.Resource, v map[string]string) (*action.TransferJob, error) {
+				*values = v
+				return &action.TransferJob{
+					Description: "Copy things to " + v["dest"],
+					Objects:     2,
+					Bytes:       2048,
+					Token:       v["dest"],
+					Warnings:    []string{"Existing objects are overwritten."},
+					Run: func(_ context.Context, progress func(action.TransferProgress)) (string, error) {
+						progress(action.TransferProgress{Objects: 2, Bytes: 2048})
+						if runErr != nil {
+							return "", runErr
+						}
+						return "Copied 2 object(s)", nil
+					},
+				}, nil
+			},
+		},
+	}
+}
+
+func TestTransferView_Run(t *testing.T) {
+	var values map[string]string
+	v := NewTransferView(context.Background(), &dao.BaseResource{ID: "s3://a/logs/", Name: "logs/"}, newTestTransferAction(&values, nil))
+	v.SetSize(100, 30)
+	if !v.HasActiveInput() {
+		t.Fatal("view should start editing the fields")
+	}
+
+	typeTransferKeys(v, "archive/")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	typeTransferKeys(v, " other ")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runTransferCmd(v, cmd)
+
+	if values["dest"] != "s3://b/archive/" || values["profile"] != "other" {
+		t.Errorf("values = %v, want trimmed dest and profile", values)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Copy things to s3://b/archive/", "2 object(s), 2.0 KiB", "overwritten"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view should contain %q, got:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !v.dangerous.active {
+		t.Fatal("Enter should open the confirmation")
+	}
+	typeTransferKeys(v, "wrong")
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); cmd != nil {
+		t.Fatal("wrong confirmation should not start the transfer")
+	}
+	v.dangerous.input = ""
+	typeTransferKeys(v, action.ConfirmSuffix("s3://b/archive/"))
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runTransferCmd(v, cmd)
+
+	if v.running || v.err != nil || v.result != "Copied 2 object(s)" {
+		t.Errorf("transfer should finish, result = %q, err = %v", v.result, v.err)
+	}
+	if !strings.Contains(v.ViewString(), "2/2") {
+		t.Error("view should show the final progress")
+	}
+	if v.HasActiveInput() {
+		t.Error("finished transfer should let the view be left")
+	}
+}
+
+func TestTransferView_RequiredField(t *testing.T) {
+	var values map[string]string
+	v := NewTransferView(context.Background(), &dao.BaseResource{ID: "x"}, newTestTransferAction(&values, nil))
+	v.SetSize(100, 30)
+	v.inputs[0].SetValue("  ")
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runTransferCmd(v, cmd)
+	if values != nil || v.err == nil || !strings.Contains(v.err.Error(), "Destination is required") {
+		t.Errorf("empty required field should not prepare, err = %v", v.err)
+	}
+}
+
+func TestTransferView_RunFailure(t *testing.T) {
+	var values map[string]string
+	v := NewTransferView(context.Background(), &dao.BaseResource{ID: "x"}, newTestTransferAction(&values, errors.New("access denied")))
+	v.SetSize(100, 30)
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runTransferCmd(v, cmd)
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	typeTransferKeys(v, action.ConfirmSuffix("s3://b/"))
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runTransferCmd(v, cmd)
+
+	if v.err == nil || !strings.Contains(v.ViewString(), "access denied") {
+		t.Errorf("view should show the transfer error, err = %v", v.err)
+	}
+}
+
+func typeTransferKeys(v *TransferView, s string) {
+	for _, r := range s {
+		v.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/iac"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -96,6 +100,23 @@ type DiffMsg struct {
 	RightID string // ID of right resource
 }
 
+// TimelineMsg tells the current view to open the incident timeline for its
+// selected resources (or the current row)
+type TimelineMsg struct{}
+
+// IaCMsg tells the current view to generate code for its selected
+// resources (or the current row)
+type IaCMsg struct {
+	Format iac.Format
+}
+
+// SnapshotSaveMsg tells the app to record a snapshot of resource types
+// If Targets is empty, the current view's resource type is used
+type SnapshotSaveMsg struct {
+	Name    string
+	Targets []string // "service" or "service/resource"
+}
+
 // ClearHistoryMsg tells the app to clear the navigation stack
 type ClearHistoryMsg struct{}
 
@@ -200,6 +221,18 @@ func (h *NavigationHelper) createCustomView(nav render.Navigation, resource dao.
 	switch nav.ViewType {
 	case render.ViewTypeLogView:
 		return h.createLogView(resource)
+	case render.ViewTypeItemExplorer:
+		return h.createItemExplorer(resource)
+	case render.ViewTypeRecordViewer:
+		return h.createRecordViewer(resource)
+	case render.ViewTypeSecretViewer:
+		return h.createSecretViewer(resource)
+	case render.ViewTypeParameterViewer:
+		return h.createParameterViewer(resource)
+	case render.ViewTypeParameterCompare:
+		return h.createParameterCompare(resource)
+	case render.ViewTypeMessagePeek:
+		return h.createMessagePeek(resource)
 	default:
 		return nil
 	}
@@ -234,6 +267,105 @@ func (h *NavigationHelper) createLogView(resource dao.Resource) tea.Cmd {
 	}
 }
 
+func (h *NavigationHelper) createItemExplorer(resource dao.Resource) tea.Cmd {
+	type tableProvider interface {
+		TableDescription() dynamodbtypes.TableDescription
+	}
+	p, ok := dao.UnwrapResource(resource).(tableProvider)
+	if !ok {
+		return nil
+	}
+
+	explorer := NewItemExplorerView(resourceContext(h.Ctx, resource), p.TableDescription())
+	return func() tea.Msg {
+		return NavigateMsg{View: explorer}
+	}
+}
+
+func (h *NavigationHelper) createRecordViewer(resource dao.Resource) tea.Cmd {
+	type streamProvider interface {
+		KinesisStream() (name, arn string)
+	}
+	type shardProvider interface{ ShardID() string }
+
+	unwrapped := dao.UnwrapResource(resource)
+	p, ok := unwrapped.(streamProvider)
+	if !ok {
+		return nil
+	}
+	var shardID string
+	if sp, ok := unwrapped.(shardProvider); ok {
+		shardID = sp.ShardID()
+	}
+
+	name, arn := p.KinesisStream()
+	viewer := NewRecordView(resourceContext(h.Ctx, resource), name, arn, shardID)
+	return func() tea.Msg {
+		return NavigateMsg{View: viewer}
+	}
+}
+
+func (h *NavigationHelper) createSecretViewer(resource dao.Resource) tea.Cmd {
+	type secretProvider interface{ SecretID() string }
+	p, ok := dao.UnwrapResource(resource).(secretProvider)
+	if !ok {
+		return nil
+	}
+	viewer := NewSecretView(resourceContext(h.Ctx, resource), p.SecretID())
+	return func() tea.Msg {
+		return NavigateMsg{View: viewer}
+	}
+}
+
+func (h *NavigationHelper) createParameterViewer(resource dao.Resource) tea.Cmd {
+	type parameterProvider interface{ ParameterName() string }
+	p, ok := dao.UnwrapResource(resource).(parameterProvider)
+	if !ok || p.ParameterName() == "" {
+		return nil
+	}
+	viewer := NewParameterView(resourceContext(h.Ctx, resource), p.ParameterName())
+	return func() tea.Msg {
+		return NavigateMsg{View: viewer}
+	}
+}
+
+func (h *NavigationHelper) createParameterCompare(resource dao.Resource) tea.Cmd {
+	type pathProvider interface{ ComparePath() string }
+	p, ok := dao.UnwrapResource(resource).(pathProvider)
+	if !ok {
+		return nil
+	}
+	compare := NewParameterCompareView(resourceContext(h.Ctx, resource), p.ComparePath())
+	return func() tea.Msg {
+		return NavigateMsg{View: compare}
+	}
+}
+
+func (h *NavigationHelper) createMessagePeek(resource dao.Resource) tea.Cmd {
+	type queueProvider interface{ QueueURL() string }
+	p, ok := dao.UnwrapResource(resource).(queueProvider)
+	if !ok {
+		return nil
+	}
+	peek := NewMessagePeekView(resourceContext(h.Ctx, resource), p.QueueURL())
+	return func() tea.Msg {
+		return NavigateMsg{View: peek}
+	}
+}
+
+// resourceContext returns ctx with the profile and region of a resource, so
+// AWS calls for a resource from a multi-profile or multi-region list, and
+// views opened from it, use its profile and region
+func resourceContext(ctx context.Context, resource dao.Resource) context.Context {
+	if profile := dao.GetResourceProfile(resource); profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+	if region := dao.GetResourceRegion(resource); region != "" {
+		ctx = aws.WithRegionOverride(ctx, region)
+	}
+	return ctx
+}
+
 // mergeResources merges the refreshed resource with the original to preserve
 // fields that are only available from List() but not from Get().
 func mergeResources(original, refreshed dao.Resource) dao.Resource {